@@ -0,0 +1,188 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	contractModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+const (
+	// auditRecordQueueSize is the number of the audit records waiting to be written by the background writer, the
+	// records are dropped once the queue is full so that the commands are never blocked by the audit log
+	auditRecordQueueSize = 1000
+)
+
+var (
+	asyncPurgeAuditRecordOnce  sync.Once
+	asyncWriteAuditRecordsOnce sync.Once
+	auditRecordQueue           = make(chan models.AuditRecord, auditRecordQueueSize)
+)
+
+// AddAuditRecord queues the audit record of the command issued at the given time if the audit log is enabled, which is
+// written to the database by the background writer started by AsyncWriteAuditRecords. The status and latency of the
+// record are derived from the status code and the issued time. The record is dropped with an error logged when the
+// queue is full, so that neither the latency nor the result of the command is affected by the audit log.
+func AddAuditRecord(record models.AuditRecord, issuedAt time.Time, dic *di.Container) {
+	if !commandContainer.ConfigurationFrom(dic.Get).AuditLog.Enabled {
+		return
+	}
+
+	record.Latency = time.Since(issuedAt).Milliseconds()
+	record.Status = contractModels.Failed
+	if record.StatusCode >= 200 && record.StatusCode < 300 {
+		record.Status = contractModels.Succeeded
+	}
+	select {
+	case auditRecordQueue <- record:
+	default:
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("The audit record queue is full, dropping the audit record of the %s command %s to device %s, Correlation-id: %s",
+			record.Method, record.CommandName, record.DeviceName, record.CorrelationId)
+	}
+}
+
+// AddGroupAuditRecords queues an audit record for each device of the group command results based on the given record,
+// which carries the common fields of the group command
+func AddGroupAuditRecords(record models.AuditRecord, results []commandDTO.DeviceCommandResult, issuedAt time.Time, dic *di.Container) {
+	for _, result := range results {
+		record.DeviceName = result.DeviceName
+		record.StatusCode = result.StatusCode
+		record.Message = result.Message
+		AddAuditRecord(record, issuedAt, dic)
+	}
+}
+
+// AsyncWriteAuditRecords writes the queued audit records to the database until the context is done, and then writes the
+// records still queued so that the commands issued before exiting are audited
+func AsyncWriteAuditRecords(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	asyncWriteAuditRecordsOnce.Do(func() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			for {
+				select {
+				case <-ctx.Done():
+					writeQueuedAuditRecords(context.WithoutCancel(ctx), dic)
+					lc.Info("Exiting audit records writer")
+					return
+				case record := <-auditRecordQueue:
+					writeAuditRecord(ctx, record, dic)
+				}
+			}
+		}()
+	})
+}
+
+// writeQueuedAuditRecords writes the audit records in the queue without waiting for more records
+func writeQueuedAuditRecords(ctx context.Context, dic *di.Container) {
+	for {
+		select {
+		case record := <-auditRecordQueue:
+			writeAuditRecord(ctx, record, dic)
+		default:
+			return
+		}
+	}
+}
+
+// writeAuditRecord persists the audit record, the failure is only logged since the command has been issued anyway
+func writeAuditRecord(ctx context.Context, record models.AuditRecord, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	record, err := commandContainer.DBClientFrom(dic.Get).AddAuditRecord(ctx, record)
+	if err != nil {
+		lc.Errorf("Failed to add the audit record of the %s command %s to device %s, Correlation-id: %s, %v",
+			record.Method, record.CommandName, record.DeviceName, record.CorrelationId, err)
+		return
+	}
+	lc.Debugf("Audit record %s is added for the %s command %s to device %s, Correlation-id: %s",
+		record.Id, record.Method, record.CommandName, record.DeviceName, record.CorrelationId)
+}
+
+// AuditRecords queries the audit records by the optional device name and status with the specified time range, offset
+// and limit, the latest ones come first
+func AuditRecords(ctx context.Context, deviceName, status string, start, end int64, offset, limit int, dic *di.Container) (records []commandDTO.AuditRecord, totalCount uint32, err errors.EdgeX) {
+	if status != "" && status != contractModels.Succeeded && status != contractModels.Failed {
+		return records, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("invalid status %s, only %s or %s is allowed", status, contractModels.Succeeded, contractModels.Failed), nil)
+	}
+
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.AuditRecordCount(ctx, deviceName, status, start, end)
+	if err != nil {
+		return records, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []commandDTO.AuditRecord{}, totalCount, err
+	}
+
+	models, err := dbClient.AuditRecords(ctx, deviceName, status, start, end, offset, limit)
+	if err != nil {
+		return records, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return commandDTO.FromAuditRecordModelsToDTOs(models), totalCount, nil
+}
+
+// AsyncPurgeAuditRecord purges the audit records exceeding the retention capacity every interval until the context is done
+func AsyncPurgeAuditRecord(ctx context.Context, dic *di.Container, interval time.Duration) {
+	asyncPurgeAuditRecordOnce.Do(func() {
+		go func() {
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			timer := time.NewTimer(interval)
+			for {
+				timer.Reset(interval)
+				select {
+				case <-ctx.Done():
+					lc.Info("Exiting audit records retention")
+					return
+				case <-timer.C:
+					lc.Info("Start checking the audit records and purge the outdated ones according to the retention settings")
+					err := purgeAuditRecord(ctx, dic)
+					if err != nil {
+						lc.Errorf("Failed to purge audit records, %v", err)
+						break
+					}
+				}
+			}
+		}()
+	})
+}
+
+func purgeAuditRecord(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	retention := commandContainer.ConfigurationFrom(dic.Get).AuditLog.Retention
+	total, err := dbClient.AuditRecordCount(ctx, "", "", 0, time.Now().UnixMilli())
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "failed to query audit record total count", err)
+	}
+	if total >= retention.MaxCap {
+		lc.Debugf("Purging the audit record amount %d to the minimum capacity %d", total, retention.MinCap)
+		record, err := dbClient.LatestAuditRecordByOffset(ctx, retention.MinCap)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query audit record with offset '%d'", retention.MinCap), err)
+		}
+		age := time.Now().UnixMilli() - record.Created
+		err = dbClient.DeleteAuditRecordsByAge(ctx, age)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete audit records by age '%d'", age), err)
+		}
+	}
+	return nil
+}
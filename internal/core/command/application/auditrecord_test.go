@@ -0,0 +1,199 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	contractModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// discardQueuedAuditRecords empties the audit record queue which may be filled by the other tests
+func discardQueuedAuditRecords() {
+	for {
+		select {
+		case <-auditRecordQueue:
+		default:
+			return
+		}
+	}
+}
+
+func TestAddAuditRecord(t *testing.T) {
+	ctx := context.Background()
+	discardQueuedAuditRecords()
+	tests := []struct {
+		name           string
+		enabled        bool
+		statusCode     int
+		expectedStatus string
+	}{
+		{"audit log disabled", false, http.StatusOK, ""},
+		{"succeeded command", true, http.StatusOK, contractModels.Succeeded},
+		{"failed command", true, http.StatusNotFound, contractModels.Failed},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddAuditRecord", ctx, mock.Anything).Return(models.AuditRecord{}, nil)
+			dic := mockDic(map[string]any{
+				commandContainer.ConfigurationName:     &config.ConfigurationStruct{AuditLog: config.AuditLogInfo{Enabled: testCase.enabled}},
+				commandContainer.DBClientInterfaceName: dbClientMock,
+			})
+
+			record := models.AuditRecord{DeviceName: testDeviceName, CommandName: testCommandName, StatusCode: testCase.statusCode}
+			AddAuditRecord(record, time.Now().Add(-time.Second), dic)
+			writeQueuedAuditRecords(ctx, dic)
+
+			if !testCase.enabled {
+				dbClientMock.AssertNotCalled(t, "AddAuditRecord", ctx, mock.Anything)
+				return
+			}
+			dbClientMock.AssertNumberOfCalls(t, "AddAuditRecord", 1)
+			added := dbClientMock.Calls[0].Arguments.Get(1).(models.AuditRecord)
+			assert.Equal(t, testCase.expectedStatus, added.Status)
+			assert.GreaterOrEqual(t, added.Latency, int64(1000))
+		})
+	}
+}
+
+func TestAddGroupAuditRecords(t *testing.T) {
+	ctx := context.Background()
+	discardQueuedAuditRecords()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddAuditRecord", ctx, mock.Anything).Return(models.AuditRecord{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed", nil))
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:     &config.ConfigurationStruct{AuditLog: config.AuditLogInfo{Enabled: true}},
+		commandContainer.DBClientInterfaceName: dbClientMock,
+	})
+
+	results := []commandDTO.DeviceCommandResult{
+		{DeviceName: "light1", StatusCode: http.StatusOK},
+		{DeviceName: "light2", StatusCode: http.StatusServiceUnavailable, Message: "device service unavailable"},
+	}
+	AddGroupAuditRecords(models.AuditRecord{CommandName: testCommandName}, results, time.Now(), dic)
+	dbClientMock.AssertNotCalled(t, "AddAuditRecord", ctx, mock.Anything)
+	writeQueuedAuditRecords(ctx, dic)
+
+	dbClientMock.AssertNumberOfCalls(t, "AddAuditRecord", len(results))
+	for i, result := range results {
+		added := dbClientMock.Calls[i].Arguments.Get(1).(models.AuditRecord)
+		assert.Equal(t, result.DeviceName, added.DeviceName)
+		assert.Equal(t, result.StatusCode, added.StatusCode)
+		assert.Equal(t, result.Message, added.Message)
+		assert.Equal(t, testCommandName, added.CommandName)
+	}
+}
+
+func TestAddAuditRecordQueueFull(t *testing.T) {
+	ctx := context.Background()
+	discardQueuedAuditRecords()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddAuditRecord", ctx, mock.Anything).Return(models.AuditRecord{}, nil)
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:     &config.ConfigurationStruct{AuditLog: config.AuditLogInfo{Enabled: true}},
+		commandContainer.DBClientInterfaceName: dbClientMock,
+	})
+
+	for i := 0; i <= auditRecordQueueSize; i++ {
+		AddAuditRecord(models.AuditRecord{DeviceName: testDeviceName, StatusCode: http.StatusOK}, time.Now(), dic)
+	}
+	writeQueuedAuditRecords(ctx, dic)
+
+	dbClientMock.AssertNumberOfCalls(t, "AddAuditRecord", auditRecordQueueSize)
+}
+
+func TestAuditRecords(t *testing.T) {
+	ctx := context.Background()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AuditRecordCount", ctx, testDeviceName, contractModels.Failed, int64(0), int64(100)).Return(uint32(2), nil)
+	dbClientMock.On("AuditRecords", ctx, testDeviceName, contractModels.Failed, int64(0), int64(100), 0, 10).
+		Return([]models.AuditRecord{{Id: "1", DeviceName: testDeviceName}, {Id: "2", DeviceName: testDeviceName}}, nil)
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:     &config.ConfigurationStruct{},
+		commandContainer.DBClientInterfaceName: dbClientMock,
+	})
+
+	tests := []struct {
+		name          string
+		status        string
+		offset        int
+		expectedCount int
+		errorKind     errors.ErrKind
+	}{
+		{"valid", contractModels.Failed, 0, 2, ""},
+		{"invalid status", "UNKNOWN", 0, 0, errors.KindContractInvalid},
+		{"offset out of range", contractModels.Failed, 3, 0, errors.KindRangeNotSatisfiable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			records, _, err := AuditRecords(ctx, testDeviceName, testCase.status, 0, 100, testCase.offset, 10, dic)
+			if testCase.errorKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.errorKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, records, testCase.expectedCount)
+		})
+	}
+}
+
+func TestPurgeAuditRecord(t *testing.T) {
+	ctx := context.Background()
+	configuration := &config.ConfigurationStruct{
+		AuditLog: config.AuditLogInfo{
+			Enabled: true,
+			Retention: config.RecordRetention{
+				Enabled:  true,
+				Interval: "1s",
+				MaxCap:   5,
+				MinCap:   3,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		recordCount uint32
+	}{
+		{"invoke audit record purging", configuration.AuditLog.Retention.MaxCap},
+		{"not invoke audit record purging", configuration.AuditLog.Retention.MinCap},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("LatestAuditRecordByOffset", ctx, configuration.AuditLog.Retention.MinCap).Return(models.AuditRecord{}, nil)
+			dbClientMock.On("AuditRecordCount", ctx, "", "", int64(0), mock.AnythingOfType("int64")).Return(testCase.recordCount, nil)
+			dbClientMock.On("DeleteAuditRecordsByAge", ctx, mock.AnythingOfType("int64")).Return(nil)
+			dic := mockDic(map[string]any{
+				commandContainer.ConfigurationName:     configuration,
+				commandContainer.DBClientInterfaceName: dbClientMock,
+			})
+
+			err := purgeAuditRecord(ctx, dic)
+			require.NoError(t, err)
+			if testCase.recordCount >= configuration.AuditLog.Retention.MaxCap {
+				dbClientMock.AssertCalled(t, "DeleteAuditRecordsByAge", ctx, mock.AnythingOfType("int64"))
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeleteAuditRecordsByAge", ctx, mock.AnythingOfType("int64"))
+			}
+		})
+	}
+}
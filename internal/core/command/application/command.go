@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -33,23 +33,18 @@ func AllCommands(offset int, limit int, dic *di.Container) (deviceCoreCommands [
 		return deviceCoreCommands, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	// retrieve device profile information through Metadata DeviceProfileClient
-	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
-	if dpc == nil {
-		return deviceCoreCommands, totalCount, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceProfileClient returned", nil)
-	}
-
 	// Prepare the url for command
 	configuration := commandContainer.ConfigurationFrom(dic.Get)
 	serviceUrl := configuration.Service.Url()
 
 	deviceCoreCommands = make([]dtos.DeviceCoreCommand, len(multiDevicesResponse.Devices))
 	for i, device := range multiDevicesResponse.Devices {
-		deviceProfileResponse, err := dpc.DeviceProfileByName(context.Background(), device.ProfileName)
+		// retrieve device profile information from the metadata cache or through Metadata DeviceProfileClient
+		profile, err := DeviceProfileByName(context.Background(), device.ProfileName, dic)
 		if err != nil {
 			return deviceCoreCommands, totalCount, errors.NewCommonEdgeXWrapper(err)
 		}
-		commands, err := buildCoreCommands(device.Name, serviceUrl, deviceProfileResponse.Profile)
+		commands, err := buildCoreCommands(device.Name, serviceUrl, profile)
 		if err != nil {
 			return nil, totalCount, errors.NewCommonEdgeXWrapper(err)
 		}
@@ -68,22 +63,14 @@ func CommandsByDeviceName(name string, dic *di.Container) (deviceCoreCommand dto
 		return deviceCoreCommand, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
 	}
 
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return deviceCoreCommand, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), name)
+	// retrieve device information from the metadata cache or through Metadata DeviceClient
+	device, err := DeviceByName(context.Background(), name, dic)
 	if err != nil {
 		return deviceCoreCommand, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	// retrieve device profile information through Metadata DeviceProfileClient
-	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
-	if dpc == nil {
-		return deviceCoreCommand, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceProfileClient returned", nil)
-	}
-	deviceProfileResponse, err := dpc.DeviceProfileByName(context.Background(), deviceResponse.Device.ProfileName)
+	// retrieve device profile information from the metadata cache or through Metadata DeviceProfileClient
+	profile, err := DeviceProfileByName(context.Background(), device.ProfileName, dic)
 	if err != nil {
 		return deviceCoreCommand, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -92,14 +79,14 @@ func CommandsByDeviceName(name string, dic *di.Container) (deviceCoreCommand dto
 	configuration := commandContainer.ConfigurationFrom(dic.Get)
 	serviceUrl := configuration.Service.Url()
 
-	commands, err := buildCoreCommands(deviceResponse.Device.Name, serviceUrl, deviceProfileResponse.Profile)
+	commands, err := buildCoreCommands(device.Name, serviceUrl, profile)
 	if err != nil {
 		return deviceCoreCommand, errors.NewCommonEdgeXWrapper(err)
 	}
 
 	deviceCoreCommand = dtos.DeviceCoreCommand{
-		DeviceName:   deviceResponse.Device.Name,
-		ProfileName:  deviceResponse.Device.ProfileName,
+		DeviceName:   device.Name,
+		ProfileName:  device.ProfileName,
 		CoreCommands: commands,
 	}
 	return deviceCoreCommand, nil
@@ -193,22 +180,14 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	// retrieve device information from the metadata cache or through Metadata DeviceClient
+	device, err := DeviceByName(context.Background(), deviceName, dic)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	// retrieve device service information through Metadata DeviceClient
-	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
-	if dsc == nil {
-		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
-	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	// retrieve device service information from the metadata cache or through Metadata DeviceServiceClient
+	deviceService, err := DeviceServiceByName(context.Background(), device.ServiceName, dic)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -218,7 +197,7 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	res, err = dscc.GetCommand(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams)
+	res, err = dscc.GetCommand(context.Background(), deviceService.BaseAddress, deviceName, commandName, queryParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -237,22 +216,22 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	// retrieve device information from the metadata cache or through Metadata DeviceClient
+	device, err := DeviceByName(context.Background(), deviceName, dic)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	// retrieve device service information through Metadata DeviceClient
-	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
-	if dsc == nil {
-		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
+	// validate the settings against the device profile before bothering the device service
+	if commandContainer.ConfigurationFrom(dic.Get).CommandValidation.Enabled {
+		err = validateSetCommandSettings(context.Background(), device, commandName, settings, dic)
+		if err != nil {
+			return response, errors.NewCommonEdgeXWrapper(err)
+		}
 	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+
+	// retrieve device service information from the metadata cache or through Metadata DeviceServiceClient
+	deviceService, err := DeviceServiceByName(context.Background(), device.ServiceName, dic)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -262,5 +241,5 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	return dscc.SetCommandWithObject(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams, settings)
+	return dscc.SetCommandWithObject(context.Background(), deviceService.BaseAddress, deviceName, commandName, queryParams, settings)
 }
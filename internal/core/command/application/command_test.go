@@ -10,9 +10,16 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,6 +36,31 @@ const (
 	command2       = "cmd2"
 )
 
+// mockDic returns the mock DIC with the default configuration and the mock logging client, the dependencies of the test
+// are registered by their names and override the defaults
+func mockDic(dependencies map[string]any) *di.Container {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service: bootstrapConfig.ServiceInfo{
+					MaxResultCount: 20,
+				},
+			}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+	for name, dependency := range dependencies {
+		dic.Update(di.ServiceConstructorMap{
+			name: func(get di.Get) interface{} {
+				return dependency
+			},
+		})
+	}
+	return dic
+}
+
 func TestBuildCoreCommands(t *testing.T) {
 	profile := dtos.DeviceProfile{
 		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "testProfile"},
@@ -0,0 +1,245 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// LoadCommandPolicyCache loads all the command policies from the database into a new CommandPolicyCache
+func LoadCommandPolicyCache(ctx context.Context, dic *di.Container) (cache.CommandPolicyCache, errors.EdgeX) {
+	policies, err := commandContainer.DBClientFrom(dic.Get).AllCommandPolicies(ctx, 0, -1)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return cache.NewCommandPolicyCache(policies), nil
+}
+
+// AddCommandPolicy adds a new command policy
+func AddCommandPolicy(ctx context.Context, policy models.CommandPolicy, dic *di.Container) (string, errors.EdgeX) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if err := validateCommandPolicy(policy); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	addedPolicy, err := commandContainer.DBClientFrom(dic.Get).AddCommandPolicy(ctx, policy)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if policyCache := commandContainer.CommandPolicyCacheFrom(dic.Get); policyCache != nil {
+		policyCache.SetCommandPolicy(addedPolicy)
+	}
+
+	lc.Debugf("Command policy created on DB successfully. CommandPolicy ID: %s, Correlation-ID: %s ",
+		addedPolicy.Id, correlation.FromContext(ctx))
+	return addedPolicy.Id, nil
+}
+
+// CommandPolicyByName queries the command policy by name
+func CommandPolicyByName(ctx context.Context, name string, dic *di.Container) (dto commandDTO.CommandPolicy, err errors.EdgeX) {
+	if name == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	policy, err := commandContainer.DBClientFrom(dic.Get).CommandPolicyByName(ctx, name)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	return commandDTO.FromCommandPolicyModelToDTO(policy), nil
+}
+
+// AllCommandPolicies queries the command policies with the specified offset and limit
+func AllCommandPolicies(ctx context.Context, offset, limit int, dic *di.Container) (policies []commandDTO.CommandPolicy, totalCount uint32, err errors.EdgeX) {
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+
+	totalCount, err = dbClient.CommandPolicyTotalCount(ctx)
+	if err != nil {
+		return policies, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []commandDTO.CommandPolicy{}, totalCount, err
+	}
+
+	policyModels, err := dbClient.AllCommandPolicies(ctx, offset, limit)
+	if err != nil {
+		return policies, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return commandDTO.FromCommandPolicyModelsToDTOs(policyModels), totalCount, nil
+}
+
+// PatchCommandPolicy executes the PATCH operation with the DTO to replace the old data
+func PatchCommandPolicy(ctx context.Context, dto commandDTO.UpdateCommandPolicy, dic *di.Container) errors.EdgeX {
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	policy, err := commandPolicyByDTO(ctx, dbClient, dto)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	requests.ReplaceCommandPolicyModelFieldsWithDTO(&policy, dto)
+	if err = validateCommandPolicy(policy); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = dbClient.UpdateCommandPolicy(ctx, policy)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if policyCache := commandContainer.CommandPolicyCacheFrom(dic.Get); policyCache != nil {
+		policyCache.SetCommandPolicy(policy)
+	}
+
+	lc.Debugf("Command policy patched on DB successfully. CommandPolicy ID: %s, Correlation-ID: %s ",
+		policy.Id, correlation.FromContext(ctx))
+	return nil
+}
+
+func commandPolicyByDTO(ctx context.Context, dbClient interfaces.DBClient, dto commandDTO.UpdateCommandPolicy) (policy models.CommandPolicy, err errors.EdgeX) {
+	// The ID or Name is required by DTO and the DTO also accepts empty string ID if the Name is provided
+	if dto.Id != nil && *dto.Id != "" {
+		policy, err = dbClient.CommandPolicyById(ctx, *dto.Id)
+		if err != nil {
+			return policy, errors.NewCommonEdgeXWrapper(err)
+		}
+	} else {
+		policy, err = dbClient.CommandPolicyByName(ctx, *dto.Name)
+		if err != nil {
+			return policy, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if dto.Name != nil && *dto.Name != policy.Name {
+		return policy, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command policy name '%s' not match the existing '%s' ", *dto.Name, policy.Name), nil)
+	}
+	return policy, nil
+}
+
+// validateCommandPolicy checks that the policy matching the issuers or subjects only applies to the REST requests, since
+// only the REST requests carry the JWT identifying the caller
+func validateCommandPolicy(policy models.CommandPolicy) errors.EdgeX {
+	if len(policy.Issuers) == 0 && len(policy.Subjects) == 0 {
+		return nil
+	}
+	if len(policy.Sources) == 0 || slices.ContainsFunc(policy.Sources, func(source string) bool { return source != models.AuditSourceREST }) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("command policy %s matches the issuers or subjects, which only identify the callers of the %s requests, so its sources must be %s",
+				policy.Name, models.AuditSourceREST, models.AuditSourceREST), nil)
+	}
+	return nil
+}
+
+// AuthorizeCommandPolicyAdmin checks whether the caller is allowed to add, patch and delete the command policies, i.e. the
+// subject of the caller is one of the AccessControl.AdminSubjects and the issuer is the AccessControl.AdminIssuer if
+// configured. The KindForbidden error is returned otherwise. The caller isn't checked when the authentication handler
+// doesn't verify the JWT, since no caller can be identified and the whole API is unauthenticated then.
+func AuthorizeCommandPolicyAdmin(caller models.CommandCaller, dic *di.Container) errors.EdgeX {
+	if !utils.JWTValidationEnabled() {
+		return nil
+	}
+	accessControl := commandContainer.ConfigurationFrom(dic.Get).AccessControl
+	if caller.Subject != "" && slices.Contains(accessControl.AdminSubjects, caller.Subject) &&
+		(accessControl.AdminIssuer == "" || caller.Issuer == accessControl.AdminIssuer) {
+		return nil
+	}
+	return errors.NewCommonEdgeX(errors.KindForbidden,
+		fmt.Sprintf("caller %s is not allowed to manage the command policies", caller.Subject), nil)
+}
+
+// DeleteCommandPolicyByName deletes the command policy by name
+func DeleteCommandPolicyByName(ctx context.Context, name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	err := commandContainer.DBClientFrom(dic.Get).DeleteCommandPolicyByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if policyCache := commandContainer.CommandPolicyCacheFrom(dic.Get); policyCache != nil {
+		policyCache.RemoveCommandPolicy(name)
+	}
+	return nil
+}
+
+// AuthorizeCommand evaluates the command policies against the command requested by the caller. The command is denied
+// when any DENY policy matches, otherwise it is allowed when any ALLOW policy matches, and the AccessControl.DefaultEffect
+// applies when no policy matches. The KindForbidden error is returned when the command is denied, and the policies are
+// not evaluated when the access control is disabled.
+func AuthorizeCommand(ctx context.Context, deviceName, commandName, method string, caller models.CommandCaller, dic *di.Container) errors.EdgeX {
+	policyCache := commandContainer.CommandPolicyCacheFrom(dic.Get)
+	if policyCache == nil {
+		return nil
+	}
+
+	var deviceLabels []string
+	deviceLabelsQueried := false
+	allowed := false
+	for _, policy := range policyCache.CommandPolicies() {
+		if !matchPatterns(policy.DeviceNames, deviceName) || !matchPatterns(policy.CommandNames, commandName) ||
+			!matchValues(policy.Methods, method) || !matchValues(policy.Sources, caller.Source) ||
+			!matchValues(policy.Issuers, caller.Issuer) || !matchValues(policy.Subjects, caller.Subject) {
+			continue
+		}
+		if len(policy.DeviceLabels) > 0 {
+			// the device is only queried when the policy matching the other fields requires the device labels
+			if !deviceLabelsQueried {
+				device, err := DeviceByName(ctx, deviceName, dic)
+				if err != nil {
+					return errors.NewCommonEdgeXWrapper(err)
+				}
+				deviceLabels, deviceLabelsQueried = device.Labels, true
+			}
+			if !slices.ContainsFunc(policy.DeviceLabels, func(label string) bool { return slices.Contains(deviceLabels, label) }) {
+				continue
+			}
+		}
+
+		if policy.Effect == models.PolicyEffectDeny {
+			return errors.NewCommonEdgeX(errors.KindForbidden,
+				fmt.Sprintf("%s command %s to device %s is denied by command policy %s", method, commandName, deviceName, policy.Name), nil)
+		}
+		allowed = true
+	}
+
+	if !allowed && commandContainer.ConfigurationFrom(dic.Get).AccessControl.DefaultEffect == models.PolicyEffectDeny {
+		return errors.NewCommonEdgeX(errors.KindForbidden,
+			fmt.Sprintf("%s command %s to device %s is not allowed by any command policy", method, commandName, deviceName), nil)
+	}
+	return nil
+}
+
+// matchPatterns checks whether the value matches any of the shell patterns, the empty patterns match any value
+func matchPatterns(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		matched, _ := path.Match(pattern, value)
+		return matched
+	})
+}
+
+// matchValues checks whether the value equals any of the values, the empty values match any value
+func matchValues(values []string, value string) bool {
+	return len(values) == 0 || slices.Contains(values, value)
+}
@@ -0,0 +1,226 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+func TestAuthorizeCommand(t *testing.T) {
+	restCaller := models.CommandCaller{Issuer: "/v1/identity/oidc", Subject: "operator", Source: models.AuditSourceREST}
+	busCaller := models.CommandCaller{Source: models.AuditSourceMessageBus}
+	policies := []models.CommandPolicy{
+		{Name: "deny-set-to-locks", Effect: models.PolicyEffectDeny, DeviceNames: []string{"lock-*"}, Methods: []string{constants.CommandMethodSet}},
+		{Name: "allow-operator", Effect: models.PolicyEffectAllow, Subjects: []string{"operator"}, Sources: []string{models.AuditSourceREST}},
+		{Name: "allow-bus-reads", Effect: models.PolicyEffectAllow, Methods: []string{constants.CommandMethodGet}, Sources: []string{models.AuditSourceMessageBus}},
+		{Name: "deny-critical", Effect: models.PolicyEffectDeny, DeviceLabels: []string{"critical"}, CommandNames: []string{"reboot"}},
+	}
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, "pump").Return(responses.DeviceResponse{Device: dtos.Device{Name: "pump", Labels: []string{"critical"}}}, nil)
+	dcMock.On("DeviceByName", mock.Anything, testMissingDevice).
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+
+	tests := []struct {
+		name          string
+		defaultEffect string
+		deviceName    string
+		commandName   string
+		method        string
+		caller        models.CommandCaller
+		expectedCode  int
+	}{
+		{"allowed by subject", models.PolicyEffectDeny, "light-1", "switch", constants.CommandMethodSet, restCaller, 0},
+		{"denied by device name pattern", models.PolicyEffectAllow, "lock-1", "open", constants.CommandMethodSet, restCaller, http.StatusForbidden},
+		{"not denied with other method", models.PolicyEffectDeny, "lock-1", "state", constants.CommandMethodGet, restCaller, 0},
+		{"allowed by source and method", models.PolicyEffectDeny, "light-1", "state", constants.CommandMethodGet, busCaller, 0},
+		{"denied by default", models.PolicyEffectDeny, "light-1", "switch", constants.CommandMethodSet, busCaller, http.StatusForbidden},
+		{"allowed by default", models.PolicyEffectAllow, "light-1", "switch", constants.CommandMethodSet, busCaller, 0},
+		{"denied by device label", models.PolicyEffectAllow, "pump", "reboot", constants.CommandMethodSet, restCaller, http.StatusForbidden},
+		{"device of label policy not found", models.PolicyEffectAllow, testMissingDevice, "reboot", constants.CommandMethodSet, restCaller, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic(map[string]any{
+				commandContainer.ConfigurationName:               &config.ConfigurationStruct{AccessControl: config.AccessControlInfo{Enabled: true, DefaultEffect: testCase.defaultEffect}},
+				commandContainer.DBClientInterfaceName:           &dbMock.DBClient{},
+				commandContainer.CommandPolicyCacheInterfaceName: cache.NewCommandPolicyCache(policies),
+				bootstrapContainer.DeviceClientName:              dcMock,
+			})
+
+			err := AuthorizeCommand(context.Background(), testCase.deviceName, testCase.commandName, testCase.method, testCase.caller, dic)
+			if testCase.expectedCode == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedCode, err.Code())
+		})
+	}
+
+	t.Run("access control disabled", func(t *testing.T) {
+		dic := mockDic(map[string]any{
+			commandContainer.ConfigurationName:     &config.ConfigurationStruct{AccessControl: config.AccessControlInfo{Enabled: false, DefaultEffect: models.PolicyEffectDeny}},
+			commandContainer.DBClientInterfaceName: &dbMock.DBClient{},
+		})
+		err := AuthorizeCommand(context.Background(), "lock-1", "open", constants.CommandMethodSet, busCaller, dic)
+		require.NoError(t, err)
+	})
+}
+
+func TestAddCommandPolicy(t *testing.T) {
+	ctx := context.Background()
+	policy := models.CommandPolicy{Name: "deny-locks", Effect: models.PolicyEffectDeny, DeviceNames: []string{"lock-*"}}
+	added := policy
+	added.Id = "c0a3a1ff-1bd0-4c38-8e0c-3a3b0d0fbe5c"
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddCommandPolicy", ctx, policy).Return(added, nil)
+	dbClientMock.On("AddCommandPolicy", ctx, mock.Anything).Return(models.CommandPolicy{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "exists", nil))
+	policyCache := cache.NewCommandPolicyCache(nil)
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:               &config.ConfigurationStruct{AccessControl: config.AccessControlInfo{Enabled: true, DefaultEffect: models.PolicyEffectAllow}},
+		commandContainer.DBClientInterfaceName:           dbClientMock,
+		commandContainer.CommandPolicyCacheInterfaceName: policyCache,
+	})
+
+	id, err := AddCommandPolicy(ctx, policy, dic)
+	require.NoError(t, err)
+	assert.Equal(t, added.Id, id)
+	assert.Equal(t, []models.CommandPolicy{added}, policyCache.CommandPolicies())
+
+	_, err = AddCommandPolicy(ctx, models.CommandPolicy{Name: "duplicate"}, dic)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, err.Code())
+	assert.Len(t, policyCache.CommandPolicies(), 1)
+
+	// the subjects never match the callers from the MessageBus, which carry no JWT
+	for _, sources := range [][]string{nil, {models.AuditSourceREST, models.AuditSourceMessageBus}} {
+		_, err = AddCommandPolicy(ctx, models.CommandPolicy{Name: "allow-operator", Effect: models.PolicyEffectAllow, Subjects: []string{"operator"}, Sources: sources}, dic)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.Code())
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddCommandPolicy", 2)
+}
+
+func TestAuthorizeCommandPolicyAdmin(t *testing.T) {
+	issuer := "/v1/identity/oidc"
+	tests := []struct {
+		name          string
+		adminIssuer   string
+		caller        models.CommandCaller
+		expectedError bool
+	}{
+		{"admin", "", models.CommandCaller{Subject: "admin"}, false},
+		{"admin of the issuer", issuer, models.CommandCaller{Issuer: issuer, Subject: "admin"}, false},
+		{"admin of another issuer", issuer, models.CommandCaller{Issuer: "other", Subject: "admin"}, true},
+		{"not an admin", "", models.CommandCaller{Subject: "operator"}, true},
+		{"no caller", "", models.CommandCaller{}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic(map[string]any{
+				commandContainer.ConfigurationName: &config.ConfigurationStruct{AccessControl: config.AccessControlInfo{AdminIssuer: testCase.adminIssuer, AdminSubjects: []string{"admin"}}},
+			})
+			err := AuthorizeCommandPolicyAdmin(testCase.caller, dic)
+			if !testCase.expectedError {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, http.StatusForbidden, err.Code())
+		})
+	}
+
+	t.Run("JWT validation disabled", func(t *testing.T) {
+		t.Setenv("EDGEX_DISABLE_JWT_VALIDATION", "true")
+		err := AuthorizeCommandPolicyAdmin(models.CommandCaller{}, mockDic(nil))
+		require.NoError(t, err, "no caller can be identified without the JWT validation")
+	})
+}
+
+func TestPatchCommandPolicy(t *testing.T) {
+	ctx := context.Background()
+	id := "c0a3a1ff-1bd0-4c38-8e0c-3a3b0d0fbe5c"
+	name := "deny-locks"
+	otherName := "other"
+	allow := models.PolicyEffectAllow
+	existing := models.CommandPolicy{Id: id, Name: name, Effect: models.PolicyEffectDeny, DeviceNames: []string{"lock-*"}}
+	patched := existing
+	patched.Effect = allow
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandPolicyById", ctx, id).Return(existing, nil)
+	dbClientMock.On("CommandPolicyByName", ctx, name).Return(existing, nil)
+	dbClientMock.On("UpdateCommandPolicy", ctx, patched).Return(nil)
+	policyCache := cache.NewCommandPolicyCache([]models.CommandPolicy{existing})
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:               &config.ConfigurationStruct{AccessControl: config.AccessControlInfo{Enabled: true, DefaultEffect: models.PolicyEffectAllow}},
+		commandContainer.DBClientInterfaceName:           dbClientMock,
+		commandContainer.CommandPolicyCacheInterfaceName: policyCache,
+	})
+
+	tests := []struct {
+		name         string
+		dto          commandDTO.UpdateCommandPolicy
+		expectedCode int
+	}{
+		{"patch by id", commandDTO.UpdateCommandPolicy{Id: &id, Effect: &allow}, 0},
+		{"patch by name", commandDTO.UpdateCommandPolicy{Name: &name, Effect: &allow}, 0},
+		{"name not match the id", commandDTO.UpdateCommandPolicy{Id: &id, Name: &otherName, Effect: &allow}, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := PatchCommandPolicy(ctx, testCase.dto, dic)
+			if testCase.expectedCode == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, []models.CommandPolicy{patched}, policyCache.CommandPolicies())
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedCode, err.Code())
+		})
+	}
+}
+
+func TestDeleteCommandPolicyByName(t *testing.T) {
+	ctx := context.Background()
+	policy := models.CommandPolicy{Name: "deny-locks", Effect: models.PolicyEffectDeny}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteCommandPolicyByName", ctx, policy.Name).Return(nil)
+	policyCache := cache.NewCommandPolicyCache([]models.CommandPolicy{policy})
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:               &config.ConfigurationStruct{AccessControl: config.AccessControlInfo{Enabled: true, DefaultEffect: models.PolicyEffectAllow}},
+		commandContainer.DBClientInterfaceName:           dbClientMock,
+		commandContainer.CommandPolicyCacheInterfaceName: policyCache,
+	})
+
+	err := DeleteCommandPolicyByName(ctx, "", dic)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.Code())
+
+	err = DeleteCommandPolicyByName(ctx, policy.Name, dic)
+	require.NoError(t, err)
+	assert.Empty(t, policyCache.CommandPolicies())
+}
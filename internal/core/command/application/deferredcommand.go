@@ -0,0 +1,421 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	contractModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// deferredCommandPurgeInterval is how often the finished deferred commands are checked against the retention
+const deferredCommandPurgeInterval = time.Hour
+
+var (
+	asyncDeliverDeferredCommandsOnce sync.Once
+	// deferredCommandWakeUp signals the delivery worker to retry the pending commands of the woken devices and device
+	// services early
+	deferredCommandWakeUp = make(chan struct{}, 1)
+	wokenDeferredCommands = &deferredCommandWakeUps{}
+)
+
+// deferredCommandWakeUps holds the names of the devices and device services which became available since the last
+// wake-up was handled
+type deferredCommandWakeUps struct {
+	mutex    sync.Mutex
+	devices  map[string]struct{}
+	services map[string]struct{}
+}
+
+func (w *deferredCommandWakeUps) add(deviceName, serviceName string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if deviceName != "" {
+		if w.devices == nil {
+			w.devices = make(map[string]struct{})
+		}
+		w.devices[deviceName] = struct{}{}
+	}
+	if serviceName != "" {
+		if w.services == nil {
+			w.services = make(map[string]struct{})
+		}
+		w.services[serviceName] = struct{}{}
+	}
+}
+
+// take returns the woken devices and device services and resets them
+func (w *deferredCommandWakeUps) take() (devices, services map[string]struct{}) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	devices, services = w.devices, w.services
+	w.devices, w.services = nil, nil
+	return devices, services
+}
+
+// deferredCommandDurations are the parsed durations of the DeferredCommand configuration
+type deferredCommandDurations struct {
+	defaultTTL       time.Duration
+	maxTTL           time.Duration
+	retryInterval    time.Duration
+	maxRetryInterval time.Duration
+	retention        time.Duration
+}
+
+func deferredCommandDurationsFrom(dic *di.Container) (d deferredCommandDurations, err errors.EdgeX) {
+	config := commandContainer.ConfigurationFrom(dic.Get).DeferredCommand
+	for _, field := range []struct {
+		name  string
+		value string
+		out   *time.Duration
+	}{
+		{"DefaultTTL", config.DefaultTTL, &d.defaultTTL},
+		{"MaxTTL", config.MaxTTL, &d.maxTTL},
+		{"RetryInterval", config.RetryInterval, &d.retryInterval},
+		{"MaxRetryInterval", config.MaxRetryInterval, &d.maxRetryInterval},
+		{"Retention", config.Retention, &d.retention},
+	} {
+		duration, parseErr := time.ParseDuration(field.value)
+		if parseErr != nil {
+			return d, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse DeferredCommand.%s '%s'", field.name, field.value), parseErr)
+		}
+		if duration <= 0 {
+			return d, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeferredCommand.%s '%s' must be greater than zero", field.name, field.value), nil)
+		}
+		*field.out = duration
+	}
+	return d, nil
+}
+
+// DeferredCommandTTL returns the ttl of a deferred command parsed from the request, the default ttl is returned if the
+// requested one is empty
+func DeferredCommandTTL(ttl string, dic *di.Container) (time.Duration, errors.EdgeX) {
+	d, err := deferredCommandDurationsFrom(dic)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindServerError, "invalid DeferredCommand configuration", err)
+	}
+	if ttl == "" {
+		return d.defaultTTL, nil
+	}
+	duration, parseErr := time.ParseDuration(ttl)
+	if parseErr != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse ttl '%s'", ttl), parseErr)
+	}
+	if duration <= 0 || duration > d.maxTTL {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("ttl '%s' must be greater than zero and no more than %s", ttl, d.maxTTL), nil)
+	}
+	return duration, nil
+}
+
+// IssueDeferrableSetCommand issues the set command to the device, and persists it as a deferred command to be retried
+// within the ttl if the device is unavailable. The id of the deferred command is returned if the command is deferred.
+func IssueDeferrableSetCommand(ctx context.Context, command models.DeferredCommand, ttl time.Duration, dic *di.Container) (response commonDTO.BaseResponse, deferredId string, err errors.EdgeX) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	if unavailable, reason := deviceUnavailable(ctx, command.DeviceName, dic); unavailable {
+		// the command isn't issued now, so the settings are validated before being deferred
+		err = ValidateSetCommand(ctx, command.DeviceName, command.CommandName, command.Settings, dic)
+		if err != nil {
+			return response, "", errors.NewCommonEdgeXWrapper(err)
+		}
+		command.Message = reason
+	} else {
+		response, err = IssueSetCommandByName(command.DeviceName, command.CommandName, command.QueryParams, command.Settings, dic)
+		if err == nil || !isDeferrableError(err) {
+			return response, "", err
+		}
+		command.Attempts = 1
+		command.StatusCode, command.Message = err.Code(), err.Error()
+	}
+
+	d, err := deferredCommandDurationsFrom(dic)
+	if err != nil {
+		return response, "", errors.NewCommonEdgeX(errors.KindServerError, "invalid DeferredCommand configuration", err)
+	}
+	now := pkgCommon.MakeTimestamp()
+	command.Created = now
+	command.Status = models.DeferredCommandStatusPending
+	command.ExpiresAt = now + ttl.Milliseconds()
+	command.NextAttemptAt = now + deferredCommandBackoff(command.Attempts, d).Milliseconds()
+	command, err = commandContainer.DBClientFrom(dic.Get).AddDeferredCommand(ctx, command)
+	if err != nil {
+		return response, "", errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("Set command %s to device %s is deferred as %s, Correlation-id: %s, %s",
+		command.CommandName, command.DeviceName, command.Id, command.CorrelationId, command.Message)
+	return response, command.Id, nil
+}
+
+// DeferredCommandById queries the deferred command by id
+func DeferredCommandById(ctx context.Context, id string, dic *di.Container) (commandDTO.DeferredCommand, errors.EdgeX) {
+	if id == "" {
+		return commandDTO.DeferredCommand{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	command, err := commandContainer.DBClientFrom(dic.Get).DeferredCommandById(ctx, id)
+	if err != nil {
+		return commandDTO.DeferredCommand{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return commandDTO.FromDeferredCommandModelToDTO(command), nil
+}
+
+// DeferredCommandsByStatus queries the deferred commands by the optional status with the specified offset and limit, the
+// latest ones come first
+func DeferredCommandsByStatus(ctx context.Context, status string, offset, limit int, dic *di.Container) (commands []commandDTO.DeferredCommand, totalCount uint32, err errors.EdgeX) {
+	statuses := []string{models.DeferredCommandStatusPending, models.DeferredCommandStatusDelivered, models.DeferredCommandStatusFailed, models.DeferredCommandStatusExpired}
+	if status != "" && !slices.Contains(statuses, status) {
+		return commands, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid status %s, only %v are allowed", status, statuses), nil)
+	}
+
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.DeferredCommandCountByStatus(ctx, status)
+	if err != nil {
+		return commands, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []commandDTO.DeferredCommand{}, totalCount, err
+	}
+
+	models, err := dbClient.DeferredCommandsByStatus(ctx, status, offset, limit)
+	if err != nil {
+		return commands, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return commandDTO.FromDeferredCommandModelsToDTOs(models), totalCount, nil
+}
+
+// WakeDeferredCommandDelivery retries the pending deferred commands of the device, or of all the devices of the device
+// service, without waiting for their backoff. It is called when a device or device service becomes available again,
+// either name may be empty.
+func WakeDeferredCommandDelivery(deviceName, serviceName string) {
+	wokenDeferredCommands.add(deviceName, serviceName)
+	select {
+	case deferredCommandWakeUp <- struct{}{}:
+	default:
+		// a wake-up is already signaled
+	}
+}
+
+// AsyncDeliverDeferredCommands retries the due pending deferred commands every retry interval, and purges the finished
+// ones exceeding the retention, until the context is done
+func AsyncDeliverDeferredCommands(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) errors.EdgeX {
+	d, err := deferredCommandDurationsFrom(dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	asyncDeliverDeferredCommandsOnce.Do(func() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			timer := time.NewTimer(d.retryInterval)
+			var lastPurged time.Time
+			for {
+				timer.Reset(d.retryInterval)
+				select {
+				case <-ctx.Done():
+					lc.Info("Exiting deferred commands delivery")
+					return
+				case <-deferredCommandWakeUp:
+					devices, services := wokenDeferredCommands.take()
+					deliverDeferredCommands(ctx, func(command models.DeferredCommand) bool {
+						return deferredCommandWoken(ctx, command, devices, services, dic)
+					}, d, dic)
+				case <-timer.C:
+					deliverDeferredCommands(ctx, nil, d, dic)
+					if time.Since(lastPurged) < deferredCommandPurgeInterval {
+						break
+					}
+					lastPurged = time.Now()
+					if err := commandContainer.DBClientFrom(dic.Get).DeleteDeferredCommandsByAge(ctx, d.retention.Milliseconds()); err != nil {
+						lc.Errorf("Failed to purge deferred commands, %v", err)
+					}
+				}
+			}
+		}()
+	})
+	return nil
+}
+
+// deferredCommandWoken returns whether the deferred command targets one of the woken devices, or a device of one of
+// the woken device services
+func deferredCommandWoken(ctx context.Context, command models.DeferredCommand, devices, services map[string]struct{}, dic *di.Container) bool {
+	if _, ok := devices[command.DeviceName]; ok {
+		return true
+	}
+	if len(services) == 0 {
+		return false
+	}
+	device, err := DeviceByName(ctx, command.DeviceName, dic)
+	if err != nil {
+		return false
+	}
+	_, ok := services[device.ServiceName]
+	return ok
+}
+
+// deliverDeferredCommands retries the pending deferred commands whose next attempt is due, and finishes the expired
+// ones. The commands matching woken are retried early, though not sooner than the retry interval after their last
+// attempt, so that a device flapping between up and down isn't flooded with the commands.
+func deliverDeferredCommands(ctx context.Context, woken func(models.DeferredCommand) bool, d deferredCommandDurations, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	commands, err := dbClient.DeferredCommandsByStatus(ctx, models.DeferredCommandStatusPending, 0, -1)
+	if err != nil {
+		lc.Errorf("Failed to query the pending deferred commands, %v", err)
+		return
+	}
+
+	for _, command := range commands {
+		now := pkgCommon.MakeTimestamp()
+		if now >= command.ExpiresAt {
+			message := fmt.Sprintf("expired after %d attempts", command.Attempts)
+			if command.Message != "" {
+				message = fmt.Sprintf("%s, last error: %s", message, command.Message)
+			}
+			finishDeferredCommand(ctx, command, models.DeferredCommandStatusExpired, http.StatusGatewayTimeout, message, dic)
+			continue
+		}
+		if command.NextAttemptAt > now {
+			lastAttemptAt := command.NextAttemptAt - deferredCommandBackoff(command.Attempts, d).Milliseconds()
+			if woken == nil || now < lastAttemptAt+d.retryInterval.Milliseconds() || !woken(command) {
+				continue
+			}
+		}
+
+		if unavailable, reason := deviceUnavailable(ctx, command.DeviceName, dic); unavailable {
+			// no attempt is made, so the backoff stays the same
+			command.Message = reason
+			command.NextAttemptAt = now + deferredCommandBackoff(command.Attempts, d).Milliseconds()
+		} else {
+			command.Attempts++
+			response, err := IssueSetCommandByName(command.DeviceName, command.CommandName, command.QueryParams, command.Settings, dic)
+			if err == nil {
+				finishDeferredCommand(ctx, command, models.DeferredCommandStatusDelivered, response.StatusCode, response.Message, dic)
+				continue
+			}
+			if !isDeferrableError(err) {
+				finishDeferredCommand(ctx, command, models.DeferredCommandStatusFailed, err.Code(), err.Error(), dic)
+				continue
+			}
+			command.StatusCode, command.Message = err.Code(), err.Error()
+			command.NextAttemptAt = now + deferredCommandBackoff(command.Attempts, d).Milliseconds()
+		}
+		if err := dbClient.UpdateDeferredCommand(ctx, command); err != nil {
+			lc.Errorf("Failed to update the deferred command %s, %v", command.Id, err)
+		}
+	}
+}
+
+// finishDeferredCommand persists the final status of the deferred command, records it in the audit log and publishes it
+// to the MessageBus
+func finishDeferredCommand(ctx context.Context, command models.DeferredCommand, status string, statusCode int, message string, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	command.Status, command.StatusCode, command.Message = status, statusCode, message
+	command.NextAttemptAt = 0
+	if err := commandContainer.DBClientFrom(dic.Get).UpdateDeferredCommand(ctx, command); err != nil {
+		lc.Errorf("Failed to update the deferred command %s to %s, %v", command.Id, status, err)
+		return
+	}
+	lc.Debugf("Deferred command %s of set command %s to device %s is %s, Correlation-id: %s",
+		command.Id, command.CommandName, command.DeviceName, status, command.CorrelationId)
+
+	AddAuditRecord(models.AuditRecord{
+		DeviceName:    command.DeviceName,
+		CommandName:   command.CommandName,
+		Method:        constants.CommandMethodSet,
+		Settings:      command.Settings,
+		Caller:        command.Caller,
+		Source:        command.Source,
+		CorrelationId: command.CorrelationId,
+		StatusCode:    statusCode,
+		Message:       message,
+	}, time.UnixMilli(command.Created), dic)
+	publishDeferredCommand(command, dic)
+}
+
+// publishDeferredCommand publishes the deferred command with its final status to the MessageBus topic
+// <base>/core/command/deferred/<status>/<device name>
+func publishDeferredCommand(command models.DeferredCommand, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if messagingClient == nil {
+		lc.Errorf("unable to publish the status of deferred command %s, MessageBus client not available", command.Id)
+		return
+	}
+	config := commandContainer.ConfigurationFrom(dic.Get)
+	topic := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
+		SetPath(config.MessageBus.GetBaseTopicPrefix()).SetPath(constants.CoreCommandDeferredCommandPublishTopic).
+		SetPath(command.Status).SetNameFieldPath(command.DeviceName).BuildPath()
+
+	ctx := context.WithValue(context.Background(), common.CorrelationHeader, command.CorrelationId) //nolint: staticcheck
+	ctx = context.WithValue(ctx, common.ContentType, common.ContentTypeJSON)                        //nolint: staticcheck
+	envelope := types.NewMessageEnvelope(commandDTO.FromDeferredCommandModelToDTO(command), ctx)
+	if err := messagingClient.Publish(envelope, topic); err != nil {
+		lc.Errorf("unable to publish the status of deferred command %s to topic '%s': %v", command.Id, topic, err)
+	}
+}
+
+// deviceUnavailable returns whether the device is known to be unavailable, i.e. the device is down or locked, or its
+// device service is locked, and the reason. The device is regarded as available if its metadata can't be retrieved, so
+// that the error is reported by the command itself.
+func deviceUnavailable(ctx context.Context, deviceName string, dic *di.Container) (bool, string) {
+	device, err := DeviceByName(ctx, deviceName, dic)
+	if err != nil {
+		return false, ""
+	}
+	if device.OperatingState == string(contractModels.Down) {
+		return true, fmt.Sprintf("device %s is %s", deviceName, contractModels.Down)
+	}
+	if device.AdminState == string(contractModels.Locked) {
+		return true, fmt.Sprintf("device %s is %s", deviceName, contractModels.Locked)
+	}
+	deviceService, err := DeviceServiceByName(ctx, device.ServiceName, dic)
+	if err != nil {
+		return false, ""
+	}
+	if deviceService.AdminState == string(contractModels.Locked) {
+		return true, fmt.Sprintf("device service %s is %s", deviceService.Name, contractModels.Locked)
+	}
+	return false, ""
+}
+
+// isDeferrableError returns whether the set command failed with the error is worth retrying later, i.e. the device
+// service is unreachable or locked
+func isDeferrableError(err errors.EdgeX) bool {
+	switch errors.Kind(err) {
+	case errors.KindServiceUnavailable, errors.KindCommunicationError, errors.KindServiceLocked:
+		return true
+	default:
+		return false
+	}
+}
+
+// deferredCommandBackoff returns the interval before the next attempt after the given number of attempts, which is the
+// retry interval doubled after each attempt and capped at the max retry interval
+func deferredCommandBackoff(attempts int, d deferredCommandDurations) time.Duration {
+	backoff := d.retryInterval
+	for i := 1; i < attempts && backoff < d.maxRetryInterval; i++ {
+		backoff *= 2
+	}
+	return min(backoff, d.maxRetryInterval)
+}
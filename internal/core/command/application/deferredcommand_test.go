@@ -0,0 +1,274 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	contractModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+const testDownDevice = "downDevice"
+
+var testDeferredCommandInfo = config.DeferredCommandInfo{
+	Enabled:          true,
+	DefaultTTL:       "1h",
+	MaxTTL:           "24h",
+	RetryInterval:    "10s",
+	MaxRetryInterval: "1m",
+	Retention:        "168h",
+}
+
+func newDeferredCommandClientMocks() (*mocks.DeviceClient, *mocks.DeviceServiceClient, *mocks.DeviceServiceCommandClient) {
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).
+		Return(responses.DeviceResponse{Device: dtos.Device{Name: testDeviceName, ServiceName: testServiceName, OperatingState: string(contractModels.Up)}}, nil)
+	dcMock.On("DeviceByName", mock.Anything, testDownDevice).
+		Return(responses.DeviceResponse{Device: dtos.Device{Name: testDownDevice, ServiceName: testServiceName, OperatingState: string(contractModels.Down)}}, nil)
+	dcMock.On("DeviceByName", mock.Anything, testBrokenDevice).
+		Return(responses.DeviceResponse{Device: dtos.Device{Name: testBrokenDevice, ServiceName: testBrokenService}}, nil)
+
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testServiceName).
+		Return(responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: testServiceName, BaseAddress: testBaseAddress}}, nil)
+	dscMock.On("DeviceServiceByName", mock.Anything, testBrokenService).
+		Return(responses.DeviceServiceResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "device service unavailable", nil))
+
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, mock.Anything, testCommandName, "", mock.Anything).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, mock.Anything, testFailedSetCommand, "", mock.Anything).
+		Return(commonDTO.BaseResponse{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid settings", nil))
+
+	return dcMock, dscMock, dsccMock
+}
+
+func TestDeferredCommandTTL(t *testing.T) {
+	dcMock, dscMock, dsccMock := newDeferredCommandClientMocks()
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:                &config.ConfigurationStruct{DeferredCommand: testDeferredCommandInfo},
+		commandContainer.DBClientInterfaceName:            &dbMock.DBClient{},
+		bootstrapContainer.MessagingClientName:            &messagingMocks.MessageClient{},
+		bootstrapContainer.DeviceClientName:               dcMock,
+		bootstrapContainer.DeviceServiceClientName:        dscMock,
+		bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+	})
+
+	tests := []struct {
+		name          string
+		ttl           string
+		expectedTTL   time.Duration
+		errorExpected bool
+	}{
+		{"default ttl", "", time.Hour, false},
+		{"requested ttl", "30m", 30 * time.Minute, false},
+		{"invalid ttl", "forever", 0, true},
+		{"negative ttl", "-1s", 0, true},
+		{"ttl exceeds max ttl", "48h", 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ttl, err := DeferredCommandTTL(testCase.ttl, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedTTL, ttl)
+		})
+	}
+}
+
+func TestDeferredCommandBackoff(t *testing.T) {
+	d := deferredCommandDurations{retryInterval: 10 * time.Second, maxRetryInterval: time.Minute}
+	assert.Equal(t, 10*time.Second, deferredCommandBackoff(0, d))
+	assert.Equal(t, 10*time.Second, deferredCommandBackoff(1, d))
+	assert.Equal(t, 20*time.Second, deferredCommandBackoff(2, d))
+	assert.Equal(t, 40*time.Second, deferredCommandBackoff(3, d))
+	assert.Equal(t, time.Minute, deferredCommandBackoff(4, d))
+	assert.Equal(t, time.Minute, deferredCommandBackoff(100, d))
+}
+
+func TestIssueDeferrableSetCommand(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name             string
+		deviceName       string
+		commandName      string
+		deferredExpected bool
+		expectedAttempts int
+		errorExpected    bool
+		errKind          errors.ErrKind
+	}{
+		{"delivered immediately", testDeviceName, testCommandName, false, 0, false, ""},
+		{"deferred - device is down", testDownDevice, testCommandName, true, 0, false, ""},
+		{"deferred - device service is unavailable", testBrokenDevice, testCommandName, true, 1, false, ""},
+		{"failed - not deferrable error", testDeviceName, testFailedSetCommand, false, 0, true, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddDeferredCommand", ctx, mock.Anything).Return(func(_ context.Context, c models.DeferredCommand) (models.DeferredCommand, errors.EdgeX) {
+				c.Id = "deferred-id"
+				return c, nil
+			})
+			dcMock, dscMock, dsccMock := newDeferredCommandClientMocks()
+			dic := mockDic(map[string]any{
+				commandContainer.ConfigurationName:                &config.ConfigurationStruct{DeferredCommand: testDeferredCommandInfo},
+				commandContainer.DBClientInterfaceName:            dbClientMock,
+				bootstrapContainer.MessagingClientName:            &messagingMocks.MessageClient{},
+				bootstrapContainer.DeviceClientName:               dcMock,
+				bootstrapContainer.DeviceServiceClientName:        dscMock,
+				bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+			})
+
+			command := models.DeferredCommand{DeviceName: testCase.deviceName, CommandName: testCase.commandName, Settings: map[string]any{"switch": "on"}}
+			_, deferredId, err := IssueDeferrableSetCommand(ctx, command, time.Hour, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.errKind, errors.Kind(err))
+				dbClientMock.AssertNotCalled(t, "AddDeferredCommand", ctx, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			if !testCase.deferredExpected {
+				assert.Empty(t, deferredId)
+				dbClientMock.AssertNotCalled(t, "AddDeferredCommand", ctx, mock.Anything)
+				return
+			}
+			assert.Equal(t, "deferred-id", deferredId)
+			added := dbClientMock.Calls[0].Arguments.Get(1).(models.DeferredCommand)
+			assert.Equal(t, models.DeferredCommandStatusPending, added.Status)
+			assert.Equal(t, testCase.expectedAttempts, added.Attempts)
+			assert.NotEmpty(t, added.Message)
+			assert.Equal(t, added.Created+time.Hour.Milliseconds(), added.ExpiresAt)
+			assert.Equal(t, added.Created+(10*time.Second).Milliseconds(), added.NextAttemptAt)
+		})
+	}
+}
+
+func TestDeliverDeferredCommands(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UnixMilli()
+	expired := models.DeferredCommand{Id: "expired", DeviceName: testDeviceName, CommandName: testCommandName, Attempts: 3, ExpiresAt: now - 1, Status: models.DeferredCommandStatusPending}
+	// attempted 10s ago with a backoff of 40s
+	notDue := models.DeferredCommand{Id: "notDue", DeviceName: testDeviceName, CommandName: testCommandName, Attempts: 3, ExpiresAt: now + time.Hour.Milliseconds(), NextAttemptAt: now + 30*time.Second.Milliseconds(), Status: models.DeferredCommandStatusPending}
+	// attempted 1s ago, i.e. within the retry interval
+	justAttempted := models.DeferredCommand{Id: "justAttempted", DeviceName: testDeviceName, CommandName: testCommandName, Attempts: 3, ExpiresAt: now + time.Hour.Milliseconds(), NextAttemptAt: now + 39*time.Second.Milliseconds(), Status: models.DeferredCommandStatusPending}
+	otherDevice := models.DeferredCommand{Id: "otherDevice", DeviceName: testBrokenDevice, CommandName: testCommandName, Attempts: 3, ExpiresAt: now + time.Hour.Milliseconds(), NextAttemptAt: now + 30*time.Second.Milliseconds(), Status: models.DeferredCommandStatusPending}
+	due := models.DeferredCommand{Id: "due", DeviceName: testDeviceName, CommandName: testCommandName, Attempts: 1, ExpiresAt: now + time.Hour.Milliseconds(), NextAttemptAt: now - 1, Status: models.DeferredCommandStatusPending}
+	rejected := models.DeferredCommand{Id: "rejected", DeviceName: testDeviceName, CommandName: testFailedSetCommand, Attempts: 1, ExpiresAt: now + time.Hour.Milliseconds(), NextAttemptAt: now - 1, Status: models.DeferredCommandStatusPending}
+	unreachable := models.DeferredCommand{Id: "unreachable", DeviceName: testBrokenDevice, CommandName: testCommandName, Attempts: 2, ExpiresAt: now + time.Hour.Milliseconds(), NextAttemptAt: now - 1, Status: models.DeferredCommandStatusPending}
+	down := models.DeferredCommand{Id: "down", DeviceName: testDownDevice, CommandName: testCommandName, Attempts: 2, ExpiresAt: now + time.Hour.Milliseconds(), NextAttemptAt: now - 1, Status: models.DeferredCommandStatusPending}
+
+	tests := []struct {
+		name             string
+		woken            bool
+		wokenDevices     map[string]struct{}
+		wokenServices    map[string]struct{}
+		expectedStatuses map[string]string
+		expectedAttempts map[string]int
+	}{
+		{"due commands", false, nil, nil,
+			map[string]string{expired.Id: models.DeferredCommandStatusExpired, due.Id: models.DeferredCommandStatusDelivered, rejected.Id: models.DeferredCommandStatusFailed,
+				unreachable.Id: models.DeferredCommandStatusPending, down.Id: models.DeferredCommandStatusPending},
+			map[string]int{expired.Id: 3, due.Id: 2, rejected.Id: 2, unreachable.Id: 3, down.Id: 2}},
+		{"woken device", true, map[string]struct{}{testDeviceName: {}}, nil,
+			map[string]string{expired.Id: models.DeferredCommandStatusExpired, notDue.Id: models.DeferredCommandStatusDelivered, due.Id: models.DeferredCommandStatusDelivered,
+				rejected.Id: models.DeferredCommandStatusFailed, unreachable.Id: models.DeferredCommandStatusPending, down.Id: models.DeferredCommandStatusPending},
+			map[string]int{expired.Id: 3, notDue.Id: 4, due.Id: 2, rejected.Id: 2, unreachable.Id: 3, down.Id: 2}},
+		{"woken device service", true, nil, map[string]struct{}{testServiceName: {}},
+			map[string]string{expired.Id: models.DeferredCommandStatusExpired, notDue.Id: models.DeferredCommandStatusDelivered, due.Id: models.DeferredCommandStatusDelivered,
+				rejected.Id: models.DeferredCommandStatusFailed, unreachable.Id: models.DeferredCommandStatusPending, down.Id: models.DeferredCommandStatusPending},
+			map[string]int{expired.Id: 3, notDue.Id: 4, due.Id: 2, rejected.Id: 2, unreachable.Id: 3, down.Id: 2}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeferredCommandsByStatus", ctx, models.DeferredCommandStatusPending, 0, -1).
+				Return([]models.DeferredCommand{expired, notDue, justAttempted, otherDevice, due, rejected, unreachable, down}, nil)
+			dbClientMock.On("UpdateDeferredCommand", ctx, mock.Anything).Return(nil)
+			messageClientMock := &messagingMocks.MessageClient{}
+			messageClientMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			dcMock, dscMock, dsccMock := newDeferredCommandClientMocks()
+			dic := mockDic(map[string]any{
+				commandContainer.ConfigurationName:                &config.ConfigurationStruct{DeferredCommand: testDeferredCommandInfo},
+				commandContainer.DBClientInterfaceName:            dbClientMock,
+				bootstrapContainer.MessagingClientName:            messageClientMock,
+				bootstrapContainer.DeviceClientName:               dcMock,
+				bootstrapContainer.DeviceServiceClientName:        dscMock,
+				bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+			})
+			d, err := deferredCommandDurationsFrom(dic)
+			require.NoError(t, err)
+
+			var woken func(models.DeferredCommand) bool
+			if testCase.woken {
+				woken = func(command models.DeferredCommand) bool {
+					return deferredCommandWoken(ctx, command, testCase.wokenDevices, testCase.wokenServices, dic)
+				}
+			}
+			deliverDeferredCommands(ctx, woken, d, dic)
+
+			updated := make(map[string]models.DeferredCommand)
+			for _, call := range dbClientMock.Calls {
+				if call.Method == "UpdateDeferredCommand" {
+					c := call.Arguments.Get(1).(models.DeferredCommand)
+					updated[c.Id] = c
+				}
+			}
+			require.Len(t, updated, len(testCase.expectedStatuses))
+			for id, status := range testCase.expectedStatuses {
+				assert.Equal(t, status, updated[id].Status, "status of deferred command %s not as expected", id)
+				assert.Equal(t, testCase.expectedAttempts[id], updated[id].Attempts, "attempts of deferred command %s not as expected", id)
+				if status != models.DeferredCommandStatusPending {
+					assert.Zero(t, updated[id].NextAttemptAt)
+				} else {
+					assert.Greater(t, updated[id].NextAttemptAt, now)
+				}
+			}
+			// only the finished commands are published
+			messageClientMock.AssertNumberOfCalls(t, "Publish", len(testCase.expectedStatuses)-2)
+		})
+	}
+}
+
+func TestWakeDeferredCommandDelivery(t *testing.T) {
+	WakeDeferredCommandDelivery(testDeviceName, "")
+	// the repeated wake-up doesn't block
+	WakeDeferredCommandDelivery("", testServiceName)
+	select {
+	case <-deferredCommandWakeUp:
+	default:
+		assert.Fail(t, "wake-up is not signaled")
+	}
+
+	devices, services := wokenDeferredCommands.take()
+	assert.Equal(t, map[string]struct{}{testDeviceName: {}}, devices)
+	assert.Equal(t, map[string]struct{}{testServiceName: {}}, services)
+	devices, services = wokenDeferredCommands.take()
+	assert.Empty(t, devices)
+	assert.Empty(t, services)
+}
@@ -0,0 +1,374 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// CaptureDeviceSnapshots captures and stores a snapshot of each device selected by the group concurrently, and returns
+// the result of each device in the order the devices are resolved. The snapshot is stored as long as any value is read,
+// and the get commands which fail are recorded in the snapshot. Each get command is audited based on the given record.
+func CaptureDeviceSnapshots(ctx context.Context, group commandDTO.DeviceGroup, description string, record models.AuditRecord, caller models.CommandCaller, dic *di.Container) ([]commandDTO.DeviceSnapshotResult, errors.EdgeX) {
+	err := group.Validate()
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices, deviceErrs, err := groupDevices(group, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	results := make([]commandDTO.DeviceSnapshotResult, len(devices))
+	forEachDeviceConcurrently(len(devices), dic, func(i int) {
+		device := devices[i]
+		results[i].DeviceName = device.Name
+		if err, ok := deviceErrs[device.Name]; ok {
+			results[i].StatusCode, results[i].Message = err.Code(), err.Error()
+			return
+		}
+		snapshot, err := readDeviceSnapshot(ctx, device, record, caller, dic)
+		if err == nil && len(snapshot.Values) == 0 {
+			err = noSnapshotValueError(snapshot)
+		}
+		if err == nil {
+			snapshot.Description = description
+			snapshot, err = dbClient.AddDeviceSnapshot(ctx, snapshot)
+		}
+		if err != nil {
+			results[i].StatusCode, results[i].Message = err.Code(), err.Error()
+			return
+		}
+		results[i].StatusCode, results[i].SnapshotId = http.StatusCreated, snapshot.Id
+		if len(snapshot.Failures) > 0 {
+			results[i].Message = fmt.Sprintf("%d of the get commands failed and are recorded in the snapshot", len(snapshot.Failures))
+		}
+	})
+	return results, nil
+}
+
+// noSnapshotValueError returns the error of the snapshot which has no value, which is the first failed get command if any
+func noSnapshotValueError(snapshot models.DeviceSnapshot) errors.EdgeX {
+	if len(snapshot.Failures) == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s has no readable resource", snapshot.DeviceName), nil)
+	}
+	f := snapshot.Failures[0]
+	return errors.NewCommonEdgeX(errors.KindMapping(f.StatusCode),
+		fmt.Sprintf("no value of device %s is read, command %s failed: %s", snapshot.DeviceName, f.CommandName, f.Message), nil)
+}
+
+// readDeviceSnapshot issues the readable core commands of the device and collects the values of the resources. The
+// commands reading more resources are issued first, and a command is skipped if all its resources are already read.
+func readDeviceSnapshot(ctx context.Context, device dtos.Device, record models.AuditRecord, caller models.CommandCaller, dic *di.Container) (models.DeviceSnapshot, errors.EdgeX) {
+	snapshot := models.DeviceSnapshot{DeviceName: device.Name, ProfileName: device.ProfileName}
+	profile, err := DeviceProfileByName(ctx, device.ProfileName, dic)
+	if err != nil {
+		return snapshot, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceService, err := DeviceServiceByName(ctx, device.ServiceName, dic)
+	if err != nil {
+		return snapshot, errors.NewCommonEdgeXWrapper(err)
+	}
+	dscc := bootstrapContainer.DeviceServiceCommandClientFrom(dic.Get)
+	if dscc == nil {
+		return snapshot, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
+	}
+	commands, err := buildCoreCommands(device.Name, "", profile)
+	if err != nil {
+		return snapshot, errors.NewCommonEdgeXWrapper(err)
+	}
+	commands = slices.DeleteFunc(commands, func(c dtos.CoreCommand) bool { return !c.Get })
+	slices.SortFunc(commands, func(a, b dtos.CoreCommand) int {
+		return cmp.Or(cmp.Compare(len(b.Parameters), len(a.Parameters)), strings.Compare(a.Name, b.Name))
+	})
+
+	captured := make(map[string]bool)
+	for _, c := range commands {
+		if !slices.ContainsFunc(c.Parameters, func(p dtos.CoreCommandParameter) bool { return !captured[p.ResourceName] }) {
+			continue
+		}
+		issuedAt := time.Now()
+		event, err := readSnapshotCommand(ctx, dscc, deviceService.BaseAddress, device.Name, c.Name, caller, dic)
+		record.DeviceName, record.CommandName, record.Method = device.Name, c.Name, constants.CommandMethodGet
+		record.StatusCode, record.Message = http.StatusOK, ""
+		if err != nil {
+			record.StatusCode, record.Message = err.Code(), err.Error()
+			snapshot.Failures = append(snapshot.Failures, models.SnapshotFailure{CommandName: c.Name, StatusCode: err.Code(), Message: err.Error()})
+		}
+		AddAuditRecord(record, issuedAt, dic)
+		if err != nil {
+			continue
+		}
+		for _, r := range event.Readings {
+			if captured[r.ResourceName] || r.ValueType == common.ValueTypeBinary || r.IsNull() {
+				continue
+			}
+			captured[r.ResourceName] = true
+			snapshot.Values = append(snapshot.Values, models.SnapshotValue{
+				ResourceName: r.ResourceName,
+				ValueType:    r.ValueType,
+				Value:        r.Value,
+				ObjectValue:  r.ObjectValue,
+			})
+		}
+	}
+	return snapshot, nil
+}
+
+// readSnapshotCommand authorizes and issues the get command to the device, and the event is only returned to core-command
+// rather than pushed to the EdgeX system
+func readSnapshotCommand(ctx context.Context, dscc interfaces.DeviceServiceCommandClient, baseAddress, deviceName, commandName string, caller models.CommandCaller, dic *di.Container) (dtos.Event, errors.EdgeX) {
+	err := AuthorizeCommand(ctx, deviceName, commandName, constants.CommandMethodGet, caller, dic)
+	if err != nil {
+		return dtos.Event{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	queryParams := url.Values{common.PushEvent: {common.ValueFalse}, common.ReturnEvent: {common.ValueTrue}}.Encode()
+	res, err := dscc.GetCommand(ctx, baseAddress, deviceName, commandName, queryParams)
+	if err != nil {
+		return dtos.Event{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if res == nil {
+		return dtos.Event{}, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("no event is returned by command %s", commandName), nil)
+	}
+	return res.Event, nil
+}
+
+// DeviceSnapshotById queries the device snapshot by id
+func DeviceSnapshotById(ctx context.Context, id string, dic *di.Container) (commandDTO.DeviceSnapshot, errors.EdgeX) {
+	if id == "" {
+		return commandDTO.DeviceSnapshot{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	snapshot, err := commandContainer.DBClientFrom(dic.Get).DeviceSnapshotById(ctx, id)
+	if err != nil {
+		return commandDTO.DeviceSnapshot{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return commandDTO.FromDeviceSnapshotModelToDTO(snapshot), nil
+}
+
+// DeviceSnapshotsByDeviceName queries the device snapshots by the optional device name with the specified offset and
+// limit, the latest ones come first
+func DeviceSnapshotsByDeviceName(ctx context.Context, deviceName string, offset, limit int, dic *di.Container) (snapshots []commandDTO.DeviceSnapshot, totalCount uint32, err errors.EdgeX) {
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.DeviceSnapshotCountByDeviceName(ctx, deviceName)
+	if err != nil {
+		return snapshots, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []commandDTO.DeviceSnapshot{}, totalCount, err
+	}
+
+	models, err := dbClient.DeviceSnapshotsByDeviceName(ctx, deviceName, offset, limit)
+	if err != nil {
+		return snapshots, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return commandDTO.FromDeviceSnapshotModelsToDTOs(models), totalCount, nil
+}
+
+// DeleteDeviceSnapshotById deletes the device snapshot by id
+func DeleteDeviceSnapshotById(ctx context.Context, id string, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	// make sure the snapshot exists so that deleting an unknown snapshot is reported as not found
+	_, err := dbClient.DeviceSnapshotById(ctx, id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteDeviceSnapshotById(ctx, id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// RestoreDeviceSnapshot restores the snapshot to the device, which is the device the snapshot was captured from if the
+// device name is empty. The current values are read from the device first, and only the writable resources whose values
+// differ from the snapshot are written by the set commands. In the dry-run mode the set commands are only validated
+// rather than issued. Each get and set command is audited based on the given record.
+func RestoreDeviceSnapshot(ctx context.Context, id, deviceName string, dryRun bool, record models.AuditRecord, caller models.CommandCaller, dic *di.Container) (restore commandDTO.DeviceSnapshotRestore, err errors.EdgeX) {
+	if id == "" {
+		return restore, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	snapshot, err := commandContainer.DBClientFrom(dic.Get).DeviceSnapshotById(ctx, id)
+	if err != nil {
+		return restore, errors.NewCommonEdgeXWrapper(err)
+	}
+	if deviceName == "" {
+		deviceName = snapshot.DeviceName
+	}
+	device, err := DeviceByName(ctx, deviceName, dic)
+	if err != nil {
+		return restore, errors.NewCommonEdgeXWrapper(err)
+	}
+	profile, err := DeviceProfileByName(ctx, device.ProfileName, dic)
+	if err != nil {
+		return restore, errors.NewCommonEdgeXWrapper(err)
+	}
+	current, err := readDeviceSnapshot(ctx, device, record, caller, dic)
+	if err != nil {
+		return restore, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	restore = commandDTO.DeviceSnapshotRestore{SnapshotId: snapshot.Id, DeviceName: device.Name, DryRun: dryRun, Diff: []commandDTO.SnapshotValueDiff{}}
+	currentValues := make(map[string]any, len(current.Values))
+	for _, v := range current.Values {
+		currentValues[v.ResourceName] = snapshotSettingValue(v)
+	}
+	snapshotValues := make(map[string]any, len(snapshot.Values))
+	var changed []string
+	for _, v := range snapshot.Values {
+		value := snapshotSettingValue(v)
+		snapshotValues[v.ResourceName] = value
+		r, exists := deviceResourcesByName(profile.DeviceResources, v.ResourceName)
+		if !exists {
+			restore.Skipped = append(restore.Skipped, commandDTO.SnapshotSkippedResource{ResourceName: v.ResourceName, Reason: fmt.Sprintf("resource doesn't exist in device profile %s", profile.Name)})
+			continue
+		}
+		if !strings.Contains(r.Properties.ReadWrite, common.ReadWrite_W) {
+			restore.Skipped = append(restore.Skipped, commandDTO.SnapshotSkippedResource{ResourceName: v.ResourceName, Reason: "resource is read-only"})
+			continue
+		}
+		currentValue, read := currentValues[v.ResourceName]
+		if read && reflect.DeepEqual(currentValue, value) {
+			continue
+		}
+		restore.Diff = append(restore.Diff, commandDTO.SnapshotValueDiff{ResourceName: v.ResourceName, SnapshotValue: value, CurrentValue: currentValue})
+		changed = append(changed, v.ResourceName)
+	}
+
+	var unplanned []string
+	restore.Commands, unplanned, err = planSnapshotRestore(device.Name, profile, snapshotValues, changed)
+	if err != nil {
+		return restore, errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, name := range unplanned {
+		restore.Skipped = append(restore.Skipped, commandDTO.SnapshotSkippedResource{ResourceName: name, Reason: "no set command writes the resource along with the captured resources only"})
+	}
+
+	for i, c := range restore.Commands {
+		if dryRun {
+			if err := ValidateSetCommand(ctx, device.Name, c.CommandName, c.Settings, dic); err != nil {
+				restore.Commands[i].StatusCode, restore.Commands[i].Message = err.Code(), err.Error()
+			}
+			continue
+		}
+		issuedAt := time.Now()
+		res, err := restoreSnapshotCommand(ctx, device.Name, c.CommandName, c.Settings, caller, dic)
+		record.DeviceName, record.CommandName, record.Method, record.Settings = device.Name, c.CommandName, constants.CommandMethodSet, c.Settings
+		record.StatusCode, record.Message = res.StatusCode, res.Message
+		if err != nil {
+			record.StatusCode, record.Message = err.Code(), err.Error()
+		}
+		AddAuditRecord(record, issuedAt, dic)
+		restore.Commands[i].StatusCode, restore.Commands[i].Message = record.StatusCode, record.Message
+	}
+	return restore, nil
+}
+
+// restoreSnapshotCommand authorizes and issues the set command restoring the snapshot values to the device
+func restoreSnapshotCommand(ctx context.Context, deviceName, commandName string, settings map[string]any, caller models.CommandCaller, dic *di.Container) (commonDTO.BaseResponse, errors.EdgeX) {
+	err := AuthorizeCommand(ctx, deviceName, commandName, constants.CommandMethodSet, caller, dic)
+	if err != nil {
+		return commonDTO.BaseResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return IssueSetCommandByName(deviceName, commandName, "", settings, dic)
+}
+
+// planSnapshotRestore plans the set commands writing the changed resources. The set command of a resource itself is
+// preferred, otherwise a device command is used if all its writable resources are in the snapshot. The changed resources
+// which can't be written by these commands are returned as unplanned.
+func planSnapshotRestore(deviceName string, profile dtos.DeviceProfile, snapshotValues map[string]any, changed []string) ([]commandDTO.SnapshotRestoreCommand, []string, errors.EdgeX) {
+	commands, err := buildCoreCommands(deviceName, "", profile)
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	commands = slices.DeleteFunc(commands, func(c dtos.CoreCommand) bool { return !c.Set })
+	slices.SortFunc(commands, func(a, b dtos.CoreCommand) int { return strings.Compare(a.Name, b.Name) })
+
+	planned := []commandDTO.SnapshotRestoreCommand{}
+	pending := make(map[string]bool, len(changed))
+	for _, name := range changed {
+		pending[name] = true
+	}
+	for _, name := range changed {
+		if slices.ContainsFunc(commands, func(c dtos.CoreCommand) bool { return c.Name == name && len(c.Parameters) == 1 }) {
+			planned = append(planned, commandDTO.SnapshotRestoreCommand{CommandName: name, Settings: map[string]any{name: snapshotValues[name]}})
+			delete(pending, name)
+		}
+	}
+	for _, c := range commands {
+		if len(pending) == 0 {
+			break
+		}
+		var writable []string
+		for _, p := range c.Parameters {
+			if r, exists := deviceResourcesByName(profile.DeviceResources, p.ResourceName); exists && strings.Contains(r.Properties.ReadWrite, common.ReadWrite_W) {
+				writable = append(writable, p.ResourceName)
+			}
+		}
+		if !slices.ContainsFunc(writable, func(name string) bool { return pending[name] }) {
+			continue
+		}
+		settings := make(map[string]any, len(writable))
+		for _, name := range writable {
+			value, ok := snapshotValues[name]
+			if !ok {
+				// the device command would write the resources absent from the snapshot with their default values
+				settings = nil
+				break
+			}
+			settings[name] = value
+		}
+		if settings == nil {
+			continue
+		}
+		planned = append(planned, commandDTO.SnapshotRestoreCommand{CommandName: c.Name, Settings: settings})
+		for _, name := range writable {
+			delete(pending, name)
+		}
+	}
+
+	var unplanned []string
+	for _, name := range changed {
+		if pending[name] {
+			unplanned = append(unplanned, name)
+		}
+	}
+	return planned, unplanned, nil
+}
+
+// snapshotSettingValue returns the value of the snapshot to be written by the set command
+func snapshotSettingValue(v models.SnapshotValue) any {
+	if v.ValueType == common.ValueTypeObject {
+		return v.ObjectValue
+	}
+	return v.Value
+}
@@ -0,0 +1,305 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+const (
+	testSnapshotId          = "c7ef6a3e-0a5f-4f57-8a8c-8a4b5d2f7e01"
+	testThermostat          = "thermostat1"
+	testReplacedThermostat  = "thermostat2"
+	testOfflineThermostat   = "offlineThermostat"
+	testThermostatProfile   = "thermostatProfile"
+	testThermostatSettings  = "settings"
+	testTemperatureResource = "temperature"
+	testSetpointResource    = "setpoint"
+	testModeResource        = "mode"
+	testConfigResource      = "config"
+	testFirmwareResource    = "firmware"
+)
+
+var testSnapshotQueryParams = url.Values{common.PushEvent: {common.ValueFalse}, common.ReturnEvent: {common.ValueTrue}}.Encode()
+
+func thermostatProfile() dtos.DeviceProfile {
+	return dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testThermostatProfile},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: testTemperatureResource, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+			{Name: testSetpointResource, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW}},
+			{Name: testModeResource, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_RW}},
+			{Name: testConfigResource, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeObject, ReadWrite: common.ReadWrite_RW}},
+			{Name: testFirmwareResource, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_R}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: testThermostatSettings, ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{
+				{DeviceResource: testSetpointResource},
+				{DeviceResource: testModeResource},
+			}},
+		},
+	}
+}
+
+func simpleReading(resourceName, valueType, value string) dtos.BaseReading {
+	return dtos.BaseReading{ResourceName: resourceName, ValueType: valueType, SimpleReading: dtos.SimpleReading{Value: value}}
+}
+
+func objectReading(resourceName string, value any) dtos.BaseReading {
+	return dtos.BaseReading{ResourceName: resourceName, ValueType: common.ValueTypeObject, ObjectReading: dtos.ObjectReading{ObjectValue: value}}
+}
+
+func eventResponse(readings ...dtos.BaseReading) *responses.EventResponse {
+	return &responses.EventResponse{BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK), Event: dtos.Event{Readings: readings}}
+}
+
+func newDeviceSnapshotClientMocks() (*mocks.DeviceClient, *mocks.DeviceProfileClient, *mocks.DeviceServiceClient, *mocks.DeviceServiceCommandClient) {
+	dcMock := &mocks.DeviceClient{}
+	for _, name := range []string{testThermostat, testReplacedThermostat} {
+		dcMock.On("DeviceByName", mock.Anything, name).
+			Return(responses.DeviceResponse{Device: dtos.Device{Name: name, ProfileName: testThermostatProfile, ServiceName: testServiceName}}, nil)
+	}
+	dcMock.On("DeviceByName", mock.Anything, testOfflineThermostat).
+		Return(responses.DeviceResponse{Device: dtos.Device{Name: testOfflineThermostat, ProfileName: testThermostatProfile, ServiceName: testBrokenService}}, nil)
+	dcMock.On("DeviceByName", mock.Anything, testMissingDevice).
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, testThermostatProfile).Return(responses.DeviceProfileResponse{Profile: thermostatProfile()}, nil)
+
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testServiceName).
+		Return(responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: testServiceName, BaseAddress: testBaseAddress}}, nil)
+	dscMock.On("DeviceServiceByName", mock.Anything, testBrokenService).
+		Return(responses.DeviceServiceResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "device service unavailable", nil))
+
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	// the thermostat which the snapshot is captured from
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testThermostat, testThermostatSettings, testSnapshotQueryParams).
+		Return(eventResponse(simpleReading(testSetpointResource, common.ValueTypeFloat32, "21.5"), simpleReading(testModeResource, common.ValueTypeString, "auto")), nil)
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testThermostat, testConfigResource, testSnapshotQueryParams).
+		Return(eventResponse(objectReading(testConfigResource, map[string]any{"fan": "low"})), nil)
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testThermostat, testFirmwareResource, testSnapshotQueryParams).
+		Return(eventResponse(simpleReading(testFirmwareResource, common.ValueTypeString, "1.0")), nil)
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testThermostat, testTemperatureResource, testSnapshotQueryParams).
+		Return(nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "sensor unavailable", nil))
+	// the replacement thermostat which the snapshot is restored to
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testReplacedThermostat, testThermostatSettings, testSnapshotQueryParams).
+		Return(eventResponse(simpleReading(testSetpointResource, common.ValueTypeFloat32, "18"), simpleReading(testModeResource, common.ValueTypeString, "auto")), nil)
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testReplacedThermostat, testConfigResource, testSnapshotQueryParams).
+		Return(eventResponse(objectReading(testConfigResource, map[string]any{"fan": "high"})), nil)
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testReplacedThermostat, testFirmwareResource, testSnapshotQueryParams).
+		Return(eventResponse(simpleReading(testFirmwareResource, common.ValueTypeString, "1.1")), nil)
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testReplacedThermostat, testTemperatureResource, testSnapshotQueryParams).
+		Return(eventResponse(simpleReading(testTemperatureResource, common.ValueTypeFloat32, "20")), nil)
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, testReplacedThermostat, mock.Anything, "", mock.Anything).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+
+	return dcMock, dpcMock, dscMock, dsccMock
+}
+
+func TestCaptureDeviceSnapshots(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddDeviceSnapshot", mock.Anything, mock.Anything).Return(func(_ context.Context, s models.DeviceSnapshot) (models.DeviceSnapshot, errors.EdgeX) {
+		s.Id = testSnapshotId
+		return s, nil
+	})
+	dcMock, dpcMock, dscMock, dsccMock := newDeviceSnapshotClientMocks()
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:                &config.ConfigurationStruct{GroupCommand: config.GroupCommandInfo{MaxWorkers: 2}},
+		commandContainer.DBClientInterfaceName:            dbClientMock,
+		bootstrapContainer.DeviceClientName:               dcMock,
+		bootstrapContainer.DeviceProfileClientName:        dpcMock,
+		bootstrapContainer.DeviceServiceClientName:        dscMock,
+		bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+	})
+
+	group := commandDTO.DeviceGroup{DeviceNames: []string{testThermostat, testOfflineThermostat, testMissingDevice}}
+	results, err := CaptureDeviceSnapshots(context.Background(), group, "before replacement", models.AuditRecord{}, models.CommandCaller{}, dic)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, testThermostat, results[0].DeviceName)
+	assert.Equal(t, http.StatusCreated, results[0].StatusCode)
+	assert.Equal(t, testSnapshotId, results[0].SnapshotId)
+	assert.Contains(t, results[0].Message, "1 of the get commands failed")
+	assert.Equal(t, http.StatusServiceUnavailable, results[1].StatusCode)
+	assert.Empty(t, results[1].SnapshotId)
+	assert.Equal(t, http.StatusNotFound, results[2].StatusCode)
+
+	dbClientMock.AssertNumberOfCalls(t, "AddDeviceSnapshot", 1)
+	stored := dbClientMock.Calls[0].Arguments.Get(1).(models.DeviceSnapshot)
+	assert.Equal(t, "before replacement", stored.Description)
+	assert.Equal(t, testThermostatProfile, stored.ProfileName)
+	assert.Equal(t, []models.SnapshotValue{
+		{ResourceName: testSetpointResource, ValueType: common.ValueTypeFloat32, Value: "21.5"},
+		{ResourceName: testModeResource, ValueType: common.ValueTypeString, Value: "auto"},
+		{ResourceName: testConfigResource, ValueType: common.ValueTypeObject, ObjectValue: map[string]any{"fan": "low"}},
+		{ResourceName: testFirmwareResource, ValueType: common.ValueTypeString, Value: "1.0"},
+	}, stored.Values)
+	require.Len(t, stored.Failures, 1)
+	assert.Equal(t, testTemperatureResource, stored.Failures[0].CommandName)
+	assert.Equal(t, http.StatusServiceUnavailable, stored.Failures[0].StatusCode)
+	// the resources already read by the device command are not read again
+	dsccMock.AssertNotCalled(t, "GetCommand", mock.Anything, testBaseAddress, testThermostat, testSetpointResource, mock.Anything)
+	dsccMock.AssertNotCalled(t, "GetCommand", mock.Anything, testBaseAddress, testThermostat, testModeResource, mock.Anything)
+}
+
+func TestCaptureDeviceSnapshotsInvalidGroup(t *testing.T) {
+	dic := mockDic(map[string]any{
+		commandContainer.DBClientInterfaceName: &dbMock.DBClient{},
+	})
+	_, err := CaptureDeviceSnapshots(context.Background(), commandDTO.DeviceGroup{}, "", models.AuditRecord{}, models.CommandCaller{}, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestRestoreDeviceSnapshot(t *testing.T) {
+	snapshot := models.DeviceSnapshot{
+		Id:          testSnapshotId,
+		DeviceName:  testThermostat,
+		ProfileName: testThermostatProfile,
+		Values: []models.SnapshotValue{
+			{ResourceName: testSetpointResource, ValueType: common.ValueTypeFloat32, Value: "21.5"},
+			{ResourceName: testModeResource, ValueType: common.ValueTypeString, Value: "auto"},
+			{ResourceName: testConfigResource, ValueType: common.ValueTypeObject, ObjectValue: map[string]any{"fan": "low"}},
+			{ResourceName: testFirmwareResource, ValueType: common.ValueTypeString, Value: "1.0"},
+			{ResourceName: "humidity", ValueType: common.ValueTypeFloat32, Value: "40"},
+		},
+	}
+	expectedDiff := []commandDTO.SnapshotValueDiff{
+		{ResourceName: testSetpointResource, SnapshotValue: "21.5", CurrentValue: "18"},
+		{ResourceName: testConfigResource, SnapshotValue: map[string]any{"fan": "low"}, CurrentValue: map[string]any{"fan": "high"}},
+	}
+	expectedSkipped := []string{testFirmwareResource, "humidity"}
+
+	tests := []struct {
+		name               string
+		dryRun             bool
+		expectedStatusCode int
+	}{
+		{"Valid - dry run", true, 0},
+		{"Valid - restore", false, http.StatusOK},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceSnapshotById", mock.Anything, testSnapshotId).Return(snapshot, nil)
+			dcMock, dpcMock, dscMock, dsccMock := newDeviceSnapshotClientMocks()
+			dic := mockDic(map[string]any{
+				commandContainer.ConfigurationName:                &config.ConfigurationStruct{GroupCommand: config.GroupCommandInfo{MaxWorkers: 2}},
+				commandContainer.DBClientInterfaceName:            dbClientMock,
+				bootstrapContainer.DeviceClientName:               dcMock,
+				bootstrapContainer.DeviceProfileClientName:        dpcMock,
+				bootstrapContainer.DeviceServiceClientName:        dscMock,
+				bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+			})
+
+			restore, err := RestoreDeviceSnapshot(context.Background(), testSnapshotId, testReplacedThermostat, testCase.dryRun, models.AuditRecord{}, models.CommandCaller{}, dic)
+			require.NoError(t, err)
+			assert.Equal(t, testReplacedThermostat, restore.DeviceName)
+			assert.Equal(t, testCase.dryRun, restore.DryRun)
+			assert.Equal(t, expectedDiff, restore.Diff)
+			var skipped []string
+			for _, s := range restore.Skipped {
+				skipped = append(skipped, s.ResourceName)
+			}
+			assert.Equal(t, expectedSkipped, skipped)
+
+			require.Len(t, restore.Commands, 2)
+			assert.Equal(t, testSetpointResource, restore.Commands[0].CommandName)
+			assert.Equal(t, map[string]any{testSetpointResource: "21.5"}, restore.Commands[0].Settings)
+			assert.Equal(t, testConfigResource, restore.Commands[1].CommandName)
+			assert.Equal(t, map[string]any{testConfigResource: map[string]any{"fan": "low"}}, restore.Commands[1].Settings)
+			for _, c := range restore.Commands {
+				assert.Equal(t, testCase.expectedStatusCode, c.StatusCode)
+			}
+			if testCase.dryRun {
+				dsccMock.AssertNotCalled(t, "SetCommandWithObject", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				dsccMock.AssertNumberOfCalls(t, "SetCommandWithObject", 2)
+			}
+		})
+	}
+}
+
+func TestRestoreDeviceSnapshotNotFound(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceSnapshotById", mock.Anything, "unknown").
+		Return(models.DeviceSnapshot{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "snapshot not found", nil))
+	dcMock, dpcMock, dscMock, dsccMock := newDeviceSnapshotClientMocks()
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:                &config.ConfigurationStruct{GroupCommand: config.GroupCommandInfo{MaxWorkers: 2}},
+		commandContainer.DBClientInterfaceName:            dbClientMock,
+		bootstrapContainer.DeviceClientName:               dcMock,
+		bootstrapContainer.DeviceProfileClientName:        dpcMock,
+		bootstrapContainer.DeviceServiceClientName:        dscMock,
+		bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+	})
+
+	_, err := RestoreDeviceSnapshot(context.Background(), "unknown", "", true, models.AuditRecord{}, models.CommandCaller{}, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestPlanSnapshotRestore(t *testing.T) {
+	rw := dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_RW}
+	profile := dtos.DeviceProfile{
+		// the resources are only written by the device commands
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "a", IsHidden: true, Properties: rw},
+			{Name: "b", IsHidden: true, Properties: rw},
+			{Name: "c", IsHidden: true, Properties: rw},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "ab", ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "a"}, {DeviceResource: "b"}}},
+			{Name: "ac", ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "a"}, {DeviceResource: "c"}}},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		snapshotValues    map[string]any
+		changed           []string
+		expectedCommands  []commandDTO.SnapshotRestoreCommand
+		expectedUnplanned []string
+	}{
+		{"device command writes the unchanged resources too", map[string]any{"a": "1", "b": "2"}, []string{"a"},
+			[]commandDTO.SnapshotRestoreCommand{{CommandName: "ab", Settings: map[string]any{"a": "1", "b": "2"}}}, nil},
+		{"one command writes all the changed resources", map[string]any{"a": "1", "b": "2", "c": "3"}, []string{"a", "b"},
+			[]commandDTO.SnapshotRestoreCommand{{CommandName: "ab", Settings: map[string]any{"a": "1", "b": "2"}}}, nil},
+		{"device command needs a resource absent from the snapshot", map[string]any{"c": "3"}, []string{"c"},
+			[]commandDTO.SnapshotRestoreCommand{}, []string{"c"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			commands, unplanned, err := planSnapshotRestore(testDeviceName, profile, testCase.snapshotValues, testCase.changed)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCommands, commands)
+			assert.Equal(t, testCase.expectedUnplanned, unplanned)
+		})
+	}
+}
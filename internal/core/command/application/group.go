@@ -0,0 +1,258 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// groupCommandTarget is a device targeted by a group command along with the base address of its device service, or
+// the result if the command can't be issued to the device
+type groupCommandTarget struct {
+	deviceName  string
+	baseAddress string
+	result      *commandDTO.DeviceCommandResult
+}
+
+// IssueGroupGetCommand issues the specified get(read) command to the devices selected by the group concurrently, and
+// returns the result of each device in the order the devices are resolved. The devices denied to the caller by the
+// command policies fail alone.
+func IssueGroupGetCommand(group commandDTO.DeviceGroup, commandName string, queryParams string, caller models.CommandCaller, dic *di.Container) ([]commandDTO.DeviceCommandResult, errors.EdgeX) {
+	return issueGroupCommand(group, commandName, constants.CommandMethodGet, caller, dic, func(dscc interfaces.DeviceServiceCommandClient, target groupCommandTarget) commandDTO.DeviceCommandResult {
+		res, err := dscc.GetCommand(context.Background(), target.baseAddress, target.deviceName, commandName, queryParams)
+		if err != nil {
+			return errorResult(target.deviceName, err)
+		}
+		// no event is returned when ds-returnevent is false
+		if res == nil {
+			return commandDTO.DeviceCommandResult{DeviceName: target.deviceName, StatusCode: http.StatusOK}
+		}
+		return commandDTO.DeviceCommandResult{DeviceName: target.deviceName, StatusCode: res.StatusCode, Message: res.Message, Event: &res.Event}
+	})
+}
+
+// IssueGroupSetCommand issues the specified set(write) command to the devices selected by the group concurrently, and
+// returns the result of each device in the order the devices are resolved. The devices denied to the caller by the
+// command policies or rejecting the settings fail alone.
+func IssueGroupSetCommand(group commandDTO.DeviceGroup, commandName string, queryParams string, settings map[string]any, caller models.CommandCaller, dic *di.Container) ([]commandDTO.DeviceCommandResult, errors.EdgeX) {
+	return issueGroupCommand(group, commandName, constants.CommandMethodSet, caller, dic, func(dscc interfaces.DeviceServiceCommandClient, target groupCommandTarget) commandDTO.DeviceCommandResult {
+		// the devices of the group may have different profiles, so the settings are validated device by device
+		if err := ValidateSetCommand(context.Background(), target.deviceName, commandName, settings, dic); err != nil {
+			return errorResult(target.deviceName, err)
+		}
+		res, err := dscc.SetCommandWithObject(context.Background(), target.baseAddress, target.deviceName, commandName, queryParams, settings)
+		if err != nil {
+			return errorResult(target.deviceName, err)
+		}
+		return commandDTO.DeviceCommandResult{DeviceName: target.deviceName, StatusCode: res.StatusCode, Message: res.Message}
+	})
+}
+
+func issueGroupCommand(
+	group commandDTO.DeviceGroup,
+	commandName string,
+	method string,
+	caller models.CommandCaller,
+	dic *di.Container,
+	issue func(interfaces.DeviceServiceCommandClient, groupCommandTarget) commandDTO.DeviceCommandResult) ([]commandDTO.DeviceCommandResult, errors.EdgeX) {
+	if commandName == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
+	}
+	err := group.Validate()
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	dscc := bootstrapContainer.DeviceServiceCommandClientFrom(dic.Get)
+	if dscc == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
+	}
+
+	targets, err := groupCommandTargets(group, commandName, method, caller, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	results := make([]commandDTO.DeviceCommandResult, len(targets))
+	forEachDeviceConcurrently(len(targets), dic, func(i int) {
+		if targets[i].result != nil {
+			results[i] = *targets[i].result
+			return
+		}
+		results[i] = issue(dscc, targets[i])
+	})
+	return results, nil
+}
+
+// forEachDeviceConcurrently calls fn with the index of each of the count devices, and at most GroupCommand.MaxWorkers
+// calls run concurrently. Each index is only taken by one call, so fn can write the result of the index without locking.
+func forEachDeviceConcurrently(count int, dic *di.Container, fn func(i int)) {
+	config := commandContainer.ConfigurationFrom(dic.Get).GroupCommand
+	workers := min(max(config.MaxWorkers, 1), count)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+	for i := range count {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// groupCommandTargets resolves the devices selected by the group and the base addresses of their device services, and
+// authorizes the command to each device for the caller
+func groupCommandTargets(group commandDTO.DeviceGroup, commandName, method string, caller models.CommandCaller, dic *di.Container) ([]groupCommandTarget, errors.EdgeX) {
+	devices, deviceErrs, err := groupDevices(group, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// resolve each device service once, and the devices of the device service which can't be resolved fail alone too
+	baseAddresses := make(map[string]string)
+	serviceErrs := make(map[string]errors.EdgeX)
+	targets := make([]groupCommandTarget, len(devices))
+	for i, device := range devices {
+		targets[i].deviceName = device.Name
+		if _, ok := deviceErrs[device.Name]; !ok {
+			if err := AuthorizeCommand(context.Background(), device.Name, commandName, method, caller, dic); err != nil {
+				deviceErrs[device.Name] = err
+			}
+		}
+		if _, ok := deviceErrs[device.Name]; !ok {
+			if _, ok := baseAddresses[device.ServiceName]; !ok && serviceErrs[device.ServiceName] == nil {
+				service, err := DeviceServiceByName(context.Background(), device.ServiceName, dic)
+				if err != nil {
+					serviceErrs[device.ServiceName] = err
+				} else {
+					baseAddresses[device.ServiceName] = service.BaseAddress
+				}
+			}
+			if err := serviceErrs[device.ServiceName]; err != nil {
+				deviceErrs[device.Name] = err
+			}
+		}
+		if err, ok := deviceErrs[device.Name]; ok {
+			result := errorResult(device.Name, err)
+			targets[i].result = &result
+			continue
+		}
+		targets[i].baseAddress = baseAddresses[device.ServiceName]
+	}
+	return targets, nil
+}
+
+// groupDevices resolves the devices selected by the valid group in order. The named devices which can't be resolved are
+// returned with only the names along with their errors, so they fail alone rather than the whole group.
+func groupDevices(group commandDTO.DeviceGroup, dic *di.Container) ([]dtos.Device, map[string]errors.EdgeX, errors.EdgeX) {
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	maxDevices := commandContainer.ConfigurationFrom(dic.Get).GroupCommand.MaxDevices
+
+	var devices []dtos.Device
+	deviceErrs := make(map[string]errors.EdgeX)
+	var err errors.EdgeX
+	switch {
+	case len(group.DeviceNames) > 0:
+		if maxDevices > 0 && len(group.DeviceNames) > maxDevices {
+			return nil, nil, exceedMaxDevicesError(maxDevices)
+		}
+		resolved := make(map[string]struct{}, len(group.DeviceNames))
+		for _, name := range group.DeviceNames {
+			if _, ok := resolved[name]; ok {
+				continue
+			}
+			resolved[name] = struct{}{}
+			device, err := DeviceByName(context.Background(), name, dic)
+			if err != nil {
+				deviceErrs[name] = err
+				devices = append(devices, dtos.Device{Name: name})
+				continue
+			}
+			devices = append(devices, device)
+		}
+	case len(group.Labels) > 0:
+		devices, err = allDevicePages(maxDevices, func(offset int) (responses.MultiDevicesResponse, errors.EdgeX) {
+			return dc.AllDevices(context.Background(), group.Labels, offset, -1)
+		})
+	case group.ProfileName != "":
+		devices, err = allDevicePages(maxDevices, func(offset int) (responses.MultiDevicesResponse, errors.EdgeX) {
+			return dc.DevicesByProfileName(context.Background(), group.ProfileName, offset, -1)
+		})
+	case group.ServiceName != "":
+		devices, err = allDevicePages(maxDevices, func(offset int) (responses.MultiDevicesResponse, errors.EdgeX) {
+			return dc.DevicesByServiceName(context.Background(), group.ServiceName, offset, -1)
+		})
+	}
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(devices) == 0 {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "no device matches the group", nil)
+	}
+	return devices, deviceErrs, nil
+}
+
+// allDevicePages queries the devices page by page until all the devices are returned
+func allDevicePages(maxDevices int, query func(offset int) (responses.MultiDevicesResponse, errors.EdgeX)) ([]dtos.Device, errors.EdgeX) {
+	return allPages(func(offset int) ([]dtos.Device, uint32, errors.EdgeX) {
+		res, err := query(offset)
+		if err == nil && maxDevices > 0 && int(res.TotalCount) > maxDevices {
+			return nil, 0, exceedMaxDevicesError(maxDevices)
+		}
+		return res.Devices, res.TotalCount, err
+	})
+}
+
+// allPages queries the entities page by page until all the entities are returned, the query returns the entities of
+// the page along with the total count of the entities
+func allPages[T any](query func(offset int) ([]T, uint32, errors.EdgeX)) ([]T, errors.EdgeX) {
+	var entities []T
+	for {
+		page, totalCount, err := query(len(entities))
+		if err != nil {
+			if errors.Kind(err) == errors.KindEntityDoesNotExist {
+				return entities, nil
+			}
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		entities = append(entities, page...)
+		if len(page) == 0 || len(entities) >= int(totalCount) {
+			return entities, nil
+		}
+	}
+}
+
+func exceedMaxDevicesError(maxDevices int) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the group targets more than %d devices", maxDevices), nil)
+}
+
+func errorResult(deviceName string, err errors.EdgeX) commandDTO.DeviceCommandResult {
+	return commandDTO.DeviceCommandResult{DeviceName: deviceName, StatusCode: err.Code(), Message: err.Error(), SettingErrors: SettingErrorsFrom(err)}
+}
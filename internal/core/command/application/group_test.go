@@ -0,0 +1,198 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+const (
+	testLabel            = "floor1"
+	testProfileName      = "testProfile"
+	testServiceName      = "testService"
+	testBrokenService    = "brokenService"
+	testCommandName      = "switch"
+	testBaseAddress      = "http://localhost:59900"
+	testMissingDevice    = "missingDevice"
+	testBrokenDevice     = "brokenDevice"
+	testFailedSetCommand = "failedCommand"
+)
+
+func newGroupCommandClientMocks() (*mocks.DeviceClient, *mocks.DeviceServiceClient, *mocks.DeviceServiceCommandClient) {
+	devices := []dtos.Device{
+		{Name: "light1", ServiceName: testServiceName},
+		{Name: "light2", ServiceName: testServiceName},
+		{Name: "light3", ServiceName: testServiceName},
+	}
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("AllDevices", mock.Anything, []string{testLabel}, 0, -1).
+		Return(responses.MultiDevicesResponse{BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, 3), Devices: devices[:2]}, nil)
+	dcMock.On("AllDevices", mock.Anything, []string{testLabel}, 2, -1).
+		Return(responses.MultiDevicesResponse{BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, 3), Devices: devices[2:]}, nil)
+	dcMock.On("DevicesByProfileName", mock.Anything, testProfileName, 0, -1).
+		Return(responses.MultiDevicesResponse{BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, 0)}, nil)
+	dcMock.On("DeviceByName", mock.Anything, "light1").Return(responses.DeviceResponse{Device: devices[0]}, nil)
+	dcMock.On("DeviceByName", mock.Anything, testBrokenDevice).Return(responses.DeviceResponse{Device: dtos.Device{Name: testBrokenDevice, ServiceName: testBrokenService}}, nil)
+	dcMock.On("DeviceByName", mock.Anything, testMissingDevice).
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testServiceName).
+		Return(responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: testServiceName, BaseAddress: testBaseAddress}}, nil)
+	dscMock.On("DeviceServiceByName", mock.Anything, testBrokenService).
+		Return(responses.DeviceServiceResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "device service unavailable", nil))
+
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, mock.Anything, testCommandName, "").Return(nil, nil)
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, mock.Anything, testCommandName, "", mock.Anything).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, mock.Anything, testFailedSetCommand, "", mock.Anything).
+		Return(commonDTO.BaseResponse{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid settings", nil))
+
+	return dcMock, dscMock, dsccMock
+}
+
+func TestIssueGroupGetCommand(t *testing.T) {
+	dcMock, dscMock, dsccMock := newGroupCommandClientMocks()
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:                &config.ConfigurationStruct{GroupCommand: config.GroupCommandInfo{MaxWorkers: 2, MaxDevices: 0}},
+		bootstrapContainer.DeviceClientName:               dcMock,
+		bootstrapContainer.DeviceServiceClientName:        dscMock,
+		bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+	})
+
+	tests := []struct {
+		name                string
+		group               commandDTO.DeviceGroup
+		expectedStatusCodes map[string]int
+		errorExpected       bool
+		errKind             errors.ErrKind
+	}{
+		{"Valid - by labels across pages", commandDTO.DeviceGroup{Labels: []string{testLabel}},
+			map[string]int{"light1": http.StatusOK, "light2": http.StatusOK, "light3": http.StatusOK}, false, ""},
+		{"Valid - by names with the missing device and the unavailable device service",
+			commandDTO.DeviceGroup{DeviceNames: []string{testMissingDevice, "light1", testBrokenDevice, "light1"}},
+			map[string]int{testMissingDevice: http.StatusNotFound, "light1": http.StatusOK, testBrokenDevice: http.StatusServiceUnavailable}, false, ""},
+		{"Invalid - no selector", commandDTO.DeviceGroup{}, nil, true, errors.KindContractInvalid},
+		{"Invalid - more than one selector", commandDTO.DeviceGroup{Labels: []string{testLabel}, ProfileName: testProfileName}, nil, true, errors.KindContractInvalid},
+		{"Not found - no device matches", commandDTO.DeviceGroup{ProfileName: testProfileName}, nil, true, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := IssueGroupGetCommand(testCase.group, testCommandName, "", models.CommandCaller{}, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.errKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, len(testCase.expectedStatusCodes))
+			for _, r := range results {
+				assert.Equal(t, testCase.expectedStatusCodes[r.DeviceName], r.StatusCode, "status code of device %s not as expected", r.DeviceName)
+			}
+		})
+	}
+}
+
+func TestIssueGroupGetCommandKeepsDeviceOrder(t *testing.T) {
+	dcMock, dscMock, dsccMock := newGroupCommandClientMocks()
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:                &config.ConfigurationStruct{GroupCommand: config.GroupCommandInfo{MaxWorkers: 2, MaxDevices: 0}},
+		bootstrapContainer.DeviceClientName:               dcMock,
+		bootstrapContainer.DeviceServiceClientName:        dscMock,
+		bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+	})
+	results, err := IssueGroupGetCommand(commandDTO.DeviceGroup{DeviceNames: []string{testMissingDevice, "light1", testBrokenDevice}}, testCommandName, "", models.CommandCaller{}, dic)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, testMissingDevice, results[0].DeviceName)
+	assert.Equal(t, "light1", results[1].DeviceName)
+	assert.Equal(t, testBrokenDevice, results[2].DeviceName)
+}
+
+func TestIssueGroupGetCommandDeniedDevice(t *testing.T) {
+	dcMock, dscMock, dsccMock := newGroupCommandClientMocks()
+	dic := mockDic(map[string]any{
+		commandContainer.ConfigurationName:                &config.ConfigurationStruct{GroupCommand: config.GroupCommandInfo{MaxWorkers: 2, MaxDevices: 0}},
+		bootstrapContainer.DeviceClientName:               dcMock,
+		bootstrapContainer.DeviceServiceClientName:        dscMock,
+		bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+	})
+	denied := models.CommandPolicy{Name: "deny-light2", Effect: models.PolicyEffectDeny, DeviceNames: []string{"light2"}}
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.CommandPolicyCacheInterfaceName: func(get di.Get) interface{} {
+			return cache.NewCommandPolicyCache([]models.CommandPolicy{denied})
+		},
+	})
+
+	results, err := IssueGroupGetCommand(commandDTO.DeviceGroup{Labels: []string{testLabel}}, testCommandName, "", models.CommandCaller{Source: models.AuditSourceREST}, dic)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for _, r := range results {
+		expected := http.StatusOK
+		if r.DeviceName == "light2" {
+			expected = http.StatusForbidden
+		}
+		assert.Equal(t, expected, r.StatusCode, "status code of device %s not as expected", r.DeviceName)
+	}
+}
+
+func TestIssueGroupSetCommand(t *testing.T) {
+	settings := map[string]any{"switch": "off"}
+
+	tests := []struct {
+		name               string
+		commandName        string
+		maxDevices         int
+		expectedStatusCode int
+		errorExpected      bool
+	}{
+		{"Valid", testCommandName, 0, http.StatusOK, false},
+		{"Valid - command fails on the devices", testFailedSetCommand, 0, http.StatusBadRequest, false},
+		{"Invalid - exceeds the maximum devices", testCommandName, 2, 0, true},
+		{"Invalid - empty command name", "", 0, 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dcMock, dscMock, dsccMock := newGroupCommandClientMocks()
+			dic := mockDic(map[string]any{
+				commandContainer.ConfigurationName:                &config.ConfigurationStruct{GroupCommand: config.GroupCommandInfo{MaxWorkers: 2, MaxDevices: testCase.maxDevices}},
+				bootstrapContainer.DeviceClientName:               dcMock,
+				bootstrapContainer.DeviceServiceClientName:        dscMock,
+				bootstrapContainer.DeviceServiceCommandClientName: dsccMock,
+			})
+			results, err := IssueGroupSetCommand(commandDTO.DeviceGroup{Labels: []string{testLabel}}, testCase.commandName, "", settings, models.CommandCaller{}, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, 3)
+			for _, r := range results {
+				assert.Equal(t, testCase.expectedStatusCode, r.StatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+// DeviceByName returns the device from the metadata cache, and queries core-metadata when the device isn't cached yet or
+// is expired. The expired device is returned when core-metadata fails to be queried.
+func DeviceByName(ctx context.Context, name string, dic *di.Container) (dtos.Device, errors.EdgeX) {
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	if metadataCache != nil {
+		if device, ok := metadataCache.Device(name); ok {
+			return device, nil
+		}
+	}
+
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return dtos.Device{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	res, err := dc.DeviceByName(ctx, name)
+	if err != nil {
+		// the expired entity keeps the commands working while core-metadata is restarting, unless it doesn't exist anymore
+		if metadataCache != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+			if device, ok := metadataCache.StaleDevice(name); ok {
+				bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("Serving the expired cached device %s as core-metadata can't be queried, %v", name, err)
+				return device, nil
+			}
+		}
+		return dtos.Device{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if metadataCache != nil {
+		metadataCache.SetDevice(res.Device)
+	}
+	return res.Device, nil
+}
+
+// DeviceProfileByName returns the device profile from the metadata cache, and queries core-metadata when the device
+// profile isn't cached yet or is expired. The expired device profile is returned when core-metadata fails to be queried.
+func DeviceProfileByName(ctx context.Context, name string, dic *di.Container) (dtos.DeviceProfile, errors.EdgeX) {
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	if metadataCache != nil {
+		if profile, ok := metadataCache.DeviceProfile(name); ok {
+			return profile, nil
+		}
+	}
+
+	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
+	if dpc == nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceProfileClient returned", nil)
+	}
+	res, err := dpc.DeviceProfileByName(ctx, name)
+	if err != nil {
+		// the expired entity keeps the commands working while core-metadata is restarting, unless it doesn't exist anymore
+		if metadataCache != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+			if profile, ok := metadataCache.StaleDeviceProfile(name); ok {
+				bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("Serving the expired cached device profile %s as core-metadata can't be queried, %v", name, err)
+				return profile, nil
+			}
+		}
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if metadataCache != nil {
+		metadataCache.SetDeviceProfile(res.Profile)
+	}
+	return res.Profile, nil
+}
+
+// DeviceServiceByName returns the device service from the metadata cache, and queries core-metadata when the device
+// service isn't cached yet or is expired. The expired device service is returned when core-metadata fails to be queried.
+func DeviceServiceByName(ctx context.Context, name string, dic *di.Container) (dtos.DeviceService, errors.EdgeX) {
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	if metadataCache != nil {
+		if service, ok := metadataCache.DeviceService(name); ok {
+			return service, nil
+		}
+	}
+
+	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
+	if dsc == nil {
+		return dtos.DeviceService{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
+	}
+	res, err := dsc.DeviceServiceByName(ctx, name)
+	if err != nil {
+		// the expired entity keeps the commands working while core-metadata is restarting, unless it doesn't exist anymore
+		if metadataCache != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+			if service, ok := metadataCache.StaleDeviceService(name); ok {
+				bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("Serving the expired cached device service %s as core-metadata can't be queried, %v", name, err)
+				return service, nil
+			}
+		}
+		return dtos.DeviceService{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if metadataCache != nil {
+		metadataCache.SetDeviceService(res.Service)
+	}
+	return res.Service, nil
+}
+
+// WarmMetadataCache loads all the devices, device profiles and device services from core-metadata into the metadata cache
+func WarmMetadataCache(ctx context.Context, dic *di.Container) errors.EdgeX {
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	if metadataCache == nil {
+		return nil
+	}
+
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
+	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
+	if dc == nil || dpc == nil || dsc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil core-metadata clients returned", nil)
+	}
+
+	// core-metadata returns at most MaxResultCount entities per query, so the entities are queried page by page
+	services, err := allPages(func(offset int) ([]dtos.DeviceService, uint32, errors.EdgeX) {
+		res, err := dsc.AllDeviceServices(ctx, nil, offset, -1)
+		return res.Services, res.TotalCount, err
+	})
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "failed to load device services", err)
+	}
+	for _, s := range services {
+		metadataCache.SetDeviceService(s)
+	}
+	profiles, err := allPages(func(offset int) ([]dtos.DeviceProfile, uint32, errors.EdgeX) {
+		res, err := dpc.AllDeviceProfiles(ctx, nil, offset, -1)
+		return res.Profiles, res.TotalCount, err
+	})
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "failed to load device profiles", err)
+	}
+	for _, p := range profiles {
+		metadataCache.SetDeviceProfile(p)
+	}
+	devices, err := allDevicePages(0, func(offset int) (responses.MultiDevicesResponse, errors.EdgeX) {
+		return dc.AllDevices(ctx, nil, offset, -1)
+	})
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "failed to load devices", err)
+	}
+	for _, d := range devices {
+		metadataCache.SetDevice(d)
+	}
+
+	bootstrapContainer.LoggingClientFrom(dic.Get).Infof("Metadata cache is warmed with %d devices, %d device profiles and %d device services",
+		len(devices), len(profiles), len(services))
+	return nil
+}
@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+func TestDeviceByName(t *testing.T) {
+	device := dtos.Device{Id: "1", Name: testDeviceName, ServiceName: testServiceName}
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(responses.DeviceResponse{Device: device}, nil)
+	dcMock.On("DeviceByName", mock.Anything, testMissingDevice).
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+
+	t.Run("cache disabled", func(t *testing.T) {
+		dic := mockDic(map[string]any{
+			bootstrapContainer.DeviceClientName: dcMock,
+		})
+		result, err := DeviceByName(context.Background(), testDeviceName, dic)
+		require.NoError(t, err)
+		assert.Equal(t, device, result)
+	})
+	t.Run("cache miss then hit", func(t *testing.T) {
+		metadataCache := cache.NewMetadataCache(0)
+		dic := mockDic(map[string]any{
+			commandContainer.MetadataCacheInterfaceName: metadataCache,
+			bootstrapContainer.DeviceClientName:         dcMock,
+		})
+		calls := len(dcMock.Calls)
+
+		_, err := DeviceByName(context.Background(), testDeviceName, dic)
+		require.NoError(t, err)
+		cached, ok := metadataCache.Device(testDeviceName)
+		require.True(t, ok, "device not cached on a cache miss")
+		assert.Equal(t, device, cached)
+
+		_, err = DeviceByName(context.Background(), testDeviceName, dic)
+		require.NoError(t, err)
+		assert.Len(t, dcMock.Calls, calls+1, "core-metadata shouldn't be queried on a cache hit")
+	})
+	t.Run("device not found", func(t *testing.T) {
+		dic := mockDic(map[string]any{
+			commandContainer.MetadataCacheInterfaceName: cache.NewMetadataCache(0),
+			bootstrapContainer.DeviceClientName:         dcMock,
+		})
+		_, err := DeviceByName(context.Background(), testMissingDevice, dic)
+		require.Error(t, err)
+		assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	})
+}
+
+func TestDeviceByNameExpired(t *testing.T) {
+	device := dtos.Device{Id: "1", Name: testDeviceName, ServiceName: testServiceName}
+	tests := []struct {
+		name          string
+		clientErr     errors.EdgeX
+		errorExpected bool
+	}{
+		{"core-metadata unavailable", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "core-metadata unavailable", nil), false},
+		{"device deleted", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil), true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dcMock := &mocks.DeviceClient{}
+			dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(responses.DeviceResponse{}, testCase.clientErr)
+			metadataCache := cache.NewMetadataCache(time.Millisecond)
+			metadataCache.SetDevice(device)
+			dic := mockDic(map[string]any{
+				commandContainer.MetadataCacheInterfaceName: metadataCache,
+				bootstrapContainer.DeviceClientName:         dcMock,
+			})
+			time.Sleep(10 * time.Millisecond)
+
+			result, err := DeviceByName(context.Background(), testDeviceName, dic)
+
+			dcMock.AssertNumberOfCalls(t, "DeviceByName", 1)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.Kind(testCase.clientErr), errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, device, result, "the expired device should be served when core-metadata fails")
+		})
+	}
+}
+
+func TestWarmMetadataCache(t *testing.T) {
+	// the devices are returned in two pages as core-metadata limits the count of the devices returned per query
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("AllDevices", mock.Anything, []string(nil), 0, -1).
+		Return(responses.MultiDevicesResponse{BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, 2),
+			Devices: []dtos.Device{{Name: testDeviceName, ProfileName: testProfileName, ServiceName: testServiceName}}}, nil)
+	dcMock.On("AllDevices", mock.Anything, []string(nil), 1, -1).
+		Return(responses.MultiDevicesResponse{BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, 2),
+			Devices: []dtos.Device{{Name: testMissingDevice, ProfileName: testProfileName, ServiceName: testServiceName}}}, nil)
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("AllDeviceProfiles", mock.Anything, []string(nil), 0, -1).
+		Return(responses.MultiDeviceProfilesResponse{BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, 1),
+			Profiles: []dtos.DeviceProfile{{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName}}}}, nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("AllDeviceServices", mock.Anything, []string(nil), 0, -1).
+		Return(responses.MultiDeviceServicesResponse{BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, 1),
+			Services: []dtos.DeviceService{{Name: testServiceName, BaseAddress: testBaseAddress}}}, nil)
+	metadataCache := cache.NewMetadataCache(0)
+	dic := mockDic(map[string]any{
+		commandContainer.MetadataCacheInterfaceName: metadataCache,
+		bootstrapContainer.DeviceClientName:         dcMock,
+		bootstrapContainer.DeviceProfileClientName:  dpcMock,
+		bootstrapContainer.DeviceServiceClientName:  dscMock,
+	})
+
+	err := WarmMetadataCache(context.Background(), dic)
+	require.NoError(t, err)
+
+	_, ok := metadataCache.Device(testDeviceName)
+	assert.True(t, ok)
+	_, ok = metadataCache.Device(testMissingDevice)
+	assert.True(t, ok, "the devices of the second page should be cached")
+	_, ok = metadataCache.DeviceProfile(testProfileName)
+	assert.True(t, ok)
+	service, ok := metadataCache.DeviceService(testServiceName)
+	require.True(t, ok)
+	assert.Equal(t, testBaseAddress, service.BaseAddress)
+
+	// the cached metadata are used to issue the command without querying core-metadata
+	service, err = DeviceServiceByName(context.Background(), testServiceName, dic)
+	require.NoError(t, err)
+	assert.Equal(t, testBaseAddress, service.BaseAddress)
+	dscMock.AssertNotCalled(t, "DeviceServiceByName", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,244 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// invalidSettingsError holds the errors of the invalid settings of a set command
+type invalidSettingsError []commandDTO.SettingError
+
+func (e invalidSettingsError) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fmt.Sprintf("%s: %s", fieldErr.Setting, fieldErr.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// SettingErrorsFrom returns the errors of the invalid settings if the set command is rejected by the validation
+func SettingErrorsFrom(err error) []commandDTO.SettingError {
+	var settingErrs invalidSettingsError
+	if stdErrors.As(err, &settingErrs) {
+		return settingErrs
+	}
+	return nil
+}
+
+// ValidateSetCommand validates the settings of the set command to the device against the device profile if the command
+// validation is enabled, see validateSetCommandSettings
+func ValidateSetCommand(ctx context.Context, deviceName, commandName string, settings map[string]any, dic *di.Container) errors.EdgeX {
+	if !commandContainer.ConfigurationFrom(dic.Get).CommandValidation.Enabled {
+		return nil
+	}
+	device, err := DeviceByName(ctx, deviceName, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return validateSetCommandSettings(ctx, device, commandName, settings, dic)
+}
+
+// validateSetCommandSettings validates the settings of the set command against the device profile of the device. Each
+// setting must target a writable resource of the command, and its value must be convertible to the value type of the
+// resource and within the minimum and maximum of the resource. All the invalid settings are reported in one error, see SettingErrorsFrom.
+func validateSetCommandSettings(ctx context.Context, device dtos.Device, commandName string, settings map[string]any, dic *di.Container) errors.EdgeX {
+	profile, err := DeviceProfileByName(ctx, device.ProfileName, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// the resources writable by the command, and the value mappings of the resource operations
+	var resources []dtos.DeviceResource
+	mappings := make(map[string]map[string]string)
+	if i := slices.IndexFunc(profile.DeviceCommands, func(c dtos.DeviceCommand) bool { return c.Name == commandName }); i >= 0 {
+		command := profile.DeviceCommands[i]
+		if !strings.Contains(command.ReadWrite, common.ReadWrite_W) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command %s of device profile %s is read-only", commandName, profile.Name), nil)
+		}
+		for _, ro := range command.ResourceOperations {
+			r, exists := deviceResourcesByName(profile.DeviceResources, ro.DeviceResource)
+			if !exists {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device command's resource %s doesn't match any device resource", ro.DeviceResource), nil)
+			}
+			resources = append(resources, r)
+			mappings[r.Name] = ro.Mappings
+		}
+	} else if r, exists := deviceResourcesByName(profile.DeviceResources, commandName); exists {
+		resources = append(resources, r)
+	} else {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("command %s doesn't exist in device profile %s", commandName, profile.Name), nil)
+	}
+
+	var fieldErrs invalidSettingsError
+	for _, name := range slices.Sorted(maps.Keys(settings)) {
+		r, exists := deviceResourcesByName(resources, name)
+		if !exists {
+			fieldErrs = append(fieldErrs, commandDTO.SettingError{Setting: name, Message: fmt.Sprintf("unknown resource of command %s", commandName)})
+			continue
+		}
+		if !strings.Contains(r.Properties.ReadWrite, common.ReadWrite_W) {
+			fieldErrs = append(fieldErrs, commandDTO.SettingError{Setting: name, Message: "resource is read-only"})
+			continue
+		}
+		value := settings[name]
+		if s, ok := value.(string); ok {
+			// the mappings are from the device values to the readable ones, which are mapped back by the device service
+			for deviceValue, mapped := range mappings[name] {
+				if mapped == s {
+					value = deviceValue
+					break
+				}
+			}
+		}
+		if err := validateSettingValue(value, r.Properties); err != nil {
+			fieldErrs = append(fieldErrs, commandDTO.SettingError{Setting: name, Message: err.Error()})
+		}
+	}
+	if len(fieldErrs) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("invalid settings of command %s to device %s", commandName, device.Name), fieldErrs)
+	}
+	return nil
+}
+
+// validateSettingValue validates the value is convertible to the value type and within the minimum and maximum of the
+// resource properties. The value is either a JSON value or its string representation, e.g. 12 or "12". Any scalar value
+// is accepted for a String resource, as the device service converts it to its string representation.
+func validateSettingValue(value any, properties dtos.ResourceProperties) error {
+	valueType := properties.ValueType
+	switch valueType {
+	case common.ValueTypeBinary:
+		// the binary value is written as is by the device service
+		return nil
+	case common.ValueTypeObject:
+		return validateSettingObject(value)
+	}
+
+	if elementType, isArray := strings.CutSuffix(valueType, "Array"); isArray {
+		elements, err := settingArray(value)
+		if err != nil {
+			return fmt.Errorf("value %v is not a %s: %w", value, valueType, err)
+		}
+		for i, element := range elements {
+			if err := validateSettingValue(element, dtos.ResourceProperties{ValueType: elementType, Minimum: properties.Minimum, Maximum: properties.Maximum}); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+
+	s, err := settingString(value)
+	if err != nil {
+		return fmt.Errorf("value %v is not a %s", value, valueType)
+	}
+	var number float64
+	switch valueType {
+	case common.ValueTypeString:
+		return nil
+	case common.ValueTypeBool:
+		if _, err = strconv.ParseBool(s); err != nil {
+			return fmt.Errorf("value %s can't be converted to %s", s, valueType)
+		}
+		return nil
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64:
+		var n uint64
+		n, err = strconv.ParseUint(s, 10, bitSize(valueType))
+		number = float64(n)
+	case common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64:
+		var n int64
+		n, err = strconv.ParseInt(s, 10, bitSize(valueType))
+		number = float64(n)
+	case common.ValueTypeFloat32, common.ValueTypeFloat64:
+		number, err = strconv.ParseFloat(s, bitSize(valueType))
+		if err == nil && (math.IsNaN(number) || math.IsInf(number, 0)) {
+			return fmt.Errorf("value %s is not a finite %s", s, valueType)
+		}
+	default:
+		return fmt.Errorf("unsupported value type %s", valueType)
+	}
+	if err != nil {
+		return fmt.Errorf("value %s can't be converted to %s", s, valueType)
+	}
+	if properties.Minimum != nil && number < *properties.Minimum {
+		return fmt.Errorf("value %s is less than the minimum %v", s, *properties.Minimum)
+	}
+	if properties.Maximum != nil && number > *properties.Maximum {
+		return fmt.Errorf("value %s is greater than the maximum %v", s, *properties.Maximum)
+	}
+	return nil
+}
+
+// settingString returns the string representation of the scalar setting value
+func settingString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("value %v is not a scalar", value)
+	}
+}
+
+// settingArray returns the elements of the array setting value, which is either a JSON array or its string representation
+func settingArray(value any) ([]any, error) {
+	switch v := value.(type) {
+	case []any:
+		return v, nil
+	case string:
+		var elements []any
+		if err := json.Unmarshal([]byte(v), &elements); err != nil {
+			return nil, err
+		}
+		return elements, nil
+	default:
+		return nil, fmt.Errorf("unexpected type %T", value)
+	}
+}
+
+// validateSettingObject validates the setting value is either a JSON object or its string representation
+func validateSettingObject(value any) error {
+	switch v := value.(type) {
+	case map[string]any:
+		return nil
+	case string:
+		var object map[string]any
+		if err := json.Unmarshal([]byte(v), &object); err != nil || object == nil {
+			return fmt.Errorf("value %s is not an %s", v, common.ValueTypeObject)
+		}
+		return nil
+	default:
+		return fmt.Errorf("value %v is not an %s", value, common.ValueTypeObject)
+	}
+}
+
+// bitSize returns the bit size of the numeric value type, e.g. 16 of Int16
+func bitSize(valueType string) int {
+	size, _ := strconv.Atoi(strings.TrimLeftFunc(valueType, unicode.IsLetter))
+	return size
+}
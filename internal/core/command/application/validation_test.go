@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+func TestValidateSettingValue(t *testing.T) {
+	minimum, maximum := -10.0, 100.0
+	tests := []struct {
+		name        string
+		value       any
+		valueType   string
+		minimum     *float64
+		maximum     *float64
+		expectedErr bool
+	}{
+		{"Valid - string", "abc", common.ValueTypeString, nil, nil, false},
+		{"Valid - bool", true, common.ValueTypeBool, nil, nil, false},
+		{"Valid - bool string", "false", common.ValueTypeBool, nil, nil, false},
+		{"Valid - int8 string", "-128", common.ValueTypeInt8, nil, nil, false},
+		{"Valid - uint16 number", float64(65535), common.ValueTypeUint16, nil, nil, false},
+		{"Valid - float32", 1.5, common.ValueTypeFloat32, nil, nil, false},
+		{"Valid - within the minimum and maximum", "100", common.ValueTypeInt32, &minimum, &maximum, false},
+		{"Valid - int array", []any{float64(1), "2"}, common.ValueTypeInt16Array, nil, nil, false},
+		{"Valid - int array string", "[1, 2, 3]", common.ValueTypeInt16Array, nil, nil, false},
+		{"Valid - object", map[string]any{"a": 1}, common.ValueTypeObject, nil, nil, false},
+		{"Valid - object string", `{"a": 1}`, common.ValueTypeObject, nil, nil, false},
+		{"Valid - binary", "anything", common.ValueTypeBinary, nil, nil, false},
+		{"Valid - number coerced to string", float64(1), common.ValueTypeString, nil, nil, false},
+		{"Valid - bool coerced to string", true, common.ValueTypeString, nil, nil, false},
+		{"Invalid - bool", "yes", common.ValueTypeBool, nil, nil, true},
+		{"Invalid - int8 overflow", "128", common.ValueTypeInt8, nil, nil, true},
+		{"Invalid - negative uint", "-1", common.ValueTypeUint32, nil, nil, true},
+		{"Invalid - fractional int", 1.5, common.ValueTypeInt64, nil, nil, true},
+		{"Invalid - float", "abc", common.ValueTypeFloat64, nil, nil, true},
+		{"Invalid - float NaN", "NaN", common.ValueTypeFloat64, nil, nil, true},
+		{"Invalid - less than the minimum", float64(-11), common.ValueTypeInt32, &minimum, &maximum, true},
+		{"Invalid - greater than the maximum", "100.5", common.ValueTypeFloat64, &minimum, &maximum, true},
+		{"Invalid - array element", []any{float64(1), "abc"}, common.ValueTypeInt16Array, nil, nil, true},
+		{"Invalid - array element greater than the maximum", "[1, 101]", common.ValueTypeInt16Array, &minimum, &maximum, true},
+		{"Invalid - not an array", "1", common.ValueTypeInt16Array, nil, nil, true},
+		{"Invalid - not an object", "[1]", common.ValueTypeObject, nil, nil, true},
+		{"Invalid - not a scalar", []any{"a"}, common.ValueTypeString, nil, nil, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateSettingValue(testCase.value, dtos.ResourceProperties{ValueType: testCase.valueType, Minimum: testCase.minimum, Maximum: testCase.maximum})
+			if testCase.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetCommandSettings(t *testing.T) {
+	minimum, maximum := 0.0, 100.0
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, Minimum: &minimum, Maximum: &maximum}},
+			{Name: "mode", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeUint8, ReadWrite: common.ReadWrite_RW}},
+			{Name: "serial", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_R}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "settings", ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{
+				{DeviceResource: "temperature"},
+				{DeviceResource: "mode", Mappings: map[string]string{"2": "eco"}},
+				{DeviceResource: "serial"},
+			}},
+			{Name: "info", ReadWrite: common.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "serial"}}},
+		},
+	}
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, testProfileName).Return(responses.DeviceProfileResponse{Profile: profile}, nil)
+	dic := mockDic(map[string]any{
+		bootstrapContainer.DeviceProfileClientName: dpcMock,
+	})
+	device := dtos.Device{Name: testDeviceName, ProfileName: testProfileName}
+
+	tests := []struct {
+		name            string
+		commandName     string
+		settings        map[string]any
+		expectedErrKind errors.ErrKind
+		expectedFields  []commandDTO.SettingError
+	}{
+		{"Valid - device command", "settings", map[string]any{"temperature": "36.6", "mode": float64(1)}, "", nil},
+		{"Valid - mapped value", "settings", map[string]any{"mode": "eco"}, "", nil},
+		{"Valid - device resource as command", "temperature", map[string]any{"temperature": float64(20)}, "", nil},
+		{"Invalid - unknown resource", "settings", map[string]any{"humidity": "1"}, errors.KindContractInvalid, []commandDTO.SettingError{{Setting: "humidity", Message: "unknown resource of command settings"}}},
+		{"Invalid - read-only resource", "settings", map[string]any{"serial": "abc"}, errors.KindContractInvalid, []commandDTO.SettingError{{Setting: "serial", Message: "resource is read-only"}}},
+		{"Invalid - all the invalid settings are reported", "settings", map[string]any{"temperature": "101", "mode": "turbo"},
+			errors.KindContractInvalid, []commandDTO.SettingError{
+				{Setting: "mode", Message: "value turbo can't be converted to Uint8"},
+				{Setting: "temperature", Message: "value 101 is greater than the maximum 100"},
+			}},
+		{"Invalid - read-only command", "info", map[string]any{"serial": "abc"}, errors.KindContractInvalid, nil},
+		{"Invalid - command not found", "unknown", map[string]any{"temperature": "1"}, errors.KindEntityDoesNotExist, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateSetCommandSettings(context.Background(), device, testCase.commandName, testCase.settings, dic)
+			if testCase.expectedErrKind == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+			assert.Equal(t, testCase.expectedFields, SettingErrorsFrom(err))
+			for _, field := range testCase.expectedFields {
+				assert.Contains(t, err.Error(), field.Setting+": "+field.Message)
+			}
+		})
+	}
+}
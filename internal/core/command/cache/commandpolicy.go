@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// CommandPolicyCache keeps all the command policies in memory, so that the policies are evaluated for each command
+// without the database round-trips
+type CommandPolicyCache interface {
+	CommandPolicies() []models.CommandPolicy
+	SetCommandPolicy(policy models.CommandPolicy)
+	RemoveCommandPolicy(name string)
+}
+
+type commandPolicyCache struct {
+	mutex    sync.RWMutex
+	policies map[string]models.CommandPolicy
+}
+
+// NewCommandPolicyCache creates a CommandPolicyCache with the given policies
+func NewCommandPolicyCache(policies []models.CommandPolicy) CommandPolicyCache {
+	c := &commandPolicyCache{policies: make(map[string]models.CommandPolicy, len(policies))}
+	for _, p := range policies {
+		c.policies[p.Name] = p
+	}
+	return c
+}
+
+func (c *commandPolicyCache) CommandPolicies() []models.CommandPolicy {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	policies := make([]models.CommandPolicy, 0, len(c.policies))
+	for _, p := range c.policies {
+		policies = append(policies, p)
+	}
+	return policies
+}
+
+func (c *commandPolicyCache) SetCommandPolicy(policy models.CommandPolicy) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.policies[policy.Name] = policy
+}
+
+func (c *commandPolicyCache) RemoveCommandPolicy(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.policies, name)
+}
@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+func TestCommandPolicyCache(t *testing.T) {
+	c := NewCommandPolicyCache([]models.CommandPolicy{{Name: "policy1", Effect: models.PolicyEffectAllow}})
+	assert.Len(t, c.CommandPolicies(), 1)
+
+	c.SetCommandPolicy(models.CommandPolicy{Name: "policy1", Effect: models.PolicyEffectDeny})
+	c.SetCommandPolicy(models.CommandPolicy{Name: "policy2", Effect: models.PolicyEffectAllow})
+	assert.ElementsMatch(t, []models.CommandPolicy{
+		{Name: "policy1", Effect: models.PolicyEffectDeny},
+		{Name: "policy2", Effect: models.PolicyEffectAllow},
+	}, c.CommandPolicies())
+
+	c.RemoveCommandPolicy("policy1")
+	assert.Equal(t, []models.CommandPolicy{{Name: "policy2", Effect: models.PolicyEffectAllow}}, c.CommandPolicies())
+}
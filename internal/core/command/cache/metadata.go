@@ -0,0 +1,184 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+)
+
+// MetadataCache caches the devices, device profiles and device services queried from core-metadata, so that the commands
+// can be issued without the metadata round-trips. The Stale methods return the entities even if they are expired, which
+// are only served when core-metadata can't be queried.
+type MetadataCache interface {
+	Device(name string) (dtos.Device, bool)
+	StaleDevice(name string) (dtos.Device, bool)
+	SetDevice(device dtos.Device)
+	RemoveDevice(name string)
+	DeviceProfile(name string) (dtos.DeviceProfile, bool)
+	StaleDeviceProfile(name string) (dtos.DeviceProfile, bool)
+	SetDeviceProfile(profile dtos.DeviceProfile)
+	RemoveDeviceProfile(name string)
+	DeviceService(name string) (dtos.DeviceService, bool)
+	StaleDeviceService(name string) (dtos.DeviceService, bool)
+	SetDeviceService(service dtos.DeviceService)
+	RemoveDeviceService(name string)
+}
+
+type metadataCache struct {
+	mutex    sync.RWMutex
+	devices  *entityStore[dtos.Device]
+	profiles *entityStore[dtos.DeviceProfile]
+	services *entityStore[dtos.DeviceService]
+}
+
+// NewMetadataCache creates an empty MetadataCache whose entities expire after the ttl, the entities never expire when
+// the ttl is less than or equal to zero
+func NewMetadataCache(ttl time.Duration) MetadataCache {
+	return &metadataCache{
+		devices:  newEntityStore[dtos.Device](ttl),
+		profiles: newEntityStore[dtos.DeviceProfile](ttl),
+		services: newEntityStore[dtos.DeviceService](ttl),
+	}
+}
+
+func (c *metadataCache) Device(name string) (dtos.Device, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.devices.get(name)
+}
+
+func (c *metadataCache) StaleDevice(name string) (dtos.Device, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.devices.getStale(name)
+}
+
+func (c *metadataCache) SetDevice(device dtos.Device) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.devices.set(device.Id, device.Name, device)
+}
+
+func (c *metadataCache) RemoveDevice(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.devices.remove(name)
+}
+
+func (c *metadataCache) DeviceProfile(name string) (dtos.DeviceProfile, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.profiles.get(name)
+}
+
+func (c *metadataCache) StaleDeviceProfile(name string) (dtos.DeviceProfile, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.profiles.getStale(name)
+}
+
+func (c *metadataCache) SetDeviceProfile(profile dtos.DeviceProfile) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.profiles.set(profile.Id, profile.Name, profile)
+}
+
+func (c *metadataCache) RemoveDeviceProfile(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.profiles.remove(name)
+}
+
+func (c *metadataCache) DeviceService(name string) (dtos.DeviceService, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.services.get(name)
+}
+
+func (c *metadataCache) StaleDeviceService(name string) (dtos.DeviceService, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.services.getStale(name)
+}
+
+func (c *metadataCache) SetDeviceService(service dtos.DeviceService) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.services.set(service.Id, service.Name, service)
+}
+
+func (c *metadataCache) RemoveDeviceService(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.services.remove(name)
+}
+
+// entityStore keeps the entities by name along with the name of each id, so that the entry of the previous name is
+// removed when an entity is renamed
+type entityStore[T any] struct {
+	ttl      time.Duration
+	entities map[string]cachedEntity[T]
+	idByName map[string]string
+	nameById map[string]string
+}
+
+// cachedEntity is the entity along with the time it expires, which is zero if the entity never expires
+type cachedEntity[T any] struct {
+	entity  T
+	expires time.Time
+}
+
+func newEntityStore[T any](ttl time.Duration) *entityStore[T] {
+	return &entityStore[T]{
+		ttl:      ttl,
+		entities: make(map[string]cachedEntity[T]),
+		idByName: make(map[string]string),
+		nameById: make(map[string]string),
+	}
+}
+
+// get returns the entity unless it's expired, the expired entity is kept until it's set again or removed, since get is
+// called with the read lock
+func (s *entityStore[T]) get(name string) (T, bool) {
+	cached, ok := s.entities[name]
+	if !ok || (!cached.expires.IsZero() && time.Now().After(cached.expires)) {
+		var empty T
+		return empty, false
+	}
+	return cached.entity, true
+}
+
+// getStale returns the entity even if it's expired
+func (s *entityStore[T]) getStale(name string) (T, bool) {
+	cached, ok := s.entities[name]
+	return cached.entity, ok
+}
+
+func (s *entityStore[T]) set(id, name string, entity T) {
+	if previous, ok := s.nameById[id]; ok && previous != name {
+		s.remove(previous)
+	}
+	cached := cachedEntity[T]{entity: entity}
+	if s.ttl > 0 {
+		cached.expires = time.Now().Add(s.ttl)
+	}
+	s.entities[name] = cached
+	if id != "" {
+		s.idByName[name] = id
+		s.nameById[id] = name
+	}
+}
+
+func (s *entityStore[T]) remove(name string) {
+	delete(s.entities, name)
+	if id, ok := s.idByName[name]; ok {
+		delete(s.nameById, id)
+		delete(s.idByName, name)
+	}
+}
@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataCacheDevice(t *testing.T) {
+	c := NewMetadataCache(0)
+	c.SetDevice(dtos.Device{Id: "1", Name: "device1", ServiceName: "service1"})
+
+	device, ok := c.Device("device1")
+	require.True(t, ok)
+	assert.Equal(t, "service1", device.ServiceName)
+
+	c.SetDevice(dtos.Device{Id: "1", Name: "device1", ServiceName: "service2"})
+	device, ok = c.Device("device1")
+	require.True(t, ok)
+	assert.Equal(t, "service2", device.ServiceName, "updated device not cached")
+
+	c.SetDevice(dtos.Device{Id: "1", Name: "renamed", ServiceName: "service2"})
+	_, ok = c.Device("device1")
+	assert.False(t, ok, "previous name of the renamed device should be removed")
+	_, ok = c.Device("renamed")
+	assert.True(t, ok)
+
+	c.RemoveDevice("renamed")
+	_, ok = c.Device("renamed")
+	assert.False(t, ok)
+}
+
+func TestMetadataCacheDeviceProfileAndService(t *testing.T) {
+	c := NewMetadataCache(0)
+	c.SetDeviceProfile(dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Id: "1", Name: "profile1"}})
+	c.SetDeviceService(dtos.DeviceService{Id: "1", Name: "service1", BaseAddress: "http://localhost:59900"})
+
+	_, ok := c.DeviceProfile("profile1")
+	assert.True(t, ok)
+	service, ok := c.DeviceService("service1")
+	require.True(t, ok)
+	assert.Equal(t, "http://localhost:59900", service.BaseAddress)
+
+	c.RemoveDeviceProfile("profile1")
+	c.RemoveDeviceService("service1")
+	_, ok = c.DeviceProfile("profile1")
+	assert.False(t, ok)
+	_, ok = c.DeviceService("service1")
+	assert.False(t, ok)
+}
+
+func TestMetadataCacheTTL(t *testing.T) {
+	c := NewMetadataCache(50 * time.Millisecond)
+	c.SetDevice(dtos.Device{Id: "1", Name: "device1", ServiceName: "service1"})
+	_, ok := c.Device("device1")
+	require.True(t, ok)
+
+	assert.Eventually(t, func() bool {
+		_, ok := c.Device("device1")
+		return !ok
+	}, time.Second, 10*time.Millisecond, "the device should expire after the ttl")
+	_, ok = c.StaleDevice("device1")
+	assert.True(t, ok, "the expired device should be kept as stale")
+
+	c.SetDevice(dtos.Device{Id: "1", Name: "device1", ServiceName: "service1"})
+	_, ok = c.Device("device1")
+	assert.True(t, ok, "the device set again should be cached")
+}
@@ -22,13 +22,21 @@ import (
 
 // ConfigurationStruct contains the configuration properties for the core-command service.
 type ConfigurationStruct struct {
-	Writable     WritableInfo
-	Clients      bootstrapConfig.ClientsCollection
-	Databases    map[string]bootstrapConfig.Database
-	Registry     bootstrapConfig.RegistryInfo
-	Service      bootstrapConfig.ServiceInfo
-	MessageBus   bootstrapConfig.MessageBusInfo
-	ExternalMQTT bootstrapConfig.ExternalMQTTInfo
+	Writable          WritableInfo
+	Clients           bootstrapConfig.ClientsCollection
+	Databases         map[string]bootstrapConfig.Database
+	Database          bootstrapConfig.Database
+	Registry          bootstrapConfig.RegistryInfo
+	Service           bootstrapConfig.ServiceInfo
+	MessageBus        bootstrapConfig.MessageBusInfo
+	ExternalMQTT      bootstrapConfig.ExternalMQTTInfo
+	GroupCommand      GroupCommandInfo
+	AuditLog          AuditLogInfo
+	MetadataCache     MetadataCacheInfo
+	AccessControl     AccessControlInfo
+	DeferredCommand   DeferredCommandInfo
+	CommandValidation CommandValidationInfo
+	DeviceSnapshot    DeviceSnapshotInfo
 }
 
 // WritableInfo contains configuration properties that can be updated and applied without restarting the service.
@@ -38,6 +46,76 @@ type WritableInfo struct {
 	Telemetry       bootstrapConfig.TelemetryInfo
 }
 
+// GroupCommandInfo defines how a group command is dispatched to the targeted devices
+type GroupCommandInfo struct {
+	// MaxWorkers is the maximum number of the commands issued to the devices concurrently, the commands are issued one by
+	// one when it is less than or equal to 1
+	MaxWorkers int
+	// MaxDevices is the maximum number of the devices a group command can target, no limit when it is less than or equal to zero
+	MaxDevices int
+}
+
+// MetadataCacheInfo defines whether the devices, device profiles and device services are cached locally. The cache is
+// warmed at startup and kept fresh by the core-metadata system events, and the cached entities expire after TTL so that
+// a missed system event doesn't leave a stale entity in the cache. The entities never expire when TTL is empty.
+type MetadataCacheInfo struct {
+	Enabled bool
+	TTL     string
+}
+
+// AccessControlInfo defines whether the command policies are enforced, and the effect of the commands matching no
+// policy, which is either ALLOW or DENY. The command policies can only be added, patched and deleted by the callers whose
+// JWT "sub" claim is one of AdminSubjects and, if AdminIssuer isn't empty, whose "iss" claim is AdminIssuer.
+type AccessControlInfo struct {
+	Enabled       bool
+	DefaultEffect string
+	AdminIssuer   string
+	AdminSubjects []string
+}
+
+// DeferredCommandInfo defines how the set commands requested with the deferred option are persisted and retried when the
+// device or its device service is unavailable. The retry interval is doubled after each failed attempt up to
+// MaxRetryInterval, and the delivered, failed and expired commands are purged once they are older than Retention.
+type DeferredCommandInfo struct {
+	Enabled          bool
+	DefaultTTL       string
+	MaxTTL           string
+	RetryInterval    string
+	MaxRetryInterval string
+	Retention        string
+}
+
+// CommandValidationInfo defines whether the settings of the set commands are validated against the device profiles
+// before the commands are forwarded to the device services
+type CommandValidationInfo struct {
+	Enabled bool
+}
+
+// DeviceSnapshotInfo defines whether the values of the devices can be captured as snapshots and restored later
+type DeviceSnapshotInfo struct {
+	Enabled bool
+}
+
+// AuditLogInfo defines whether the commands issued through core-command are recorded and how long the records are kept
+type AuditLogInfo struct {
+	Enabled   bool
+	Retention RecordRetention
+}
+
+// RecordRetention defines how the records are purged, the records are purged to MinCap once the total count reaches
+// MaxCap when checking every Interval
+type RecordRetention struct {
+	Enabled  bool
+	Interval string
+	MaxCap   uint32
+	MinCap   uint32
+}
+
+// DatabaseRequired tells whether any feature keeping its records in the database is enabled
+func (c *ConfigurationStruct) DatabaseRequired() bool {
+	return c.AuditLog.Enabled || c.AccessControl.Enabled || c.DeferredCommand.Enabled || c.DeviceSnapshot.Enabled
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -76,6 +154,7 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 func (c *ConfigurationStruct) GetBootstrap() bootstrapConfig.BootstrapConfiguration {
 	return bootstrapConfig.BootstrapConfiguration{
 		Clients:      &c.Clients,
+		Database:     &c.Database,
 		Service:      &c.Service,
 		Registry:     &c.Registry,
 		MessageBus:   &c.MessageBus,
@@ -95,7 +174,7 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 
 // GetDatabaseInfo returns a database information map.
 func (c *ConfigurationStruct) GetDatabaseInfo() bootstrapConfig.Database {
-	return bootstrapConfig.Database{}
+	return c.Database
 }
 
 // GetInsecureSecrets returns the service's InsecureSecrets.
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// new constants relates to EdgeX Core Command service and will be added to go-mod-core-contracts in the future
+
+// Constants related to defined routes in the v3 service APIs
+const (
+	ApiDeviceGroupCommandRoute = common.ApiDeviceRoute + "/" + Group + "/:" + common.Command
+
+	ApiAuditRecordRoute                      = common.ApiBase + "/" + AuditRecord
+	ApiAllAuditRecordRoute                   = ApiAuditRecordRoute + "/" + common.All
+	ApiAuditRecordByDeviceNameRoute          = ApiAuditRecordRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name
+	ApiAuditRecordByStatusRoute              = ApiAuditRecordRoute + "/" + common.Status + "/:" + common.Status
+	ApiAuditRecordByDeviceNameAndStatusRoute = ApiAuditRecordByDeviceNameRoute + "/" + common.Status + "/:" + common.Status
+
+	ApiCommandPolicyRoute       = common.ApiBase + "/" + CommandPolicy
+	ApiAllCommandPolicyRoute    = ApiCommandPolicyRoute + "/" + common.All
+	ApiCommandPolicyByNameRoute = ApiCommandPolicyRoute + "/" + common.Name + "/:" + common.Name
+
+	ApiDeferredCommandRoute         = common.ApiBase + "/" + DeferredCommand
+	ApiAllDeferredCommandRoute      = ApiDeferredCommandRoute + "/" + common.All
+	ApiDeferredCommandByIdRoute     = ApiDeferredCommandRoute + "/" + common.Id + "/:" + common.Id
+	ApiDeferredCommandByStatusRoute = ApiDeferredCommandRoute + "/" + common.Status + "/:" + common.Status
+
+	ApiDeviceSnapshotRoute             = common.ApiBase + "/" + DeviceSnapshot
+	ApiAllDeviceSnapshotRoute          = ApiDeviceSnapshotRoute + "/" + common.All
+	ApiDeviceSnapshotByIdRoute         = ApiDeviceSnapshotRoute + "/" + common.Id + "/:" + common.Id
+	ApiDeviceSnapshotByDeviceNameRoute = ApiDeviceSnapshotRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name
+	ApiRestoreDeviceSnapshotRoute      = ApiDeviceSnapshotByIdRoute + "/" + Restore
+)
+
+// Constants related to defined url path names and parameters in the v3 service APIs
+const (
+	Group = "group"
+	Names = "names"
+
+	AuditRecord     = "auditrecord"
+	CommandPolicy   = "commandpolicy"
+	DeferredCommand = "deferredcommand"
+	DeviceSnapshot  = "devicesnapshot"
+	Restore         = "restore"
+
+	// Deferred and TTL are the query parameters of the set command requesting the command to be retried within the ttl
+	// if the device is unavailable, they are not forwarded to the device service
+	Deferred = "deferred"
+	TTL      = "ttl"
+)
+
+// Constants related to the group command topics
+const (
+	// CoreCommandGroupRequestSubscribeTopic is the internal MessageBus topic of the group command requests, and the
+	// requester appends <CommandName>/<CommandMethod>
+	CoreCommandGroupRequestSubscribeTopic = "core/commandgroup/request/#"
+
+	CommandGroupRequestTopicKey        = "CommandGroupRequestTopic"
+	CommandGroupResponseTopicPrefixKey = "CommandGroupResponseTopicPrefix"
+)
+
+// CoreCommandDeferredCommandPublishTopic is the internal MessageBus topic where the final status of the deferred commands
+// is published, and <Status>/<DeviceName> is appended
+const CoreCommandDeferredCommandPublishTopic = "core/command/deferred"
+
+// Constants related to the command methods
+const (
+	CommandMethodGet = "get"
+	CommandMethodSet = "set"
+)
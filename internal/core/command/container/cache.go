@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+)
+
+// MetadataCacheInterfaceName contains the name of the cache.MetadataCache implementation in the DIC.
+var MetadataCacheInterfaceName = di.TypeInstanceToName((*cache.MetadataCache)(nil))
+
+// MetadataCacheFrom helper function queries the DIC and returns the cache.MetadataCache implementation, or nil when the
+// metadata cache is disabled.
+func MetadataCacheFrom(get di.Get) cache.MetadataCache {
+	metadataCache, ok := get(MetadataCacheInterfaceName).(cache.MetadataCache)
+	if !ok {
+		return nil
+	}
+	return metadataCache
+}
+
+// CommandPolicyCacheInterfaceName contains the name of the cache.CommandPolicyCache implementation in the DIC.
+var CommandPolicyCacheInterfaceName = di.TypeInstanceToName((*cache.CommandPolicyCache)(nil))
+
+// CommandPolicyCacheFrom helper function queries the DIC and returns the cache.CommandPolicyCache implementation, or nil
+// when the access control is disabled.
+func CommandPolicyCacheFrom(get di.Get) cache.CommandPolicyCache {
+	policyCache, ok := get(CommandPolicyCacheInterfaceName).(cache.CommandPolicyCache)
+	if !ok {
+		return nil
+	}
+	return policyCache
+}
@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type AuditRecordController struct {
+	dic *di.Container
+}
+
+// NewAuditRecordController creates and initializes an AuditRecordController
+func NewAuditRecordController(dic *di.Container) *AuditRecordController {
+	return &AuditRecordController{
+		dic: dic,
+	}
+}
+
+// AllAuditRecords handles the GET request of querying all audit records
+func (ac *AuditRecordController) AllAuditRecords(c echo.Context) error {
+	return ac.auditRecords(c, "", "")
+}
+
+// AuditRecordsByDeviceName handles the GET request of querying audit records by device name
+func (ac *AuditRecordController) AuditRecordsByDeviceName(c echo.Context) error {
+	return ac.auditRecords(c, c.Param(common.Name), "")
+}
+
+// AuditRecordsByStatus handles the GET request of querying audit records by status
+func (ac *AuditRecordController) AuditRecordsByStatus(c echo.Context) error {
+	return ac.auditRecords(c, "", c.Param(common.Status))
+}
+
+// AuditRecordsByDeviceNameAndStatus handles the GET request of querying audit records by device name and status
+func (ac *AuditRecordController) AuditRecordsByDeviceNameAndStatus(c echo.Context) error {
+	return ac.auditRecords(c, c.Param(common.Name), c.Param(common.Status))
+}
+
+func (ac *AuditRecordController) auditRecords(c echo.Context, deviceName, status string) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(ac.dic.Get)
+	config := commandContainer.ConfigurationFrom(ac.dic.Get)
+
+	// Parse time range (start, end), offset, and limit from incoming request
+	start, end, offset, limit, err := utils.ParseQueryStringTimeRangeOffsetLimit(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	records, totalCount, err := application.AuditRecords(ctx, deviceName, status, start, end, offset, limit, ac.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiAuditRecordsResponse("", "", http.StatusOK, totalCount, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	contractModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandResponseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+func TestAuditRecords(t *testing.T) {
+	records := []models.AuditRecord{
+		{Id: "1", DeviceName: testDeviceName, CommandName: testCommandName, Status: contractModels.Succeeded},
+		{Id: "2", DeviceName: testDeviceName, CommandName: testCommandName, Status: contractModels.Failed},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AuditRecordCount", context.Background(), mock.Anything, mock.Anything, int64(0), mock.AnythingOfType("int64")).Return(uint32(len(records)), nil)
+	dbClientMock.On("AuditRecords", context.Background(), mock.Anything, mock.Anything, int64(0), mock.AnythingOfType("int64"), 0, 20).Return(records, nil)
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewAuditRecordController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		route              string
+		deviceName         string
+		status             string
+		handler            func(c echo.Context) error
+		offset             string
+		expectedStatusCode int
+	}{
+		{"Valid - all audit records", constants.ApiAllAuditRecordRoute, "", "", controller.AllAuditRecords, "", http.StatusOK},
+		{"Valid - audit records by device name", constants.ApiAuditRecordByDeviceNameRoute, testDeviceName, "", controller.AuditRecordsByDeviceName, "", http.StatusOK},
+		{"Valid - audit records by status", constants.ApiAuditRecordByStatusRoute, "", contractModels.Failed, controller.AuditRecordsByStatus, "", http.StatusOK},
+		{"Valid - audit records by device name and status", constants.ApiAuditRecordByDeviceNameAndStatusRoute, testDeviceName, contractModels.Succeeded, controller.AuditRecordsByDeviceNameAndStatus, "", http.StatusOK},
+		{"Invalid - unknown status", constants.ApiAuditRecordByStatusRoute, "", "UNKNOWN", controller.AuditRecordsByStatus, "", http.StatusBadRequest},
+		{"Invalid - invalid offset format", constants.ApiAllAuditRecordRoute, "", "", controller.AllAuditRecords, "aaa", http.StatusBadRequest},
+		{"Invalid - offset out of range", constants.ApiAllAuditRecordRoute, "", "", controller.AllAuditRecords, "3", http.StatusRequestedRangeNotSatisfiable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, testCase.route, http.NoBody)
+			if testCase.offset != "" {
+				query := req.URL.Query()
+				query.Add(common.Offset, testCase.offset)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.Status)
+			c.SetParamValues(testCase.deviceName, testCase.status)
+			err := testCase.handler(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res commandResponseDTO.MultiAuditRecordsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, uint32(len(records)), res.TotalCount, "Response total count not as expected")
+			assert.Len(t, res.AuditRecords, len(records))
+		})
+	}
+}
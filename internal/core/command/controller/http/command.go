@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,16 +9,22 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandResponseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/labstack/echo/v4"
@@ -95,6 +101,7 @@ func (cc *CommandController) IssueGetCommandByName(c echo.Context) error {
 	r := c.Request()
 	w := c.Response()
 	ctx := r.Context()
+	issuedAt := time.Now()
 
 	// URL parameters
 	deviceName := c.Param(common.Name)
@@ -107,15 +114,28 @@ func (cc *CommandController) IssueGetCommandByName(c echo.Context) error {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
+	record := restAuditRecord(r, deviceName, commandName, constants.CommandMethodGet, nil)
+	err = application.AuthorizeCommand(ctx, deviceName, commandName, constants.CommandMethodGet, restCommandCaller(r), cc.dic)
+	if err != nil {
+		record.StatusCode, record.Message = err.Code(), err.Error()
+		application.AddAuditRecord(record, issuedAt, cc.dic)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
 	response, err := application.IssueGetCommandByName(deviceName, commandName, queryParams, cc.dic)
 	if err != nil {
+		record.StatusCode, record.Message = err.Code(), err.Error()
+		application.AddAuditRecord(record, issuedAt, cc.dic)
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 	// encode and send out the response
 	if response != nil {
+		record.StatusCode, record.Message = response.StatusCode, response.Message
+		application.AddAuditRecord(record, issuedAt, cc.dic)
 		utils.WriteHttpHeader(w, ctx, response.StatusCode)
 		return pkg.EncodeAndWriteResponse(response, w, lc)
 	}
+	record.StatusCode = http.StatusOK
+	application.AddAuditRecord(record, issuedAt, cc.dic)
 	// If dsReturnEvent is no, there will be no content returned in the http response
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return nil
@@ -126,24 +146,116 @@ func (cc *CommandController) IssueSetCommandByName(c echo.Context) error {
 	r := c.Request()
 	w := c.Response()
 	ctx := r.Context()
+	issuedAt := time.Now()
 
 	// URL parameters
 	deviceName := c.Param(common.Name)
 	commandName := c.Param(common.Command)
 	// Query params
-	queryParams := r.URL.RawQuery
+	deferred, ttl, queryParams, err := cc.deferredCommandParameters(r)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
 
 	// Request body
 	settings, err := utils.ParseBodyToMap(r)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	response, err := application.IssueSetCommandByName(deviceName, commandName, queryParams, settings, cc.dic)
+	record := restAuditRecord(r, deviceName, commandName, constants.CommandMethodSet, settings)
+	err = application.AuthorizeCommand(ctx, deviceName, commandName, constants.CommandMethodSet, restCommandCaller(r), cc.dic)
+	if err != nil {
+		record.StatusCode, record.Message = err.Code(), err.Error()
+		application.AddAuditRecord(record, issuedAt, cc.dic)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var response commonDTO.BaseResponse
+	if deferred {
+		var deferredId string
+		response, deferredId, err = application.IssueDeferrableSetCommand(ctx, models.DeferredCommand{
+			DeviceName:    deviceName,
+			CommandName:   commandName,
+			QueryParams:   queryParams,
+			Settings:      settings,
+			Caller:        record.Caller,
+			Source:        record.Source,
+			CorrelationId: record.CorrelationId,
+		}, ttl, cc.dic)
+		if err == nil && deferredId != "" {
+			// the final status of the deferred command is audited once it is delivered, failed or expired
+			deferredResponse := commonDTO.NewBaseWithIdResponse("", "the set command is deferred until the device is available", http.StatusAccepted, deferredId)
+			utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+			return pkg.EncodeAndWriteResponse(deferredResponse, w, lc)
+		}
+	} else {
+		response, err = application.IssueSetCommandByName(deviceName, commandName, queryParams, settings, cc.dic)
+	}
 	if err != nil {
+		record.StatusCode, record.Message = err.Code(), err.Error()
+		application.AddAuditRecord(record, issuedAt, cc.dic)
+		if settingErrs := application.SettingErrorsFrom(err); len(settingErrs) > 0 {
+			lc.Error(err.Error(), common.CorrelationHeader, correlation.FromContext(ctx))
+			utils.WriteHttpHeader(w, ctx, err.Code())
+			return pkg.EncodeAndWriteResponse(commandResponseDTO.NewInvalidSettingsResponse("", err.Message(), err.Code(), settingErrs), w, lc)
+		}
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	record.StatusCode, record.Message = response.StatusCode, response.Message
+	application.AddAuditRecord(record, issuedAt, cc.dic)
 
 	utils.WriteHttpHeader(w, ctx, response.StatusCode)
 	// encode and send out the response
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// deferredCommandParameters parses the deferred and ttl query parameters of the set command request, and returns the raw
+// query parameters without them to be forwarded to the device service
+func (cc *CommandController) deferredCommandParameters(r *http.Request) (deferred bool, ttl time.Duration, queryParams string, err errors.EdgeX) {
+	query := r.URL.Query()
+	if !query.Has(constants.Deferred) && !query.Has(constants.TTL) {
+		return false, 0, r.URL.RawQuery, nil
+	}
+
+	switch query.Get(constants.Deferred) {
+	case common.ValueTrue:
+		deferred = true
+	case common.ValueFalse, "":
+	default:
+		return false, 0, "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid query parameter, %s has to be %s or %s", constants.Deferred, common.ValueTrue, common.ValueFalse), nil)
+	}
+	if deferred {
+		if !commandContainer.ConfigurationFrom(cc.dic.Get).DeferredCommand.Enabled {
+			return false, 0, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "deferred set command is disabled", nil)
+		}
+		ttl, err = application.DeferredCommandTTL(query.Get(constants.TTL), cc.dic)
+		if err != nil {
+			return false, 0, "", errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	query.Del(constants.Deferred)
+	query.Del(constants.TTL)
+	return deferred, ttl, query.Encode(), nil
+}
+
+// restAuditRecord returns the audit record of the command requested through the REST API, the caller and correlation id
+// are taken from the request
+func restAuditRecord(r *http.Request, deviceName, commandName, method string, settings map[string]any) models.AuditRecord {
+	return models.AuditRecord{
+		DeviceName:    deviceName,
+		CommandName:   commandName,
+		Method:        method,
+		Settings:      settings,
+		Caller:        utils.CallerFromRequest(r),
+		Source:        models.AuditSourceREST,
+		CorrelationId: correlation.FromContext(r.Context()),
+	}
+}
+
+// restCommandCaller returns the caller of the command requested through the REST API, which is identified by the issuer
+// and subject of the JWT carried by the request
+func restCommandCaller(r *http.Request) models.CommandCaller {
+	issuer, subject := utils.CallerClaimsFromRequest(r)
+	return models.CommandCaller{Issuer: issuer, Subject: subject, Source: models.AuditSourceREST}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandResponseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
@@ -513,3 +514,91 @@ func TestIssueSetCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueSetCommandWithValidation(t *testing.T) {
+	maximum := 50.0
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: testResourceName, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, Maximum: &maximum}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: testCommandName, ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: testResourceName}}},
+		},
+	}
+	validSettings := map[string]interface{}{testResourceName: "28.5"}
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(buildDeviceResponse(), nil)
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", context.Background(), testProfileName).Return(responseDTO.DeviceProfileResponse{Profile: profile}, nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(buildDeviceServiceResponse(), nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, testDeviceName, testCommandName, "", validSettings).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+
+	dic := NewMockDIC()
+	commandContainer.ConfigurationFrom(dic.Get).CommandValidation.Enabled = true
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	cc := NewCommandController(dic)
+
+	tests := []struct {
+		name                  string
+		commandName           string
+		settings              map[string]interface{}
+		expectedStatusCode    int
+		expectedSettingErrors []string
+	}{
+		{"Valid - valid settings", testCommandName, validSettings, http.StatusOK, nil},
+		{"Invalid - unknown resource", testCommandName, map[string]interface{}{"unknown": "1"}, http.StatusBadRequest, []string{"unknown"}},
+		{"Invalid - value can't be converted", testCommandName, map[string]interface{}{testResourceName: "abc"}, http.StatusBadRequest, []string{testResourceName}},
+		{"Invalid - value greater than the maximum", testCommandName, map[string]interface{}{testResourceName: float64(51)}, http.StatusBadRequest, []string{testResourceName}},
+		{"Invalid - several settings", testCommandName, map[string]interface{}{testResourceName: float64(51), "unknown": "1"}, http.StatusBadRequest, []string{testResourceName, "unknown"}},
+		{"Invalid - command not found", "unknown", validSettings, http.StatusNotFound, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			settings, _ := json.Marshal(testCase.settings)
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, common.ApiDeviceNameCommandNameRoute, bytes.NewBuffer(settings))
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.Command)
+			c.SetParamValues(testDeviceName, testCase.commandName)
+
+			err := cc.IssueSetCommandByName(c)
+			require.NoError(t, err)
+
+			var res commandResponseDTO.InvalidSettingsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				dsccMock.AssertCalled(t, "SetCommandWithObject", context.Background(), testBaseAddress, testDeviceName, testCommandName, "", validSettings)
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+			var settingNames []string
+			for _, settingErr := range res.SettingErrors {
+				assert.NotEmpty(t, settingErr.Message)
+				settingNames = append(settingNames, settingErr.Setting)
+			}
+			assert.Equal(t, testCase.expectedSettingErrors, settingNames)
+		})
+	}
+	dsccMock.AssertNumberOfCalls(t, "SetCommandWithObject", 1)
+}
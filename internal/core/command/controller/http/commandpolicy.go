@@ -0,0 +1,192 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	requestDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type CommandPolicyController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewCommandPolicyController creates and initializes a CommandPolicyController
+func NewCommandPolicyController(dic *di.Container) *CommandPolicyController {
+	return &CommandPolicyController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// AddCommandPolicy handles the POST request of adding new CommandPolicy
+func (pc *CommandPolicyController) AddCommandPolicy(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	if err := application.AuthorizeCommandPolicyAdmin(restCommandCaller(r), pc.dic); err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var reqDTOs []requestDTO.AddCommandPolicyRequest
+	err := pc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var addResponses []any
+	for _, req := range reqDTOs {
+		var response any
+		reqId := req.RequestId
+		newId, err := application.AddCommandPolicy(ctx, commandDTO.ToCommandPolicyModel(req.CommandPolicy), pc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+		} else {
+			response = commonDTO.NewBaseWithIdResponse(reqId, "", http.StatusCreated, newId)
+		}
+		addResponses = append(addResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(addResponses, w, lc)
+}
+
+// CommandPolicyByName handles the GET request of querying CommandPolicy by name
+func (pc *CommandPolicyController) CommandPolicyByName(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(pc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	policy, err := application.CommandPolicyByName(ctx, name, pc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewCommandPolicyResponse("", "", http.StatusOK, policy)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// AllCommandPolicies handles the GET request of querying all CommandPolicies
+func (pc *CommandPolicyController) AllCommandPolicies(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	config := commandContainer.ConfigurationFrom(pc.dic.Get)
+
+	// parse URL query string for offset and limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	policies, totalCount, err := application.AllCommandPolicies(ctx, offset, limit, pc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiCommandPoliciesResponse("", "", http.StatusOK, totalCount, policies)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// PatchCommandPolicy handles the PATCH request of updating CommandPolicy
+func (pc *CommandPolicyController) PatchCommandPolicy(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	if err := application.AuthorizeCommandPolicyAdmin(restCommandCaller(r), pc.dic); err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var reqDTOs []requestDTO.UpdateCommandPolicyRequest
+	err := pc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var responses []any
+	for _, dto := range reqDTOs {
+		var response any
+		reqId := dto.RequestId
+		err := application.PatchCommandPolicy(ctx, dto.CommandPolicy, pc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+		} else {
+			response = commonDTO.NewBaseResponse(reqId, "", http.StatusOK)
+		}
+		responses = append(responses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(responses, w, lc)
+}
+
+// DeleteCommandPolicyByName handles the DELETE request of deleting CommandPolicy by name
+func (pc *CommandPolicyController) DeleteCommandPolicyByName(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(pc.dic.Get)
+
+	if err := application.AuthorizeCommandPolicyAdmin(restCommandCaller(r), pc.dic); err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteCommandPolicyByName(ctx, name, pc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,414 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	commandRequestDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	commandResponseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+const (
+	testPolicyName   = "deny-locks"
+	testAdminSubject = "admin"
+)
+
+// bearerToken returns the Authorization header value carrying the JWT of the subject
+func bearerToken(t *testing.T, subject string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": subject}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	return "Bearer " + token
+}
+
+func addCommandPolicyRequestData() commandRequestDTO.AddCommandPolicyRequest {
+	return commandRequestDTO.AddCommandPolicyRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		CommandPolicy: commandDTO.CommandPolicy{
+			Name:        testPolicyName,
+			Effect:      models.PolicyEffectDeny,
+			DeviceNames: []string{"lock-*"},
+			Methods:     []string{constants.CommandMethodSet},
+		},
+	}
+}
+
+func TestAddCommandPolicy(t *testing.T) {
+	valid := addCommandPolicyRequestData()
+	duplicate := addCommandPolicyRequestData()
+	duplicate.CommandPolicy.Name = "duplicate"
+	invalidEffect := addCommandPolicyRequestData()
+	invalidEffect.CommandPolicy.Effect = "MAYBE"
+	invalidMethod := addCommandPolicyRequestData()
+	invalidMethod.CommandPolicy.Methods = []string{"delete"}
+	invalidPattern := addCommandPolicyRequestData()
+	invalidPattern.CommandPolicy.DeviceNames = []string{"lock-["}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddCommandPolicy", context.Background(), commandDTO.ToCommandPolicyModel(valid.CommandPolicy)).
+		Return(models.CommandPolicy{Id: "c0a3a1ff-1bd0-4c38-8e0c-3a3b0d0fbe5c"}, nil)
+	dbClientMock.On("AddCommandPolicy", context.Background(), commandDTO.ToCommandPolicyModel(duplicate.CommandPolicy)).
+		Return(models.CommandPolicy{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "exists", nil))
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{AccessControl: config.AccessControlInfo{AdminSubjects: []string{testAdminSubject}}}
+		},
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		commandContainer.CommandPolicyCacheInterfaceName: func(get di.Get) interface{} {
+			return cache.NewCommandPolicyCache(nil)
+		},
+	})
+	controller := NewCommandPolicyController(dic)
+
+	tests := []struct {
+		name               string
+		request            []commandRequestDTO.AddCommandPolicyRequest
+		expectedStatusCode int
+		expectedItemCode   int
+	}{
+		{"Valid", []commandRequestDTO.AddCommandPolicyRequest{valid}, http.StatusMultiStatus, http.StatusCreated},
+		{"Duplicate name", []commandRequestDTO.AddCommandPolicyRequest{duplicate}, http.StatusMultiStatus, http.StatusConflict},
+		{"Invalid - unknown effect", []commandRequestDTO.AddCommandPolicyRequest{invalidEffect}, http.StatusBadRequest, 0},
+		{"Invalid - unknown method", []commandRequestDTO.AddCommandPolicyRequest{invalidMethod}, http.StatusBadRequest, 0},
+		{"Invalid - malformed pattern", []commandRequestDTO.AddCommandPolicyRequest{invalidPattern}, http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, constants.ApiCommandPolicyRoute, bytes.NewReader(jsonData))
+			req.Header.Set("Authorization", bearerToken(t, testAdminSubject))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AddCommandPolicy(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusMultiStatus {
+				return
+			}
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedItemCode, res[0].StatusCode)
+		})
+	}
+
+	t.Run("Forbidden - not an admin", func(t *testing.T) {
+		jsonData, err := json.Marshal([]commandRequestDTO.AddCommandPolicyRequest{valid})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, constants.ApiCommandPolicyRoute, bytes.NewReader(jsonData))
+		req.Header.Set("Authorization", bearerToken(t, "operator"))
+		recorder := httptest.NewRecorder()
+		err = controller.AddCommandPolicy(echo.New().NewContext(req, recorder))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, recorder.Result().StatusCode, "HTTP status code not as expected")
+	})
+}
+
+func TestCommandPolicyByName(t *testing.T) {
+	policy := models.CommandPolicy{Name: testPolicyName, Effect: models.PolicyEffectDeny}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandPolicyByName", context.Background(), testPolicyName).Return(policy, nil)
+	dbClientMock.On("CommandPolicyByName", context.Background(), "missing").
+		Return(models.CommandPolicy{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		commandContainer.CommandPolicyCacheInterfaceName: func(get di.Get) interface{} {
+			return cache.NewCommandPolicyCache(nil)
+		},
+	})
+	controller := NewCommandPolicyController(dic)
+
+	tests := []struct {
+		name               string
+		policyName         string
+		expectedStatusCode int
+	}{
+		{"Valid", testPolicyName, http.StatusOK},
+		{"Not found", "missing", http.StatusNotFound},
+		{"Invalid - empty name", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiCommandPolicyByNameRoute, http.NoBody)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.policyName)
+			err := controller.CommandPolicyByName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res commandResponseDTO.CommandPolicyResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testPolicyName, res.CommandPolicy.Name)
+		})
+	}
+}
+
+func TestAllCommandPolicies(t *testing.T) {
+	policies := []models.CommandPolicy{{Name: testPolicyName, Effect: models.PolicyEffectDeny}, {Name: "allow-all", Effect: models.PolicyEffectAllow}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandPolicyTotalCount", context.Background()).Return(uint32(len(policies)), nil)
+	dbClientMock.On("AllCommandPolicies", context.Background(), 0, 20).Return(policies, nil)
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		commandContainer.CommandPolicyCacheInterfaceName: func(get di.Get) interface{} {
+			return cache.NewCommandPolicyCache(nil)
+		},
+	})
+	controller := NewCommandPolicyController(dic)
+
+	tests := []struct {
+		name               string
+		offset             string
+		expectedStatusCode int
+	}{
+		{"Valid", "", http.StatusOK},
+		{"Invalid - invalid offset format", "aaa", http.StatusBadRequest},
+		{"Invalid - offset out of range", "3", http.StatusRequestedRangeNotSatisfiable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiAllCommandPolicyRoute, http.NoBody)
+			if testCase.offset != "" {
+				query := req.URL.Query()
+				query.Add(common.Offset, testCase.offset)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err := controller.AllCommandPolicies(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res commandResponseDTO.MultiCommandPoliciesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(len(policies)), res.TotalCount)
+			assert.Len(t, res.CommandPolicies, len(policies))
+		})
+	}
+}
+
+func TestPatchCommandPolicy(t *testing.T) {
+	existing := models.CommandPolicy{Id: "c0a3a1ff-1bd0-4c38-8e0c-3a3b0d0fbe5c", Name: testPolicyName, Effect: models.PolicyEffectDeny}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandPolicyByName", context.Background(), testPolicyName).Return(existing, nil)
+	dbClientMock.On("CommandPolicyByName", context.Background(), "missing").
+		Return(models.CommandPolicy{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("UpdateCommandPolicy", context.Background(), mock.Anything).Return(nil)
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{AccessControl: config.AccessControlInfo{AdminSubjects: []string{testAdminSubject}}}
+		},
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		commandContainer.CommandPolicyCacheInterfaceName: func(get di.Get) interface{} {
+			return cache.NewCommandPolicyCache(nil)
+		},
+	})
+	controller := NewCommandPolicyController(dic)
+
+	name := testPolicyName
+	missing := "missing"
+	allow := models.PolicyEffectAllow
+	invalid := "MAYBE"
+	tests := []struct {
+		name               string
+		policy             commandDTO.UpdateCommandPolicy
+		expectedStatusCode int
+		expectedItemCode   int
+	}{
+		{"Valid", commandDTO.UpdateCommandPolicy{Name: &name, Effect: &allow}, http.StatusMultiStatus, http.StatusOK},
+		{"Not found", commandDTO.UpdateCommandPolicy{Name: &missing, Effect: &allow}, http.StatusMultiStatus, http.StatusNotFound},
+		{"Invalid - unknown effect", commandDTO.UpdateCommandPolicy{Name: &name, Effect: &invalid}, http.StatusBadRequest, 0},
+		{"Invalid - no id and name", commandDTO.UpdateCommandPolicy{Effect: &allow}, http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			request := []commandRequestDTO.UpdateCommandPolicyRequest{{BaseRequest: commonDTO.NewBaseRequest(), CommandPolicy: testCase.policy}}
+			jsonData, err := json.Marshal(request)
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPatch, constants.ApiCommandPolicyRoute, bytes.NewReader(jsonData))
+			req.Header.Set("Authorization", bearerToken(t, testAdminSubject))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.PatchCommandPolicy(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusMultiStatus {
+				return
+			}
+			var res []commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedItemCode, res[0].StatusCode)
+		})
+	}
+}
+
+func TestDeleteCommandPolicyByName(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteCommandPolicyByName", context.Background(), testPolicyName).Return(nil)
+	dbClientMock.On("DeleteCommandPolicyByName", context.Background(), "missing").
+		Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{AccessControl: config.AccessControlInfo{AdminSubjects: []string{testAdminSubject}}}
+		},
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		commandContainer.CommandPolicyCacheInterfaceName: func(get di.Get) interface{} {
+			return cache.NewCommandPolicyCache(nil)
+		},
+	})
+	controller := NewCommandPolicyController(dic)
+
+	tests := []struct {
+		name               string
+		policyName         string
+		expectedStatusCode int
+	}{
+		{"Valid", testPolicyName, http.StatusOK},
+		{"Not found", "missing", http.StatusNotFound},
+		{"Invalid - empty name", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, constants.ApiCommandPolicyByNameRoute, http.NoBody)
+			req.Header.Set("Authorization", bearerToken(t, testAdminSubject))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.policyName)
+			err := controller.DeleteCommandPolicyByName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+
+	t.Run("Forbidden - no JWT", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, constants.ApiCommandPolicyByNameRoute, http.NoBody)
+		recorder := httptest.NewRecorder()
+		c := echo.New().NewContext(req, recorder)
+		c.SetParamNames(common.Name)
+		c.SetParamValues(testPolicyName)
+		err := controller.DeleteCommandPolicyByName(c)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, recorder.Result().StatusCode, "HTTP status code not as expected")
+		dbClientMock.AssertNumberOfCalls(t, "DeleteCommandPolicyByName", 2)
+	})
+}
+
+func TestIssueCommandDeniedByPolicy(t *testing.T) {
+	denied := models.CommandPolicy{Name: testPolicyName, Effect: models.PolicyEffectDeny, DeviceNames: []string{testDeviceName}}
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.CommandPolicyCacheInterfaceName: func(get di.Get) interface{} {
+			return cache.NewCommandPolicyCache([]models.CommandPolicy{denied})
+		},
+	})
+	cc := NewCommandController(dic)
+
+	tests := []struct {
+		name    string
+		method  string
+		body    string
+		handler func(c echo.Context) error
+	}{
+		{"get command", http.MethodGet, "", cc.IssueGetCommandByName},
+		{"set command", http.MethodPut, `{"switch":"on"}`, cc.IssueSetCommandByName},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(testCase.method, "/api/v3/device/name/:name/:command", bytes.NewReader([]byte(testCase.body)))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.Command)
+			c.SetParamValues(testDeviceName, testCommandName)
+			err := testCase.handler(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusForbidden, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+		})
+	}
+}
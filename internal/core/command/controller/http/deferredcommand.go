@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type DeferredCommandController struct {
+	dic *di.Container
+}
+
+// NewDeferredCommandController creates and initializes a DeferredCommandController
+func NewDeferredCommandController(dic *di.Container) *DeferredCommandController {
+	return &DeferredCommandController{
+		dic: dic,
+	}
+}
+
+// DeferredCommandById handles the GET request of querying the delivery status of a deferred command by id
+func (dc *DeferredCommandController) DeferredCommandById(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	lc := container.LoggingClientFrom(dc.dic.Get)
+
+	command, err := application.DeferredCommandById(ctx, c.Param(common.Id), dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewDeferredCommandResponse("", "", http.StatusOK, command)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// AllDeferredCommands handles the GET request of querying all deferred commands
+func (dc *DeferredCommandController) AllDeferredCommands(c echo.Context) error {
+	return dc.deferredCommands(c, "")
+}
+
+// DeferredCommandsByStatus handles the GET request of querying deferred commands by status
+func (dc *DeferredCommandController) DeferredCommandsByStatus(c echo.Context) error {
+	return dc.deferredCommands(c, c.Param(common.Status))
+}
+
+func (dc *DeferredCommandController) deferredCommands(c echo.Context, status string) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	config := commandContainer.ConfigurationFrom(dc.dic.Get)
+
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	commands, totalCount, err := application.DeferredCommandsByStatus(ctx, status, offset, limit, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiDeferredCommandsResponse("", "", http.StatusOK, totalCount, commands)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
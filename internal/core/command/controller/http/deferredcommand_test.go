@@ -0,0 +1,257 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+const (
+	testDeferredCommandId = "7a1707f0-166f-4c4b-bc9d-1d54c74e0137"
+	testOfflineCommand    = "offlineCommand"
+)
+
+func newDeferredCommandClientMocks() (*mocks.DeviceClient, *mocks.DeviceServiceClient, *mocks.DeviceServiceCommandClient) {
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(buildDeviceResponse(), nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).Return(buildDeviceServiceResponse(), nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, testDeviceName, testCommandName, mock.Anything, mock.Anything).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, testDeviceName, testOfflineCommand, mock.Anything, mock.Anything).
+		Return(commonDTO.BaseResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "device service unavailable", nil))
+
+	return dcMock, dscMock, dsccMock
+}
+
+func TestIssueDeferredSetCommand(t *testing.T) {
+	settings, _ := json.Marshal(buildTestSettings())
+
+	tests := []struct {
+		name                string
+		enabled             bool
+		commandName         string
+		queryStrings        string
+		expectedStatusCode  int
+		expectedQueryParams string
+	}{
+		{"Valid - deferred as the device service is unavailable", true, testOfflineCommand, "a=1&deferred=true&ttl=10m", http.StatusAccepted, "a=1"},
+		{"Valid - deferred with the default ttl", true, testOfflineCommand, "deferred=true", http.StatusAccepted, ""},
+		{"Valid - delivered immediately", true, testCommandName, "deferred=true", http.StatusOK, ""},
+		{"Invalid - not deferred", true, testOfflineCommand, "deferred=false", http.StatusServiceUnavailable, ""},
+		{"Invalid - invalid deferred", true, testOfflineCommand, "deferred=yes", http.StatusBadRequest, ""},
+		{"Invalid - ttl exceeds the max ttl", true, testOfflineCommand, "deferred=true&ttl=48h", http.StatusBadRequest, ""},
+		{"Invalid - deferred set command disabled", false, testOfflineCommand, "deferred=true", http.StatusBadRequest, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddDeferredCommand", mock.Anything, mock.Anything).Return(func(_ context.Context, c models.DeferredCommand) (models.DeferredCommand, errors.EdgeX) {
+				c.Id = testDeferredCommandId
+				return c, nil
+			})
+			dcMock, dscMock, dsccMock := newDeferredCommandClientMocks()
+			dic := NewMockDIC()
+			commandContainer.ConfigurationFrom(dic.Get).DeferredCommand = config.DeferredCommandInfo{
+				Enabled:          testCase.enabled,
+				DefaultTTL:       "1h",
+				MaxTTL:           "24h",
+				RetryInterval:    "10s",
+				MaxRetryInterval: "5m",
+				Retention:        "168h",
+			}
+			dic.Update(di.ServiceConstructorMap{
+				commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+					return dcMock
+				},
+				bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+					return dscMock
+				},
+				bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+					return dsccMock
+				},
+			})
+			cc := NewCommandController(dic)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, common.ApiDeviceNameCommandNameRoute, bytes.NewBuffer(settings))
+			req.URL.RawQuery = testCase.queryStrings
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.Command)
+			c.SetParamValues(testDeviceName, testCase.commandName)
+
+			err := cc.IssueSetCommandByName(c)
+			require.NoError(t, err)
+
+			var res commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode != http.StatusAccepted {
+				dbClientMock.AssertNotCalled(t, "AddDeferredCommand", mock.Anything, mock.Anything)
+				return
+			}
+			assert.Equal(t, testDeferredCommandId, res.Id)
+			added := dbClientMock.Calls[0].Arguments.Get(1).(models.DeferredCommand)
+			assert.Equal(t, testCase.expectedQueryParams, added.QueryParams)
+			assert.Equal(t, models.AuditSourceREST, added.Source)
+		})
+	}
+}
+
+func TestDeferredCommandById(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeferredCommandById", mock.Anything, testDeferredCommandId).
+		Return(models.DeferredCommand{Id: testDeferredCommandId, DeviceName: testDeviceName, Status: models.DeferredCommandStatusDelivered}, nil)
+	dbClientMock.On("DeferredCommandById", mock.Anything, "notFound").
+		Return(models.DeferredCommand{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dcMock, dscMock, dsccMock := newDeferredCommandClientMocks()
+	dic := NewMockDIC()
+	commandContainer.ConfigurationFrom(dic.Get).DeferredCommand = config.DeferredCommandInfo{
+		Enabled:          true,
+		DefaultTTL:       "1h",
+		MaxTTL:           "24h",
+		RetryInterval:    "10s",
+		MaxRetryInterval: "5m",
+		Retention:        "168h",
+	}
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	dc := NewDeferredCommandController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid - find deferred command by id", testDeferredCommandId, http.StatusOK},
+		{"Invalid - deferred command not found", "notFound", http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.id)
+
+			err := dc.DeferredCommandById(c)
+			require.NoError(t, err)
+
+			var res responses.DeferredCommandResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, models.DeferredCommandStatusDelivered, res.DeferredCommand.Status)
+			}
+		})
+	}
+}
+
+func TestDeferredCommandsByStatus(t *testing.T) {
+	commands := []models.DeferredCommand{{Id: "1", Status: models.DeferredCommandStatusPending}, {Id: "2", Status: models.DeferredCommandStatusPending}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeferredCommandCountByStatus", mock.Anything, models.DeferredCommandStatusPending).Return(uint32(2), nil)
+	dbClientMock.On("DeferredCommandsByStatus", mock.Anything, models.DeferredCommandStatusPending, 0, 20).Return(commands, nil)
+	dbClientMock.On("DeferredCommandCountByStatus", mock.Anything, "").Return(uint32(0), nil)
+	dcMock, dscMock, dsccMock := newDeferredCommandClientMocks()
+	dic := NewMockDIC()
+	commandContainer.ConfigurationFrom(dic.Get).DeferredCommand = config.DeferredCommandInfo{
+		Enabled:          true,
+		DefaultTTL:       "1h",
+		MaxTTL:           "24h",
+		RetryInterval:    "10s",
+		MaxRetryInterval: "5m",
+		Retention:        "168h",
+	}
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	dc := NewDeferredCommandController(dic)
+
+	tests := []struct {
+		name               string
+		status             string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - find pending deferred commands", models.DeferredCommandStatusPending, 2, http.StatusOK},
+		{"Valid - no deferred commands", "", 0, http.StatusOK},
+		{"Invalid - invalid status", "UNKNOWN", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Status)
+			c.SetParamValues(testCase.status)
+
+			err := dc.DeferredCommandsByStatus(c)
+			require.NoError(t, err)
+
+			var res responses.MultiDeferredCommandsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Len(t, res.DeferredCommands, testCase.expectedCount)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	requestDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type DeviceSnapshotController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewDeviceSnapshotController creates and initializes a DeviceSnapshotController
+func NewDeviceSnapshotController(dic *di.Container) *DeviceSnapshotController {
+	return &DeviceSnapshotController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// CaptureDeviceSnapshots handles the POST request of capturing the snapshots of the devices selected by the group, it
+// responds 201 when the snapshots of all the devices are stored, otherwise 207 with the result of each device
+func (sc *DeviceSnapshotController) CaptureDeviceSnapshots(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+
+	var req requestDTO.CaptureDeviceSnapshotRequest
+	err := sc.reader.Read(r.Body, &req)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	results, err := application.CaptureDeviceSnapshots(ctx, req.Group, req.Description, restAuditRecord(r, "", "", "", nil), restCommandCaller(r), sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, req.RequestId)
+	}
+
+	statusCode := http.StatusCreated
+	response := responseDTO.NewMultiDeviceSnapshotResultsResponse(req.RequestId, "", statusCode, results)
+	if response.FailedCount > 0 {
+		statusCode = http.StatusMultiStatus
+		response.StatusCode = statusCode
+	}
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// RestoreDeviceSnapshot handles the POST request of restoring the device snapshot by id, it responds 200 when all the
+// set commands succeed or the restore is a dry run, otherwise 207 with the result of each set command
+func (sc *DeviceSnapshotController) RestoreDeviceSnapshot(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+
+	var req requestDTO.RestoreDeviceSnapshotRequest
+	err := sc.reader.Read(r.Body, &req)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	restore, err := application.RestoreDeviceSnapshot(ctx, c.Param(common.Id), req.DeviceName, req.DryRun, restAuditRecord(r, "", "", "", nil), restCommandCaller(r), sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, req.RequestId)
+	}
+
+	statusCode := http.StatusOK
+	for _, command := range restore.Commands {
+		if !req.DryRun && (command.StatusCode < 200 || command.StatusCode >= 300) {
+			statusCode = http.StatusMultiStatus
+			break
+		}
+	}
+	response := responseDTO.NewDeviceSnapshotRestoreResponse(req.RequestId, "", statusCode, restore)
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeviceSnapshotById handles the GET request of querying the device snapshot by id
+func (sc *DeviceSnapshotController) DeviceSnapshotById(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	lc := container.LoggingClientFrom(sc.dic.Get)
+
+	snapshot, err := application.DeviceSnapshotById(ctx, c.Param(common.Id), sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewDeviceSnapshotResponse("", "", http.StatusOK, snapshot)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// AllDeviceSnapshots handles the GET request of querying all device snapshots
+func (sc *DeviceSnapshotController) AllDeviceSnapshots(c echo.Context) error {
+	return sc.deviceSnapshots(c, "")
+}
+
+// DeviceSnapshotsByDeviceName handles the GET request of querying device snapshots by device name
+func (sc *DeviceSnapshotController) DeviceSnapshotsByDeviceName(c echo.Context) error {
+	return sc.deviceSnapshots(c, c.Param(common.Name))
+}
+
+func (sc *DeviceSnapshotController) deviceSnapshots(c echo.Context, deviceName string) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	config := commandContainer.ConfigurationFrom(sc.dic.Get)
+
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	snapshots, totalCount, err := application.DeviceSnapshotsByDeviceName(ctx, deviceName, offset, limit, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiDeviceSnapshotsResponse("", "", http.StatusOK, totalCount, snapshots)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteDeviceSnapshotById handles the DELETE request of deleting the device snapshot by id
+func (sc *DeviceSnapshotController) DeleteDeviceSnapshotById(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	lc := container.LoggingClientFrom(sc.dic.Get)
+
+	err := application.DeleteDeviceSnapshotById(ctx, c.Param(common.Id), sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,224 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	commandRequestDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	commandResponseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+const (
+	testSnapshotId         = "c7ef6a3e-0a5f-4f57-8a8c-8a4b5d2f7e01"
+	testSnapshotDeviceName = "thermostat1"
+)
+
+func deviceSnapshotByIdMock() *dbMock.DBClient {
+	snapshot := models.DeviceSnapshot{
+		Id:         testSnapshotId,
+		DeviceName: testSnapshotDeviceName,
+		Values:     []models.SnapshotValue{{ResourceName: "setpoint", ValueType: common.ValueTypeFloat32, Value: "21.5"}},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceSnapshotById", context.Background(), testSnapshotId).Return(snapshot, nil)
+	dbClientMock.On("DeviceSnapshotById", context.Background(), "missing").
+		Return(models.DeviceSnapshot{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	return dbClientMock
+}
+
+func TestCaptureDeviceSnapshotsInvalidRequest(t *testing.T) {
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return &dbMock.DBClient{}
+		},
+	})
+	controller := NewDeviceSnapshotController(dic)
+
+	tests := []struct {
+		name  string
+		group commandDTO.DeviceGroup
+	}{
+		{"Invalid - empty group", commandDTO.DeviceGroup{}},
+		{"Invalid - more than one selector", commandDTO.DeviceGroup{DeviceNames: []string{testSnapshotDeviceName}, Labels: []string{"hvac"}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			request := commandRequestDTO.CaptureDeviceSnapshotRequest{BaseRequest: commonDTO.NewBaseRequest(), Group: testCase.group}
+			jsonData, err := json.Marshal(request)
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, constants.ApiDeviceSnapshotRoute, bytes.NewReader(jsonData))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.CaptureDeviceSnapshots(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
+
+func TestDeviceSnapshotById(t *testing.T) {
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return deviceSnapshotByIdMock()
+		},
+	})
+	controller := NewDeviceSnapshotController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid", testSnapshotId, http.StatusOK},
+		{"Not found", "missing", http.StatusNotFound},
+		{"Invalid - empty id", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiDeviceSnapshotByIdRoute, http.NoBody)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.id)
+			err := controller.DeviceSnapshotById(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res commandResponseDTO.DeviceSnapshotResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testSnapshotDeviceName, res.DeviceSnapshot.DeviceName)
+			require.Len(t, res.DeviceSnapshot.Values, 1)
+		})
+	}
+}
+
+func TestDeviceSnapshotsByDeviceName(t *testing.T) {
+	snapshots := []models.DeviceSnapshot{{Id: testSnapshotId, DeviceName: testSnapshotDeviceName}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceSnapshotCountByDeviceName", context.Background(), testSnapshotDeviceName).Return(uint32(len(snapshots)), nil)
+	dbClientMock.On("DeviceSnapshotsByDeviceName", context.Background(), testSnapshotDeviceName, 0, 20).Return(snapshots, nil)
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceSnapshotController(dic)
+
+	tests := []struct {
+		name               string
+		offset             string
+		expectedStatusCode int
+	}{
+		{"Valid", "", http.StatusOK},
+		{"Invalid - invalid offset format", "aaa", http.StatusBadRequest},
+		{"Invalid - offset out of range", "2", http.StatusRequestedRangeNotSatisfiable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiDeviceSnapshotByDeviceNameRoute, http.NoBody)
+			if testCase.offset != "" {
+				query := req.URL.Query()
+				query.Add(common.Offset, testCase.offset)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testSnapshotDeviceName)
+			err := controller.DeviceSnapshotsByDeviceName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res commandResponseDTO.MultiDeviceSnapshotsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(len(snapshots)), res.TotalCount)
+			assert.Len(t, res.DeviceSnapshots, len(snapshots))
+		})
+	}
+}
+
+func TestDeleteDeviceSnapshotById(t *testing.T) {
+	dbClientMock := deviceSnapshotByIdMock()
+	dbClientMock.On("DeleteDeviceSnapshotById", context.Background(), testSnapshotId).Return(nil)
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceSnapshotController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid", testSnapshotId, http.StatusOK},
+		{"Not found", "missing", http.StatusNotFound},
+		{"Invalid - empty id", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, constants.ApiDeviceSnapshotByIdRoute, http.NoBody)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.id)
+			err := controller.DeleteDeviceSnapshotById(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "DeleteDeviceSnapshotById", 1)
+}
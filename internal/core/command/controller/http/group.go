@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// IssueGroupGetCommand issues the get(read) command to the devices selected by the labels, profileName, serviceName or
+// names query parameter, and the rest of the query parameters are passed to the device services
+func (cc *CommandController) IssueGroupGetCommand(c echo.Context) error {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	issuedAt := time.Now()
+
+	err := validateGetCommandParameters(r)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	group, queryParams := commandDTO.NewDeviceGroupFromQueryParams(r.URL.Query())
+
+	commandName := c.Param(common.Command)
+	results, err := application.IssueGroupGetCommand(group, commandName, queryParams.Encode(), restCommandCaller(r), cc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	application.AddGroupAuditRecords(restAuditRecord(r, "", commandName, constants.CommandMethodGet, nil), results, issuedAt, cc.dic)
+
+	return cc.writeGroupCommandResponse(c, results)
+}
+
+// IssueGroupSetCommand issues the set(write) command to the devices selected by the labels, profileName, serviceName or
+// names query parameter, and the rest of the query parameters are passed to the device services
+func (cc *CommandController) IssueGroupSetCommand(c echo.Context) error {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	issuedAt := time.Now()
+
+	// Request body
+	settings, err := utils.ParseBodyToMap(r)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	group, queryParams := commandDTO.NewDeviceGroupFromQueryParams(r.URL.Query())
+
+	commandName := c.Param(common.Command)
+	results, err := application.IssueGroupSetCommand(group, commandName, queryParams.Encode(), settings, restCommandCaller(r), cc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	application.AddGroupAuditRecords(restAuditRecord(r, "", commandName, constants.CommandMethodSet, settings), results, issuedAt, cc.dic)
+
+	return cc.writeGroupCommandResponse(c, results)
+}
+
+// writeGroupCommandResponse responds 200 when the command succeeds on all the devices, otherwise 207 with the result of
+// each device
+func (cc *CommandController) writeGroupCommandResponse(c echo.Context, results []commandDTO.DeviceCommandResult) error {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	w := c.Response()
+	statusCode := http.StatusOK
+	response := responseDTO.NewMultiDeviceCommandResultsResponse("", "", statusCode, results)
+	if response.FailedCount > 0 {
+		statusCode = http.StatusMultiStatus
+		response.StatusCode = statusCode
+	}
+	utils.WriteHttpHeader(w, c.Request().Context(), statusCode)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
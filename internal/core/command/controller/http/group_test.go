@@ -0,0 +1,164 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandResponseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+)
+
+func newGroupCommandClientMocks() (*mocks.DeviceClient, *mocks.DeviceServiceClient, *mocks.DeviceServiceCommandClient) {
+	devices := []dtos.Device{
+		{Name: testDeviceName + "1", ProfileName: testProfileName, ServiceName: testDeviceServiceName},
+		{Name: testDeviceName + "2", ProfileName: testProfileName, ServiceName: testDeviceServiceName},
+	}
+	expectedEventResponse := buildEventResponse()
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DevicesByProfileName", mock.Anything, testProfileName, 0, -1).
+		Return(responseDTO.MultiDevicesResponse{BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, 2), Devices: devices}, nil)
+	dcMock.On("DeviceByName", mock.Anything, devices[0].Name).Return(responseDTO.NewDeviceResponse("", "", http.StatusOK, devices[0]), nil)
+	dcMock.On("DeviceByName", mock.Anything, "nonExist").
+		Return(responseDTO.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "fail to query device by name", nil))
+
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).Return(buildDeviceServiceResponse(), nil)
+
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, mock.Anything, testCommandName, "ds-pushevent=false").Return(&expectedEventResponse, nil)
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, mock.Anything, testCommandName, "", buildTestSettings()).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+
+	return dcMock, dscMock, dsccMock
+}
+
+func TestIssueGroupGetCommand(t *testing.T) {
+	dcMock, dscMock, dsccMock := newGroupCommandClientMocks()
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	cc := NewCommandController(dic)
+
+	tests := []struct {
+		name                string
+		queryStrings        string
+		expectedStatusCode  int
+		expectedTotalCount  uint32
+		expectedFailedCount uint32
+	}{
+		{"Valid - by profile name", "profileName=" + testProfileName + "&ds-pushevent=false", http.StatusOK, 2, 0},
+		{"Valid - partially failed by names", "names=" + testDeviceName + "1,nonExist&ds-pushevent=false", http.StatusMultiStatus, 2, 1},
+		{"Invalid - no selector", "ds-pushevent=false", http.StatusBadRequest, 0, 0},
+		{"Invalid - invalid ds-pushevent parameter", "profileName=" + testProfileName + "&ds-pushevent=123", http.StatusBadRequest, 0, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiDeviceGroupCommandRoute, http.NoBody)
+			req.URL.RawQuery = testCase.queryStrings
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Command)
+			c.SetParamValues(testCommandName)
+			err := cc.IssueGroupGetCommand(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusBadRequest {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res commandResponseDTO.MultiDeviceCommandResultsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+			assert.Equal(t, testCase.expectedFailedCount, res.FailedCount, "Failed count not as expected")
+		})
+	}
+}
+
+func TestIssueGroupSetCommand(t *testing.T) {
+	dcMock, dscMock, dsccMock := newGroupCommandClientMocks()
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	cc := NewCommandController(dic)
+	validBody, err := json.Marshal(buildTestSettings())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		queryStrings       string
+		body               []byte
+		expectedStatusCode int
+	}{
+		{"Valid - by profile name", "profileName=" + testProfileName, validBody, http.StatusOK},
+		{"Invalid - more than one selector", "profileName=" + testProfileName + "&names=" + testDeviceName + "1", validBody, http.StatusBadRequest},
+		{"Invalid - empty request body", "profileName=" + testProfileName, []byte{}, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, constants.ApiDeviceGroupCommandRoute, bytes.NewReader(testCase.body))
+			req.URL.RawQuery = testCase.queryStrings
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Command)
+			c.SetParamValues(testCommandName)
+			err := cc.IssueGroupSetCommand(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
@@ -21,7 +21,9 @@ import (
 
 	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
 )
 
 func OnConnectHandler(requestTimeout time.Duration, dic *di.Container) mqtt.OnConnectHandler {
@@ -44,6 +46,17 @@ func OnConnectHandler(requestTimeout time.Duration, dic *di.Container) mqtt.OnCo
 		} else {
 			lc.Debugf("Subscribed to topic '%s' on external MQTT broker", requestCommandTopic)
 		}
+
+		// the group command requests are only subscribed when the topic is configured
+		requestGroupCommandTopic := externalTopics[constants.CommandGroupRequestTopicKey]
+		if requestGroupCommandTopic == "" {
+			return
+		}
+		if token := client.Subscribe(requestGroupCommandTopic, qos, groupCommandRequestHandler(dic)); token.Wait() && token.Error() != nil {
+			lc.Errorf("could not subscribe to topic '%s': %s", requestGroupCommandTopic, token.Error().Error())
+		} else {
+			lc.Debugf("Subscribed to topic '%s' on external MQTT broker", requestGroupCommandTopic)
+		}
 	}
 }
 
@@ -94,6 +107,7 @@ func commandQueryHandler(dic *di.Container) mqtt.MessageHandler {
 
 func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt.MessageHandler {
 	return func(client mqtt.Client, message mqtt.Message) {
+		issuedAt := time.Now()
 		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 		config := container.ConfigurationFrom(dic.Get)
 		lc.Debugf("Received command request from external message broker on topic '%s' with %d bytes", message.Topic(), len(message.Payload()))
@@ -156,6 +170,14 @@ func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt
 			return
 		}
 
+		edgexErr := checkMessagingCommand(requestEnvelope, deviceName, commandName, method, models.AuditSourceExternalMQTT, issuedAt, dic)
+		if edgexErr != nil {
+			lc.Error(edgexErr.Error())
+			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, edgexErr.Error())
+			publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
+			return
+		}
+
 		deviceRequestTopic := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
 			SetPath(topicPrefix).SetNameFieldPath(deviceServiceName).SetNameFieldPath(deviceName).SetNameFieldPath(commandName).SetPath(method).BuildPath()
 		deviceResponseTopicPrefix := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
@@ -168,6 +190,7 @@ func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt
 
 		// Request waits for the response and returns it.
 		response, err := internalMessageBus.Request(requestEnvelope, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout)
+		addMessagingAuditRecord(messagingAuditRecord(requestEnvelope, deviceName, commandName, method, models.AuditSourceExternalMQTT), response, err, issuedAt, dic)
 		if err != nil {
 			errorMessage := fmt.Sprintf("Failed to send DeviceCommand request with internal MessageBus: %v", err)
 			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, errorMessage)
@@ -0,0 +1,179 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// SubscribeGroupCommandRequests subscribes group command requests from EdgeX service (e.g., Application Service) via
+// internal MessageBus, and responds with the result of each targeted device
+func SubscribeGroupCommandRequests(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	baseTopic := container.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix()
+	requestTopic := common.BuildTopic(baseTopic, constants.CoreCommandGroupRequestSubscribeTopic)
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+	topics := []types.TopicChannel{
+		{
+			Topic:    requestTopic,
+			Messages: messages,
+		},
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+
+	lc.Infof("Subscribing to internal group command requests on topic: %s", requestTopic)
+
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", requestTopic)
+				return
+			case err = <-messageErrors:
+				lc.Error(err.Error())
+			case requestEnvelope := <-messages:
+				lc.Debugf("Group command request received on internal MessageBus. Topic: %s, Request-id: %s, Correlation-id: %s", requestEnvelope.ReceivedTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
+				if len(strings.TrimSpace(requestEnvelope.RequestID)) == 0 {
+					lc.Errorf("RequestId not set in group command request received on internal MessageBus")
+					lc.Warn("Not publishing error message back due to insufficient information to publish on response topic")
+					break
+				}
+
+				_, _, responseEnvelope := processGroupCommandRequest(requestEnvelope, models.AuditSourceMessageBus, lc, dic)
+
+				// internal response topic scheme: <ResponseTopicPrefix>/<service-name>/<request-id>
+				responseTopic := common.BuildTopic(baseTopic, common.ResponseTopic, common.CoreCommandServiceKey, requestEnvelope.RequestID)
+				err = messageBus.Publish(responseEnvelope, responseTopic)
+				if err != nil {
+					lc.Errorf("Could not publish to topic '%s': %s", responseTopic, err.Error())
+					break
+				}
+				lc.Debugf("Group command response sent to internal MessageBus. Topic: %s, Correlation-id: %s", responseTopic, requestEnvelope.CorrelationID)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func groupCommandRequestHandler(dic *di.Container) mqtt.MessageHandler {
+	return func(client mqtt.Client, message mqtt.Message) {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		lc.Debugf("Received group command request from external message broker on topic '%s' with %d bytes", message.Topic(), len(message.Payload()))
+
+		externalMQTTInfo := container.ConfigurationFrom(dic.Get).ExternalMQTT
+		requestEnvelope, err := types.NewMessageEnvelopeFromJSON(message.Payload())
+		if err != nil {
+			lc.Errorf("Failed to decode request MessageEnvelope: %s", err.Error())
+			lc.Warn("Not publishing error message back due to insufficient information on response topic")
+			return
+		}
+		requestEnvelope.ReceivedTopic = message.Topic()
+
+		commandName, method, responseEnvelope := processGroupCommandRequest(requestEnvelope, models.AuditSourceExternalMQTT, lc, dic)
+		if commandName == "" {
+			lc.Warn("Not publishing error message back due to insufficient information on response topic")
+			return
+		}
+
+		// external response topic scheme: <CommandGroupResponseTopicPrefix>/<command-name>/<method>
+		responseTopic := common.BuildTopic(externalMQTTInfo.Topics[constants.CommandGroupResponseTopicPrefixKey], commandName, method)
+		responseEnvelope.ReceivedTopic = responseTopic
+		publishMessage(client, responseTopic, externalMQTTInfo.QoS, externalMQTTInfo.Retain, responseEnvelope, lc)
+	}
+}
+
+// processGroupCommandRequest issues the group command of the request, and returns the command name and method parsed
+// from the request topic along with the response. The devices are selected by the labels, profileName, serviceName or
+// names query parameter, and the settings of a set command are carried by the payload. The source indicates where the
+// request is received from for the audit records.
+func processGroupCommandRequest(requestEnvelope types.MessageEnvelope, source string, lc logger.LoggingClient, dic *di.Container) (string, string, types.MessageEnvelope) {
+	issuedAt := time.Now()
+	// expected group command request topic scheme: #/<command-name>/<method>
+	topicLevels := strings.Split(requestEnvelope.ReceivedTopic, "/")
+	length := len(topicLevels)
+	if length < 2 {
+		lc.Errorf("Invalid group command request topic %s, expected request topic scheme: '#/<command-name>/<method>'", requestEnvelope.ReceivedTopic)
+		return "", "", types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, fmt.Sprintf("invalid group command request topic %s", requestEnvelope.ReceivedTopic))
+	}
+	commandName, err := url.PathUnescape(topicLevels[length-2])
+	if err != nil {
+		lc.Errorf("Failed to unescape command name from '%s': %s", topicLevels[length-2], err.Error())
+		return "", "", types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+	}
+	method := strings.ToLower(topicLevels[length-1])
+
+	params := url.Values{}
+	for key, value := range requestEnvelope.QueryParams {
+		params.Set(key, value)
+	}
+	group, queryParams := commandDTO.NewDeviceGroupFromQueryParams(params)
+
+	var results []commandDTO.DeviceCommandResult
+	var edgexErr errors.EdgeX
+	switch method {
+	case constants.CommandMethodGet:
+		err = validateGetCommandQueryParameters(requestEnvelope.QueryParams)
+		if err != nil {
+			edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid query parameters", err)
+			break
+		}
+		results, edgexErr = application.IssueGroupGetCommand(group, commandName, queryParams.Encode(), models.CommandCaller{Source: source}, dic)
+	case constants.CommandMethodSet:
+		settings, err := types.GetMsgPayload[map[string]any](requestEnvelope)
+		if err != nil {
+			edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the settings of the set command", err)
+			break
+		}
+		results, edgexErr = application.IssueGroupSetCommand(group, commandName, queryParams.Encode(), settings, models.CommandCaller{Source: source}, dic)
+	default:
+		edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown request method: %s, only 'get' or 'set' is allowed", method), nil)
+	}
+	if edgexErr != nil {
+		lc.Errorf("Failed to issue group command %s: %s", commandName, edgexErr.Error())
+		return commandName, method, types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, edgexErr.Error())
+	}
+	application.AddGroupAuditRecords(messagingAuditRecord(requestEnvelope, "", commandName, method, source), results, issuedAt, dic)
+
+	response := responseDTO.NewMultiDeviceCommandResultsResponse(requestEnvelope.RequestID, "", http.StatusOK, results)
+	if response.FailedCount > 0 {
+		response.StatusCode = http.StatusMultiStatus
+	}
+	responseEnvelope, err := types.NewMessageEnvelopeForResponse(response, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
+	if err != nil {
+		lc.Errorf("Failed to create response MessageEnvelope: %s", err.Error())
+		return commandName, method, types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+	}
+	return commandName, method, responseEnvelope
+}
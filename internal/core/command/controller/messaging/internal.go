@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2022-2023 IOTech Ltd
+// Copyright (C) 2022-2025 IOTech Ltd
 // Copyright (C) 2023 Intel Inc.
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -24,6 +24,7 @@ import (
 	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
 )
 
 // SubscribeCommandRequests subscribes command requests from EdgeX service (e.g., Application Service)
@@ -73,6 +74,7 @@ func processDeviceCommandRequest(
 	lc logger.LoggingClient,
 	dic *di.Container) {
 	var err error
+	issuedAt := time.Now()
 	config := container.ConfigurationFrom(dic.Get)
 
 	lc.Debugf("Command device request received on internal MessageBus. Topic: %s, Request-id: %s, Correlation-id: %s", requestEnvelope.ReceivedTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
@@ -153,6 +155,17 @@ func processDeviceCommandRequest(
 		return
 	}
 
+	edgexErr := checkMessagingCommand(requestEnvelope, deviceName, commandName, method, models.AuditSourceMessageBus, issuedAt, dic)
+	if edgexErr != nil {
+		lc.Error(edgexErr.Error())
+		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, edgexErr.Error())
+		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+		if err != nil {
+			lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		}
+		return
+	}
+
 	deviceRequestTopic := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
 		SetPath(topicPrefix).SetNameFieldPath(deviceServiceName).SetNameFieldPath(deviceName).SetNameFieldPath(commandName).SetPath(method).BuildPath()
 	deviceResponseTopicPrefix := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
@@ -162,6 +175,7 @@ func processDeviceCommandRequest(
 	lc.Debugf("Expecting response on topic: %s/%s", deviceResponseTopicPrefix, requestEnvelope.RequestID)
 
 	response, err := messageBus.Request(requestEnvelope, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout)
+	addMessagingAuditRecord(messagingAuditRecord(requestEnvelope, deviceName, commandName, method, models.AuditSourceMessageBus), response, err, issuedAt, dic)
 	if err != nil {
 		lc.Errorf("Request to topic '%s' failed: %s", deviceRequestTopic, err.Error())
 		return
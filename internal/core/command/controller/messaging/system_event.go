@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+// SubscribeSystemEvents subscribes the device, device profile and device service system events published by core-metadata
+// to keep the metadata cache fresh and to wake up the deferred commands delivery
+func SubscribeSystemEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	messages := make(chan types.MessageEnvelope, 1)
+	messageErrors := make(chan error, 1)
+	var topics []types.TopicChannel
+	// system event topic scheme: edgex/system-events/core-metadata/<type>/<action>/<owner>/...
+	for _, eventType := range []string{common.DeviceSystemEventType, common.DeviceProfileSystemEventType, common.DeviceServiceSystemEventType} {
+		topic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+			SetPath(configuration.MessageBus.GetBaseTopicPrefix()).SetPath(common.SystemEventPublishTopic).SetPath(common.CoreMetaDataServiceKey).
+			SetPath(eventType).SetPath("#").BuildPath()
+		lc.Infof("Subscribing to System Events on topic: %s", topic)
+		topics = append(topics, types.TopicChannel{Topic: topic, Messages: messages})
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting waiting for MessageBus system event messages")
+				return
+			case err = <-messageErrors:
+				lc.Error(err.Error())
+			case msgEnvelope := <-messages:
+				lc.Debugf("System event received on message queue. Topic: %s, Correlation-id: %s", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID)
+				systemEvent, err := types.GetMsgPayload[dtos.SystemEvent](msgEnvelope)
+				if err != nil {
+					lc.Errorf("failed to JSON decoding system event: %s", err.Error())
+					continue
+				}
+				err = metadataSystemEventAction(systemEvent, dic)
+				if err != nil {
+					lc.Error(err.Error(), common.CorrelationHeader, msgEnvelope.CorrelationID)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// metadataSystemEventAction updates or removes the cached device, device profile or device service of the system event,
+// and wakes up the deferred commands delivery once a device or device service becomes available
+func metadataSystemEventAction(systemEvent dtos.SystemEvent, dic *di.Container) error {
+	metadataCache := container.MetadataCacheFrom(dic.Get)
+	deferredCommandEnabled := container.ConfigurationFrom(dic.Get).DeferredCommand.Enabled
+	if metadataCache == nil && !deferredCommandEnabled {
+		return nil
+	}
+
+	switch systemEvent.Type {
+	case common.DeviceSystemEventType:
+		var device dtos.Device
+		if err := systemEvent.DecodeDetails(&device); err != nil {
+			return fmt.Errorf("failed to decode %s system event details: %s", systemEvent.Type, err.Error())
+		}
+		switch systemEvent.Action {
+		case common.SystemEventActionAdd, common.SystemEventActionUpdate:
+			if metadataCache != nil {
+				metadataCache.SetDevice(device)
+			}
+			if deferredCommandEnabled && device.OperatingState == string(models.Up) && device.AdminState == string(models.Unlocked) {
+				application.WakeDeferredCommandDelivery(device.Name, "")
+			}
+		case common.SystemEventActionDelete:
+			if metadataCache != nil {
+				metadataCache.RemoveDevice(device.Name)
+			}
+		}
+	case common.DeviceProfileSystemEventType:
+		if metadataCache == nil {
+			return nil
+		}
+		var profile dtos.DeviceProfile
+		if err := systemEvent.DecodeDetails(&profile); err != nil {
+			return fmt.Errorf("failed to decode %s system event details: %s", systemEvent.Type, err.Error())
+		}
+		switch systemEvent.Action {
+		case common.SystemEventActionAdd, common.SystemEventActionUpdate:
+			metadataCache.SetDeviceProfile(profile)
+		case common.SystemEventActionDelete:
+			metadataCache.RemoveDeviceProfile(profile.Name)
+		}
+	case common.DeviceServiceSystemEventType:
+		var service dtos.DeviceService
+		if err := systemEvent.DecodeDetails(&service); err != nil {
+			return fmt.Errorf("failed to decode %s system event details: %s", systemEvent.Type, err.Error())
+		}
+		switch systemEvent.Action {
+		case common.SystemEventActionAdd, common.SystemEventActionUpdate:
+			if metadataCache != nil {
+				metadataCache.SetDeviceService(service)
+			}
+			// the device service updates itself in core-metadata when it starts
+			if deferredCommandEnabled && service.AdminState == string(models.Unlocked) {
+				application.WakeDeferredCommandDelivery("", service.Name)
+			}
+		case common.SystemEventActionDelete:
+			if metadataCache != nil {
+				metadataCache.RemoveDeviceService(service.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+func TestMetadataSystemEventAction(t *testing.T) {
+	metadataCache := cache.NewMetadataCache(0)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.MetadataCacheInterfaceName: func(get di.Get) interface{} {
+			return metadataCache
+		},
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+	})
+	device := dtos.Device{Id: "1", Name: "device1", ServiceName: "service1"}
+	updatedDevice := dtos.Device{Id: "1", Name: "device1", ServiceName: "service2"}
+	profile := dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Id: "1", Name: "profile1"}}
+	service := dtos.DeviceService{Id: "1", Name: "service1", BaseAddress: "http://localhost:59900"}
+
+	tests := []struct {
+		name        string
+		systemEvent dtos.SystemEvent
+		assertion   func(t *testing.T)
+	}{
+		{"add device", dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, "service1", nil, device),
+			func(t *testing.T) {
+				cached, ok := metadataCache.Device(device.Name)
+				require.True(t, ok)
+				assert.Equal(t, device.ServiceName, cached.ServiceName)
+			}},
+		{"update device", dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, "service2", nil, updatedDevice),
+			func(t *testing.T) {
+				cached, ok := metadataCache.Device(device.Name)
+				require.True(t, ok)
+				assert.Equal(t, updatedDevice.ServiceName, cached.ServiceName)
+			}},
+		{"delete device", dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, "service2", nil, updatedDevice),
+			func(t *testing.T) {
+				_, ok := metadataCache.Device(device.Name)
+				assert.False(t, ok)
+			}},
+		{"update device profile", dtos.NewSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, common.CoreMetaDataServiceKey, nil, profile),
+			func(t *testing.T) {
+				_, ok := metadataCache.DeviceProfile(profile.Name)
+				assert.True(t, ok)
+			}},
+		{"delete device profile", dtos.NewSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, common.CoreMetaDataServiceKey, nil, profile),
+			func(t *testing.T) {
+				_, ok := metadataCache.DeviceProfile(profile.Name)
+				assert.False(t, ok)
+			}},
+		{"update device service", dtos.NewSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, service.Name, nil, service),
+			func(t *testing.T) {
+				cached, ok := metadataCache.DeviceService(service.Name)
+				require.True(t, ok)
+				assert.Equal(t, service.BaseAddress, cached.BaseAddress)
+			}},
+		{"delete device service", dtos.NewSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, service.Name, nil, service),
+			func(t *testing.T) {
+				_, ok := metadataCache.DeviceService(service.Name)
+				assert.False(t, ok)
+			}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := metadataSystemEventAction(testCase.systemEvent, dic)
+			require.NoError(t, err)
+			testCase.assertion(t)
+		})
+	}
+}
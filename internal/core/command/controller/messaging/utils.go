@@ -8,44 +8,39 @@ package messaging
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
-	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
 )
 
 // retrieveServiceNameByDevice validates the existence of device and device service,
 // returns the service name to which the command request will be sent.
 func retrieveServiceNameByDevice(deviceName string, dic *di.Container) (string, error) {
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return "", errors.New("nil Device Client")
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	// retrieve device information from the metadata cache or through Metadata DeviceClient
+	device, err := application.DeviceByName(context.Background(), deviceName, dic)
 	if err != nil {
 		return "", fmt.Errorf("failed to get Device by name %s: %v", deviceName, err)
 	}
 
-	// retrieve device service information through Metadata DeviceClient
-	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
-	if dsc == nil {
-		return "", errors.New("nil DeviceService Client")
-	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	// retrieve device service information from the metadata cache or through Metadata DeviceServiceClient
+	deviceService, err := application.DeviceServiceByName(context.Background(), device.ServiceName, dic)
 	if err != nil {
-		return "", fmt.Errorf("failed to get DeviceService by name %s: %v", deviceResponse.Device.ServiceName, err)
+		return "", fmt.Errorf("failed to get DeviceService by name %s: %v", device.ServiceName, err)
 	}
-	return deviceServiceResponse.Service.Name, nil
+	return deviceService.Name, nil
 }
 
 // validateGetCommandQueryParameters validates the value is valid for device service's reserved query parameters
@@ -109,3 +104,64 @@ func getCommandQueryResponseEnvelope(requestEnvelope types.MessageEnvelope, devi
 
 	return responseEnvelope, nil
 }
+
+// messagingAuditRecord returns the audit record of the command requested through the internal MessageBus or the external
+// MQTT, the settings of a set command are decoded from the payload of the request
+func messagingAuditRecord(requestEnvelope types.MessageEnvelope, deviceName, commandName, method, source string) models.AuditRecord {
+	record := models.AuditRecord{
+		DeviceName:    deviceName,
+		CommandName:   commandName,
+		Method:        strings.ToLower(method),
+		Source:        source,
+		CorrelationId: requestEnvelope.CorrelationID,
+	}
+	if record.Method == constants.CommandMethodSet {
+		if settings, err := types.GetMsgPayload[map[string]any](requestEnvelope); err == nil {
+			record.Settings = settings
+		}
+	}
+	return record
+}
+
+// checkMessagingCommand authorizes the command requested through the messaging by the command policies and validates
+// the settings of a set command, and the rejected command is recorded in the audit log. The messaging callers are only
+// identified by the source.
+func checkMessagingCommand(envelope types.MessageEnvelope, deviceName, commandName, method, source string, issuedAt time.Time, dic *di.Container) errors.EdgeX {
+	method = strings.ToLower(method)
+	err := application.AuthorizeCommand(context.Background(), deviceName, commandName, method, models.CommandCaller{Source: source}, dic)
+	if err == nil && method == constants.CommandMethodSet {
+		settings, decodeErr := types.GetMsgPayload[map[string]any](envelope)
+		if decodeErr != nil {
+			err = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the settings of the set command", decodeErr)
+		} else {
+			err = application.ValidateSetCommand(context.Background(), deviceName, commandName, settings, dic)
+		}
+	}
+	if err != nil {
+		record := messagingAuditRecord(envelope, deviceName, commandName, method, source)
+		record.StatusCode, record.Message = err.Code(), err.Error()
+		application.AddAuditRecord(record, issuedAt, dic)
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// addMessagingAuditRecord adds the audit record with the result of the command request to the device service, the
+// request error indicates the device service didn't respond in time
+func addMessagingAuditRecord(record models.AuditRecord, response *types.MessageEnvelope, requestErr error, issuedAt time.Time, dic *di.Container) {
+	switch {
+	case requestErr != nil:
+		record.StatusCode, record.Message = http.StatusServiceUnavailable, requestErr.Error()
+	case response.ErrorCode != 0:
+		record.StatusCode = http.StatusInternalServerError
+		switch payload := response.Payload.(type) {
+		case string:
+			record.Message = payload
+		case []byte:
+			record.Message = string(payload)
+		}
+	default:
+		record.StatusCode = http.StatusOK
+	}
+	application.AddAuditRecord(record, issuedAt, dic)
+}
@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// AuditRecord records a get or set command issued through core-command to a device
+type AuditRecord struct {
+	Id            string         `json:"id"`
+	Created       int64          `json:"created"`
+	DeviceName    string         `json:"deviceName"`
+	CommandName   string         `json:"commandName"`
+	Method        string         `json:"method"`
+	Settings      map[string]any `json:"settings,omitempty"`
+	Caller        string         `json:"caller,omitempty"`
+	Source        string         `json:"source"`
+	CorrelationId string         `json:"correlationId,omitempty"`
+	Latency       int64          `json:"latency"`
+	StatusCode    int            `json:"statusCode"`
+	Status        string         `json:"status"`
+	Message       string         `json:"message,omitempty"`
+}
+
+// FromAuditRecordModelToDTO transforms the AuditRecord Model to the AuditRecord DTO
+func FromAuditRecordModelToDTO(r models.AuditRecord) AuditRecord {
+	return AuditRecord{
+		Id:            r.Id,
+		Created:       r.Created,
+		DeviceName:    r.DeviceName,
+		CommandName:   r.CommandName,
+		Method:        r.Method,
+		Settings:      r.Settings,
+		Caller:        r.Caller,
+		Source:        r.Source,
+		CorrelationId: r.CorrelationId,
+		Latency:       r.Latency,
+		StatusCode:    r.StatusCode,
+		Status:        r.Status,
+		Message:       r.Message,
+	}
+}
+
+// FromAuditRecordModelsToDTOs transforms the AuditRecord Models to the AuditRecord DTOs
+func FromAuditRecordModelsToDTOs(records []models.AuditRecord) []AuditRecord {
+	dtos := make([]AuditRecord, len(records))
+	for i, r := range records {
+		dtos[i] = FromAuditRecordModelToDTO(r)
+	}
+	return dtos
+}
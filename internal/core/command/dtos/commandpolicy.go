@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// CommandPolicy decides whether the matching callers may issue the matching commands, see models.CommandPolicy
+type CommandPolicy struct {
+	Id           string   `json:"id,omitempty" validate:"omitempty,uuid"`
+	Created      int64    `json:"created,omitempty"`
+	Modified     int64    `json:"modified,omitempty"`
+	Name         string   `json:"name" validate:"edgex-dto-none-empty-string"`
+	Description  string   `json:"description,omitempty"`
+	Effect       string   `json:"effect" validate:"oneof='ALLOW' 'DENY'"`
+	DeviceNames  []string `json:"deviceNames,omitempty"`
+	DeviceLabels []string `json:"deviceLabels,omitempty"`
+	CommandNames []string `json:"commandNames,omitempty"`
+	Methods      []string `json:"methods,omitempty" validate:"omitempty,dive,oneof='get' 'set'"`
+	Sources      []string `json:"sources,omitempty" validate:"omitempty,dive,oneof='REST' 'MessageBus' 'ExternalMQTT'"`
+	Issuers      []string `json:"issuers,omitempty"`
+	Subjects     []string `json:"subjects,omitempty"`
+}
+
+// UpdateCommandPolicy defines the fields of the CommandPolicy to be patched, which is located by id or name
+type UpdateCommandPolicy struct {
+	Id           *string  `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	Name         *string  `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
+	Description  *string  `json:"description"`
+	Effect       *string  `json:"effect" validate:"omitempty,oneof='ALLOW' 'DENY'"`
+	DeviceNames  []string `json:"deviceNames"`
+	DeviceLabels []string `json:"deviceLabels"`
+	CommandNames []string `json:"commandNames"`
+	Methods      []string `json:"methods" validate:"omitempty,dive,oneof='get' 'set'"`
+	Sources      []string `json:"sources" validate:"omitempty,dive,oneof='REST' 'MessageBus' 'ExternalMQTT'"`
+	Issuers      []string `json:"issuers"`
+	Subjects     []string `json:"subjects"`
+}
+
+// Validate satisfies the Validator interface
+func (p *CommandPolicy) Validate() error {
+	err := common.Validate(p)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid CommandPolicy.", err)
+	}
+	return ValidatePolicyPatterns(p.DeviceNames, p.CommandNames)
+}
+
+// Validate satisfies the Validator interface
+func (p *UpdateCommandPolicy) Validate() error {
+	err := common.Validate(p)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid UpdateCommandPolicy.", err)
+	}
+	return ValidatePolicyPatterns(p.DeviceNames, p.CommandNames)
+}
+
+// ValidatePolicyPatterns checks whether the device name and command name patterns are well-formed shell patterns
+func ValidatePolicyPatterns(patternGroups ...[]string) error {
+	for _, patterns := range patternGroups {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid pattern %s", pattern), err)
+			}
+		}
+	}
+	return nil
+}
+
+// ToCommandPolicyModel transforms the CommandPolicy DTO to the CommandPolicy Model
+func ToCommandPolicyModel(p CommandPolicy) models.CommandPolicy {
+	return models.CommandPolicy{
+		Id:           p.Id,
+		Name:         p.Name,
+		Description:  p.Description,
+		Effect:       p.Effect,
+		DeviceNames:  p.DeviceNames,
+		DeviceLabels: p.DeviceLabels,
+		CommandNames: p.CommandNames,
+		Methods:      p.Methods,
+		Sources:      p.Sources,
+		Issuers:      p.Issuers,
+		Subjects:     p.Subjects,
+	}
+}
+
+// FromCommandPolicyModelToDTO transforms the CommandPolicy Model to the CommandPolicy DTO
+func FromCommandPolicyModelToDTO(p models.CommandPolicy) CommandPolicy {
+	return CommandPolicy{
+		Id:           p.Id,
+		Created:      p.Created,
+		Modified:     p.Modified,
+		Name:         p.Name,
+		Description:  p.Description,
+		Effect:       p.Effect,
+		DeviceNames:  p.DeviceNames,
+		DeviceLabels: p.DeviceLabels,
+		CommandNames: p.CommandNames,
+		Methods:      p.Methods,
+		Sources:      p.Sources,
+		Issuers:      p.Issuers,
+		Subjects:     p.Subjects,
+	}
+}
+
+// FromCommandPolicyModelsToDTOs transforms the CommandPolicy Models to the CommandPolicy DTOs
+func FromCommandPolicyModelsToDTOs(policies []models.CommandPolicy) []CommandPolicy {
+	dtos := make([]CommandPolicy, len(policies))
+	for i, p := range policies {
+		dtos[i] = FromCommandPolicyModelToDTO(p)
+	}
+	return dtos
+}
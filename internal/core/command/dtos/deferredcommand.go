@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// DeferredCommand is a set command which is persisted and retried until the device becomes available
+type DeferredCommand struct {
+	Id            string         `json:"id"`
+	Created       int64          `json:"created"`
+	Modified      int64          `json:"modified"`
+	DeviceName    string         `json:"deviceName"`
+	CommandName   string         `json:"commandName"`
+	QueryParams   string         `json:"queryParams,omitempty"`
+	Settings      map[string]any `json:"settings,omitempty"`
+	Caller        string         `json:"caller,omitempty"`
+	Source        string         `json:"source"`
+	CorrelationId string         `json:"correlationId,omitempty"`
+	ExpiresAt     int64          `json:"expiresAt"`
+	Attempts      int            `json:"attempts"`
+	NextAttemptAt int64          `json:"nextAttemptAt,omitempty"`
+	Status        string         `json:"status"`
+	StatusCode    int            `json:"statusCode,omitempty"`
+	Message       string         `json:"message,omitempty"`
+}
+
+// FromDeferredCommandModelToDTO transforms the DeferredCommand Model to the DeferredCommand DTO
+func FromDeferredCommandModelToDTO(c models.DeferredCommand) DeferredCommand {
+	return DeferredCommand{
+		Id:            c.Id,
+		Created:       c.Created,
+		Modified:      c.Modified,
+		DeviceName:    c.DeviceName,
+		CommandName:   c.CommandName,
+		QueryParams:   c.QueryParams,
+		Settings:      c.Settings,
+		Caller:        c.Caller,
+		Source:        c.Source,
+		CorrelationId: c.CorrelationId,
+		ExpiresAt:     c.ExpiresAt,
+		Attempts:      c.Attempts,
+		NextAttemptAt: c.NextAttemptAt,
+		Status:        c.Status,
+		StatusCode:    c.StatusCode,
+		Message:       c.Message,
+	}
+}
+
+// FromDeferredCommandModelsToDTOs transforms the DeferredCommand Models to the DeferredCommand DTOs
+func FromDeferredCommandModelsToDTOs(commands []models.DeferredCommand) []DeferredCommand {
+	dtos := make([]DeferredCommand, len(commands))
+	for i, c := range commands {
+		dtos[i] = FromDeferredCommandModelToDTO(c)
+	}
+	return dtos
+}
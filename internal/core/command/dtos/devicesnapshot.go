@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// DeviceSnapshot is the values of the readable resources of a device captured at a point in time
+type DeviceSnapshot struct {
+	Id          string            `json:"id"`
+	Created     int64             `json:"created"`
+	Description string            `json:"description,omitempty"`
+	DeviceName  string            `json:"deviceName"`
+	ProfileName string            `json:"profileName"`
+	Values      []SnapshotValue   `json:"values"`
+	Failures    []SnapshotFailure `json:"failures,omitempty"`
+}
+
+// SnapshotValue is the value of a resource read from the device
+type SnapshotValue struct {
+	ResourceName string `json:"resourceName"`
+	ValueType    string `json:"valueType"`
+	Value        string `json:"value,omitempty"`
+	ObjectValue  any    `json:"objectValue,omitempty"`
+}
+
+// SnapshotFailure is a get command which failed when capturing the snapshot
+type SnapshotFailure struct {
+	CommandName string `json:"commandName"`
+	StatusCode  int    `json:"statusCode"`
+	Message     string `json:"message,omitempty"`
+}
+
+// DeviceSnapshotResult is the result of capturing the snapshot of one of the devices selected by the group, the
+// SnapshotId is only set when the snapshot is stored
+type DeviceSnapshotResult struct {
+	DeviceName string `json:"deviceName"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message,omitempty"`
+	SnapshotId string `json:"snapshotId,omitempty"`
+}
+
+// DeviceSnapshotRestore is the result of restoring a snapshot to a device, the commands are not issued in the dry-run mode
+type DeviceSnapshotRestore struct {
+	SnapshotId string `json:"snapshotId"`
+	DeviceName string `json:"deviceName"`
+	DryRun     bool   `json:"dryRun"`
+	// Diff are the resources whose current values differ from the snapshot
+	Diff []SnapshotValueDiff `json:"diff"`
+	// Commands are the set commands restoring the diff
+	Commands []SnapshotRestoreCommand `json:"commands"`
+	// Skipped are the resources of the snapshot which can't be restored to the device
+	Skipped []SnapshotSkippedResource `json:"skipped,omitempty"`
+}
+
+// SnapshotValueDiff is a resource whose current value differs from the snapshot, the CurrentValue is absent if the
+// resource can't be read from the device
+type SnapshotValueDiff struct {
+	ResourceName  string `json:"resourceName"`
+	SnapshotValue any    `json:"snapshotValue"`
+	CurrentValue  any    `json:"currentValue,omitempty"`
+}
+
+// SnapshotRestoreCommand is a set command restoring the snapshot values, the StatusCode is absent if the command isn't
+// issued in the dry-run mode and passes the validation
+type SnapshotRestoreCommand struct {
+	CommandName string         `json:"commandName"`
+	Settings    map[string]any `json:"settings"`
+	StatusCode  int            `json:"statusCode,omitempty"`
+	Message     string         `json:"message,omitempty"`
+}
+
+// SnapshotSkippedResource is a resource of the snapshot which can't be restored to the device and the reason
+type SnapshotSkippedResource struct {
+	ResourceName string `json:"resourceName"`
+	Reason       string `json:"reason"`
+}
+
+// FromDeviceSnapshotModelToDTO transforms the DeviceSnapshot Model to the DeviceSnapshot DTO
+func FromDeviceSnapshotModelToDTO(s models.DeviceSnapshot) DeviceSnapshot {
+	values := make([]SnapshotValue, len(s.Values))
+	for i, v := range s.Values {
+		values[i] = SnapshotValue(v)
+	}
+	var failures []SnapshotFailure
+	for _, f := range s.Failures {
+		failures = append(failures, SnapshotFailure(f))
+	}
+	return DeviceSnapshot{
+		Id:          s.Id,
+		Created:     s.Created,
+		Description: s.Description,
+		DeviceName:  s.DeviceName,
+		ProfileName: s.ProfileName,
+		Values:      values,
+		Failures:    failures,
+	}
+}
+
+// FromDeviceSnapshotModelsToDTOs transforms the DeviceSnapshot Models to the DeviceSnapshot DTOs
+func FromDeviceSnapshotModelsToDTOs(snapshots []models.DeviceSnapshot) []DeviceSnapshot {
+	dtos := make([]DeviceSnapshot, len(snapshots))
+	for i, s := range snapshots {
+		dtos[i] = FromDeviceSnapshotModelToDTO(s)
+	}
+	return dtos
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+)
+
+// DeviceGroup selects the devices targeted by a group command, and exactly one of the selectors must be specified
+type DeviceGroup struct {
+	Labels      []string `json:"labels,omitempty"`
+	ProfileName string   `json:"profileName,omitempty"`
+	ServiceName string   `json:"serviceName,omitempty"`
+	DeviceNames []string `json:"deviceNames,omitempty"`
+}
+
+// NewDeviceGroupFromQueryParams creates the DeviceGroup from the labels, profileName, serviceName or names query
+// parameter, and returns the rest of the query parameters which are passed to the device services
+func NewDeviceGroupFromQueryParams(params url.Values) (DeviceGroup, url.Values) {
+	group := DeviceGroup{
+		Labels:      splitQueryParam(params.Get(common.Labels)),
+		ProfileName: params.Get(common.ProfileName),
+		ServiceName: params.Get(common.ServiceName),
+		DeviceNames: splitQueryParam(params.Get(constants.Names)),
+	}
+	rest := url.Values{}
+	for key, values := range params {
+		switch key {
+		case common.Labels, common.ProfileName, common.ServiceName, constants.Names:
+		default:
+			rest[key] = values
+		}
+	}
+	return group, rest
+}
+
+// Validate checks whether exactly one of the selectors is specified
+func (g DeviceGroup) Validate() errors.EdgeX {
+	count := 0
+	if len(g.Labels) > 0 {
+		count++
+	}
+	if g.ProfileName != "" {
+		count++
+	}
+	if g.ServiceName != "" {
+		count++
+	}
+	if len(g.DeviceNames) > 0 {
+		count++
+	}
+	if count != 1 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "exactly one of labels, profileName, serviceName and names must be specified to select the devices", nil)
+	}
+	return nil
+}
+
+func splitQueryParam(value string) []string {
+	if value == "" {
+		return nil
+	}
+	var values []string
+	for _, v := range strings.Split(value, common.CommaSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// DeviceCommandResult is the result of the command issued to one of the devices of a group command
+type DeviceCommandResult struct {
+	DeviceName    string         `json:"deviceName"`
+	StatusCode    int            `json:"statusCode"`
+	Message       string         `json:"message,omitempty"`
+	SettingErrors []SettingError `json:"settingErrors,omitempty"`
+	Event         *dtos.Event    `json:"event,omitempty"`
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// AddCommandPolicyRequest defines the Request Content for POST CommandPolicy DTO.
+type AddCommandPolicyRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	CommandPolicy         dtos.CommandPolicy `json:"commandPolicy"`
+}
+
+// Validate satisfies the Validator interface
+func (a *AddCommandPolicyRequest) Validate() error {
+	err := common.Validate(a)
+	if err != nil {
+		return err
+	}
+	return a.CommandPolicy.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddCommandPolicyRequest type
+func (a *AddCommandPolicyRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		CommandPolicy dtos.CommandPolicy
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*a = AddCommandPolicyRequest(alias)
+
+	// validate AddCommandPolicyRequest DTO
+	if err := a.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// UpdateCommandPolicyRequest defines the Request Content for PATCH CommandPolicy DTO.
+type UpdateCommandPolicyRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	CommandPolicy         dtos.UpdateCommandPolicy `json:"commandPolicy"`
+}
+
+// Validate satisfies the Validator interface
+func (u *UpdateCommandPolicyRequest) Validate() error {
+	err := common.Validate(u)
+	if err != nil {
+		return err
+	}
+	return u.CommandPolicy.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateCommandPolicyRequest type
+func (u *UpdateCommandPolicyRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		CommandPolicy dtos.UpdateCommandPolicy
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*u = UpdateCommandPolicyRequest(alias)
+
+	// validate UpdateCommandPolicyRequest DTO
+	if err := u.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// ReplaceCommandPolicyModelFieldsWithDTO replace existing CommandPolicy's fields with DTO patch
+func ReplaceCommandPolicyModelFieldsWithDTO(policy *models.CommandPolicy, patch dtos.UpdateCommandPolicy) {
+	if patch.Description != nil {
+		policy.Description = *patch.Description
+	}
+	if patch.Effect != nil {
+		policy.Effect = *patch.Effect
+	}
+	if patch.DeviceNames != nil {
+		policy.DeviceNames = patch.DeviceNames
+	}
+	if patch.DeviceLabels != nil {
+		policy.DeviceLabels = patch.DeviceLabels
+	}
+	if patch.CommandNames != nil {
+		policy.CommandNames = patch.CommandNames
+	}
+	if patch.Methods != nil {
+		policy.Methods = patch.Methods
+	}
+	if patch.Sources != nil {
+		policy.Sources = patch.Sources
+	}
+	if patch.Issuers != nil {
+		policy.Issuers = patch.Issuers
+	}
+	if patch.Subjects != nil {
+		policy.Subjects = patch.Subjects
+	}
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// CaptureDeviceSnapshotRequest defines the Request Content for POST DeviceSnapshot, a snapshot is captured for each
+// device selected by the group
+type CaptureDeviceSnapshotRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Description           string           `json:"description,omitempty"`
+	Group                 dtos.DeviceGroup `json:"group"`
+}
+
+// Validate satisfies the Validator interface
+func (c *CaptureDeviceSnapshotRequest) Validate() error {
+	err := common.Validate(c)
+	if err != nil {
+		return err
+	}
+	return c.Group.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the CaptureDeviceSnapshotRequest type
+func (c *CaptureDeviceSnapshotRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Description string
+		Group       dtos.DeviceGroup
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*c = CaptureDeviceSnapshotRequest(alias)
+
+	// validate CaptureDeviceSnapshotRequest DTO
+	if err := c.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// RestoreDeviceSnapshotRequest defines the Request Content for POST DeviceSnapshot restore, the snapshot is restored to
+// the device it was captured from if the DeviceName is empty
+type RestoreDeviceSnapshotRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	DeviceName            string `json:"deviceName,omitempty"`
+	DryRun                bool   `json:"dryRun"`
+}
+
+// Validate satisfies the Validator interface
+func (r *RestoreDeviceSnapshotRequest) Validate() error {
+	return common.Validate(r)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the RestoreDeviceSnapshotRequest type
+func (r *RestoreDeviceSnapshotRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		DeviceName string
+		DryRun     bool
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = RestoreDeviceSnapshotRequest(alias)
+
+	// validate RestoreDeviceSnapshotRequest DTO
+	if err := r.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
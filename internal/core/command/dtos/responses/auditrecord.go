@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// MultiAuditRecordsResponse defines the Response Content for GET multiple AuditRecord DTOs.
+type MultiAuditRecordsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	AuditRecords                      []dtos.AuditRecord `json:"auditRecords"`
+}
+
+func NewMultiAuditRecordsResponse(requestId string, message string, statusCode int, totalCount uint32, records []dtos.AuditRecord) MultiAuditRecordsResponse {
+	return MultiAuditRecordsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		AuditRecords:               records,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// CommandPolicyResponse defines the Response Content for GET CommandPolicy DTO.
+type CommandPolicyResponse struct {
+	common.BaseResponse `json:",inline"`
+	CommandPolicy       dtos.CommandPolicy `json:"commandPolicy"`
+}
+
+func NewCommandPolicyResponse(requestId string, message string, statusCode int, policy dtos.CommandPolicy) CommandPolicyResponse {
+	return CommandPolicyResponse{
+		BaseResponse:  common.NewBaseResponse(requestId, message, statusCode),
+		CommandPolicy: policy,
+	}
+}
+
+// MultiCommandPoliciesResponse defines the Response Content for GET multiple CommandPolicy DTOs.
+type MultiCommandPoliciesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	CommandPolicies                   []dtos.CommandPolicy `json:"commandPolicies"`
+}
+
+func NewMultiCommandPoliciesResponse(requestId string, message string, statusCode int, totalCount uint32, policies []dtos.CommandPolicy) MultiCommandPoliciesResponse {
+	return MultiCommandPoliciesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		CommandPolicies:            policies,
+	}
+}
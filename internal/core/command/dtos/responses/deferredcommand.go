@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// DeferredCommandResponse defines the Response Content for GET DeferredCommand DTO.
+type DeferredCommandResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeferredCommand     dtos.DeferredCommand `json:"deferredCommand"`
+}
+
+func NewDeferredCommandResponse(requestId string, message string, statusCode int, command dtos.DeferredCommand) DeferredCommandResponse {
+	return DeferredCommandResponse{
+		BaseResponse:    common.NewBaseResponse(requestId, message, statusCode),
+		DeferredCommand: command,
+	}
+}
+
+// MultiDeferredCommandsResponse defines the Response Content for GET multiple DeferredCommand DTOs.
+type MultiDeferredCommandsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	DeferredCommands                  []dtos.DeferredCommand `json:"deferredCommands"`
+}
+
+func NewMultiDeferredCommandsResponse(requestId string, message string, statusCode int, totalCount uint32, commands []dtos.DeferredCommand) MultiDeferredCommandsResponse {
+	return MultiDeferredCommandsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		DeferredCommands:           commands,
+	}
+}
@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// DeviceSnapshotResponse defines the Response Content for GET DeviceSnapshot DTO.
+type DeviceSnapshotResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeviceSnapshot      dtos.DeviceSnapshot `json:"deviceSnapshot"`
+}
+
+func NewDeviceSnapshotResponse(requestId string, message string, statusCode int, snapshot dtos.DeviceSnapshot) DeviceSnapshotResponse {
+	return DeviceSnapshotResponse{
+		BaseResponse:   common.NewBaseResponse(requestId, message, statusCode),
+		DeviceSnapshot: snapshot,
+	}
+}
+
+// MultiDeviceSnapshotsResponse defines the Response Content for GET multiple DeviceSnapshot DTOs.
+type MultiDeviceSnapshotsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	DeviceSnapshots                   []dtos.DeviceSnapshot `json:"deviceSnapshots"`
+}
+
+func NewMultiDeviceSnapshotsResponse(requestId string, message string, statusCode int, totalCount uint32, snapshots []dtos.DeviceSnapshot) MultiDeviceSnapshotsResponse {
+	return MultiDeviceSnapshotsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		DeviceSnapshots:            snapshots,
+	}
+}
+
+// MultiDeviceSnapshotResultsResponse defines the Response Content for POST DeviceSnapshot, the TotalCount is the number
+// of the selected devices and the FailedCount is the number of the devices whose snapshot isn't stored
+type MultiDeviceSnapshotResultsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	FailedCount                       uint32                      `json:"failedCount"`
+	Results                           []dtos.DeviceSnapshotResult `json:"results"`
+}
+
+func NewMultiDeviceSnapshotResultsResponse(requestId string, message string, statusCode int, results []dtos.DeviceSnapshotResult) MultiDeviceSnapshotResultsResponse {
+	var failedCount uint32
+	for _, r := range results {
+		if r.SnapshotId == "" {
+			failedCount++
+		}
+	}
+	return MultiDeviceSnapshotResultsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, uint32(len(results))),
+		FailedCount:                failedCount,
+		Results:                    results,
+	}
+}
+
+// DeviceSnapshotRestoreResponse defines the Response Content for POST DeviceSnapshot restore
+type DeviceSnapshotRestoreResponse struct {
+	common.BaseResponse `json:",inline"`
+	Restore             dtos.DeviceSnapshotRestore `json:"restore"`
+}
+
+func NewDeviceSnapshotRestoreResponse(requestId string, message string, statusCode int, restore dtos.DeviceSnapshotRestore) DeviceSnapshotRestoreResponse {
+	return DeviceSnapshotRestoreResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Restore:      restore,
+	}
+}
@@ -0,0 +1,34 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// MultiDeviceCommandResultsResponse defines the Response Content for the group command, the TotalCount is the number of
+// the targeted devices and the FailedCount is the number of the devices which the command failed on
+type MultiDeviceCommandResultsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	FailedCount                       uint32                     `json:"failedCount"`
+	Results                           []dtos.DeviceCommandResult `json:"results"`
+}
+
+func NewMultiDeviceCommandResultsResponse(requestId string, message string, statusCode int, results []dtos.DeviceCommandResult) MultiDeviceCommandResultsResponse {
+	var failedCount uint32
+	for _, r := range results {
+		if r.StatusCode < 200 || r.StatusCode >= 300 {
+			failedCount++
+		}
+	}
+	return MultiDeviceCommandResultsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, uint32(len(results))),
+		FailedCount:                failedCount,
+		Results:                    results,
+	}
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// InvalidSettingsResponse defines the Response Content of a set command rejected for its invalid settings.
+type InvalidSettingsResponse struct {
+	common.BaseResponse `json:",inline"`
+	SettingErrors       []dtos.SettingError `json:"settingErrors"`
+}
+
+func NewInvalidSettingsResponse(requestId string, message string, statusCode int, settingErrors []dtos.SettingError) InvalidSettingsResponse {
+	return InvalidSettingsResponse{
+		BaseResponse:  common.NewBaseResponse(requestId, message, statusCode),
+		SettingErrors: settingErrors,
+	}
+}
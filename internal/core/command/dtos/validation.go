@@ -0,0 +1,12 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// SettingError is why a setting of a set command doesn't match the writable resources of the device profile
+type SettingError struct {
+	Setting string `json:"setting"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,47 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_command.audit_record is used to store the audit record of each command issued through core-command
+CREATE TABLE IF NOT EXISTS core_command.audit_record (
+    id UUID PRIMARY KEY,
+    device_name TEXT NOT NULL,
+    status TEXT NOT NULL,
+    content JSONB NOT NULL,
+    created timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc')
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_record_created
+    ON core_command.audit_record(created);
+
+CREATE INDEX IF NOT EXISTS idx_audit_record_device_name_created
+    ON core_command.audit_record(device_name, created);
+
+-- core_command.command_policy is used to store the access control policies of the commands
+CREATE TABLE IF NOT EXISTS core_command.command_policy (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL
+);
+
+-- core_command.deferred_command is used to store the set commands waiting to be delivered to the unavailable devices
+CREATE TABLE IF NOT EXISTS core_command.deferred_command (
+    id UUID PRIMARY KEY,
+    status TEXT NOT NULL,
+    content JSONB NOT NULL,
+    created timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc')
+);
+
+CREATE INDEX IF NOT EXISTS idx_deferred_command_status_created
+    ON core_command.deferred_command(status, created);
+
+-- core_command.device_snapshot is used to store the captured values of the device resources to be restored later
+CREATE TABLE IF NOT EXISTS core_command.device_snapshot (
+    id UUID PRIMARY KEY,
+    device_name TEXT NOT NULL,
+    content JSONB NOT NULL,
+    created timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc')
+);
+
+CREATE INDEX IF NOT EXISTS idx_device_snapshot_device_name_created
+    ON core_command.device_snapshot(device_name, created);
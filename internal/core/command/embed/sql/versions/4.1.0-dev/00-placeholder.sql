@@ -0,0 +1,6 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- this is a placeholder file for the 4.1.0-dev version of the database schema
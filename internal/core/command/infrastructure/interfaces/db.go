@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// DBClient is the persistence of core-command, the empty deviceName and status arguments of the audit record, deferred
+// command and device snapshot queries match any device and any status
+type DBClient interface {
+	CloseSession()
+
+	AddAuditRecord(ctx context.Context, record models.AuditRecord) (models.AuditRecord, errors.EdgeX)
+	AuditRecords(ctx context.Context, deviceName, status string, start, end int64, offset, limit int) ([]models.AuditRecord, errors.EdgeX)
+	AuditRecordCount(ctx context.Context, deviceName, status string, start, end int64) (uint32, errors.EdgeX)
+	LatestAuditRecordByOffset(ctx context.Context, offset uint32) (models.AuditRecord, errors.EdgeX)
+	DeleteAuditRecordsByAge(ctx context.Context, age int64) errors.EdgeX
+
+	AddCommandPolicy(ctx context.Context, policy models.CommandPolicy) (models.CommandPolicy, errors.EdgeX)
+	AllCommandPolicies(ctx context.Context, offset, limit int) ([]models.CommandPolicy, errors.EdgeX)
+	CommandPolicyTotalCount(ctx context.Context) (uint32, errors.EdgeX)
+	CommandPolicyById(ctx context.Context, id string) (models.CommandPolicy, errors.EdgeX)
+	CommandPolicyByName(ctx context.Context, name string) (models.CommandPolicy, errors.EdgeX)
+	UpdateCommandPolicy(ctx context.Context, policy models.CommandPolicy) errors.EdgeX
+	DeleteCommandPolicyByName(ctx context.Context, name string) errors.EdgeX
+
+	AddDeferredCommand(ctx context.Context, command models.DeferredCommand) (models.DeferredCommand, errors.EdgeX)
+	UpdateDeferredCommand(ctx context.Context, command models.DeferredCommand) errors.EdgeX
+	DeferredCommandById(ctx context.Context, id string) (models.DeferredCommand, errors.EdgeX)
+	DeferredCommandsByStatus(ctx context.Context, status string, offset, limit int) ([]models.DeferredCommand, errors.EdgeX)
+	DeferredCommandCountByStatus(ctx context.Context, status string) (uint32, errors.EdgeX)
+	// DeleteDeferredCommandsByAge deletes the deferred commands which are no longer pending and older than the given age in milliseconds
+	DeleteDeferredCommandsByAge(ctx context.Context, age int64) errors.EdgeX
+
+	AddDeviceSnapshot(ctx context.Context, snapshot models.DeviceSnapshot) (models.DeviceSnapshot, errors.EdgeX)
+	DeviceSnapshotById(ctx context.Context, id string) (models.DeviceSnapshot, errors.EdgeX)
+	DeviceSnapshotsByDeviceName(ctx context.Context, deviceName string, offset, limit int) ([]models.DeviceSnapshot, errors.EdgeX)
+	DeviceSnapshotCountByDeviceName(ctx context.Context, deviceName string) (uint32, errors.EdgeX)
+	DeleteDeviceSnapshotById(ctx context.Context, id string) errors.EdgeX
+}
@@ -0,0 +1,675 @@
+// Code generated by mockery v2.49.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// DBClient is an autogenerated mock type for the DBClient type
+type DBClient struct {
+	mock.Mock
+}
+
+// AddAuditRecord provides a mock function with given fields: ctx, record
+func (_m *DBClient) AddAuditRecord(ctx context.Context, record models.AuditRecord) (models.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAuditRecord")
+	}
+
+	var r0 models.AuditRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditRecord) (models.AuditRecord, errors.EdgeX)); ok {
+		return rf(ctx, record)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditRecord) models.AuditRecord); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Get(0).(models.AuditRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.AuditRecord) errors.EdgeX); ok {
+		r1 = rf(ctx, record)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddCommandPolicy provides a mock function with given fields: ctx, policy
+func (_m *DBClient) AddCommandPolicy(ctx context.Context, policy models.CommandPolicy) (models.CommandPolicy, errors.EdgeX) {
+	ret := _m.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCommandPolicy")
+	}
+
+	var r0 models.CommandPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandPolicy) (models.CommandPolicy, errors.EdgeX)); ok {
+		return rf(ctx, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandPolicy) models.CommandPolicy); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Get(0).(models.CommandPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CommandPolicy) errors.EdgeX); ok {
+		r1 = rf(ctx, policy)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDeferredCommand provides a mock function with given fields: ctx, command
+func (_m *DBClient) AddDeferredCommand(ctx context.Context, command models.DeferredCommand) (models.DeferredCommand, errors.EdgeX) {
+	ret := _m.Called(ctx, command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDeferredCommand")
+	}
+
+	var r0 models.DeferredCommand
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeferredCommand) (models.DeferredCommand, errors.EdgeX)); ok {
+		return rf(ctx, command)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeferredCommand) models.DeferredCommand); ok {
+		r0 = rf(ctx, command)
+	} else {
+		r0 = ret.Get(0).(models.DeferredCommand)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DeferredCommand) errors.EdgeX); ok {
+		r1 = rf(ctx, command)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDeviceSnapshot provides a mock function with given fields: ctx, snapshot
+func (_m *DBClient) AddDeviceSnapshot(ctx context.Context, snapshot models.DeviceSnapshot) (models.DeviceSnapshot, errors.EdgeX) {
+	ret := _m.Called(ctx, snapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDeviceSnapshot")
+	}
+
+	var r0 models.DeviceSnapshot
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeviceSnapshot) (models.DeviceSnapshot, errors.EdgeX)); ok {
+		return rf(ctx, snapshot)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeviceSnapshot) models.DeviceSnapshot); ok {
+		r0 = rf(ctx, snapshot)
+	} else {
+		r0 = ret.Get(0).(models.DeviceSnapshot)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DeviceSnapshot) errors.EdgeX); ok {
+		r1 = rf(ctx, snapshot)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllCommandPolicies provides a mock function with given fields: ctx, offset, limit
+func (_m *DBClient) AllCommandPolicies(ctx context.Context, offset int, limit int) ([]models.CommandPolicy, errors.EdgeX) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllCommandPolicies")
+	}
+
+	var r0 []models.CommandPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]models.CommandPolicy, errors.EdgeX)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []models.CommandPolicy); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommandPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecordCount provides a mock function with given fields: ctx, deviceName, status, start, end
+func (_m *DBClient) AuditRecordCount(ctx context.Context, deviceName string, status string, start int64, end int64) (uint32, errors.EdgeX) {
+	ret := _m.Called(ctx, deviceName, status, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditRecordCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) (uint32, errors.EdgeX)); ok {
+		return rf(ctx, deviceName, status, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) uint32); ok {
+		r0 = rf(ctx, deviceName, status, start, end)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, int64) errors.EdgeX); ok {
+		r1 = rf(ctx, deviceName, status, start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecords provides a mock function with given fields: ctx, deviceName, status, start, end, offset, limit
+func (_m *DBClient) AuditRecords(ctx context.Context, deviceName string, status string, start int64, end int64, offset int, limit int) ([]models.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(ctx, deviceName, status, start, end, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditRecords")
+	}
+
+	var r0 []models.AuditRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int, int) ([]models.AuditRecord, errors.EdgeX)); ok {
+		return rf(ctx, deviceName, status, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int, int) []models.AuditRecord); ok {
+		r0 = rf(ctx, deviceName, status, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, deviceName, status, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CloseSession provides a mock function with given fields:
+func (_m *DBClient) CloseSession() {
+	_m.Called()
+}
+
+// CommandPolicyById provides a mock function with given fields: ctx, id
+func (_m *DBClient) CommandPolicyById(ctx context.Context, id string) (models.CommandPolicy, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommandPolicyById")
+	}
+
+	var r0 models.CommandPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.CommandPolicy, errors.EdgeX)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.CommandPolicy); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.CommandPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CommandPolicyByName provides a mock function with given fields: ctx, name
+func (_m *DBClient) CommandPolicyByName(ctx context.Context, name string) (models.CommandPolicy, errors.EdgeX) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommandPolicyByName")
+	}
+
+	var r0 models.CommandPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.CommandPolicy, errors.EdgeX)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.CommandPolicy); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(models.CommandPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CommandPolicyTotalCount provides a mock function with given fields: ctx
+func (_m *DBClient) CommandPolicyTotalCount(ctx context.Context) (uint32, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommandPolicyTotalCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context) (uint32, errors.EdgeX)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint32); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeferredCommandById provides a mock function with given fields: ctx, id
+func (_m *DBClient) DeferredCommandById(ctx context.Context, id string) (models.DeferredCommand, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeferredCommandById")
+	}
+
+	var r0 models.DeferredCommand
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.DeferredCommand, errors.EdgeX)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.DeferredCommand); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.DeferredCommand)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeferredCommandCountByStatus provides a mock function with given fields: ctx, status
+func (_m *DBClient) DeferredCommandCountByStatus(ctx context.Context, status string) (uint32, errors.EdgeX) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeferredCommandCountByStatus")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uint32, errors.EdgeX)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uint32); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeferredCommandsByStatus provides a mock function with given fields: ctx, status, offset, limit
+func (_m *DBClient) DeferredCommandsByStatus(ctx context.Context, status string, offset int, limit int) ([]models.DeferredCommand, errors.EdgeX) {
+	ret := _m.Called(ctx, status, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeferredCommandsByStatus")
+	}
+
+	var r0 []models.DeferredCommand
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]models.DeferredCommand, errors.EdgeX)); ok {
+		return rf(ctx, status, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []models.DeferredCommand); ok {
+		r0 = rf(ctx, status, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeferredCommand)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, status, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteAuditRecordsByAge provides a mock function with given fields: ctx, age
+func (_m *DBClient) DeleteAuditRecordsByAge(ctx context.Context, age int64) errors.EdgeX {
+	ret := _m.Called(ctx, age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAuditRecordsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, int64) errors.EdgeX); ok {
+		r0 = rf(ctx, age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteCommandPolicyByName provides a mock function with given fields: ctx, name
+func (_m *DBClient) DeleteCommandPolicyByName(ctx context.Context, name string) errors.EdgeX {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCommandPolicyByName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) errors.EdgeX); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDeferredCommandsByAge provides a mock function with given fields: ctx, age
+func (_m *DBClient) DeleteDeferredCommandsByAge(ctx context.Context, age int64) errors.EdgeX {
+	ret := _m.Called(ctx, age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeferredCommandsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, int64) errors.EdgeX); ok {
+		r0 = rf(ctx, age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDeviceSnapshotById provides a mock function with given fields: ctx, id
+func (_m *DBClient) DeleteDeviceSnapshotById(ctx context.Context, id string) errors.EdgeX {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeviceSnapshotById")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) errors.EdgeX); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeviceSnapshotById provides a mock function with given fields: ctx, id
+func (_m *DBClient) DeviceSnapshotById(ctx context.Context, id string) (models.DeviceSnapshot, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceSnapshotById")
+	}
+
+	var r0 models.DeviceSnapshot
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.DeviceSnapshot, errors.EdgeX)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.DeviceSnapshot); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.DeviceSnapshot)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceSnapshotCountByDeviceName provides a mock function with given fields: ctx, deviceName
+func (_m *DBClient) DeviceSnapshotCountByDeviceName(ctx context.Context, deviceName string) (uint32, errors.EdgeX) {
+	ret := _m.Called(ctx, deviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceSnapshotCountByDeviceName")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uint32, errors.EdgeX)); ok {
+		return rf(ctx, deviceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uint32); ok {
+		r0 = rf(ctx, deviceName)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, deviceName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceSnapshotsByDeviceName provides a mock function with given fields: ctx, deviceName, offset, limit
+func (_m *DBClient) DeviceSnapshotsByDeviceName(ctx context.Context, deviceName string, offset int, limit int) ([]models.DeviceSnapshot, errors.EdgeX) {
+	ret := _m.Called(ctx, deviceName, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceSnapshotsByDeviceName")
+	}
+
+	var r0 []models.DeviceSnapshot
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]models.DeviceSnapshot, errors.EdgeX)); ok {
+		return rf(ctx, deviceName, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []models.DeviceSnapshot); ok {
+		r0 = rf(ctx, deviceName, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, deviceName, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// LatestAuditRecordByOffset provides a mock function with given fields: ctx, offset
+func (_m *DBClient) LatestAuditRecordByOffset(ctx context.Context, offset uint32) (models.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(ctx, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestAuditRecordByOffset")
+	}
+
+	var r0 models.AuditRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) (models.AuditRecord, errors.EdgeX)); ok {
+		return rf(ctx, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) models.AuditRecord); ok {
+		r0 = rf(ctx, offset)
+	} else {
+		r0 = ret.Get(0).(models.AuditRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32) errors.EdgeX); ok {
+		r1 = rf(ctx, offset)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateCommandPolicy provides a mock function with given fields: ctx, policy
+func (_m *DBClient) UpdateCommandPolicy(ctx context.Context, policy models.CommandPolicy) errors.EdgeX {
+	ret := _m.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCommandPolicy")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandPolicy) errors.EdgeX); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateDeferredCommand provides a mock function with given fields: ctx, command
+func (_m *DBClient) UpdateDeferredCommand(ctx context.Context, command models.DeferredCommand) errors.EdgeX {
+	ret := _m.Called(ctx, command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDeferredCommand")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeferredCommand) errors.EdgeX); ok {
+		r0 = rf(ctx, command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDBClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DBClient {
+	mock := &DBClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -19,7 +19,9 @@ package command
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/secret"
@@ -67,5 +69,24 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		},
 	})
 
+	if config.AuditLog.Enabled {
+		application.AsyncWriteAuditRecords(ctx, wg, dic)
+	}
+	if config.AuditLog.Enabled && config.AuditLog.Retention.Enabled {
+		retentionInterval, err := time.ParseDuration(config.AuditLog.Retention.Interval)
+		if err != nil {
+			lc.Errorf("Failed to parse audit record retention interval, %v", err)
+			return false
+		}
+		application.AsyncPurgeAuditRecord(ctx, dic, retentionInterval)
+	}
+
+	if config.DeferredCommand.Enabled {
+		if err := application.AsyncDeliverDeferredCommands(ctx, wg, dic); err != nil {
+			lc.Errorf("Failed to start the deferred commands delivery, %v", err)
+			return false
+		}
+	}
+
 	return true
 }
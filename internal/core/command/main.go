@@ -18,6 +18,7 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -31,9 +32,14 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 
 	"github.com/edgexfoundry/edgex-go"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/embed"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
@@ -61,6 +67,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo, arg
 	})
 
 	httpServer := handlers.NewHttpServer(router, true, common.CoreCommandServiceKey)
+	dbHandler := pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName, embed.SchemaName,
+		common.CoreCommandServiceKey, edgex.Version, embed.SQLFiles)
 
 	bootstrap.Run(
 		ctx,
@@ -74,6 +82,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo, arg
 		true,
 		bootstrapConfig.ServiceTypeOther,
 		[]interfaces.BootstrapHandler{
+			DatabaseBootstrapHandler(dbHandler), // add db client bootstrap handler
+			AccessControlBootstrapHandler,       // Must be after the database and before Messaging
 			handlers.NewClientsBootstrap().BootstrapHandler,
 			MessagingBootstrapHandler,
 			handlers.NewServiceMetrics(common.CoreCommandServiceKey).BootstrapHandler, // Must be after Messaging
@@ -120,5 +130,86 @@ func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupT
 		return false
 	}
 
+	if err := messaging.SubscribeGroupCommandRequests(ctx, dic); err != nil {
+		lc.Errorf("Failed to subscribe group command request from internal message bus, %v", err)
+		return false
+	}
+
+	if configuration.MetadataCache.Enabled {
+		var ttl time.Duration
+		if configuration.MetadataCache.TTL != "" {
+			var err error
+			ttl, err = time.ParseDuration(configuration.MetadataCache.TTL)
+			if err != nil {
+				lc.Errorf("Failed to parse the metadata cache ttl %s, %v", configuration.MetadataCache.TTL, err)
+				return false
+			}
+		}
+		metadataCache := cache.NewMetadataCache(ttl)
+		dic.Update(di.ServiceConstructorMap{
+			container.MetadataCacheInterfaceName: func(get di.Get) interface{} {
+				return metadataCache
+			},
+		})
+	}
+	// the system events keep the metadata cache fresh and wake up the deferred commands delivery, they are subscribed
+	// before warming the cache, so that no change is missed during warming
+	if configuration.MetadataCache.Enabled || configuration.DeferredCommand.Enabled {
+		if err := messaging.SubscribeSystemEvents(ctx, dic); err != nil {
+			lc.Errorf("Failed to subscribe system events from internal message bus, %v", err)
+			return false
+		}
+	}
+	if configuration.MetadataCache.Enabled {
+		if err := application.WarmMetadataCache(ctx, dic); err != nil {
+			lc.Warnf("Failed to warm the metadata cache and the metadata will be cached on demand, %v", err)
+		}
+	}
+
 	return true
 }
+
+// DatabaseBootstrapHandler returns the handler adding the database client only when a feature keeping its records in
+// the database is enabled, so that core-command doesn't depend on the database otherwise
+func DatabaseBootstrapHandler(dbHandler pkgHandlers.Database) interfaces.BootstrapHandler {
+	return func(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+		if !container.ConfigurationFrom(dic.Get).DatabaseRequired() {
+			bootstrapContainer.LoggingClientFrom(dic.Get).Info("The database is not used as no feature keeping its records in the database is enabled")
+			return true
+		}
+		return dbHandler.BootstrapHandler(ctx, wg, startupTimer, dic)
+	}
+}
+
+// AccessControlBootstrapHandler loads the command policies into the cache if the access control is enabled, so that the
+// policies are enforced before any command request is received
+func AccessControlBootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	if !configuration.AccessControl.Enabled {
+		return true
+	}
+
+	if err := validateDefaultEffect(configuration.AccessControl.DefaultEffect); err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	policyCache, err := application.LoadCommandPolicyCache(ctx, dic)
+	if err != nil {
+		lc.Errorf("Failed to load the command policies, %v", err)
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.CommandPolicyCacheInterfaceName: func(get di.Get) interface{} {
+			return policyCache
+		},
+	})
+	return true
+}
+
+func validateDefaultEffect(effect string) error {
+	if effect != models.PolicyEffectAllow && effect != models.PolicyEffectDeny {
+		return fmt.Errorf("invalid AccessControl.DefaultEffect %s, only %s or %s is allowed", effect, models.PolicyEffectAllow, models.PolicyEffectDeny)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// AuditRecord records a get or set command issued through core-command to a device, including who issued it, how it was
+// received and how it ended
+type AuditRecord struct {
+	Id          string
+	Created     int64
+	DeviceName  string
+	CommandName string
+	Method      string
+	// Settings are the values written to the device by a set command
+	Settings map[string]any
+	// Caller is the identity of the caller parsed from the JWT of the request, it is empty if the caller is unknown
+	Caller string
+	// Source is where the command request is received from, see the AuditSource constants
+	Source        string
+	CorrelationId string
+	// Latency is the time taken to issue the command in milliseconds
+	Latency    int64
+	StatusCode int
+	// Status is either models.Succeeded or models.Failed of go-mod-core-contracts
+	Status  string
+	Message string
+}
+
+// constants relate to the sources of the command requests
+const (
+	AuditSourceREST         = "REST"
+	AuditSourceMessageBus   = "MessageBus"
+	AuditSourceExternalMQTT = "ExternalMQTT"
+)
@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// CommandPolicy decides whether the callers matching the policy may issue the commands matching the policy. Each of the
+// matching fields matches anything when it is empty, and the device names and command names can be shell patterns, e.g.
+// "light-*". A command is denied when any DENY policy matches, otherwise it is allowed when any ALLOW policy matches.
+type CommandPolicy struct {
+	Id          string
+	Created     int64
+	Modified    int64
+	Name        string
+	Description string
+	// Effect is either PolicyEffectAllow or PolicyEffectDeny
+	Effect       string
+	DeviceNames  []string
+	DeviceLabels []string
+	CommandNames []string
+	// Methods are the command methods, i.e. get or set
+	Methods []string
+	// Sources are where the command requests are received from, see the AuditSource constants
+	Sources []string
+	// Issuers and Subjects are matched against the "iss" and "sub" claims of the JWT of the REST requests. The requests
+	// from the MessageBus or the external MQTT carry no verified identity, so the policies with Issuers or Subjects must
+	// limit the Sources to REST.
+	Issuers  []string
+	Subjects []string
+}
+
+// CommandCaller identifies who requests a command and where the request is received from
+type CommandCaller struct {
+	Issuer  string
+	Subject string
+	Source  string
+}
+
+// constants relate to the effects of the command policies
+const (
+	PolicyEffectAllow = "ALLOW"
+	PolicyEffectDeny  = "DENY"
+)
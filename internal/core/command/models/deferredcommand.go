@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DeferredCommand is a set command which couldn't be delivered to the device when requested, it is persisted and retried
+// with backoff until it is delivered, rejected by the device service, or expired
+type DeferredCommand struct {
+	Id          string
+	Created     int64
+	Modified    int64
+	DeviceName  string
+	CommandName string
+	// QueryParams are the raw query parameters forwarded to the device service
+	QueryParams   string
+	Settings      map[string]any
+	Caller        string
+	Source        string
+	CorrelationId string
+	// ExpiresAt is the timestamp in milliseconds after which the command is no longer retried
+	ExpiresAt int64
+	Attempts  int
+	// NextAttemptAt is the timestamp in milliseconds of the next delivery attempt
+	NextAttemptAt int64
+	// Status is one of the DeferredCommandStatus constants
+	Status     string
+	StatusCode int
+	Message    string
+}
+
+// constants relate to the delivery status of the deferred commands
+const (
+	DeferredCommandStatusPending   = "PENDING"
+	DeferredCommandStatusDelivered = "DELIVERED"
+	DeferredCommandStatusFailed    = "FAILED"
+	DeferredCommandStatusExpired   = "EXPIRED"
+)
@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DeviceSnapshot is the values of the readable resources of a device captured at a point in time, which can be restored
+// to the device or its replacement later
+type DeviceSnapshot struct {
+	Id          string
+	Created     int64
+	Description string
+	DeviceName  string
+	ProfileName string
+	Values      []SnapshotValue
+	// Failures are the get commands which couldn't be read when the snapshot was captured
+	Failures []SnapshotFailure
+}
+
+// SnapshotValue is the value of a resource read from the device, either Value or ObjectValue is set according to the
+// value type. The binary values are not captured.
+type SnapshotValue struct {
+	ResourceName string
+	ValueType    string
+	Value        string
+	ObjectValue  any
+}
+
+// SnapshotFailure is a get command which failed when capturing the snapshot
+type SnapshotFailure struct {
+	CommandName string
+	StatusCode  int
+	Message     string
+}
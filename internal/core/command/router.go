@@ -8,6 +8,8 @@ package command
 
 import (
 	"github.com/edgexfoundry/edgex-go"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	commandController "github.com/edgexfoundry/edgex-go/internal/core/command/controller/http"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/controller"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/handlers"
@@ -19,6 +21,7 @@ import (
 
 func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	authenticationHook := handlers.AutoConfigAuthenticationFunc(dic)
+	configuration := container.ConfigurationFrom(dic.Get)
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
@@ -29,4 +32,44 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiDeviceByNameRoute, cmd.CommandsByDeviceName, authenticationHook)
 	r.GET(common.ApiDeviceNameCommandNameRoute, cmd.IssueGetCommandByName, authenticationHook)
 	r.PUT(common.ApiDeviceNameCommandNameRoute, cmd.IssueSetCommandByName, authenticationHook)
+	r.GET(constants.ApiDeviceGroupCommandRoute, cmd.IssueGroupGetCommand, authenticationHook)
+	r.PUT(constants.ApiDeviceGroupCommandRoute, cmd.IssueGroupSetCommand, authenticationHook)
+
+	// Audit Record, served only when the audit log is enabled since the records are kept in the database
+	if configuration.AuditLog.Enabled {
+		ac := commandController.NewAuditRecordController(dic)
+		r.GET(constants.ApiAllAuditRecordRoute, ac.AllAuditRecords, authenticationHook)
+		r.GET(constants.ApiAuditRecordByDeviceNameRoute, ac.AuditRecordsByDeviceName, authenticationHook)
+		r.GET(constants.ApiAuditRecordByStatusRoute, ac.AuditRecordsByStatus, authenticationHook)
+		r.GET(constants.ApiAuditRecordByDeviceNameAndStatusRoute, ac.AuditRecordsByDeviceNameAndStatus, authenticationHook)
+	}
+
+	// Command Policy, served only when the access control is enabled since the policies are kept in the database
+	if configuration.AccessControl.Enabled {
+		cp := commandController.NewCommandPolicyController(dic)
+		r.POST(constants.ApiCommandPolicyRoute, cp.AddCommandPolicy, authenticationHook)
+		r.PATCH(constants.ApiCommandPolicyRoute, cp.PatchCommandPolicy, authenticationHook)
+		r.GET(constants.ApiAllCommandPolicyRoute, cp.AllCommandPolicies, authenticationHook)
+		r.GET(constants.ApiCommandPolicyByNameRoute, cp.CommandPolicyByName, authenticationHook)
+		r.DELETE(constants.ApiCommandPolicyByNameRoute, cp.DeleteCommandPolicyByName, authenticationHook)
+	}
+
+	// Deferred Command, served only when the deferred commands are enabled
+	if configuration.DeferredCommand.Enabled {
+		dc := commandController.NewDeferredCommandController(dic)
+		r.GET(constants.ApiAllDeferredCommandRoute, dc.AllDeferredCommands, authenticationHook)
+		r.GET(constants.ApiDeferredCommandByIdRoute, dc.DeferredCommandById, authenticationHook)
+		r.GET(constants.ApiDeferredCommandByStatusRoute, dc.DeferredCommandsByStatus, authenticationHook)
+	}
+
+	// Device Snapshot, served only when the device snapshots are enabled
+	if configuration.DeviceSnapshot.Enabled {
+		ds := commandController.NewDeviceSnapshotController(dic)
+		r.POST(constants.ApiDeviceSnapshotRoute, ds.CaptureDeviceSnapshots, authenticationHook)
+		r.GET(constants.ApiAllDeviceSnapshotRoute, ds.AllDeviceSnapshots, authenticationHook)
+		r.GET(constants.ApiDeviceSnapshotByIdRoute, ds.DeviceSnapshotById, authenticationHook)
+		r.DELETE(constants.ApiDeviceSnapshotByIdRoute, ds.DeleteDeviceSnapshotById, authenticationHook)
+		r.GET(constants.ApiDeviceSnapshotByDeviceNameRoute, ds.DeviceSnapshotsByDeviceName, authenticationHook)
+		r.POST(constants.ApiRestoreDeviceSnapshotRoute, ds.RestoreDeviceSnapshot, authenticationHook)
+	}
 }
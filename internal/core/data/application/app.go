@@ -1,5 +1,6 @@
 /*******************************************************************************
  * Copyright 2022 Intel Corp.
+ * Copyright 2025 IOTech Ltd.
  *
  * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
  * in compliance with the License. You may obtain a copy of the License at
@@ -29,6 +30,8 @@ import (
 const (
 	eventsPersistedMetricName   = "EventsPersisted"
 	readingsPersistedMetricName = "ReadingsPersisted"
+	eventsPurgedMetricName      = "EventsPurged"
+	readingsPurgedMetricName    = "ReadingsPurged"
 )
 
 // CoreDataApp encapsulates the Core Data Application functionality
@@ -37,16 +40,24 @@ type CoreDataApp struct {
 	lc                       logger.LoggingClient
 	eventsPersistedCounter   gometrics.Counter
 	readingsPersistedCounter gometrics.Counter
+	eventsPurgedCounter      gometrics.Counter
+	readingsPurgedCounter    gometrics.Counter
+	latestReadings           *latestReadingStore
+	deviceResources          *deviceResourceCache
 }
 
 // NewCoreDataApp create a new initialized Core Data application
 func NewCoreDataApp(dic *di.Container) *CoreDataApp {
 	app := &CoreDataApp{
-		lc: bootstrapContainer.LoggingClientFrom(dic.Get),
+		lc:              bootstrapContainer.LoggingClientFrom(dic.Get),
+		latestReadings:  newLatestReadingStore(),
+		deviceResources: newDeviceResourceCache(),
 	}
 
 	app.eventsPersistedCounter = gometrics.NewCounter()
 	app.readingsPersistedCounter = gometrics.NewCounter()
+	app.eventsPurgedCounter = gometrics.NewCounter()
+	app.readingsPurgedCounter = gometrics.NewCounter()
 	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
 	if metricsManager == nil {
 		app.lc.Error("Metric Manager not available. Events and Readings metrics will not be collected.")
@@ -63,6 +74,16 @@ func NewCoreDataApp(dic *di.Container) *CoreDataApp {
 	}
 	app.lc.Infof("Registered metrics counter %s", readingsPersistedMetricName)
 
+	if err := metricsManager.Register(eventsPurgedMetricName, app.eventsPurgedCounter, nil); err != nil {
+		app.lc.Errorf("%s metrics will not be collected: %s", eventsPurgedMetricName, err.Error())
+	}
+	app.lc.Infof("Registered metrics counter %s", eventsPurgedMetricName)
+
+	if err := metricsManager.Register(readingsPurgedMetricName, app.readingsPurgedCounter, nil); err != nil {
+		app.lc.Errorf("%s metrics will not be collected: %s", readingsPurgedMetricName, err.Error())
+	}
+	app.lc.Infof("Registered metrics counter %s", readingsPurgedMetricName)
+
 	return app
 }
 
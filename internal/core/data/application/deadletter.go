@@ -0,0 +1,254 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	goErrors "errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDtos "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+const (
+	// eventPersistRetries is the number of times persisting the event received from the message bus is retried when the
+	// database or the server fails, and the event is dropped afterward
+	eventPersistRetries = 2
+	// eventPersistRetryInterval is the interval before the first retry, which grows linearly with the retries
+	eventPersistRetryInterval = 100 * time.Millisecond
+)
+
+// errEventQuarantined is wrapped by the error of the event whose readings are all quarantined
+var errEventQuarantined = goErrors.New("event quarantined")
+
+// AddEventFromMessage decodes the event from the message received from the message bus, checks it against the message
+// topic and the device profile, and then persists it
+func (a *CoreDataApp) AddEventFromMessage(msgEnvelope msgTypes.MessageEnvelope, ctx context.Context, dic *di.Container) errors.EdgeX {
+	event, err := msgTypes.GetMsgPayload[requestDTO.AddEventRequest](msgEnvelope)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to unmarshal event", err)
+	}
+	edgeXerr := validateEventTopic(msgEnvelope.ReceivedTopic, event.Event)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	eventModel, edgeXerr := a.ValidateReadings(requestDTO.AddEventReqToEventModel(event), ctx, dic)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to validate the readings of the event", edgeXerr)
+	}
+	for retry := 1; ; retry++ {
+		edgeXerr = a.AddEvent(eventModel, ctx, dic)
+		if edgeXerr == nil {
+			return nil
+		}
+		if !isRetryable(edgeXerr) || retry > eventPersistRetries {
+			return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to persist the event", edgeXerr)
+		}
+		a.lc.Warnf("Retrying to persist the event from topic %s, Correlation-id: %s, retry %d of %d, %v",
+			msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID, retry, eventPersistRetries, edgeXerr)
+		select {
+		case <-ctx.Done():
+			return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to persist the event", edgeXerr)
+		case <-time.After(time.Duration(retry) * eventPersistRetryInterval):
+		}
+	}
+}
+
+// isRetryable tells whether the error is caused by the database or the server, rather than by the event itself
+func isRetryable(err errors.EdgeX) bool {
+	kind := errors.Kind(err)
+	return kind == errors.KindDatabaseError || kind == errors.KindServerError
+}
+
+// IsDeadLetter tells whether the message failed to be processed should be kept as a dead letter, which is only the case
+// when the message can't be decoded or doesn't conform to the contracts. The events whose readings are all quarantined
+// are already published to the quarantine topic, and the database or server failures are retried rather than being the
+// fault of the message.
+func IsDeadLetter(err error) bool {
+	return errors.Kind(err) == errors.KindContractInvalid && !goErrors.Is(err, errEventQuarantined)
+}
+
+// validateEventTopic checks whether the event fields match the message topic
+func validateEventTopic(messageTopic string, e dtos.Event) errors.EdgeX {
+	// Parse messageTopic by the pattern `edgex/events/device/<device-service-name>/<device-profile-name>/<device-name>/<source-name>`
+	fields := strings.Split(messageTopic, "/")
+
+	// assumes a non-empty base topic with events/device/<device-service-name>/<device-profile-name>/<device-name>/<source-name>
+	if len(fields) < 6 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid message topic %s", messageTopic), nil)
+	}
+
+	len := len(fields)
+	profileName, err := url.PathUnescape(fields[len-3])
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceName, err := url.PathUnescape(fields[len-2])
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	sourceName, err := url.PathUnescape(fields[len-1])
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// Check whether the event fields match the message topic
+	if e.ProfileName != profileName {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's profileName %s mismatches with the name %s received in topic", e.ProfileName, profileName), nil)
+	}
+	if e.DeviceName != deviceName {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's deviceName %s mismatches with the name %s received in topic", e.DeviceName, deviceName), nil)
+	}
+	if e.SourceName != sourceName {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's sourceName %s mismatches with the name %s received in topic", e.SourceName, sourceName), nil)
+	}
+	return nil
+}
+
+// AddDeadLetter keeps the message which core data failed to process along with the cause, and discards the oldest dead
+// letters once the configured maximum count is exceeded. The failure is only logged since the message is dropped anyway.
+func (a *CoreDataApp) AddDeadLetter(msgEnvelope msgTypes.MessageEnvelope, cause error, dic *di.Container) {
+	maxCount := container.ConfigurationFrom(dic.Get).DeadLetter.MaxCount
+	if maxCount <= 0 {
+		return
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	d := dataModels.DeadLetter{
+		Topic:         msgEnvelope.ReceivedTopic,
+		CorrelationId: msgEnvelope.CorrelationID,
+		ContentType:   msgEnvelope.ContentType,
+		Payload:       rawMsgPayload(msgEnvelope),
+	}
+	if cause != nil {
+		d.Error = cause.Error()
+	}
+	d, err := dbClient.AddDeadLetter(d)
+	if err != nil {
+		a.lc.Errorf("Failed to add the dead letter of the message from topic %s, Correlation-id: %s, %v", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID, err)
+		return
+	}
+	a.lc.Debugf("Dead letter %s is added for the message from topic %s, Correlation-id: %s", d.Id, d.Topic, d.CorrelationId)
+
+	err = dbClient.TrimDeadLetters(uint32(maxCount))
+	if err != nil {
+		a.lc.Errorf("Failed to discard the dead letters exceeding the maximum count %d, %v", maxCount, err)
+	}
+}
+
+// rawMsgPayload returns the message payload as bytes, the payload which isn't received as bytes is encoded in JSON
+func rawMsgPayload(msgEnvelope msgTypes.MessageEnvelope) []byte {
+	switch payload := msgEnvelope.Payload.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return payload
+	case string:
+		// the string payload is supposed to be encoded in base64, see msgTypes.GetMsgPayload
+		if bytes, err := base64.StdEncoding.DecodeString(payload); err == nil {
+			return bytes
+		}
+		return []byte(payload)
+	default:
+		bytes, err := json.Marshal(payload)
+		if err != nil {
+			return []byte(fmt.Sprintf("%v", payload))
+		}
+		return bytes
+	}
+}
+
+// AllDeadLetters query the dead letters with offset and limit, the latest ones come first
+func (a *CoreDataApp) AllDeadLetters(offset int, limit int, dic *di.Container) (deadLetters []dataDtos.DeadLetter, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.DeadLetterTotalCount()
+	if err != nil {
+		return deadLetters, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dataDtos.DeadLetter{}, totalCount, err
+	}
+
+	models, err := dbClient.AllDeadLetters(offset, limit)
+	if err != nil {
+		return deadLetters, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	deadLetters = make([]dataDtos.DeadLetter, len(models))
+	for i, d := range models {
+		deadLetters[i] = dataDtos.FromDeadLetterModelToDTO(d)
+	}
+	return deadLetters, totalCount, nil
+}
+
+// DeadLetterById query the dead letter by id
+func (a *CoreDataApp) DeadLetterById(id string, dic *di.Container) (dataDtos.DeadLetter, errors.EdgeX) {
+	if id == "" {
+		return dataDtos.DeadLetter{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	d, err := container.DBClientFrom(dic.Get).DeadLetterById(id)
+	if err != nil {
+		return dataDtos.DeadLetter{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dataDtos.FromDeadLetterModelToDTO(d), nil
+}
+
+// DeleteDeadLetterById discards the dead letter by id
+func (a *CoreDataApp) DeleteDeadLetterById(id string, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	err := container.DBClientFrom(dic.Get).DeleteDeadLetterById(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// ReplayDeadLetter processes the message of the dead letter again as if it was just received from the message bus, and
+// discards the dead letter once the event is persisted. The dead letter is kept if the message still fails.
+func (a *CoreDataApp) ReplayDeadLetter(id string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	d, err := dbClient.DeadLetterById(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	msgEnvelope := msgTypes.MessageEnvelope{
+		ReceivedTopic: d.Topic,
+		CorrelationID: d.CorrelationId,
+		ContentType:   d.ContentType,
+		Payload:       d.Payload,
+	}
+	err = a.AddEventFromMessage(msgEnvelope, ctx, dic)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to replay dead letter %s", id), err)
+	}
+	a.lc.Debugf("Dead letter %s is replayed, Correlation-id: %s", id, correlation.FromContext(ctx))
+
+	err = dbClient.DeleteDeadLetterById(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
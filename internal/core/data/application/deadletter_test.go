@@ -0,0 +1,206 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+const (
+	testDeadLetterId    = "0d7b3a7e-3d3f-4a0c-9a43-7e6b7c3b3c2a"
+	testDeadLetterTopic = "edgex/events/device/testService/" + testProfileName + "/" + testDeviceName + "/" + testSourceName
+)
+
+func testDeadLetterPayload(t *testing.T) []byte {
+	event := dtos.NewEvent(testProfileName, testDeviceName, testSourceName)
+	err := event.AddSimpleReading(testDeviceResourceName, common.ValueTypeInt32, int32(1))
+	require.NoError(t, err)
+	payload, err := json.Marshal(requests.NewAddEventRequest(event))
+	require.NoError(t, err)
+	return payload
+}
+
+func TestAddDeadLetter(t *testing.T) {
+	msgEnvelope := msgTypes.MessageEnvelope{
+		ReceivedTopic: testDeadLetterTopic,
+		CorrelationID: "correlationId",
+		ContentType:   common.ContentTypeJSON,
+		Payload:       []byte("invalid"),
+	}
+	cause := errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to unmarshal event", nil)
+	expected := dataModels.DeadLetter{
+		Topic:         msgEnvelope.ReceivedTopic,
+		CorrelationId: msgEnvelope.CorrelationID,
+		ContentType:   msgEnvelope.ContentType,
+		Payload:       []byte("invalid"),
+		Error:         cause.Error(),
+	}
+
+	tests := []struct {
+		name     string
+		maxCount int
+		added    bool
+	}{
+		{"Valid - dead letter added and trimmed", 2, true},
+		{"Valid - dead letter disabled", 0, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddDeadLetter", expected).Return(expected, nil)
+			dbClientMock.On("TrimDeadLetters", uint32(testCase.maxCount)).Return(nil)
+			dic := newMockDICWithConfig(&config.ConfigurationStruct{DeadLetter: config.DeadLetterInfo{MaxCount: testCase.maxCount}}, dbClientMock)
+			app := NewCoreDataApp(dic)
+
+			app.AddDeadLetter(msgEnvelope, cause, dic)
+
+			if testCase.added {
+				dbClientMock.AssertCalled(t, "AddDeadLetter", expected)
+				dbClientMock.AssertCalled(t, "TrimDeadLetters", uint32(testCase.maxCount))
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddDeadLetter", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestReplayDeadLetter(t *testing.T) {
+	payload := testDeadLetterPayload(t)
+	notFoundId := "notFoundId"
+	invalidTopicId := "invalidTopicId"
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeadLetterById", testDeadLetterId).Return(dataModels.DeadLetter{
+		Id: testDeadLetterId, Topic: testDeadLetterTopic, ContentType: common.ContentTypeJSON, Payload: payload,
+	}, nil)
+	dbClientMock.On("DeadLetterById", invalidTopicId).Return(dataModels.DeadLetter{
+		Id: invalidTopicId, Topic: "edgex/events/device/testService/unknown/" + testDeviceName + "/" + testSourceName, ContentType: common.ContentTypeJSON, Payload: payload,
+	}, nil)
+	dbClientMock.On("DeadLetterById", notFoundId).Return(dataModels.DeadLetter{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DeleteDeadLetterById", testDeadLetterId).Return(nil)
+	dic := newMockDICWithConfig(&config.ConfigurationStruct{DeadLetter: config.DeadLetterInfo{MaxCount: 10}}, dbClientMock)
+	app := NewCoreDataApp(dic)
+
+	tests := []struct {
+		name          string
+		id            string
+		errorExpected bool
+		errKind       errors.ErrKind
+	}{
+		{"Valid - replayed", testDeadLetterId, false, ""},
+		{"Invalid - empty id", "", true, errors.KindContractInvalid},
+		{"Invalid - topic mismatches the event", invalidTopicId, true, errors.KindContractInvalid},
+		{"Not found", notFoundId, true, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := app.ReplayDeadLetter(testCase.id, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.errKind, errors.Kind(err))
+				dbClientMock.AssertNotCalled(t, "DeleteDeadLetterById", testCase.id)
+				return
+			}
+			require.NoError(t, err)
+			dbClientMock.AssertCalled(t, "DeleteDeadLetterById", testCase.id)
+			_, err = app.LatestReadingByDeviceNameAndResourceName(testDeviceName, testDeviceResourceName)
+			assert.NoError(t, err, "the replayed event should be added")
+		})
+	}
+}
+
+func TestRawMsgPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  any
+		expected []byte
+	}{
+		{"bytes", []byte("{}"), []byte("{}")},
+		{"base64 string", "e30=", []byte("{}")},
+		{"object", map[string]string{}, []byte("{}")},
+		{"nil", nil, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, rawMsgPayload(msgTypes.MessageEnvelope{Payload: testCase.payload}))
+		})
+	}
+}
+
+func TestAddEventFromMessageRetries(t *testing.T) {
+	msgEnvelope := msgTypes.MessageEnvelope{
+		ReceivedTopic: testDeadLetterTopic,
+		ContentType:   common.ContentTypeJSON,
+		Payload:       testDeadLetterPayload(t),
+	}
+	dbErr := errors.NewCommonEdgeX(errors.KindDatabaseError, "database unavailable", nil)
+
+	tests := []struct {
+		name          string
+		failures      int
+		calls         int
+		errorExpected bool
+	}{
+		{"Valid - persisted after retrying", 1, 2, false},
+		{"Invalid - dropped after the retries", eventPersistRetries + 1, eventPersistRetries + 1, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, dbErr).Times(testCase.failures)
+			dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, nil)
+			dic := newMockDICWithConfig(&config.ConfigurationStruct{Writable: config.WritableInfo{PersistData: true}}, dbClientMock)
+			app := NewCoreDataApp(dic)
+
+			err := app.AddEventFromMessage(msgEnvelope, context.Background(), dic)
+
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindDatabaseError, errors.Kind(err))
+				assert.False(t, IsDeadLetter(err), "the database failure should not be dead-lettered")
+			} else {
+				require.NoError(t, err)
+			}
+			dbClientMock.AssertNumberOfCalls(t, "AddEvent", testCase.calls)
+		})
+	}
+}
+
+func TestIsDeadLetter(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      errors.EdgeX
+		expected bool
+	}{
+		{"decode failure", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to unmarshal event", nil), true},
+		{"contract failure", errors.NewCommonEdgeXWrapper(errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid message topic", nil)), true},
+		{"all readings quarantined", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to validate the readings of the event",
+			errors.NewCommonEdgeX(errors.KindContractInvalid, "all the readings are quarantined", errEventQuarantined)), false},
+		{"database failure", errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to persist the event", nil), false},
+		{"server failure", errors.NewCommonEdgeX(errors.KindServerError, "fail to persist the event", nil), false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, IsDeadLetter(testCase.err))
+		})
+	}
+}
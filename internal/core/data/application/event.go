@@ -21,6 +21,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
@@ -49,6 +50,7 @@ func (a *CoreDataApp) ValidateEvent(e models.Event, profileName string, deviceNa
 func (a *CoreDataApp) AddEvent(e models.Event, ctx context.Context, dic *di.Container) (err errors.EdgeX) {
 	configuration := container.ConfigurationFrom(dic.Get)
 	if !configuration.Writable.PersistData {
+		a.latestReadings.update(e.Readings)
 		return nil
 	}
 
@@ -72,10 +74,40 @@ func (a *CoreDataApp) AddEvent(e models.Event, ctx context.Context, dic *di.Cont
 		a.eventsPersistedCounter.Inc(1)
 		a.readingsPersistedCounter.Inc(int64(len(addedEvent.Readings)))
 	}
+	a.latestReadings.update(e.Readings)
 
 	return nil
 }
 
+// AddEvents accepts the validated event models from the controller functions and invokes the AddEvents function in
+// the infrastructure layer to add them in a single batch, either all the events are added or none of them
+func (a *CoreDataApp) AddEvents(events []models.Event, ctx context.Context, dic *di.Container) errors.EdgeX {
+	configuration := container.ConfigurationFrom(dic.Get)
+	if !configuration.Writable.PersistData || len(events) == 0 {
+		for _, e := range events {
+			a.latestReadings.update(e.Readings)
+		}
+		return nil
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	addedEvents, err := dbClient.AddEvents(events)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	readingCount := 0
+	for _, e := range addedEvents {
+		readingCount += len(e.Readings)
+		a.latestReadings.update(e.Readings)
+	}
+	a.lc.Debugf("%d events created on DB successfully. Correlation-id: %s ", len(addedEvents), correlation.FromContext(ctx))
+
+	a.eventsPersistedCounter.Inc(int64(len(addedEvents)))
+	a.readingsPersistedCounter.Inc(int64(readingCount))
+	return nil
+}
+
 // PublishEvent publishes incoming AddEventRequest in the format of []byte through MessageClient
 func (a *CoreDataApp) PublishEvent(data requestDTO.AddEventRequest, serviceName string, profileName string, deviceName string, sourceName string, ctx context.Context, dic *di.Container) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -259,6 +291,46 @@ func (a *CoreDataApp) EventsByTimeRange(startTime int64, endTime int64, offset i
 	return events, totalCount, nil
 }
 
+// EventsByCursor query events with the query conditions and limit, starting after the cursor, and returns the continuation token
+// of the next page which is empty when there are no more events. The total count is skipped if skipCount is true.
+func (a *CoreDataApp) EventsByCursor(conds dataModels.EventQueryConditions, cursor dataModels.Cursor, limit int, skipCount bool, dic *di.Container) (events []dtos.Event, nextCursor string, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	eventModels, err := dbClient.EventsByCursor(conds, cursor, limit)
+	if err != nil {
+		return events, nextCursor, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(e)
+	}
+	if limit > 0 && len(eventModels) == limit {
+		last := eventModels[len(eventModels)-1]
+		nextCursor = dataModels.Cursor{Origin: last.Origin, Id: last.Id}.Token()
+	}
+
+	if skipCount {
+		return events, nextCursor, 0, nil
+	}
+	totalCount, err = dbClient.EventCountByQueryConditions(conds)
+	if err != nil {
+		return events, nextCursor, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return events, nextCursor, totalCount, nil
+}
+
+// ExportEvents streams the events matching the query conditions in ascending order of origin, and passes the events
+// to the handler one by one without loading all of them into memory
+func (a *CoreDataApp) ExportEvents(ctx context.Context, conds dataModels.EventQueryConditions, handler func(dtos.Event) error, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	err := dbClient.StreamEvents(ctx, conds, func(e models.Event) error {
+		return handler(dtos.FromEventModelToDTO(e))
+	})
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
 // The DeleteEventsByAge function will be invoked by controller functions
 // and then invokes DeleteEventsByAge function in the infrastructure layer to remove
 // events that are older than age.  Age is supposed in milliseconds since created timestamp.
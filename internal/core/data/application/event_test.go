@@ -19,6 +19,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/cache"
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
@@ -113,6 +114,31 @@ func buildReadings() []models.Reading {
 	return readings
 }
 
+// newMockDICWithConfig returns the mock DIC with the configuration, the db client, an empty device store and the
+// CoreDataApp, the tests register the other dependencies by updating the DIC
+func newMockDICWithConfig(configuration *config.ConfigurationStruct, dbClientMock *dbMock.DBClient) *di.Container {
+	dic := mocks.NewMockDIC()
+	deviceStore := cache.DeviceStore(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.DeviceStoreInterfaceName: func(get di.Get) interface{} {
+			return deviceStore
+		},
+	})
+	app := NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	return dic
+}
+
 func newMockDB(persist bool) *dbMock.DBClient {
 	myMock := &dbMock.DBClient{}
 
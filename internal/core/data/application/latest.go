@@ -0,0 +1,257 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+)
+
+type latestReadingKey struct {
+	deviceName   string
+	resourceName string
+}
+
+// latestReadingStore keeps the last known reading of each device resource in memory, and tracks the changed ones which
+// haven't been persisted yet
+type latestReadingStore struct {
+	mutex    sync.RWMutex
+	readings map[string]map[string]models.Reading // device name -> resource name -> reading
+	changed  map[latestReadingKey]struct{}
+	// persisting tells whether the taken changed readings are being persisted, and removed tracks the devices removed
+	// meanwhile, whose readings may be written back to the database by the persistence
+	persisting bool
+	removed    map[string]struct{}
+}
+
+func newLatestReadingStore() *latestReadingStore {
+	return &latestReadingStore{
+		readings: make(map[string]map[string]models.Reading),
+		changed:  make(map[latestReadingKey]struct{}),
+		removed:  make(map[string]struct{}),
+	}
+}
+
+// update replaces the last known readings of the device resources unless the stored ones have a later origin
+func (s *latestReadingStore) update(readings []models.Reading) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, r := range readings {
+		if s.put(r) {
+			baseReading := r.GetBaseReading()
+			s.changed[latestReadingKey{deviceName: baseReading.DeviceName, resourceName: baseReading.ResourceName}] = struct{}{}
+		}
+	}
+}
+
+// load fills the store with the persisted readings without marking them as changed
+func (s *latestReadingStore) load(readings []models.Reading) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, r := range readings {
+		s.put(r)
+	}
+}
+
+// put stores the reading unless the stored one of the same device resource has a later origin, the caller must hold the lock
+func (s *latestReadingStore) put(r models.Reading) bool {
+	baseReading := r.GetBaseReading()
+	resources, ok := s.readings[baseReading.DeviceName]
+	if !ok {
+		resources = make(map[string]models.Reading)
+		s.readings[baseReading.DeviceName] = resources
+	}
+	if stored, ok := resources[baseReading.ResourceName]; ok && stored.GetBaseReading().Origin > baseReading.Origin {
+		return false
+	}
+	resources[baseReading.ResourceName] = r
+	return true
+}
+
+// readingsByDeviceName returns the last known readings of all the resources of the device sorted by resource name
+func (s *latestReadingStore) readingsByDeviceName(deviceName string) []models.Reading {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	resources := s.readings[deviceName]
+	return slices.SortedFunc(maps.Values(resources), func(a, b models.Reading) int {
+		return strings.Compare(a.GetBaseReading().ResourceName, b.GetBaseReading().ResourceName)
+	})
+}
+
+// reading returns the last known reading of the device resource
+func (s *latestReadingStore) reading(deviceName string, resourceName string) (models.Reading, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	r, ok := s.readings[deviceName][resourceName]
+	return r, ok
+}
+
+// removeByDeviceName removes the last known readings of all the resources of the device
+func (s *latestReadingStore) removeByDeviceName(deviceName string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.readings, deviceName)
+	for key := range s.changed {
+		if key.deviceName == deviceName {
+			delete(s.changed, key)
+		}
+	}
+	if s.persisting {
+		s.removed[deviceName] = struct{}{}
+	}
+}
+
+// takeChanged returns the readings changed since the last call, and resets the changed readings. The store is marked as
+// persisting the returned readings until persisted is called.
+func (s *latestReadingStore) takeChanged() []models.Reading {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	readings := make([]models.Reading, 0, len(s.changed))
+	for key := range s.changed {
+		if r, ok := s.readings[key.deviceName][key.resourceName]; ok {
+			readings = append(readings, r)
+		}
+	}
+	clear(s.changed)
+	s.persisting = len(readings) > 0
+	return readings
+}
+
+// persisted ends persisting the readings taken by takeChanged, and returns the devices removed meanwhile. The readings
+// are marked as changed again if they failed to be persisted, except the ones of the removed devices.
+func (s *latestReadingStore) persisted(readings []models.Reading, failed bool) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if failed {
+		for _, r := range readings {
+			baseReading := r.GetBaseReading()
+			if _, removed := s.removed[baseReading.DeviceName]; !removed && s.put(r) {
+				s.changed[latestReadingKey{deviceName: baseReading.DeviceName, resourceName: baseReading.ResourceName}] = struct{}{}
+			}
+		}
+	}
+	removed := slices.Sorted(maps.Keys(s.removed))
+	clear(s.removed)
+	s.persisting = false
+	return removed
+}
+
+// LatestReadingsByDeviceName returns the last known readings of all the resources of the specified device
+func (a *CoreDataApp) LatestReadingsByDeviceName(deviceName string) ([]dtos.BaseReading, errors.EdgeX) {
+	readingModels := a.latestReadings.readingsByDeviceName(deviceName)
+	if len(readingModels) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no latest reading of device %s", deviceName), nil)
+	}
+	readings, err := convertReadingModelsToDTOs(readingModels)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return readings, nil
+}
+
+// LatestReadingByDeviceNameAndResourceName returns the last known reading of the specified device resource
+func (a *CoreDataApp) LatestReadingByDeviceNameAndResourceName(deviceName string, resourceName string) (dtos.BaseReading, errors.EdgeX) {
+	r, ok := a.latestReadings.reading(deviceName, resourceName)
+	if !ok {
+		return dtos.BaseReading{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("no latest reading of device %s and resource %s", deviceName, resourceName), nil)
+	}
+	return dtos.FromReadingModelToDTO(r), nil
+}
+
+// DeleteLatestReadingsByDeviceName removes the last known readings of the specified device, e.g. when the device is deleted
+func (a *CoreDataApp) DeleteLatestReadingsByDeviceName(deviceName string, dic *di.Container) errors.EdgeX {
+	a.latestReadings.removeByDeviceName(deviceName)
+	if !container.ConfigurationFrom(dic.Get).LatestReading.Persist {
+		return nil
+	}
+	err := container.DBClientFrom(dic.Get).DeleteLatestReadingsByDeviceName(deviceName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// AsyncPersistLatestReadings loads the persisted last known readings, and then stores the changed ones into the database
+// periodically and once more when the service is shutting down, if the persistence is enabled
+func (a *CoreDataApp) AsyncPersistLatestReadings(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get).LatestReading
+	if !config.Persist {
+		return nil
+	}
+	interval, err := time.ParseDuration(config.PersistInterval)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("latest reading persist interval %s parse failed", config.PersistInterval), err)
+	}
+	if interval <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "latest reading persist interval must be greater than 0", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	readings, edgeXerr := dbClient.AllLatestReadings()
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	a.latestReadings.load(readings)
+	lc.Infof("Loaded %d latest readings from the database", len(readings))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				if err := a.persistLatestReadings(dic); err != nil {
+					lc.Errorf("Failed to persist the latest readings, %v", err)
+				}
+				lc.Info("Exiting latest reading persistence")
+				return
+			case <-ticker.C:
+				if err := a.persistLatestReadings(dic); err != nil {
+					lc.Errorf("Failed to persist the latest readings, %v", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (a *CoreDataApp) persistLatestReadings(dic *di.Container) errors.EdgeX {
+	readings := a.latestReadings.takeChanged()
+	if len(readings) == 0 {
+		return nil
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	err := dbClient.UpsertLatestReadings(readings)
+	// the failed readings are marked as changed again so that they are persisted next time
+	removedDeviceNames := a.latestReadings.persisted(readings, err != nil)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	// the devices removed while upserting may have their readings written back after they were deleted, so delete them again
+	for _, deviceName := range removedDeviceNames {
+		if err = dbClient.DeleteLatestReadingsByDeviceName(deviceName); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
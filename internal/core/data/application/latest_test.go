@@ -0,0 +1,150 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+)
+
+func testLatestReading(deviceName string, resourceName string, origin int64, value string) models.Reading {
+	return models.SimpleReading{
+		BaseReading: models.BaseReading{DeviceName: deviceName, ResourceName: resourceName, Origin: origin, ValueType: common.ValueTypeInt32},
+		Value:       value,
+	}
+}
+
+func TestLatestReadingStore(t *testing.T) {
+	store := newLatestReadingStore()
+	store.update([]models.Reading{
+		testLatestReading(testDeviceName, "b", 2, "2"),
+		testLatestReading(testDeviceName, "a", 1, "1"),
+	})
+	store.update([]models.Reading{testLatestReading(testDeviceName, "a", 0, "0")})
+
+	r, ok := store.reading(testDeviceName, "a")
+	require.True(t, ok)
+	assert.Equal(t, "1", r.(models.SimpleReading).Value, "the reading with an earlier origin should not replace the stored one")
+
+	readings := store.readingsByDeviceName(testDeviceName)
+	require.Len(t, readings, 2)
+	assert.Equal(t, "a", readings[0].GetBaseReading().ResourceName, "readings should be sorted by resource name")
+	assert.Len(t, store.takeChanged(), 2)
+	assert.Empty(t, store.takeChanged(), "changed readings should be reset once taken")
+
+	store.load([]models.Reading{testLatestReading("device2", "a", 1, "1")})
+	assert.Empty(t, store.takeChanged(), "loaded readings should not be marked as changed")
+
+	store.update([]models.Reading{testLatestReading(testDeviceName, "a", 3, "3")})
+	store.removeByDeviceName(testDeviceName)
+	_, ok = store.reading(testDeviceName, "a")
+	assert.False(t, ok)
+	assert.Empty(t, store.takeChanged(), "changed readings of the removed device should not be persisted")
+}
+
+func TestLatestReadings(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+	})
+	app := NewCoreDataApp(dic)
+	err := app.AddEvent(models.Event{Readings: []models.Reading{testLatestReading(testDeviceName, testDeviceResourceName, 1, "1")}}, context.Background(), dic)
+	require.NoError(t, err)
+
+	readings, err := app.LatestReadingsByDeviceName(testDeviceName)
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+	reading, err := app.LatestReadingByDeviceNameAndResourceName(testDeviceName, testDeviceResourceName)
+	require.NoError(t, err)
+	assert.Equal(t, "1", reading.Value)
+
+	_, err = app.LatestReadingsByDeviceName("unknown")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	_, err = app.LatestReadingByDeviceNameAndResourceName(testDeviceName, "unknown")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestAsyncPersistLatestReadings(t *testing.T) {
+	persisted := testLatestReading(testDeviceName, "persisted", 1, "1")
+	added := testLatestReading(testDeviceName, testDeviceResourceName, 2, "2")
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllLatestReadings").Return([]models.Reading{persisted}, nil)
+	dbClientMock.On("UpsertLatestReadings", []models.Reading{added}).Return(nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{LatestReading: config.LatestReadingInfo{Persist: true, PersistInterval: "1h"}}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	app := NewCoreDataApp(dic)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	err := app.AsyncPersistLatestReadings(ctx, wg, dic)
+	require.NoError(t, err)
+	_, err = app.LatestReadingByDeviceNameAndResourceName(testDeviceName, "persisted")
+	require.NoError(t, err, "persisted reading should be loaded at startup")
+
+	app.latestReadings.update([]models.Reading{added})
+	cancel()
+	wg.Wait()
+	dbClientMock.AssertCalled(t, "UpsertLatestReadings", []models.Reading{added})
+}
+
+func TestPersistLatestReadingsOfRemovedDevice(t *testing.T) {
+	reading := testLatestReading(testDeviceName, testDeviceResourceName, 1, "1")
+	tests := []struct {
+		name                string
+		upsertErr           errors.EdgeX
+		expectedDeleteCalls int
+	}{
+		{"upserted", nil, 2},
+		{"upsert failed", errors.NewCommonEdgeX(errors.KindDatabaseError, "failed", nil), 1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeleteLatestReadingsByDeviceName", testDeviceName).Return(nil)
+			dic := newMockDICWithConfig(&config.ConfigurationStruct{LatestReading: config.LatestReadingInfo{Persist: true}}, dbClientMock)
+			app := CoreDataAppFrom(dic.Get)
+			// the device is deleted while its changed readings are being upserted
+			dbClientMock.On("UpsertLatestReadings", []models.Reading{reading}).Run(func(mock.Arguments) {
+				require.NoError(t, app.DeleteLatestReadingsByDeviceName(testDeviceName, dic))
+			}).Return(testCase.upsertErr)
+
+			app.latestReadings.update([]models.Reading{reading})
+			err := app.persistLatestReadings(dic)
+			assert.Equal(t, testCase.upsertErr != nil, err != nil)
+			dbClientMock.AssertNumberOfCalls(t, "DeleteLatestReadingsByDeviceName", testCase.expectedDeleteCalls)
+			assert.Equal(t, "DeleteLatestReadingsByDeviceName", dbClientMock.Calls[len(dbClientMock.Calls)-1].Method,
+				"the readings of the removed device should be deleted after they are upserted")
+			assert.Empty(t, app.latestReadings.takeChanged(), "the readings of the removed device should not be persisted again")
+			_, err = app.LatestReadingByDeviceNameAndResourceName(testDeviceName, testDeviceResourceName)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDtos "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
@@ -287,6 +289,105 @@ func ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName string, resourc
 	return readings, totalCount, err
 }
 
+// ReadingsByCursor query readings with the query conditions and limit, starting after the cursor, and returns the continuation token
+// of the next page which is empty when there are no more readings. The total count is skipped if skipCount is true.
+func ReadingsByCursor(conds dataModels.ReadingQueryConditions, cursor dataModels.Cursor, limit int, skipCount bool, dic *di.Container) (readings []dtos.BaseReading, nextCursor string, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	readingModels, err := dbClient.ReadingsByCursor(conds, cursor, limit)
+	if err != nil {
+		return readings, nextCursor, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	readings, err = convertReadingModelsToDTOs(readingModels)
+	if err != nil {
+		return readings, nextCursor, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	if limit > 0 && len(readingModels) == limit {
+		last := readingModels[len(readingModels)-1].GetBaseReading()
+		nextCursor = dataModels.Cursor{Origin: last.Origin, Id: last.Id}.Token()
+	}
+
+	if skipCount {
+		return readings, nextCursor, 0, nil
+	}
+	totalCount, err = dbClient.ReadingCountByQueryConditions(conds)
+	if err != nil {
+		return readings, nextCursor, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return readings, nextCursor, totalCount, nil
+}
+
+// ReadingsByQueryConditions query readings matching the query conditions with offset and limit, and the readings are
+// sorted in descending order of origin
+func ReadingsByQueryConditions(conds dataModels.ReadingQueryConditions, offset int, limit int, dic *di.Container) (readings []dtos.BaseReading, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.ReadingCountByQueryConditions(conds)
+	if err != nil {
+		return readings, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.BaseReading{}, totalCount, err
+	}
+
+	readingModels, err := dbClient.ReadingsByQueryConditions(conds, offset, limit)
+	if err != nil {
+		return readings, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	readings, err = convertReadingModelsToDTOs(readingModels)
+	if err != nil {
+		return readings, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return readings, totalCount, nil
+}
+
+// ExportReadings streams the readings matching the query conditions in ascending order of origin, and passes the readings
+// to the handler one by one without loading all of them into memory
+func ExportReadings(ctx context.Context, conds dataModels.ReadingQueryConditions, handler func(dtos.BaseReading) error, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	err := dbClient.StreamReadings(ctx, conds, func(r models.Reading) error {
+		return handler(dtos.FromReadingModelToDTO(r))
+	})
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange computes the count/min/max/avg/sum/first/last of the numeric readings with device name,
+// its associated resource name and specified time range, grouped by the fixed time buckets of interval. Aggregates are sorted in ascending order of bucket start time.
+func ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval time.Duration, dic *di.Container) (aggregates []dataDtos.ReadingAggregate, err errors.EdgeX) {
+	if deviceName == "" {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
+	}
+	if resourceName == "" {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
+	}
+	if interval <= 0 {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("interval %s must be greater than 0", interval), nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	aggregateModels, err := dbClient.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, interval.Nanoseconds())
+	if err != nil {
+		return aggregates, errors.NewCommonEdgeXWrapper(err)
+	}
+	// the aged readings might have been replaced by the rollups, which answer the coarse resolution transparently
+	rollups, err := dbClient.ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end)
+	if err != nil {
+		return aggregates, errors.NewCommonEdgeXWrapper(err)
+	}
+	aggregateModels, err = mergeReadingRollups(aggregateModels, rollups, start, interval.Nanoseconds())
+	if err != nil {
+		return aggregates, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	aggregates = make([]dataDtos.ReadingAggregate, len(aggregateModels))
+	for i, a := range aggregateModels {
+		aggregates[i] = dataDtos.FromReadingAggregateModelToDTO(a)
+	}
+	return aggregates, nil
+}
+
 // AsyncPurgeEvent purge events and related readings according to the retention capability.
 func AsyncPurgeEvent(ctx context.Context, dic *di.Container) errors.EdgeX {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -300,7 +401,7 @@ func AsyncPurgeEvent(ctx context.Context, dic *di.Container) errors.EdgeX {
 		return nil
 	}
 
-	// purge events by auto event
+	// roll up the aged readings, then purge events by auto event and the service-wide retention policy
 	asyncPurgeReadingOnce.Do(func() {
 		go func() {
 			timer := time.NewTimer(interval)
@@ -311,10 +412,14 @@ func AsyncPurgeEvent(ctx context.Context, dic *di.Container) errors.EdgeX {
 					lc.Info("Exiting event retention")
 					return
 				case <-timer.C:
-					err = purgeEventByAutoEvents(dic)
-					if err != nil {
+					if err := rollupReadings(dic); err != nil {
+						lc.Errorf("Failed to roll up readings, %v", err)
+					}
+					if err := purgeEventByAutoEvents(dic); err != nil {
 						lc.Errorf("Failed to purge events and readings, %v", err)
-						break
+					}
+					if err := purgeEventByRetentionPolicy(ctx, dic); err != nil {
+						lc.Errorf("Failed to purge events and readings by the retention policy, %v", err)
 					}
 				}
 			}
@@ -19,6 +19,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
@@ -363,3 +364,44 @@ func TestPurgeEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestReadingsByCursor(t *testing.T) {
+	readings := buildReadings()
+	totalCount := uint32(10)
+	conds := dataModels.ReadingQueryConditions{DeviceName: testDeviceName, Start: 0, End: 100}
+	lastReading := readings[len(readings)-1].GetBaseReading()
+	cursor := dataModels.Cursor{Origin: 123, Id: "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"}
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingsByCursor", conds, dataModels.Cursor{}, len(readings)).Return(readings, nil)
+	dbClientMock.On("ReadingsByCursor", conds, cursor, 20).Return(readings, nil)
+	dbClientMock.On("ReadingCountByQueryConditions", conds).Return(totalCount, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name               string
+		cursor             dataModels.Cursor
+		limit              int
+		skipCount          bool
+		expectedNextCursor string
+		expectedTotalCount uint32
+	}{
+		{"Valid - first page", dataModels.Cursor{}, len(readings), false, dataModels.Cursor{Origin: lastReading.Origin, Id: lastReading.Id}.Token(), totalCount},
+		{"Valid - last page", cursor, 20, false, "", totalCount},
+		{"Valid - skip total count", cursor, 20, true, "", 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, nextCursor, total, err := ReadingsByCursor(conds, testCase.cursor, testCase.limit, testCase.skipCount, dic)
+			require.NoError(t, err)
+			assert.Equal(t, len(readings), len(result), "Reading count is not expected")
+			assert.Equal(t, testCase.expectedNextCursor, nextCursor, "Next cursor is not expected")
+			assert.Equal(t, testCase.expectedTotalCount, total, "Total count is not expected")
+		})
+	}
+}
@@ -0,0 +1,302 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDtos "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// purgeEventByRetentionPolicy enforces the service-wide retention policy configured under Retention on all the events,
+// no matter whether the events are generated by auto events or not. The overrides of the devices and profiles are applied
+// first, then the MaxAge is applied to the events of the other devices, and finally the total event and reading count
+// ceilings are applied to all the events as the storage quota.
+func purgeEventByRetentionPolicy(ctx context.Context, dic *di.Container) errors.EdgeX {
+	retention := container.ConfigurationFrom(dic.Get).Retention
+	now := time.Now().UnixNano()
+	var purges []dataDtos.RetentionPurge
+
+	maxAge, err := parseRetentionAge(retention.MaxAge)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	overrides := retentionOverrides(retention, container.DeviceStoreFrom(dic.Get).Devices())
+	overriddenDeviceNames := slices.Sorted(maps.Keys(overrides))
+	for _, deviceName := range overriddenDeviceNames {
+		rule := overrides[deviceName]
+		ruleMaxAge := maxAge
+		if rule.MaxAge != "" {
+			ruleMaxAge, err = parseRetentionAge(rule.MaxAge)
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("invalid retention override of device %s", deviceName), err)
+			}
+		}
+		if ruleMaxAge > 0 {
+			purge, err := purgeEventsByAge(deviceName, nil, now-ruleMaxAge.Nanoseconds(), dic)
+			if err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+			purges = appendRetentionPurge(purges, purge)
+		}
+		if rule.MaxEventCount > 0 {
+			purge, err := purgeDeviceEventsByMaxEventCount(deviceName, rule.MaxEventCount, dic)
+			if err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+			purges = appendRetentionPurge(purges, purge)
+		}
+	}
+
+	if maxAge > 0 {
+		purge, err := purgeEventsByAge("", overriddenDeviceNames, now-maxAge.Nanoseconds(), dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		purges = appendRetentionPurge(purges, purge)
+	}
+	if retention.MaxEventCount > 0 {
+		purge, err := purgeEventsByMaxEventCount(retention.MaxEventCount, dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		purges = appendRetentionPurge(purges, purge)
+	}
+	if retention.MaxReadingCount > 0 {
+		purge, err := purgeEventsByMaxReadingCount(retention.MaxReadingCount, dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		purges = appendRetentionPurge(purges, purge)
+	}
+
+	reportRetentionPurges(ctx, purges, dic)
+	return nil
+}
+
+// retentionOverrides resolves the retention rule of each device overridden by the device name or the profile name,
+// the device override takes precedence over the profile override
+func retentionOverrides(retention config.EventRetention, devices map[string]models.Device) map[string]config.RetentionRule {
+	overrides := make(map[string]config.RetentionRule)
+	for _, device := range devices {
+		if rule, ok := retention.ProfileOverrides[device.ProfileName]; ok {
+			overrides[device.Name] = rule
+		}
+	}
+	for deviceName, rule := range retention.DeviceOverrides {
+		overrides[deviceName] = rule
+	}
+	return overrides
+}
+
+func parseRetentionAge(age string) (time.Duration, errors.EdgeX) {
+	if age == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(age)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("retention max age %s parse failed", age), err)
+	}
+	return duration, nil
+}
+
+// purgeEventsByAge purges the events whose origin is not after the specified timestamp. The events of the specified device
+// are purged if the device name is not empty, otherwise the events of all the devices except the excluded ones are purged.
+func purgeEventsByAge(deviceName string, excludedDeviceNames []string, before int64, dic *di.Container) (dataDtos.RetentionPurge, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	purge := dataDtos.RetentionPurge{Rule: dataDtos.RetentionRuleMaxAge, DeviceName: deviceName, Before: before}
+
+	var err errors.EdgeX
+	purge.MatchedEventCount, purge.MatchedReadingCount, err = countEventsAndReadingsBefore(deviceName, before, dic)
+	if err != nil {
+		return purge, errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, excludedDeviceName := range excludedDeviceNames {
+		eventCount, readingCount, err := countEventsAndReadingsBefore(excludedDeviceName, before, dic)
+		if err != nil {
+			return purge, errors.NewCommonEdgeXWrapper(err)
+		}
+		purge.MatchedEventCount -= min(eventCount, purge.MatchedEventCount)
+		purge.MatchedReadingCount -= min(readingCount, purge.MatchedReadingCount)
+	}
+	if purge.MatchedEventCount == 0 {
+		return purge, nil
+	}
+
+	age := time.Now().UnixNano() - before
+	if deviceName != "" {
+		err = dbClient.DeleteEventsByAgeAndDeviceName(age, deviceName)
+	} else {
+		err = dbClient.DeleteEventsByAgeAndExcludedDeviceNames(age, excludedDeviceNames)
+	}
+	if err != nil {
+		return purge, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete events and readings older than %d", before), err)
+	}
+	return purge, nil
+}
+
+// purgeDeviceEventsByMaxEventCount purges the oldest events of the device to keep the max event count
+func purgeDeviceEventsByMaxEventCount(deviceName string, maxEventCount int64, dic *di.Container) (dataDtos.RetentionPurge, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	purge := dataDtos.RetentionPurge{Rule: dataDtos.RetentionRuleMaxEventCount, DeviceName: deviceName}
+
+	// the events are sorted in descending order of origin, so the event at the max event count offset is the latest one to purge
+	events, err := dbClient.EventsByDeviceName(int(maxEventCount), 1, deviceName)
+	if err != nil {
+		return purge, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(events) == 0 {
+		return purge, nil
+	}
+	purge.Before = events[0].Origin
+	purge.MatchedEventCount, purge.MatchedReadingCount, err = countEventsAndReadingsBefore(deviceName, purge.Before, dic)
+	if err != nil {
+		return purge, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = dbClient.DeleteEventsByAgeAndDeviceName(time.Now().UnixNano()-purge.Before, deviceName)
+	if err != nil {
+		return purge, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete events and readings of device %s to keep max event count %d", deviceName, maxEventCount), err)
+	}
+	return purge, nil
+}
+
+// purgeEventsByMaxEventCount purges the oldest events to keep the total event count under the ceiling
+func purgeEventsByMaxEventCount(maxEventCount int64, dic *di.Container) (dataDtos.RetentionPurge, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	purge := dataDtos.RetentionPurge{Rule: dataDtos.RetentionRuleMaxEventCount}
+
+	count, err := dbClient.EventTotalCount()
+	if err != nil {
+		return purge, errors.NewCommonEdgeXWrapper(err)
+	}
+	if int64(count) <= maxEventCount {
+		return purge, nil
+	}
+	events, err := dbClient.AllEvents(int(maxEventCount), 1)
+	if err != nil {
+		return purge, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(events) == 0 {
+		return purge, nil
+	}
+	return purgeEventsBefore(purge, events[0].Origin, dic)
+}
+
+// purgeEventsByMaxReadingCount purges the events of the oldest readings to keep the total reading count under the ceiling
+func purgeEventsByMaxReadingCount(maxReadingCount int64, dic *di.Container) (dataDtos.RetentionPurge, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	purge := dataDtos.RetentionPurge{Rule: dataDtos.RetentionRuleMaxReadingCount}
+
+	count, err := dbClient.ReadingTotalCount()
+	if err != nil {
+		return purge, errors.NewCommonEdgeXWrapper(err)
+	}
+	if int64(count) <= maxReadingCount {
+		return purge, nil
+	}
+	reading, err := dbClient.LatestReadingByOffset(uint32(maxReadingCount))
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		return purge, nil
+	} else if err != nil {
+		return purge, errors.NewCommonEdgeXWrapper(err)
+	}
+	return purgeEventsBefore(purge, reading.GetBaseReading().Origin, dic)
+}
+
+// purgeEventsBefore purges all the events whose origin is not after the specified timestamp
+func purgeEventsBefore(purge dataDtos.RetentionPurge, before int64, dic *di.Container) (dataDtos.RetentionPurge, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	var err errors.EdgeX
+	purge.Before = before
+	purge.MatchedEventCount, purge.MatchedReadingCount, err = countEventsAndReadingsBefore("", before, dic)
+	if err != nil {
+		return purge, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = dbClient.DeleteEventsByAge(time.Now().UnixNano() - before)
+	if err != nil {
+		return purge, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete events and readings to meet the retention rule %s", purge.Rule), err)
+	}
+	return purge, nil
+}
+
+// countEventsAndReadingsBefore counts the events and readings whose origin is not after the specified timestamp, the
+// counting is limited to the specified device if the device name is not empty
+func countEventsAndReadingsBefore(deviceName string, before int64, dic *di.Container) (eventCount uint32, readingCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	eventCount, err = dbClient.EventCountByQueryConditions(dataModels.EventQueryConditions{DeviceName: deviceName, Start: 0, End: before})
+	if err != nil {
+		return 0, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	readingCount, err = dbClient.ReadingCountByQueryConditions(dataModels.ReadingQueryConditions{DeviceName: deviceName, Start: 0, End: before})
+	if err != nil {
+		return 0, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return eventCount, readingCount, nil
+}
+
+func appendRetentionPurge(purges []dataDtos.RetentionPurge, purge dataDtos.RetentionPurge) []dataDtos.RetentionPurge {
+	if purge.MatchedEventCount == 0 {
+		return purges
+	}
+	return append(purges, purge)
+}
+
+// reportRetentionPurges increases the purged metrics and publishes the retention purge system event with the events and
+// readings matched by the retention rules
+func reportRetentionPurges(ctx context.Context, purges []dataDtos.RetentionPurge, dic *di.Container) {
+	if len(purges) == 0 {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	app := CoreDataAppFrom(dic.Get)
+
+	for _, purge := range purges {
+		lc.Infof("Requested purging %d events and %d readings with origin not after %d by the retention rule %s, device name: '%s'",
+			purge.MatchedEventCount, purge.MatchedReadingCount, purge.Before, purge.Rule, purge.DeviceName)
+		app.eventsPurgedCounter.Inc(int64(purge.MatchedEventCount))
+		app.readingsPurgedCounter.Inc(int64(purge.MatchedReadingCount))
+	}
+
+	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if messagingClient == nil {
+		lc.Errorf("unable to publish '%s' System Event: no messaging client", constants.RetentionSystemEventType)
+		return
+	}
+	configuration := container.ConfigurationFrom(dic.Get)
+	systemEvent := dtos.NewSystemEvent(constants.RetentionSystemEventType, constants.SystemEventActionPurge, common.CoreDataServiceKey, common.CoreDataServiceKey, nil, purges)
+	publishTopic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(configuration.MessageBus.GetBaseTopicPrefix()).SetPath(common.SystemEventPublishTopic).
+		SetPath(systemEvent.Source).SetPath(systemEvent.Type).SetPath(systemEvent.Action).SetNameFieldPath(systemEvent.Owner).BuildPath()
+
+	// make sure the Content Type is set appropriate if payload is required to be encoded
+	ctx = context.WithValue(ctx, common.ContentType, common.ContentTypeJSON) //nolint: staticcheck
+	envelope := msgTypes.NewMessageEnvelope(systemEvent, ctx)
+	if err := messagingClient.Publish(envelope, publishTopic); err != nil {
+		lc.Errorf("unable to publish the '%s' System Event to topic '%s': %v", constants.RetentionSystemEventType, publishTopic, err)
+		return
+	}
+	lc.Debugf("Published the '%s' System Event to topic '%s'", constants.RetentionSystemEventType, publishTopic)
+}
@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDtos "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+)
+
+func TestRetentionOverrides(t *testing.T) {
+	retention := config.EventRetention{
+		DeviceOverrides:  map[string]config.RetentionRule{"device1": {MaxAge: "1h"}},
+		ProfileOverrides: map[string]config.RetentionRule{testProfileName: {MaxAge: "24h", MaxEventCount: 10}},
+	}
+	devices := map[string]models.Device{
+		"device1": {Name: "device1", ProfileName: testProfileName},
+		"device2": {Name: "device2", ProfileName: testProfileName},
+		"device3": {Name: "device3", ProfileName: "otherProfile"},
+	}
+
+	overrides := retentionOverrides(retention, devices)
+	require.Len(t, overrides, 2)
+	assert.Equal(t, config.RetentionRule{MaxAge: "1h"}, overrides["device1"], "device override should take precedence over profile override")
+	assert.Equal(t, config.RetentionRule{MaxAge: "24h", MaxEventCount: 10}, overrides["device2"])
+	assert.NotContains(t, overrides, "device3")
+}
+
+func TestPurgeEventByRetentionPolicy(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		dbClientMock := &dbMock.DBClient{}
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Retention: config.EventRetention{}}, dbClientMock)
+
+		err := purgeEventByRetentionPolicy(context.Background(), dic)
+		require.NoError(t, err)
+		dbClientMock.AssertExpectations(t)
+	})
+
+	t.Run("Invalid MaxAge", func(t *testing.T) {
+		dbClientMock := &dbMock.DBClient{}
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Retention: config.EventRetention{MaxAge: "invalid"}}, dbClientMock)
+
+		err := purgeEventByRetentionPolicy(context.Background(), dic)
+		require.Error(t, err)
+	})
+
+	t.Run("MaxAge with device override", func(t *testing.T) {
+		dbClientMock := &dbMock.DBClient{}
+		dbClientMock.On("EventCountByQueryConditions", mock.Anything).Return(uint32(2), nil)
+		dbClientMock.On("ReadingCountByQueryConditions", mock.Anything).Return(uint32(4), nil)
+		dbClientMock.On("DeleteEventsByAgeAndDeviceName", mock.Anything, testDeviceName).Return(nil)
+		dbClientMock.On("DeleteEventsByAgeAndExcludedDeviceNames", mock.Anything, []string{testDeviceName}).Return(nil)
+		retention := config.EventRetention{
+			MaxAge:          "720h",
+			DeviceOverrides: map[string]config.RetentionRule{testDeviceName: {MaxAge: "1h"}},
+		}
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Retention: retention}, dbClientMock)
+
+		err := purgeEventByRetentionPolicy(context.Background(), dic)
+		require.NoError(t, err)
+		dbClientMock.AssertCalled(t, "DeleteEventsByAgeAndDeviceName", mock.Anything, testDeviceName)
+		// all the events counted for the global MaxAge belong to the overridden device, so nothing else is purged
+		dbClientMock.AssertNotCalled(t, "DeleteEventsByAgeAndExcludedDeviceNames", mock.Anything, mock.Anything)
+	})
+
+	t.Run("MaxEventCount of profile override", func(t *testing.T) {
+		dbClientMock := &dbMock.DBClient{}
+		dbClientMock.On("EventsByDeviceName", 10, 1, testDeviceName).Return([]models.Event{persistedEvent}, nil)
+		dbClientMock.On("EventCountByQueryConditions", mock.Anything).Return(uint32(5), nil)
+		dbClientMock.On("ReadingCountByQueryConditions", mock.Anything).Return(uint32(5), nil)
+		dbClientMock.On("DeleteEventsByAgeAndDeviceName", mock.Anything, testDeviceName).Return(nil)
+		retention := config.EventRetention{
+			ProfileOverrides: map[string]config.RetentionRule{testProfileName: {MaxEventCount: 10}},
+		}
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Retention: retention}, dbClientMock)
+		container.DeviceStoreFrom(dic.Get).Add(models.Device{Name: testDeviceName, ProfileName: testProfileName})
+
+		err := purgeEventByRetentionPolicy(context.Background(), dic)
+		require.NoError(t, err)
+		dbClientMock.AssertExpectations(t)
+	})
+
+	t.Run("MaxEventCount and MaxReadingCount", func(t *testing.T) {
+		dbClientMock := &dbMock.DBClient{}
+		dbClientMock.On("EventTotalCount").Return(uint32(20), nil)
+		dbClientMock.On("AllEvents", 10, 1).Return([]models.Event{persistedEvent}, nil)
+		dbClientMock.On("ReadingTotalCount").Return(uint32(20), nil)
+		dbClientMock.On("LatestReadingByOffset", uint32(15)).Return(persistedEvent.Readings[0], nil)
+		dbClientMock.On("EventCountByQueryConditions", mock.Anything).Return(uint32(10), nil)
+		dbClientMock.On("ReadingCountByQueryConditions", mock.Anything).Return(uint32(10), nil)
+		dbClientMock.On("DeleteEventsByAge", mock.Anything).Return(nil)
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Retention: config.EventRetention{MaxEventCount: 10, MaxReadingCount: 15}}, dbClientMock)
+
+		err := purgeEventByRetentionPolicy(context.Background(), dic)
+		require.NoError(t, err)
+		dbClientMock.AssertNumberOfCalls(t, "DeleteEventsByAge", 2)
+	})
+
+	t.Run("Under the ceilings", func(t *testing.T) {
+		dbClientMock := &dbMock.DBClient{}
+		dbClientMock.On("EventTotalCount").Return(uint32(5), nil)
+		dbClientMock.On("ReadingTotalCount").Return(uint32(5), nil)
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Retention: config.EventRetention{MaxEventCount: 10, MaxReadingCount: 15}}, dbClientMock)
+
+		err := purgeEventByRetentionPolicy(context.Background(), dic)
+		require.NoError(t, err)
+		dbClientMock.AssertNotCalled(t, "DeleteEventsByAge", mock.Anything)
+	})
+}
+
+func TestAppendRetentionPurge(t *testing.T) {
+	purges := appendRetentionPurge(nil, dataDtos.RetentionPurge{Rule: dataDtos.RetentionRuleMaxAge})
+	assert.Empty(t, purges, "purge without any event should not be reported")
+	purges = appendRetentionPurge(purges, dataDtos.RetentionPurge{Rule: dataDtos.RetentionRuleMaxAge, MatchedEventCount: 1})
+	assert.Len(t, purges, 1)
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// rollupReadings replaces the raw numeric readings older than Retention.Rollup.After with the rollups of min/max/avg/count
+// per time bucket of Retention.Rollup.Resolution, and purges the rollups older than Retention.Rollup.MaxAge. The rollup
+// boundary is aligned to the resolution so that a time bucket is always rolled up at once.
+func rollupReadings(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	policy := container.ConfigurationFrom(dic.Get).Retention.Rollup
+	if policy.After == "" {
+		return nil
+	}
+
+	after, err := parseRollupDuration("After", policy.After)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	resolution, err := parseRollupDuration("Resolution", policy.Resolution)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if resolution <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("rollup resolution '%s' must be greater than 0", policy.Resolution), nil)
+	}
+
+	before := (time.Now().Add(-after).UnixNano() / resolution.Nanoseconds()) * resolution.Nanoseconds()
+	count, err := dbClient.RollupReadings(before, resolution.Nanoseconds())
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if count > 0 {
+		lc.Infof("Rolled up %d readings with origin before %d into the rollups of resolution %s", count, before, resolution)
+	}
+
+	maxAge, err := parseRollupDuration("MaxAge", policy.MaxAge)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if maxAge > 0 {
+		if err = dbClient.DeleteReadingRollupsByAge(maxAge.Nanoseconds()); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// parseRollupDuration parses the duration of the rollup policy field, and an empty duration is 0
+func parseRollupDuration(field string, duration string) (time.Duration, errors.EdgeX) {
+	if duration == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("rollup %s '%s' parse failed", field, duration), err)
+	}
+	return d, nil
+}
+
+// mergeReadingRollups merges the reading rollups into the aggregates of the raw readings, which are grouped by the fixed
+// time buckets of the interval starting from start. A rollup can't be split into the finer time buckets, so the interval
+// and start must be aligned to the resolution of every rollup, otherwise the aggregates would silently miss the rolled up
+// readings and an error is returned. The merged aggregates are sorted in ascending order of bucket start time.
+func mergeReadingRollups(aggregates []dataModels.ReadingAggregate, rollups []dataModels.ReadingRollup, start int64, interval int64) ([]dataModels.ReadingAggregate, errors.EdgeX) {
+	if len(rollups) == 0 {
+		return aggregates, nil
+	}
+
+	merged := make(map[int64]dataModels.ReadingAggregate)
+	// the rollups are sorted in ascending order of bucket start, and the rolled up readings are always earlier than the raw readings
+	for _, rollup := range rollups {
+		if rollup.Resolution <= 0 || interval%rollup.Resolution != 0 || start%rollup.Resolution != 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf(
+				"interval %s and start %d must be multiples of the rollup resolution %s, as the readings in the time range have been rolled up",
+				time.Duration(interval), start, time.Duration(rollup.Resolution)), nil)
+		}
+		bucketStart := start + ((rollup.BucketStart-start)/interval)*interval
+		aggregate, ok := merged[bucketStart]
+		if !ok {
+			aggregate = dataModels.ReadingAggregate{BucketStart: bucketStart}
+		}
+		merged[bucketStart] = aggregate.Merge(rollup.ReadingAggregate)
+	}
+
+	for _, a := range aggregates {
+		aggregate, ok := merged[a.BucketStart]
+		if !ok {
+			aggregate = dataModels.ReadingAggregate{BucketStart: a.BucketStart}
+		}
+		merged[a.BucketStart] = aggregate.Merge(a)
+	}
+
+	result := make([]dataModels.ReadingAggregate, 0, len(merged))
+	for _, bucketStart := range slices.Sorted(maps.Keys(merged)) {
+		result = append(result, merged[bucketStart])
+	}
+	return result, nil
+}
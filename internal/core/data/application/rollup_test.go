@@ -0,0 +1,125 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+func TestRollupReadings(t *testing.T) {
+	tests := []struct {
+		name            string
+		policy          config.RollupPolicy
+		errorExpected   bool
+		rollupExpected  bool
+		purgingExpected bool
+	}{
+		{"Valid - disabled", config.RollupPolicy{}, false, false, false},
+		{"Valid - rollup", config.RollupPolicy{After: "168h", Resolution: "1m"}, false, true, false},
+		{"Valid - rollup and purge rollups", config.RollupPolicy{After: "168h", Resolution: "1h", MaxAge: "8760h"}, false, true, true},
+		{"Invalid - invalid after", config.RollupPolicy{After: "aaa", Resolution: "1m"}, true, false, false},
+		{"Invalid - empty resolution", config.RollupPolicy{After: "168h"}, true, false, false},
+		{"Invalid - invalid max age", config.RollupPolicy{After: "168h", Resolution: "1m", MaxAge: "aaa"}, true, true, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("RollupReadings", mock.Anything, mock.Anything).Return(uint32(10), nil)
+			dbClientMock.On("DeleteReadingRollupsByAge", mock.Anything).Return(nil)
+			dic := mocks.NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return &config.ConfigurationStruct{Retention: config.EventRetention{Rollup: testCase.policy}}
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+
+			err := rollupReadings(dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if testCase.rollupExpected {
+				resolution, _ := time.ParseDuration(testCase.policy.Resolution)
+				dbClientMock.AssertCalled(t, "RollupReadings", mock.MatchedBy(func(before int64) bool {
+					return before%resolution.Nanoseconds() == 0
+				}), resolution.Nanoseconds())
+			} else {
+				dbClientMock.AssertNotCalled(t, "RollupReadings", mock.Anything, mock.Anything)
+			}
+			if testCase.purgingExpected {
+				dbClientMock.AssertCalled(t, "DeleteReadingRollupsByAge", (8760 * time.Hour).Nanoseconds())
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeleteReadingRollupsByAge", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestMergeReadingRollups(t *testing.T) {
+	minute := time.Minute.Nanoseconds()
+	hour := time.Hour.Nanoseconds()
+	aggregates := []dataModels.ReadingAggregate{
+		{BucketStart: hour, Count: 1, Min: 4, Max: 4, Avg: 4, Sum: 4, First: 4, Last: 4},
+		{BucketStart: 2 * hour, Count: 1, Min: 6, Max: 6, Avg: 6, Sum: 6, First: 6, Last: 6},
+	}
+	rollups := []dataModels.ReadingRollup{
+		{Resolution: minute, ReadingAggregate: dataModels.ReadingAggregate{BucketStart: 0, Count: 2, Min: 1, Max: 3, Avg: 2, Sum: 4, First: 1, Last: 3}},
+		{Resolution: minute, ReadingAggregate: dataModels.ReadingAggregate{BucketStart: 30 * minute, Count: 1, Min: 2, Max: 2, Avg: 2, Sum: 2, First: 2, Last: 2}},
+		{Resolution: minute, ReadingAggregate: dataModels.ReadingAggregate{BucketStart: hour, Count: 1, Min: 2, Max: 2, Avg: 2, Sum: 2, First: 2, Last: 2}},
+	}
+
+	t.Run("coarse interval", func(t *testing.T) {
+		merged, err := mergeReadingRollups(aggregates, rollups, 0, hour)
+		require.NoError(t, err)
+		expected := []dataModels.ReadingAggregate{
+			{BucketStart: 0, Count: 3, Min: 1, Max: 3, Avg: 2, Sum: 6, First: 1, Last: 2},
+			{BucketStart: hour, Count: 2, Min: 2, Max: 4, Avg: 3, Sum: 6, First: 2, Last: 4},
+			{BucketStart: 2 * hour, Count: 1, Min: 6, Max: 6, Avg: 6, Sum: 6, First: 6, Last: 6},
+		}
+		assert.Equal(t, expected, merged)
+	})
+
+	t.Run("no rollups", func(t *testing.T) {
+		merged, err := mergeReadingRollups(aggregates, nil, 0, 30*time.Second.Nanoseconds())
+		require.NoError(t, err)
+		assert.Equal(t, aggregates, merged)
+	})
+
+	invalidTests := []struct {
+		name     string
+		start    int64
+		interval int64
+	}{
+		{"interval finer than resolution", 0, 30 * time.Second.Nanoseconds()},
+		{"interval not multiple of resolution", 0, 90 * time.Second.Nanoseconds()},
+		{"start not aligned to resolution", 30 * time.Second.Nanoseconds(), hour},
+	}
+	for _, testCase := range invalidTests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := mergeReadingRollups(aggregates, rollups, testCase.start, testCase.interval)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
@@ -0,0 +1,261 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// deviceResourceCache caches the device resources of the device profiles queried from core-metadata, keyed by profile
+// name and then resource name, and is kept fresh by the device profile system events
+type deviceResourceCache struct {
+	mutex     sync.RWMutex
+	resources map[string]map[string]models.DeviceResource
+}
+
+func newDeviceResourceCache() *deviceResourceCache {
+	return &deviceResourceCache{resources: make(map[string]map[string]models.DeviceResource)}
+}
+
+func (c *deviceResourceCache) get(profileName string) (map[string]models.DeviceResource, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	resources, ok := c.resources[profileName]
+	return resources, ok
+}
+
+func (c *deviceResourceCache) set(profile models.DeviceProfile) map[string]models.DeviceResource {
+	resources := make(map[string]models.DeviceResource, len(profile.DeviceResources))
+	for _, r := range profile.DeviceResources {
+		resources[r.Name] = r
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.resources[profile.Name] = resources
+	return resources
+}
+
+func (c *deviceResourceCache) remove(profileName string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.resources, profileName)
+}
+
+// ValidateReadingConfig checks whether the reading validation policies in the configuration are supported
+func ValidateReadingConfig(validation config.ReadingValidation) errors.EdgeX {
+	if !validation.Enabled {
+		return nil
+	}
+	switch validation.Policy {
+	case constants.ValidationPolicyReject, constants.ValidationPolicyTag, constants.ValidationPolicyQuarantine:
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported reading validation policy %s", validation.Policy), nil)
+	}
+	for deviceName, policy := range validation.DeviceOverrides {
+		switch policy {
+		case constants.ValidationPolicyReject, constants.ValidationPolicyTag, constants.ValidationPolicyQuarantine, constants.ValidationPolicyNone:
+		default:
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported reading validation policy %s of device %s", policy, deviceName), nil)
+		}
+	}
+	return nil
+}
+
+// ValidateReadings validates the readings of the event against the device resources of the device profile when the
+// reading validation is enabled, and applies the policy of the device to the non-conforming readings:
+//   - reject: the whole event is rejected with the validation errors
+//   - tag: the readings are kept with the validation error in the reading tag
+//   - quarantine: the readings are removed from the event and published to the quarantine topic
+//
+// The event to be persisted is returned.
+func (a *CoreDataApp) ValidateReadings(e models.Event, ctx context.Context, dic *di.Container) (models.Event, errors.EdgeX) {
+	validation := container.ConfigurationFrom(dic.Get).Validation
+	if !validation.Enabled {
+		return e, nil
+	}
+	policy := validation.Policy
+	if override, ok := validation.DeviceOverrides[e.DeviceName]; ok {
+		policy = override
+	}
+	if policy == constants.ValidationPolicyNone {
+		return e, nil
+	}
+
+	resources, err := a.deviceResourcesByProfileName(ctx, e.ProfileName, dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			// don't block the ingestion when core-metadata is unavailable
+			a.lc.Warnf("Skip validating the readings of event %s, %v", e.Id, err)
+			return e, nil
+		}
+		resources = nil
+	}
+
+	var conforming, nonConforming []models.Reading
+	var validationErrs []string
+	for _, r := range e.Readings {
+		validationErr := validateReading(r, e.ProfileName, resources)
+		if validationErr == "" {
+			conforming = append(conforming, r)
+			continue
+		}
+		validationErrs = append(validationErrs, validationErr)
+		if policy == constants.ValidationPolicyTag {
+			r = readingWithTag(r, constants.ValidationErrorTag, validationErr)
+			conforming = append(conforming, r)
+		} else {
+			nonConforming = append(nonConforming, r)
+		}
+	}
+	if len(validationErrs) == 0 {
+		return e, nil
+	}
+
+	switch policy {
+	case constants.ValidationPolicyTag:
+		e.Readings = conforming
+	case constants.ValidationPolicyQuarantine:
+		quarantined := e
+		quarantined.Readings = nonConforming
+		a.quarantineEvent(quarantined, validationErrs, ctx, dic)
+		if len(conforming) == 0 {
+			return e, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("all the readings of event %s are quarantined: %s", e.Id, strings.Join(validationErrs, "; ")), errEventQuarantined)
+		}
+		e.Readings = conforming
+	default:
+		return e, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("event %s doesn't conform to device profile %s: %s", e.Id, e.ProfileName, strings.Join(validationErrs, "; ")), nil)
+	}
+	return e, nil
+}
+
+// UpdateDeviceProfileCache refreshes the cached device resources of the updated device profile, the device resources
+// of the device profile not cached yet are queried on demand
+func (a *CoreDataApp) UpdateDeviceProfileCache(profile models.DeviceProfile) {
+	if _, ok := a.deviceResources.get(profile.Name); ok {
+		a.deviceResources.set(profile)
+	}
+}
+
+// RemoveDeviceProfileCache removes the cached device resources of the deleted device profile
+func (a *CoreDataApp) RemoveDeviceProfileCache(profileName string) {
+	a.deviceResources.remove(profileName)
+}
+
+func (a *CoreDataApp) deviceResourcesByProfileName(ctx context.Context, profileName string, dic *di.Container) (map[string]models.DeviceResource, errors.EdgeX) {
+	if resources, ok := a.deviceResources.get(profileName); ok {
+		return resources, nil
+	}
+	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
+	if dpc == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceProfileClient returned", nil)
+	}
+	res, err := dpc.DeviceProfileByName(ctx, profileName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return a.deviceResources.set(dtos.ToDeviceProfileModel(res.Profile)), nil
+}
+
+// validateReading returns the reason why the reading doesn't conform to the device resource, or an empty string if it does
+func validateReading(r models.Reading, profileName string, resources map[string]models.DeviceResource) string {
+	baseReading := r.GetBaseReading()
+	resource, ok := resources[baseReading.ResourceName]
+	if !ok {
+		return fmt.Sprintf("resource %s is not defined in device profile %s", baseReading.ResourceName, profileName)
+	}
+	if !strings.EqualFold(baseReading.ValueType, resource.Properties.ValueType) {
+		return fmt.Sprintf("resource %s's value type %s mismatches %s", baseReading.ResourceName, baseReading.ValueType, resource.Properties.ValueType)
+	}
+
+	simpleReading, ok := r.(models.SimpleReading)
+	if !ok || !dataModels.IsNumericValueType(simpleReading.ValueType) || (resource.Properties.Minimum == nil && resource.Properties.Maximum == nil) {
+		return ""
+	}
+	value, err := strconv.ParseFloat(simpleReading.Value, 64)
+	if err != nil {
+		return fmt.Sprintf("resource %s's value %s is not a number", baseReading.ResourceName, simpleReading.Value)
+	}
+	if resource.Properties.Minimum != nil && value < *resource.Properties.Minimum {
+		return fmt.Sprintf("resource %s's value %s is less than the minimum %v", baseReading.ResourceName, simpleReading.Value, *resource.Properties.Minimum)
+	}
+	if resource.Properties.Maximum != nil && value > *resource.Properties.Maximum {
+		return fmt.Sprintf("resource %s's value %s is greater than the maximum %v", baseReading.ResourceName, simpleReading.Value, *resource.Properties.Maximum)
+	}
+	return ""
+}
+
+// quarantineEvent publishes the event of the non-conforming readings, tagged with the validation errors, to the
+// quarantine topic so that they can be inspected without being persisted
+func (a *CoreDataApp) quarantineEvent(e models.Event, validationErrs []string, ctx context.Context, dic *di.Container) {
+	msgClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	e.Tags = cloneTags(e.Tags)
+	e.Tags[constants.ValidationErrorTag] = strings.Join(validationErrs, "; ")
+	topic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(configuration.MessageBus.GetBaseTopicPrefix()).SetPath(constants.QuarantineTopic).
+		SetNameFieldPath(e.ProfileName).SetNameFieldPath(e.DeviceName).SetNameFieldPath(e.SourceName).BuildPath()
+
+	msgEnvelope := msgTypes.NewMessageEnvelope(requestDTO.NewAddEventRequest(dtos.FromEventModelToDTO(e)), ctx)
+	err := msgClient.Publish(msgEnvelope, topic)
+	if err != nil {
+		a.lc.Errorf("Failed to publish the quarantined readings of event %s to topic %s, Correlation-id: %s, %v", e.Id, topic, correlationId, err)
+		return
+	}
+	a.lc.Debugf("%d readings of event %s are quarantined to topic %s, Correlation-id: %s", len(e.Readings), e.Id, topic, correlationId)
+}
+
+// readingWithTag returns the copy of the reading with the tag added, the tags of the original reading are not modified
+func readingWithTag(r models.Reading, key string, value any) models.Reading {
+	switch reading := r.(type) {
+	case models.SimpleReading:
+		reading.Tags = cloneTags(reading.Tags)
+		reading.Tags[key] = value
+		return reading
+	case models.BinaryReading:
+		reading.Tags = cloneTags(reading.Tags)
+		reading.Tags[key] = value
+		return reading
+	case models.ObjectReading:
+		reading.Tags = cloneTags(reading.Tags)
+		reading.Tags[key] = value
+		return reading
+	case models.NullReading:
+		reading.Tags = cloneTags(reading.Tags)
+		reading.Tags[key] = value
+		return reading
+	}
+	return r
+}
+
+func cloneTags(tags map[string]any) map[string]any {
+	cloned := make(map[string]any, len(tags)+1)
+	maps.Copy(cloned, tags)
+	return cloned
+}
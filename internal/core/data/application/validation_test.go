@@ -0,0 +1,218 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+)
+
+func newDeviceProfileClientMock(profileErr errors.EdgeX) *clientMocks.DeviceProfileClient {
+	minimum, maximum := 0.0, 100.0
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: testDeviceResourceName, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32, Minimum: &minimum, Maximum: &maximum}},
+		},
+	}
+	dpcMock := &clientMocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, testProfileName).Return(responses.DeviceProfileResponse{Profile: profile}, profileErr)
+	return dpcMock
+}
+
+func testValidationEvent(values ...string) models.Event {
+	e := models.Event{Id: testUUIDString, DeviceName: testDeviceName, ProfileName: testProfileName, SourceName: testSourceName}
+	for _, value := range values {
+		e.Readings = append(e.Readings, models.SimpleReading{
+			BaseReading: models.BaseReading{DeviceName: testDeviceName, ProfileName: testProfileName, ResourceName: testDeviceResourceName, ValueType: common.ValueTypeInt32},
+			Value:       value,
+		})
+	}
+	return e
+}
+
+func TestValidateReading(t *testing.T) {
+	minimum, maximum := 0.0, 100.0
+	resources := map[string]models.DeviceResource{
+		testDeviceResourceName: {Name: testDeviceResourceName, Properties: models.ResourceProperties{ValueType: common.ValueTypeInt32, Minimum: &minimum, Maximum: &maximum}},
+	}
+	reading := func(resourceName string, valueType string, value string) models.Reading {
+		return models.SimpleReading{BaseReading: models.BaseReading{ResourceName: resourceName, ValueType: valueType}, Value: value}
+	}
+
+	tests := []struct {
+		name     string
+		reading  models.Reading
+		expected bool
+	}{
+		{"conforming", reading(testDeviceResourceName, common.ValueTypeInt32, "50"), true},
+		{"on the maximum", reading(testDeviceResourceName, common.ValueTypeInt32, "100"), true},
+		{"unknown resource", reading("unknown", common.ValueTypeInt32, "50"), false},
+		{"wrong value type", reading(testDeviceResourceName, common.ValueTypeString, "50"), false},
+		{"less than the minimum", reading(testDeviceResourceName, common.ValueTypeInt32, "-1"), false},
+		{"greater than the maximum", reading(testDeviceResourceName, common.ValueTypeInt32, "101"), false},
+		{"not a number", reading(testDeviceResourceName, common.ValueTypeInt32, "abc"), false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, validateReading(testCase.reading, testProfileName, resources) == "")
+		})
+	}
+}
+
+func TestValidateReadings(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		dpcMock := newDeviceProfileClientMock(nil)
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Validation: config.ReadingValidation{}}, nil)
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+				return dpcMock
+			},
+		})
+		e, err := NewCoreDataApp(dic).ValidateReadings(testValidationEvent("200"), context.Background(), dic)
+		require.NoError(t, err)
+		assert.Len(t, e.Readings, 1)
+		dpcMock.AssertNotCalled(t, "DeviceProfileByName", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		dpcMock := newDeviceProfileClientMock(nil)
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Validation: config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyReject}}, nil)
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+				return dpcMock
+			},
+		})
+		app := NewCoreDataApp(dic)
+		_, err := app.ValidateReadings(testValidationEvent("50", "200"), context.Background(), dic)
+		require.Error(t, err)
+		assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		_, err = app.ValidateReadings(testValidationEvent("50"), context.Background(), dic)
+		require.NoError(t, err)
+		dpcMock.AssertNumberOfCalls(t, "DeviceProfileByName", 1)
+	})
+
+	t.Run("Tag", func(t *testing.T) {
+		dpcMock := newDeviceProfileClientMock(nil)
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Validation: config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyTag}}, nil)
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+				return dpcMock
+			},
+		})
+		e, err := NewCoreDataApp(dic).ValidateReadings(testValidationEvent("50", "200"), context.Background(), dic)
+		require.NoError(t, err)
+		require.Len(t, e.Readings, 2)
+		assert.NotContains(t, e.Readings[0].GetBaseReading().Tags, constants.ValidationErrorTag)
+		assert.Contains(t, e.Readings[1].GetBaseReading().Tags, constants.ValidationErrorTag)
+	})
+
+	t.Run("Quarantine", func(t *testing.T) {
+		dpcMock := newDeviceProfileClientMock(nil)
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Validation: config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyQuarantine}}, nil)
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+				return dpcMock
+			},
+		})
+		msgClientMock := bootstrapContainer.MessagingClientFrom(dic.Get).(*messagingMocks.MessageClient)
+		app := NewCoreDataApp(dic)
+		e, err := app.ValidateReadings(testValidationEvent("50", "200"), context.Background(), dic)
+		require.NoError(t, err)
+		require.Len(t, e.Readings, 1)
+		msgClientMock.AssertNumberOfCalls(t, "Publish", 1)
+
+		_, err = app.ValidateReadings(testValidationEvent("200"), context.Background(), dic)
+		require.Error(t, err, "the event should be dropped when all the readings are quarantined")
+		msgClientMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("Device override", func(t *testing.T) {
+		validation := config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyReject, DeviceOverrides: map[string]string{testDeviceName: constants.ValidationPolicyNone}}
+		dpcMock := newDeviceProfileClientMock(nil)
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Validation: validation}, nil)
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+				return dpcMock
+			},
+		})
+		_, err := NewCoreDataApp(dic).ValidateReadings(testValidationEvent("200"), context.Background(), dic)
+		require.NoError(t, err)
+		dpcMock.AssertNotCalled(t, "DeviceProfileByName", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Profile not found", func(t *testing.T) {
+		dpcMock := newDeviceProfileClientMock(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Validation: config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyReject}}, nil)
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+				return dpcMock
+			},
+		})
+		_, err := NewCoreDataApp(dic).ValidateReadings(testValidationEvent("50"), context.Background(), dic)
+		require.Error(t, err)
+	})
+
+	t.Run("Core metadata unavailable", func(t *testing.T) {
+		dpcMock := newDeviceProfileClientMock(errors.NewCommonEdgeX(errors.KindServiceUnavailable, "unavailable", nil))
+		dic := newMockDICWithConfig(&config.ConfigurationStruct{Validation: config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyReject}}, nil)
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+				return dpcMock
+			},
+		})
+		_, err := NewCoreDataApp(dic).ValidateReadings(testValidationEvent("200"), context.Background(), dic)
+		require.NoError(t, err, "the readings should not be validated when core-metadata is unavailable")
+	})
+}
+
+func TestDeviceProfileCache(t *testing.T) {
+	dpcMock := newDeviceProfileClientMock(nil)
+	dic := newMockDICWithConfig(&config.ConfigurationStruct{Validation: config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyReject}}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+	})
+	app := NewCoreDataApp(dic)
+	_, err := app.ValidateReadings(testValidationEvent("50"), context.Background(), dic)
+	require.NoError(t, err)
+
+	// the updated profile doesn't define the resource anymore
+	app.UpdateDeviceProfileCache(models.DeviceProfile{Name: testProfileName})
+	_, err = app.ValidateReadings(testValidationEvent("50"), context.Background(), dic)
+	require.Error(t, err)
+
+	app.RemoveDeviceProfileCache(testProfileName)
+	_, err = app.ValidateReadings(testValidationEvent("50"), context.Background(), dic)
+	require.NoError(t, err)
+	dpcMock.AssertNumberOfCalls(t, "DeviceProfileByName", 2)
+}
+
+func TestValidateReadingConfig(t *testing.T) {
+	assert.NoError(t, ValidateReadingConfig(config.ReadingValidation{Policy: "invalid"}), "the policy should not be checked when disabled")
+	assert.NoError(t, ValidateReadingConfig(config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyTag,
+		DeviceOverrides: map[string]string{testDeviceName: constants.ValidationPolicyNone}}))
+	assert.Error(t, ValidateReadingConfig(config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyNone}))
+	assert.Error(t, ValidateReadingConfig(config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyReject,
+		DeviceOverrides: map[string]string{testDeviceName: "invalid"}}))
+}
@@ -28,7 +28,12 @@ type ConfigurationStruct struct {
 	Registry     bootstrapConfig.RegistryInfo
 	Service      bootstrapConfig.ServiceInfo
 	MaxEventSize int64
-	Retention    EventRetention
+	// MaxBatchSize is the maximum number of the events in a batch ingestion request, and 0 means unlimited
+	MaxBatchSize  int
+	Retention     EventRetention
+	LatestReading LatestReadingInfo
+	Validation    ReadingValidation
+	DeadLetter    DeadLetterInfo
 }
 
 type WritableInfo struct {
@@ -44,6 +49,62 @@ type EventRetention struct {
 	DefaultMaxCap   int64
 	DefaultMinCap   int64
 	DefaultDuration string
+	// MaxEventCount is the ceiling of the total event count, the oldest events are purged once it is exceeded
+	MaxEventCount int64
+	// MaxReadingCount is the ceiling of the total reading count, the events of the oldest readings are purged once it is exceeded
+	MaxReadingCount int64
+	// MaxAge is the maximum age of the events, the older events are purged unless overridden for their device or profile
+	MaxAge string
+	// DeviceOverrides overrides the MaxAge and limits the event count per device, keyed by device name
+	DeviceOverrides map[string]RetentionRule
+	// ProfileOverrides overrides the MaxAge and limits the event count per device of the profile, keyed by profile name
+	ProfileOverrides map[string]RetentionRule
+	// Rollup compacts the aged numeric readings into the rollups rather than keeping the raw readings
+	Rollup RollupPolicy
+}
+
+// RetentionRule defines the retention policy overriding the service-wide one for a device or the devices of a profile
+type RetentionRule struct {
+	// MaxAge is the maximum age of the events, the service-wide MaxAge is applied when it is empty
+	MaxAge string
+	// MaxEventCount is the maximum event count of each device, no limit when it is less than or equal to zero
+	MaxEventCount int64
+}
+
+// RollupPolicy defines when the raw numeric readings are replaced by the rollups of min/max/avg/count per time bucket
+type RollupPolicy struct {
+	// After is the age of the raw numeric readings to be rolled up, the rollup is disabled when it is empty
+	After string
+	// Resolution is the length of the rollup time bucket, e.g. "1m" or "1h"
+	Resolution string
+	// MaxAge is the maximum age of the rollups, the rollups are kept forever when it is empty
+	MaxAge string
+}
+
+// LatestReadingInfo defines whether the last known reading of each device resource survives restart
+type LatestReadingInfo struct {
+	// Persist stores the latest readings into the database and loads them at startup
+	Persist bool
+	// PersistInterval is the interval to store the changed latest readings into the database, e.g. "10s"
+	PersistInterval string
+}
+
+// ReadingValidation defines how the readings of the incoming events are validated against the device resources of the
+// device profile before they are persisted
+type ReadingValidation struct {
+	// Enabled enables the validation of the readings
+	Enabled bool
+	// Policy is the action applied to the non-conforming readings, which is one of "reject", "tag" and "quarantine"
+	Policy string
+	// DeviceOverrides overrides the Policy per device, keyed by device name, and "none" skips the validation of the device
+	DeviceOverrides map[string]string
+}
+
+// DeadLetterInfo defines how the events rejected by the message bus subscriber are kept for inspection and replay
+type DeadLetterInfo struct {
+	// MaxCount is the maximum number of the dead letters, the oldest ones are discarded once it is exceeded, and no
+	// dead letter is kept when it is less than or equal to zero
+	MaxCount int
 }
 
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// new constants relates to EdgeX Core Data service and will be added to go-mod-core-contracts in the future
+
+// Constants related to defined routes in the v3 service APIs
+const (
+	ApiReadingAggregateRoute                                        = common.ApiReadingRoute + "/" + Aggregate
+	ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute = ApiReadingAggregateRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name + "/" + common.ResourceName + "/:" + common.ResourceName + "/" + common.Start + "/:" + common.Start + "/" + common.End + "/:" + common.End
+	ApiEventBatchRoute                                              = common.ApiEventRoute + "/" + Batch + "/:" + common.ServiceName
+	ApiEventExportRoute                                             = common.ApiEventRoute + "/" + Export
+	ApiReadingExportRoute                                           = common.ApiReadingRoute + "/" + Export
+	ApiLatestReadingRoute                                           = common.ApiReadingRoute + "/" + Latest
+	ApiLatestReadingsByDeviceNameRoute                              = ApiLatestReadingRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name
+	ApiLatestReadingByDeviceNameAndResourceNameRoute                = ApiLatestReadingsByDeviceNameRoute + "/" + common.ResourceName + "/:" + common.ResourceName
+	ApiDeadLetterRoute                                              = common.ApiBase + "/" + DeadLetter
+	ApiAllDeadLetterRoute                                           = ApiDeadLetterRoute + "/" + common.All
+	ApiDeadLetterIdRoute                                            = ApiDeadLetterRoute + "/" + common.Id + "/:" + common.Id
+	ApiDeadLetterReplayByIdRoute                                    = ApiDeadLetterIdRoute + "/" + Replay
+)
+
+// Constants related to defined url path names and parameters in the v3 service APIs
+const (
+	Aggregate  = "aggregate"
+	Batch      = "batch"
+	Between    = "between"
+	Cursor     = "cursor"
+	DeadLetter = "deadletter"
+	Export     = "export"
+	Format     = "format"
+	Gt         = "gt"
+	Interval   = "interval"
+	Latest     = "latest"
+	Lt         = "lt"
+	Replay     = "replay"
+	SkipCount  = "skipCount"
+	Tags       = "tags"
+)
+
+// Constants related to the separators of the query parameter values
+const (
+	ValueSeparator = ","
+	TagKVSeparator = ":"
+)
+
+// Constants related to the formats of the exported events and readings
+const (
+	ExportFormatNDJSON = "ndjson"
+	ExportFormatCSV    = "csv"
+	ExportFormatCBOR   = "cbor"
+
+	ContentTypeNDJSON  = "application/x-ndjson"
+	ContentTypeCSV     = "text/csv"
+	ContentTypeCBORSeq = "application/cbor-seq"
+)
+
+// Constants related to the policies applied to the readings not conforming to the device profile
+const (
+	ValidationPolicyReject     = "reject"
+	ValidationPolicyTag        = "tag"
+	ValidationPolicyQuarantine = "quarantine"
+	ValidationPolicyNone       = "none"
+
+	// ValidationErrorTag is the reading tag key of the validation error when the readings are tagged
+	ValidationErrorTag = "validationError"
+	// QuarantineTopic is the message bus topic to publish the quarantined readings, which is followed by
+	// <profile name>/<device name>/<source name>
+	QuarantineTopic = "quarantine"
+)
+
+// Constants related to the system events published by core data
+const (
+	RetentionSystemEventType = "retention"
+	SystemEventActionPurge   = "purge"
+)
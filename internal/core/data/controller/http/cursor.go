@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// parseCursorQueryString parses the cursor and skipCount query parameters of the cursor-based pagination. The cursor-based
+// pagination is used when the cursor query parameter is present, and an empty cursor starts from the latest record.
+// The cursor-based pagination is not allowed to be combined with a non-zero offset.
+func parseCursorQueryString(c echo.Context, offset int) (cursor dataModels.Cursor, skipCount bool, isCursorPagination bool, err errors.EdgeX) {
+	if !c.QueryParams().Has(constants.Cursor) {
+		return cursor, skipCount, false, nil
+	}
+	if offset != 0 {
+		return cursor, skipCount, true, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("querystring %s is not allowed to be used with %s", common.Offset, constants.Cursor), nil)
+	}
+
+	cursor, err = dataModels.ParseCursorToken(c.QueryParam(constants.Cursor))
+	if err != nil {
+		return cursor, skipCount, true, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	if value := c.QueryParam(constants.SkipCount); value != "" {
+		var parsingErr error
+		skipCount, parsingErr = strconv.ParseBool(value)
+		if parsingErr != nil {
+			return cursor, skipCount, true, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("failed to parse querystring %s's value %s into boolean", constants.SkipCount, value), parsingErr)
+		}
+	}
+	return cursor, skipCount, true, nil
+}
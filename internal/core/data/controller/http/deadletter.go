@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type DeadLetterController struct {
+	dic *di.Container
+	app *application.CoreDataApp
+}
+
+// NewDeadLetterController creates and initializes a DeadLetterController
+func NewDeadLetterController(dic *di.Container) *DeadLetterController {
+	return &DeadLetterController{
+		dic: dic,
+		app: application.CoreDataAppFrom(dic.Get),
+	}
+}
+
+// AllDeadLetters returns the messages rejected by the message bus subscriber, the latest ones come first
+func (dc *DeadLetterController) AllDeadLetters(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := dataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	deadLetters, totalCount, err := dc.app.AllDeadLetters(offset, limit, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	response := responseDTO.NewMultiDeadLettersResponse("", "", http.StatusOK, totalCount, deadLetters)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeadLetterById returns the dead letter with the raw message payload
+func (dc *DeadLetterController) DeadLetterById(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	deadLetter, err := dc.app.DeadLetterById(c.Param(common.Id), dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	response := responseDTO.NewDeadLetterResponse("", "", http.StatusOK, deadLetter)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteDeadLetterById discards the dead letter
+func (dc *DeadLetterController) DeleteDeadLetterById(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	err := dc.app.DeleteDeadLetterById(c.Param(common.Id), dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ReplayDeadLetter adds the event of the dead letter again, and discards the dead letter once the event is added
+func (dc *DeadLetterController) ReplayDeadLetter(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	err := dc.app.ReplayDeadLetter(c.Param(common.Id), ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
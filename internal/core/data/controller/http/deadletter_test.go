@@ -0,0 +1,253 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+const testDeadLetterId = "0d7b3a7e-3d3f-4a0c-9a43-7e6b7c3b3c2a"
+
+var testDeadLetter = dataModels.DeadLetter{
+	Id:          testDeadLetterId,
+	Created:     TestCreatedTime,
+	Topic:       "edgex/events/device/" + TestServiceName + "/" + TestProfileName + "/" + TestDeviceName + "/" + TestSourceName,
+	ContentType: common.ContentTypeJSON,
+	Payload:     []byte("invalid"),
+	Error:       "fail to unmarshal event",
+}
+
+func TestAllDeadLetters(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeadLetterTotalCount").Return(uint32(1), nil)
+	dbClientMock.On("AllDeadLetters", 0, 20).Return([]dataModels.DeadLetter{testDeadLetter}, nil)
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service:    bootstrapConfig.ServiceInfo{MaxResultCount: 20},
+				DeadLetter: config.DeadLetterInfo{MaxCount: 10},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	dc := NewDeadLetterController(dic)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid", "0", "20", 1, http.StatusOK},
+		{"Invalid - offset out of range", "2", "20", 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - invalid limit", "0", "invalid", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiAllDeadLetterRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = dc.AllDeadLetters(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res responseDTO.MultiDeadLettersResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res.DeadLetters, testCase.expectedCount, "Dead letter count not as expected")
+			assert.Equal(t, testDeadLetter.Payload, res.DeadLetters[0].Payload, "Payload not as expected")
+		})
+	}
+}
+
+func TestDeadLetterById(t *testing.T) {
+	notFoundId := "notFoundId"
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeadLetterById", testDeadLetterId).Return(testDeadLetter, nil)
+	dbClientMock.On("DeadLetterById", notFoundId).Return(dataModels.DeadLetter{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service:    bootstrapConfig.ServiceInfo{MaxResultCount: 20},
+				DeadLetter: config.DeadLetterInfo{MaxCount: 10},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	dc := NewDeadLetterController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid", testDeadLetterId, http.StatusOK},
+		{"Invalid - empty id", "", http.StatusBadRequest},
+		{"Not found", notFoundId, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiDeadLetterIdRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.id)
+			err = dc.DeadLetterById(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responseDTO.DeadLetterResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testDeadLetterId, res.DeadLetter.Id, "Dead letter id not as expected")
+			}
+		})
+	}
+}
+
+func TestDeleteDeadLetterById(t *testing.T) {
+	notFoundId := "notFoundId"
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteDeadLetterById", testDeadLetterId).Return(nil)
+	dbClientMock.On("DeleteDeadLetterById", notFoundId).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service:    bootstrapConfig.ServiceInfo{MaxResultCount: 20},
+				DeadLetter: config.DeadLetterInfo{MaxCount: 10},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	dc := NewDeadLetterController(dic)
+
+	tests := []struct {
+		name               string
+		id                 string
+		expectedStatusCode int
+	}{
+		{"Valid", testDeadLetterId, http.StatusOK},
+		{"Not found", notFoundId, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodDelete, constants.ApiDeadLetterIdRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.id)
+			err = dc.DeleteDeadLetterById(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
+
+func TestReplayDeadLetter(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeadLetterById", testDeadLetterId).Return(testDeadLetter, nil)
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service:    bootstrapConfig.ServiceInfo{MaxResultCount: 20},
+				DeadLetter: config.DeadLetterInfo{MaxCount: 10},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	dc := NewDeadLetterController(dic)
+
+	e := echo.New()
+	req, err := http.NewRequest(http.MethodPost, constants.ApiDeadLetterReplayByIdRoute, http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	c.SetParamNames(common.Id)
+	c.SetParamValues(testDeadLetterId)
+	err = dc.ReplayDeadLetter(c)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode, "the dead letter of the invalid payload should not be replayed")
+	dbClientMock.AssertNotCalled(t, "DeleteDeadLetterById", testDeadLetterId)
+}
@@ -4,6 +4,8 @@ package http
 
 import (
 	"bytes"
+	"encoding/json"
+	stdErrs "errors"
 	"fmt"
 	"io"
 	"math"
@@ -13,18 +15,25 @@ import (
 	"sync"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataResponses "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	edgexIO "github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/labstack/echo/v4"
 )
 
@@ -104,7 +113,6 @@ func (ec *EventController) AddEvent(c echo.Context) error {
 		if err != nil {
 			return utils.WriteErrorResponse(w, ctx, lc, err, "")
 		}
-		go ec.app.PublishEvent(addEventReqDTO, serviceName, profileName, deviceName, sourceName, ctx, ec.dic)
 	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -113,6 +121,12 @@ func (ec *EventController) AddEvent(c echo.Context) error {
 	event := requestDTO.AddEventReqToEventModel(addEventReqDTO)
 	err = ec.app.ValidateEvent(event, profileName, deviceName, sourceName, ctx, ec.dic)
 	if err == nil {
+		event, err = ec.app.ValidateReadings(event, ctx, ec.dic)
+	}
+	if err == nil {
+		// publish the validated event, i.e. without the quarantined readings
+		published := requestDTO.AddEventRequest{BaseRequest: addEventReqDTO.BaseRequest, Event: dtos.FromEventModelToDTO(event)}
+		go ec.app.PublishEvent(published, serviceName, profileName, deviceName, sourceName, ctx, ec.dic)
 		err = ec.app.AddEvent(event, ctx, ec.dic)
 	}
 	if err != nil {
@@ -125,6 +139,152 @@ func (ec *EventController) AddEvent(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// AddEvents adds a batch of events, e.g. the events buffered by the device service while the gateway was offline, in a
+// single transaction. The request body is a JSON array, a CBOR array or a NDJSON stream of at most MaxBatchSize
+// AddEventRequests, and each AddEventRequest is validated individually so that the invalid ones are reported without
+// failing the others. The added events are published to the message bus as AddEvent does.
+func (ec *EventController) AddEvents(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	serviceName := c.Param(common.ServiceName)
+	if len(strings.TrimSpace(serviceName)) == 0 {
+		err := errors.NewCommonEdgeX(errors.KindContractInvalid, "service name sending events can not be empty", nil)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	// the batch is limited to MaxBatchSize events of MaxEventSize
+	if config.MaxEventSize > 0 && config.MaxBatchSize > 0 {
+		maxBodySize := config.MaxEventSize * 1024 * int64(config.MaxBatchSize)
+		if r.ContentLength > maxBodySize {
+			err := errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("request size exceed %d KB", maxBodySize/1024), nil)
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+	}
+
+	items, unmarshal, err := splitAddEventRequests(r.Body, strings.ToLower(r.Header.Get(common.ContentType)), config.MaxBatchSize)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	addResponses := make([]interface{}, len(items))
+	reqs := make([]requestDTO.AddEventRequest, 0, len(items))
+	indexes := make([]int, 0, len(items))
+	events := make([]models.Event, 0, len(items))
+	for i, item := range items {
+		var addEventReqDTO requestDTO.AddEventRequest
+		if config.MaxEventSize > 0 && int64(len(item)) > config.MaxEventSize*1024 {
+			err = errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("request size exceed %d KB", config.MaxEventSize), nil)
+		} else if unmarshalErr := unmarshal(item, &addEventReqDTO); unmarshalErr != nil {
+			err = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode AddEventRequest", unmarshalErr)
+		} else {
+			// the AddEventRequest is validated while decoding, and the batch has no profile, device and source names in
+			// the URL to check the event against, so only the readings are validated against the device profile
+			var event models.Event
+			if event, err = ec.app.ValidateReadings(requestDTO.AddEventReqToEventModel(addEventReqDTO), ctx, ec.dic); err == nil {
+				reqs = append(reqs, addEventReqDTO)
+				indexes = append(indexes, i)
+				events = append(events, event)
+				continue
+			}
+		}
+		lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+		addResponses[i] = commonDTO.NewBaseResponse(addEventReqDTO.RequestId, err.Message(), err.Code())
+	}
+
+	err = ec.app.AddEvents(events, ctx, ec.dic)
+	if err != nil {
+		lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+	}
+	for i, index := range indexes {
+		if err != nil {
+			addResponses[index] = commonDTO.NewBaseResponse(reqs[i].RequestId, err.Message(), err.Code())
+		} else {
+			addResponses[index] = commonDTO.NewBaseWithIdResponse(reqs[i].RequestId, "", http.StatusCreated, events[i].Id)
+		}
+	}
+	if err == nil && len(events) > 0 {
+		// publish the validated events, whose quarantined readings are removed
+		go func() {
+			for i, event := range events {
+				published := requestDTO.AddEventRequest{BaseRequest: reqs[i].BaseRequest, Event: dtos.FromEventModelToDTO(event)}
+				ec.app.PublishEvent(published, serviceName, event.ProfileName, event.DeviceName, event.SourceName, ctx, ec.dic)
+			}
+		}()
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(addResponses, w, lc)
+}
+
+// splitAddEventRequests splits the request body into the encoded AddEventRequests by the content type, and returns the
+// function to decode them. The body must not contain more than maxItems AddEventRequests if maxItems is positive.
+func splitAddEventRequests(body io.Reader, contentType string, maxItems int) ([][]byte, func([]byte, any) error, errors.EdgeX) {
+	var result [][]byte
+	var unmarshal func([]byte, any) error
+	switch contentType {
+	case common.ContentTypeCBOR:
+		var items []cbor.RawMessage
+		if err := cbor.NewDecoder(body).Decode(&items); err != nil {
+			return nil, nil, batchDecodeError("failed to decode the CBOR array of AddEventRequests", err)
+		}
+		result = make([][]byte, len(items))
+		for i, item := range items {
+			result[i] = item
+		}
+		unmarshal = cbor.Unmarshal
+	case constants.ContentTypeNDJSON:
+		decoder := json.NewDecoder(body)
+		for {
+			var item json.RawMessage
+			err := decoder.Decode(&item)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, nil, batchDecodeError("failed to decode the NDJSON stream of AddEventRequests", err)
+			}
+			result = append(result, item)
+			if maxItems > 0 && len(result) > maxItems {
+				break
+			}
+		}
+		unmarshal = json.Unmarshal
+	default:
+		var items []json.RawMessage
+		if err := json.NewDecoder(body).Decode(&items); err != nil {
+			return nil, nil, batchDecodeError("failed to decode the JSON array of AddEventRequests", err)
+		}
+		result = make([][]byte, len(items))
+		for i, item := range items {
+			result[i] = item
+		}
+		unmarshal = json.Unmarshal
+	}
+	if maxItems > 0 && len(result) > maxItems {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("the batch exceeds %d events", maxItems), nil)
+	}
+	return result, unmarshal, nil
+}
+
+// batchDecodeError returns the LimitExceeded error if the request body exceeds the size limit, or the ContractInvalid error
+func batchDecodeError(message string, err error) errors.EdgeX {
+	var maxBytesErr *http.MaxBytesError
+	if stdErrs.As(err, &maxBytesErr) {
+		return errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("request size exceed %d KB", maxBytesErr.Limit/1024), err)
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, message, err)
+}
+
 func (ec *EventController) EventById(c echo.Context) error {
 	// retrieve all the service injections from bootstrap
 	lc := container.LoggingClientFrom(ec.dic.Get)
@@ -222,6 +382,13 @@ func (ec *EventController) AllEvents(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination {
+		return ec.eventsByCursor(c, dataModels.EventQueryConditions{Start: 0, End: math.MaxInt64}, cursor, limit, skipCount)
+	}
 	events, totalCount, err := ec.app.AllEvents(offset, limit, ec.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -245,6 +412,13 @@ func (ec *EventController) EventsByDeviceName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination {
+		return ec.eventsByCursor(c, dataModels.EventQueryConditions{DeviceName: name, Start: 0, End: math.MaxInt64}, cursor, limit, skipCount)
+	}
 	events, totalCount, err := ec.app.EventsByDeviceName(offset, limit, name, ec.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -287,6 +461,13 @@ func (ec *EventController) EventsByTimeRange(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination {
+		return ec.eventsByCursor(c, dataModels.EventQueryConditions{Start: start, End: end}, cursor, limit, skipCount)
+	}
 	events, totalCount, err := ec.app.EventsByTimeRange(start, end, offset, limit, ec.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -320,3 +501,19 @@ func (ec *EventController) DeleteEventsByAge(c echo.Context) error {
 	// encode and send out the response
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// eventsByCursor writes the events of the cursor-based pagination and the continuation token of the next page to the response
+func (ec *EventController) eventsByCursor(c echo.Context, conds dataModels.EventQueryConditions, cursor dataModels.Cursor, limit int, skipCount bool) error {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	w := c.Response()
+	ctx := c.Request().Context()
+
+	events, nextCursor, totalCount, err := ec.app.EventsByCursor(conds, cursor, limit, skipCount, ec.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := dataResponses.NewMultiEventsResponse("", "", http.StatusOK, totalCount, events, nextCursor)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -8,17 +8,24 @@ package http
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
@@ -26,12 +33,16 @@ import (
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	msgMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataResponses "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 
 	"github.com/labstack/echo/v4"
 )
@@ -99,6 +110,12 @@ func TestAddEvent(t *testing.T) {
 
 	dbClientMock := &dbMock.DBClient{}
 
+	var published atomic.Int32
+	msgClient := &msgMocks.MessageClient{}
+	msgClient.On("PublishWithSizeLimit", mock.Anything, mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		published.Add(1)
+	}).Return(nil)
+
 	dic := mocks.NewMockDIC()
 	app := application.NewCoreDataApp(dic)
 	dic.Update(di.ServiceConstructorMap{
@@ -115,6 +132,9 @@ func TestAddEvent(t *testing.T) {
 		application.CoreDataAppName: func(get di.Get) interface{} {
 			return app
 		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClient
+		},
 	})
 	ec := NewEventController(dic)
 
@@ -199,6 +219,7 @@ func TestAddEvent(t *testing.T) {
 	}{
 		{"Valid - AddEventRequest JSON", validRequest, common.ContentTypeJSON, validRequest.Event.ProfileName, validRequest.Event.DeviceName, false, http.StatusCreated},
 		{"Valid - No RequestId JSON", noRequestId, common.ContentTypeJSON, noRequestId.Event.ProfileName, noRequestId.Event.DeviceName, false, http.StatusCreated},
+		{"Invalid - Mismatched DeviceName JSON", validRequest, common.ContentTypeJSON, validRequest.Event.ProfileName, "mismatched", true, http.StatusBadRequest},
 		{"Invalid - Bad RequestId JSON", badRequestId, common.ContentTypeJSON, badRequestId.Event.ProfileName, badRequestId.Event.DeviceName, true, http.StatusBadRequest},
 		{"Invalid - No Event JSON", noEvent, common.ContentTypeJSON, "", "", true, http.StatusBadRequest},
 		{"Invalid - No Event Id JSON", noEventID, common.ContentTypeJSON, noEventID.Event.ProfileName, noEventID.Event.DeviceName, true, http.StatusBadRequest},
@@ -275,6 +296,9 @@ func TestAddEvent(t *testing.T) {
 			assert.Empty(t, actualResponse.Message, "Message should be empty when it is successful")
 		})
 	}
+	// only the events passing the validation are published
+	assert.Eventually(t, func() bool { return published.Load() == 6 }, time.Second, 10*time.Millisecond)
+	assert.Never(t, func() bool { return published.Load() > 6 }, 100*time.Millisecond, 10*time.Millisecond)
 }
 
 func TestAddEventSize(t *testing.T) {
@@ -363,6 +387,156 @@ func TestAddEventSize(t *testing.T) {
 	}
 }
 
+func TestAddEvents(t *testing.T) {
+	validRequest := testAddEvent
+	noEventDevice := validRequest
+	noEventDevice.Event.DeviceName = ""
+	failedRequest := validRequest
+	failedRequest.Event.DeviceName = "failed"
+	outOfRangeReading := testReading
+	outOfRangeReading.Value = "200"
+	outOfRangeRequest := validRequest
+	outOfRangeRequest.Event.Readings = []dtos.BaseReading{outOfRangeReading}
+
+	minimum, maximum := 0.0, 100.0
+	dpcMock := &clientMocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, TestDeviceProfileName).Return(responseDTO.DeviceProfileResponse{Profile: dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: TestDeviceProfileName},
+		DeviceResources: []dtos.DeviceResource{{Name: TestDeviceResourceName,
+			Properties: dtos.ResourceProperties{ValueType: common.ValueTypeUint8, Minimum: &minimum, Maximum: &maximum}}},
+	}}, nil)
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvents", mock.MatchedBy(func(events []models.Event) bool {
+		return events[0].DeviceName == failedRequest.Event.DeviceName
+	})).Return(nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed", nil))
+	dbClientMock.On("AddEvents", mock.Anything).Return(func(events []models.Event) ([]models.Event, errors.EdgeX) {
+		return events, nil
+	})
+
+	var published atomic.Int32
+	msgClient := &msgMocks.MessageClient{}
+	msgClient.On("PublishWithSizeLimit", mock.Anything, mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		published.Add(1)
+	}).Return(nil)
+
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					PersistData: true,
+				},
+				MaxEventSize: 25000,
+				MaxBatchSize: 2,
+				Validation:   config.ReadingValidation{Enabled: true, Policy: constants.ValidationPolicyReject},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClient
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+	})
+	ec := NewEventController(dic)
+
+	encodeNDJSON := func(reqs ...requests.AddEventRequest) []byte {
+		var lines []string
+		for _, req := range reqs {
+			line, err := json.Marshal(req)
+			require.NoError(t, err)
+			lines = append(lines, string(line))
+		}
+		return []byte(strings.Join(lines, "\n"))
+	}
+
+	tests := []struct {
+		name                string
+		requests            []requests.AddEventRequest
+		requestContentType  string
+		expectedErrorStatus int
+		expectedStatusCodes []int
+	}{
+		{"Valid - JSON array", []requests.AddEventRequest{validRequest, validRequest}, common.ContentTypeJSON, 0, []int{http.StatusCreated, http.StatusCreated}},
+		{"Valid - CBOR array", []requests.AddEventRequest{validRequest}, common.ContentTypeCBOR, 0, []int{http.StatusCreated}},
+		{"Valid - NDJSON stream", []requests.AddEventRequest{validRequest, validRequest}, constants.ContentTypeNDJSON, 0, []int{http.StatusCreated, http.StatusCreated}},
+		{"Partial - invalid event", []requests.AddEventRequest{validRequest, noEventDevice}, common.ContentTypeJSON, 0, []int{http.StatusCreated, http.StatusBadRequest}},
+		{"Partial - reading out of the device profile range", []requests.AddEventRequest{validRequest, outOfRangeRequest}, common.ContentTypeJSON, 0, []int{http.StatusCreated, http.StatusBadRequest}},
+		{"Partial - database error", []requests.AddEventRequest{failedRequest, noEventDevice}, constants.ContentTypeNDJSON, 0, []int{http.StatusInternalServerError, http.StatusBadRequest}},
+		{"Invalid - not an array", nil, common.ContentTypeJSON, http.StatusBadRequest, nil},
+		{"Invalid - JSON array exceeds MaxBatchSize", []requests.AddEventRequest{validRequest, validRequest, validRequest}, common.ContentTypeJSON, http.StatusRequestEntityTooLarge, nil},
+		{"Invalid - NDJSON stream exceeds MaxBatchSize", []requests.AddEventRequest{validRequest, validRequest, validRequest}, constants.ContentTypeNDJSON, http.StatusRequestEntityTooLarge, nil},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			var byteData []byte
+			var err error
+			switch {
+			case testCase.requests == nil:
+				byteData, err = json.Marshal(validRequest)
+			case testCase.requestContentType == constants.ContentTypeNDJSON:
+				byteData = encodeNDJSON(testCase.requests...)
+			default:
+				byteData, err = toByteArray(testCase.requestContentType, testCase.requests)
+			}
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, constants.ApiEventBatchRoute, strings.NewReader(string(byteData)))
+			require.NoError(t, err)
+			req.Header.Set(common.ContentType, testCase.requestContentType)
+
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.ServiceName)
+			c.SetParamValues(TestServiceName)
+			err = ec.AddEvents(c)
+			require.NoError(t, err)
+
+			if testCase.expectedErrorStatus != 0 {
+				assert.Equal(t, testCase.expectedErrorStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+				return
+			}
+
+			var actualResponses []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &actualResponses)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			require.Len(t, actualResponses, len(testCase.expectedStatusCodes))
+			for i, expectedStatusCode := range testCase.expectedStatusCodes {
+				assert.Equal(t, expectedStatusCode, int(actualResponses[i].StatusCode), "BaseResponse status code not as expected")
+				if expectedStatusCode == http.StatusCreated {
+					assert.Equal(t, testCase.requests[i].Event.Id, actualResponses[i].Id)
+				} else {
+					assert.NotEmpty(t, actualResponses[i].Message)
+				}
+			}
+		})
+	}
+	// the events added by the valid cases are published
+	assert.Eventually(t, func() bool { return published.Load() == 7 }, time.Second, 10*time.Millisecond)
+}
+
+func TestBatchDecodeError(t *testing.T) {
+	body := http.MaxBytesReader(httptest.NewRecorder(), io.NopCloser(strings.NewReader(`[{"apiVersion":"v3"}]`)), 4)
+	_, _, err := splitAddEventRequests(body, common.ContentTypeJSON, 0)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+
+	_, _, err = splitAddEventRequests(strings.NewReader(`{`), common.ContentTypeJSON, 0)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
 func TestEventById(t *testing.T) {
 	validEventId := expectedEventId
 	emptyEventId := ""
@@ -923,3 +1097,84 @@ func TestDeleteEventsByAge(t *testing.T) {
 		})
 	}
 }
+
+func TestEventsByCursor(t *testing.T) {
+	totalCount := uint32(3)
+	conds := dataModels.EventQueryConditions{Start: 0, End: math.MaxInt64}
+	cursor := dataModels.Cursor{Origin: persistedEvent.Origin, Id: persistedEvent.Id}
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventsByCursor", conds, dataModels.Cursor{}, 1).Return([]models.Event{persistedEvent}, nil)
+	dbClientMock.On("EventsByCursor", conds, cursor, 20).Return([]models.Event{persistedEvent, persistedEvent}, nil)
+	dbClientMock.On("EventCountByQueryConditions", conds).Return(totalCount, nil)
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	controller := NewEventController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		cursor             string
+		limit              string
+		skipCount          string
+		errorExpected      bool
+		expectedCount      int
+		expectedTotalCount uint32
+		expectedNextCursor string
+		expectedStatusCode int
+	}{
+		{"Valid - first page", "", "1", "", false, 1, totalCount, cursor.Token(), http.StatusOK},
+		{"Valid - last page", cursor.Token(), "", "", false, 2, totalCount, "", http.StatusOK},
+		{"Valid - skip total count", cursor.Token(), "", "true", false, 2, 0, "", http.StatusOK},
+		{"Invalid - invalid cursor", "aaa", "", "", true, 0, 0, "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiAllEventRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.Cursor, testCase.cursor)
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			if testCase.skipCount != "" {
+				query.Add(constants.SkipCount, testCase.skipCount)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AllEvents(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res dataResponses.MultiEventsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.Len(t, res.Events, testCase.expectedCount, "Event count not as expected")
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+				assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+			}
+		})
+	}
+}
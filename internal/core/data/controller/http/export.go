@@ -0,0 +1,268 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// exportFlushInterval is the number of records written to the response between two flushes
+const exportFlushInterval = 100
+
+var readingCSVHeader = []string{"id", "origin", "deviceName", "profileName", "resourceName", "valueType", "units", "value", "mediaType", "binaryValue", "objectValue", "tags"}
+var eventCSVHeader = []string{"eventId", "eventOrigin", "eventDeviceName", "eventProfileName", "eventSourceName", "eventTags"}
+
+// ExportEvents streams the events matching the query string as NDJSON, CSV or CBOR sequence, one record after another
+// with chunked transfer encoding. A CSV row is written for each reading of the events.
+func (ec *EventController) ExportEvents(c echo.Context) error {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	deviceName, start, end, format, err := parseExportQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	stream := newExportStream(ctx, w, format, append(eventCSVHeader, readingCSVHeader...))
+	conds := dataModels.EventQueryConditions{DeviceName: deviceName, Start: start, End: end}
+	err = ec.app.ExportEvents(ctx, conds, func(e dtos.Event) error {
+		if format != constants.ExportFormatCSV {
+			return stream.write(e, nil)
+		}
+		eventRecord := eventCSVRecord(e)
+		if len(e.Readings) == 0 {
+			return stream.write(nil, append(eventRecord, make([]string, len(readingCSVHeader))...))
+		}
+		for _, reading := range e.Readings {
+			readingRecord, err := readingCSVRecord(reading)
+			if err != nil {
+				return err
+			}
+			if err = stream.write(nil, append(append([]string{}, eventRecord...), readingRecord...)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, ec.dic)
+	return stream.close(lc, err)
+}
+
+// ExportReadings streams the readings matching the query string as NDJSON, CSV or CBOR sequence, one record after another
+// with chunked transfer encoding
+func (rc *ReadingController) ExportReadings(c echo.Context) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	deviceName, start, end, format, err := parseExportQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	filter, err := parseReadingFilterQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	stream := newExportStream(ctx, w, format, readingCSVHeader)
+	conds := dataModels.ReadingQueryConditions{DeviceName: deviceName, ResourceName: c.QueryParam(common.ResourceName), Start: start, End: end, ReadingFilter: filter}
+	err = application.ExportReadings(ctx, conds, func(reading dtos.BaseReading) error {
+		if format != constants.ExportFormatCSV {
+			return stream.write(reading, nil)
+		}
+		record, err := readingCSVRecord(reading)
+		if err != nil {
+			return err
+		}
+		return stream.write(nil, record)
+	}, rc.dic)
+	return stream.close(lc, err)
+}
+
+// parseExportQueryString parses the deviceName, start, end and format query parameters of the export APIs
+func parseExportQueryString(c echo.Context) (deviceName string, start int64, end int64, format string, err errors.EdgeX) {
+	start, err = utils.ParseQueryStringToInt64(c, common.Start, 0, 0, math.MaxInt64)
+	if err != nil {
+		return deviceName, start, end, format, errors.NewCommonEdgeXWrapper(err)
+	}
+	end, err = utils.ParseQueryStringToInt64(c, common.End, math.MaxInt64, 0, math.MaxInt64)
+	if err != nil {
+		return deviceName, start, end, format, errors.NewCommonEdgeXWrapper(err)
+	}
+	if end < start {
+		return deviceName, start, end, format, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be greater than start's value %v", end, start), nil)
+	}
+
+	format = strings.ToLower(c.QueryParam(constants.Format))
+	switch format {
+	case "":
+		format = constants.ExportFormatNDJSON
+	case constants.ExportFormatNDJSON, constants.ExportFormatCSV, constants.ExportFormatCBOR:
+	default:
+		return deviceName, start, end, format, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("querystring %s's value %s is not one of %s, %s or %s", constants.Format, format, constants.ExportFormatNDJSON, constants.ExportFormatCSV, constants.ExportFormatCBOR), nil)
+	}
+	return c.QueryParam(common.DeviceName), start, end, format, nil
+}
+
+// exportStream writes the exported records to the response in the requested format. The response header is written
+// along with the first record, so that an error occurred before any record is exported can still be responded as usual.
+type exportStream struct {
+	ctx         context.Context
+	w           *echo.Response
+	format      string
+	csvHeader   []string
+	jsonEncoder *json.Encoder
+	cborEncoder *cbor.Encoder
+	csvWriter   *csv.Writer
+	count       int
+}
+
+func newExportStream(ctx context.Context, w *echo.Response, format string, csvHeader []string) *exportStream {
+	s := &exportStream{ctx: ctx, w: w, format: format, csvHeader: csvHeader}
+	switch format {
+	case constants.ExportFormatCSV:
+		s.csvWriter = csv.NewWriter(w)
+	case constants.ExportFormatCBOR:
+		s.cborEncoder = cbor.NewEncoder(w)
+	default:
+		s.jsonEncoder = json.NewEncoder(w)
+	}
+	return s
+}
+
+func (s *exportStream) contentType() string {
+	switch s.format {
+	case constants.ExportFormatCSV:
+		return constants.ContentTypeCSV
+	case constants.ExportFormatCBOR:
+		return constants.ContentTypeCBORSeq
+	default:
+		return constants.ContentTypeNDJSON
+	}
+}
+
+// begin writes the response header before the first record, and the CSV header row for the CSV format
+func (s *exportStream) begin() error {
+	if s.w.Committed {
+		return nil
+	}
+	s.w.Header().Set(common.CorrelationHeader, correlation.FromContext(s.ctx))
+	s.w.Header().Set(common.ContentType, s.contentType())
+	s.w.WriteHeader(http.StatusOK)
+	if s.csvWriter != nil {
+		return s.csvWriter.Write(s.csvHeader)
+	}
+	return nil
+}
+
+// write encodes the value as a NDJSON line or CBOR data item, or writes the record as a CSV row
+func (s *exportStream) write(v any, record []string) error {
+	if err := s.begin(); err != nil {
+		return err
+	}
+
+	var err error
+	switch s.format {
+	case constants.ExportFormatCSV:
+		err = s.csvWriter.Write(record)
+	case constants.ExportFormatCBOR:
+		err = s.cborEncoder.Encode(v)
+	default:
+		err = s.jsonEncoder.Encode(v)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.count++
+	if s.count%exportFlushInterval == 0 {
+		return s.flush()
+	}
+	return nil
+}
+
+func (s *exportStream) flush() error {
+	if s.csvWriter != nil {
+		s.csvWriter.Flush()
+		if err := s.csvWriter.Error(); err != nil {
+			return err
+		}
+	}
+	s.w.Flush()
+	return nil
+}
+
+// close ends the export. The error is responded as usual if no record has been exported yet, otherwise the error can
+// only be logged as the response header has already been sent and the exported records are truncated.
+func (s *exportStream) close(lc logger.LoggingClient, err errors.EdgeX) error {
+	if err != nil {
+		if !s.w.Committed {
+			return utils.WriteErrorResponse(s.w, s.ctx, lc, err, "")
+		}
+		lc.Errorf("failed to export all the records, %d records have been exported: %v", s.count, err)
+		return s.flush()
+	}
+	if err := s.begin(); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func eventCSVRecord(e dtos.Event) []string {
+	return []string{e.Id, strconv.FormatInt(e.Origin, 10), e.DeviceName, e.ProfileName, e.SourceName, tagsToCSVField(e.Tags)}
+}
+
+func readingCSVRecord(r dtos.BaseReading) ([]string, error) {
+	var binaryValue, objectValue string
+	if len(r.BinaryValue) > 0 {
+		binaryValue = base64.StdEncoding.EncodeToString(r.BinaryValue)
+	}
+	if r.ObjectValue != nil {
+		bytes, err := json.Marshal(r.ObjectValue)
+		if err != nil {
+			return nil, err
+		}
+		objectValue = string(bytes)
+	}
+	return []string{r.Id, strconv.FormatInt(r.Origin, 10), r.DeviceName, r.ProfileName, r.ResourceName, r.ValueType, r.Units,
+		r.Value, r.MediaType, binaryValue, objectValue, tagsToCSVField(r.Tags)}, nil
+}
+
+// tagsToCSVField encodes the tags as a JSON object, the tags were already decoded from JSON so that the encoding won't fail
+func tagsToCSVField(tags dtos.Tags) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	bytes, _ := json.Marshal(tags)
+	return string(bytes)
+}
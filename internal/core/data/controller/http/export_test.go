@@ -0,0 +1,214 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+func TestExportReadings(t *testing.T) {
+	conds := dataModels.ReadingQueryConditions{DeviceName: TestDeviceName, ResourceName: TestDeviceResourceName, Start: 0, End: math.MaxInt64}
+	failedConds := dataModels.ReadingQueryConditions{DeviceName: "failed", Start: 0, End: math.MaxInt64}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("StreamReadings", mock.Anything, conds, mock.Anything).Run(func(args mock.Arguments) {
+		handler := args.Get(2).(func(models.Reading) error)
+		_ = handler(persistedReading)
+		_ = handler(persistedReading)
+	}).Return(nil)
+	dbClientMock.On("StreamReadings", mock.Anything, failedConds, mock.Anything).Return(errors.NewCommonEdgeX(errors.KindDatabaseError, "failed", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name                string
+		deviceName          string
+		start               string
+		end                 string
+		format              string
+		expectedContentType string
+		expectedStatusCode  int
+	}{
+		{"Valid - default format", TestDeviceName, "", "", "", constants.ContentTypeNDJSON, http.StatusOK},
+		{"Valid - ndjson", TestDeviceName, "", "", constants.ExportFormatNDJSON, constants.ContentTypeNDJSON, http.StatusOK},
+		{"Valid - csv", TestDeviceName, "", "", constants.ExportFormatCSV, constants.ContentTypeCSV, http.StatusOK},
+		{"Valid - cbor", TestDeviceName, "", "", constants.ExportFormatCBOR, constants.ContentTypeCBORSeq, http.StatusOK},
+		{"Invalid - unknown format", TestDeviceName, "", "", "xml", common.ContentTypeJSON, http.StatusBadRequest},
+		{"Invalid - end is less than start", TestDeviceName, "100", "1", "", common.ContentTypeJSON, http.StatusBadRequest},
+		{"Invalid - invalid start", TestDeviceName, "aaa", "", "", common.ContentTypeJSON, http.StatusBadRequest},
+		{"Invalid - database error", "failed", "", "", "", common.ContentTypeJSON, http.StatusInternalServerError},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiReadingExportRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.DeviceName, testCase.deviceName)
+			if testCase.deviceName == TestDeviceName {
+				query.Add(common.ResourceName, TestDeviceResourceName)
+			}
+			if testCase.start != "" {
+				query.Add(common.Start, testCase.start)
+			}
+			if testCase.end != "" {
+				query.Add(common.End, testCase.end)
+			}
+			if testCase.format != "" {
+				query.Add(constants.Format, testCase.format)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = rc.ExportReadings(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedContentType, recorder.Header().Get(common.ContentType), "Content-Type not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+
+			expected := dtos.FromReadingModelToDTO(persistedReading)
+			switch testCase.expectedContentType {
+			case constants.ContentTypeCSV:
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 3)
+				assert.Equal(t, readingCSVHeader, records[0])
+				assert.Equal(t, expected.Id, records[1][0])
+				assert.Equal(t, expected.Value, records[2][7])
+			case constants.ContentTypeCBORSeq:
+				decoder := cbor.NewDecoder(recorder.Body)
+				for i := 0; i < 2; i++ {
+					var actual dtos.BaseReading
+					require.NoError(t, decoder.Decode(&actual))
+					assert.Equal(t, expected, actual)
+				}
+			default:
+				scanner := bufio.NewScanner(recorder.Body)
+				count := 0
+				for scanner.Scan() {
+					var actual dtos.BaseReading
+					require.NoError(t, json.Unmarshal(scanner.Bytes(), &actual))
+					assert.Equal(t, expected, actual)
+					count++
+				}
+				assert.Equal(t, 2, count)
+			}
+		})
+	}
+}
+
+func TestExportEvents(t *testing.T) {
+	conds := dataModels.EventQueryConditions{DeviceName: TestDeviceName, Start: 0, End: math.MaxInt64}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("StreamEvents", mock.Anything, conds, mock.Anything).Run(func(args mock.Arguments) {
+		handler := args.Get(2).(func(models.Event) error)
+		_ = handler(persistedEvent)
+	}).Return(nil)
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	controller := NewEventController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name                string
+		format              string
+		expectedContentType string
+		expectedStatusCode  int
+	}{
+		{"Valid - ndjson", constants.ExportFormatNDJSON, constants.ContentTypeNDJSON, http.StatusOK},
+		{"Valid - csv", constants.ExportFormatCSV, constants.ContentTypeCSV, http.StatusOK},
+		{"Valid - cbor", constants.ExportFormatCBOR, constants.ContentTypeCBORSeq, http.StatusOK},
+		{"Invalid - unknown format", "xml", common.ContentTypeJSON, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiEventExportRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.DeviceName, TestDeviceName)
+			query.Add(constants.Format, testCase.format)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.ExportEvents(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedContentType, recorder.Header().Get(common.ContentType), "Content-Type not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+
+			expected := dtos.FromEventModelToDTO(persistedEvent)
+			switch testCase.expectedContentType {
+			case constants.ContentTypeCSV:
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 2)
+				assert.Equal(t, append(eventCSVHeader, readingCSVHeader...), records[0])
+				assert.Equal(t, expected.Id, records[1][0])
+				assert.Equal(t, expected.Readings[0].Id, records[1][len(eventCSVHeader)])
+			case constants.ContentTypeCBORSeq:
+				var actual dtos.Event
+				require.NoError(t, cbor.NewDecoder(recorder.Body).Decode(&actual))
+				assert.Equal(t, expected.Id, actual.Id)
+				assert.Equal(t, expected.Readings, actual.Readings)
+			default:
+				var actual dtos.Event
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&actual))
+				assert.Equal(t, expected.Id, actual.Id)
+				assert.Equal(t, expected.Readings, actual.Readings)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// parseReadingFilterQueryString parses the gt, lt, between and tags query parameters into the reading filter. The gt and lt
+// bounds are exclusive, while the between bounds in the form of "min,max" are inclusive and can't be used with gt or lt.
+// The tags are in the form of "key1:value1,key2:value2".
+func parseReadingFilterQueryString(c echo.Context) (filter dataModels.ReadingFilter, err errors.EdgeX) {
+	if value := c.QueryParam(constants.Between); value != "" {
+		if c.QueryParam(constants.Gt) != "" || c.QueryParam(constants.Lt) != "" {
+			return filter, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("querystring %s is not allowed to be used with %s or %s", constants.Between, constants.Gt, constants.Lt), nil)
+		}
+		minStr, maxStr, found := strings.Cut(value, constants.ValueSeparator)
+		if !found {
+			return filter, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("querystring %s's value %s is not in the form of min%smax", constants.Between, value, constants.ValueSeparator), nil)
+		}
+		if filter.ValueMin, err = parseValueBound(constants.Between, minStr); err != nil {
+			return filter, err
+		}
+		if filter.ValueMax, err = parseValueBound(constants.Between, maxStr); err != nil {
+			return filter, err
+		}
+		filter.ValueMinInclusive = true
+		filter.ValueMaxInclusive = true
+	}
+	if value := c.QueryParam(constants.Gt); value != "" {
+		if filter.ValueMin, err = parseValueBound(constants.Gt, value); err != nil {
+			return filter, err
+		}
+	}
+	if value := c.QueryParam(constants.Lt); value != "" {
+		if filter.ValueMax, err = parseValueBound(constants.Lt, value); err != nil {
+			return filter, err
+		}
+	}
+	if filter.ValueMin != nil && filter.ValueMax != nil && *filter.ValueMax < *filter.ValueMin {
+		return filter, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the upper bound %v of the reading value is less than the lower bound %v", *filter.ValueMax, *filter.ValueMin), nil)
+	}
+
+	if value := c.QueryParam(constants.Tags); value != "" {
+		filter.Tags = make(map[string]string)
+		for _, tag := range strings.Split(value, constants.ValueSeparator) {
+			key, tagValue, found := strings.Cut(tag, constants.TagKVSeparator)
+			if !found || key == "" {
+				return filter, errors.NewCommonEdgeX(errors.KindContractInvalid,
+					fmt.Sprintf("querystring %s's value %s is not in the form of key%svalue", constants.Tags, tag, constants.TagKVSeparator), nil)
+			}
+			filter.Tags[key] = tagValue
+		}
+	}
+	return filter, nil
+}
+
+func parseValueBound(param string, value string) (*float64, errors.EdgeX) {
+	bound, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(bound) {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s's value %s into number", param, value), err)
+	}
+	return &bound, nil
+}
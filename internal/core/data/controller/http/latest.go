@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// LatestReadingsByDeviceName returns the last known readings of all the resources of the device from the in-memory cache
+func (rc *ReadingController) LatestReadingsByDeviceName(c echo.Context) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	w := c.Response()
+	ctx := c.Request().Context()
+
+	readings, err := application.CoreDataAppFrom(rc.dic.Get).LatestReadingsByDeviceName(c.Param(common.Name))
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiReadingsResponse("", "", http.StatusOK, uint32(len(readings)), readings)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// LatestReadingByDeviceNameAndResourceName returns the last known reading of the device resource from the in-memory cache
+func (rc *ReadingController) LatestReadingByDeviceNameAndResourceName(c echo.Context) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	w := c.Response()
+	ctx := c.Request().Context()
+
+	reading, err := application.CoreDataAppFrom(rc.dic.Get).LatestReadingByDeviceNameAndResourceName(c.Param(common.Name), c.Param(common.ResourceName))
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewReadingResponse("", "", http.StatusOK, reading)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,140 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+)
+
+func TestLatestReadingsByDeviceName(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+	})
+	app := application.NewCoreDataApp(dic)
+	err := app.AddEvent(models.Event{Readings: []models.Reading{persistedReading}}, context.Background(), dic)
+	require.NoError(t, err)
+	dic.Update(di.ServiceConstructorMap{
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	rc := NewReadingController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, 1, http.StatusOK},
+		{"Not found - unknown device", "unknown", 0, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiLatestReadingsByDeviceNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.deviceName)
+			err = rc.LatestReadingsByDeviceName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res responseDTO.MultiReadingsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Len(t, res.Readings, testCase.expectedCount, "Reading count not as expected")
+			assert.Equal(t, uint32(testCase.expectedCount), res.TotalCount, "Total count not as expected")
+		})
+	}
+}
+
+func TestLatestReadingByDeviceNameAndResourceName(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+	})
+	app := application.NewCoreDataApp(dic)
+	err := app.AddEvent(models.Event{Readings: []models.Reading{persistedReading}}, context.Background(), dic)
+	require.NoError(t, err)
+	dic.Update(di.ServiceConstructorMap{
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	rc := NewReadingController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		resourceName       string
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, TestDeviceResourceName, http.StatusOK},
+		{"Not found - unknown resource", TestDeviceName, "unknown", http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiLatestReadingByDeviceNameAndResourceNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.ResourceName)
+			c.SetParamValues(testCase.deviceName, testCase.resourceName)
+			err = rc.LatestReadingByDeviceNameAndResourceName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res responseDTO.ReadingResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, persistedReading.Id, res.Reading.Id, "Reading not as expected")
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,15 +9,20 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataResponses "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -71,6 +76,18 @@ func (rc *ReadingController) AllReadings(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	filter, err := parseReadingFilterQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination || !filter.IsEmpty() {
+		conds := dataModels.ReadingQueryConditions{Start: 0, End: math.MaxInt64, ReadingFilter: filter}
+		return rc.readingsByQueryConditions(c, conds, isCursorPagination, cursor, offset, limit, skipCount)
+	}
 	readings, totalCount, err := application.AllReadings(offset, limit, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -93,6 +110,18 @@ func (rc *ReadingController) ReadingsByTimeRange(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	filter, err := parseReadingFilterQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination || !filter.IsEmpty() {
+		conds := dataModels.ReadingQueryConditions{Start: start, End: end, ReadingFilter: filter}
+		return rc.readingsByQueryConditions(c, conds, isCursorPagination, cursor, offset, limit, skipCount)
+	}
 	readings, totalCount, err := application.ReadingsByTimeRange(start, end, offset, limit, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -117,6 +146,18 @@ func (rc *ReadingController) ReadingsByResourceName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	filter, err := parseReadingFilterQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination || !filter.IsEmpty() {
+		conds := dataModels.ReadingQueryConditions{ResourceName: resourceName, Start: 0, End: math.MaxInt64, ReadingFilter: filter}
+		return rc.readingsByQueryConditions(c, conds, isCursorPagination, cursor, offset, limit, skipCount)
+	}
 	readings, totalCount, err := application.ReadingsByResourceName(offset, limit, resourceName, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -141,6 +182,18 @@ func (rc *ReadingController) ReadingsByDeviceName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	filter, err := parseReadingFilterQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination || !filter.IsEmpty() {
+		conds := dataModels.ReadingQueryConditions{DeviceName: name, Start: 0, End: math.MaxInt64, ReadingFilter: filter}
+		return rc.readingsByQueryConditions(c, conds, isCursorPagination, cursor, offset, limit, skipCount)
+	}
 	readings, totalCount, err := application.ReadingsByDeviceName(offset, limit, name, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -187,6 +240,18 @@ func (rc *ReadingController) ReadingsByResourceNameAndTimeRange(c echo.Context)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	filter, err := parseReadingFilterQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination || !filter.IsEmpty() {
+		conds := dataModels.ReadingQueryConditions{ResourceName: resourceName, Start: start, End: end, ReadingFilter: filter}
+		return rc.readingsByQueryConditions(c, conds, isCursorPagination, cursor, offset, limit, skipCount)
+	}
 	readings, totalCount, err := application.ReadingsByResourceNameAndTimeRange(resourceName, start, end, offset, limit, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -212,6 +277,18 @@ func (rc *ReadingController) ReadingsByDeviceNameAndResourceName(c echo.Context)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	filter, err := parseReadingFilterQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination || !filter.IsEmpty() {
+		conds := dataModels.ReadingQueryConditions{DeviceName: deviceName, ResourceName: resourceName, Start: 0, End: math.MaxInt64, ReadingFilter: filter}
+		return rc.readingsByQueryConditions(c, conds, isCursorPagination, cursor, offset, limit, skipCount)
+	}
 	readings, totalCount, err := application.ReadingsByDeviceNameAndResourceName(deviceName, resourceName, offset, limit, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -238,6 +315,18 @@ func (rc *ReadingController) ReadingsByDeviceNameAndResourceNameAndTimeRange(c e
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	filter, err := parseReadingFilterQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination || !filter.IsEmpty() {
+		conds := dataModels.ReadingQueryConditions{DeviceName: deviceName, ResourceName: resourceName, Start: start, End: end, ReadingFilter: filter}
+		return rc.readingsByQueryConditions(c, conds, isCursorPagination, cursor, offset, limit, skipCount)
+	}
 	readings, totalCount, err := application.ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, offset, limit, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -286,6 +375,18 @@ func (rc *ReadingController) ReadingsByDeviceNameAndResourceNamesAndTimeRange(c
 		}
 	}
 
+	cursor, skipCount, isCursorPagination, err := parseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	filter, err := parseReadingFilterQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isCursorPagination || !filter.IsEmpty() {
+		conds := dataModels.ReadingQueryConditions{DeviceName: deviceName, ResourceNames: resourceNames, Start: start, End: end, ReadingFilter: filter}
+		return rc.readingsByQueryConditions(c, conds, isCursorPagination, cursor, offset, limit, skipCount)
+	}
 	readings, totalCount, err := application.ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName, resourceNames, start, end, offset, limit, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -295,3 +396,62 @@ func (rc *ReadingController) ReadingsByDeviceNameAndResourceNamesAndTimeRange(c
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange returns the count/min/max/avg/sum/first/last of the numeric readings by device name,
+// resource name and specified time range, grouped by the fixed time buckets of the interval query parameter.
+func (rc *ReadingController) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(c echo.Context) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	deviceName := c.Param(common.Name)
+	resourceName := c.Param(common.ResourceName)
+
+	// parse time range (start, end) from incoming request
+	start, end, err := utils.ParseTimeRange(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	intervalStr := c.QueryParam(constants.Interval)
+	interval, parseErr := time.ParseDuration(intervalStr)
+	if parseErr != nil {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s's value %s into duration", constants.Interval, intervalStr), parseErr)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	aggregates, err := application.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, interval, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := dataResponses.NewMultiReadingAggregatesResponse("", "", http.StatusOK, deviceName, resourceName, intervalStr, aggregates)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// readingsByQueryConditions writes the readings matching the query conditions to the response, along with the continuation token
+// of the next page when the cursor-based pagination is used
+func (rc *ReadingController) readingsByQueryConditions(c echo.Context, conds dataModels.ReadingQueryConditions, isCursorPagination bool, cursor dataModels.Cursor, offset int, limit int, skipCount bool) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	w := c.Response()
+	ctx := c.Request().Context()
+
+	var readings []dtos.BaseReading
+	var nextCursor string
+	var totalCount uint32
+	var err errors.EdgeX
+	if isCursorPagination {
+		readings, nextCursor, totalCount, err = application.ReadingsByCursor(conds, cursor, limit, skipCount, rc.dic)
+	} else {
+		readings, totalCount, err = application.ReadingsByQueryConditions(conds, offset, limit, rc.dic)
+	}
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := dataResponses.NewMultiReadingsResponse("", "", http.StatusOK, totalCount, readings, nextCursor)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"encoding/json"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -12,9 +13,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataResponses "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -712,3 +716,249 @@ func TestReadingsByDeviceNameAndResourceNamesAndTimeRange(t *testing.T) {
 		})
 	}
 }
+
+func TestReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(t *testing.T) {
+	aggregates := []dataModels.ReadingAggregate{
+		{BucketStart: 0, Count: 2, Min: 1, Max: 3, Avg: 2, Sum: 4, First: 1, Last: 3},
+		{BucketStart: 60000000000, Count: 1, Min: 5, Max: 5, Avg: 5, Sum: 5, First: 5, Last: 5},
+	}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange", TestDeviceName, TestDeviceResourceName, int64(0), int64(100000000000), int64(60000000000)).Return(aggregates, nil)
+	dbClientMock.On("ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange", TestDeviceName, TestDeviceResourceName, int64(0), int64(100000000000), int64(30000000000)).Return(aggregates, nil)
+	rollups := []dataModels.ReadingRollup{
+		{DeviceName: TestDeviceName, ResourceName: TestDeviceResourceName, Resolution: 60000000000, ReadingAggregate: dataModels.ReadingAggregate{BucketStart: 0, Count: 1, Min: 0, Max: 0, Avg: 0, Sum: 0, First: 0, Last: 0}},
+	}
+	dbClientMock.On("ReadingRollupsByDeviceNameAndResourceNameAndTimeRange", TestDeviceName, TestDeviceResourceName, int64(0), int64(100000000000)).Return(rollups, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		resourceName       string
+		start              string
+		end                string
+		interval           string
+		errorExpected      bool
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "1m", false, len(aggregates), http.StatusOK},
+		{"Invalid - empty deviceName", "", TestDeviceResourceName, "0", "100000000000", "1m", true, 0, http.StatusBadRequest},
+		{"Invalid - empty resourceName", TestDeviceName, "", "0", "100000000000", "1m", true, 0, http.StatusBadRequest},
+		{"Invalid - invalid start format", TestDeviceName, TestDeviceResourceName, "aaa", "100000000000", "1m", true, 0, http.StatusBadRequest},
+		{"Invalid - end before start", TestDeviceName, TestDeviceResourceName, "10", "0", "1m", true, 0, http.StatusBadRequest},
+		{"Invalid - empty interval", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "", true, 0, http.StatusBadRequest},
+		{"Invalid - invalid interval format", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "aaa", true, 0, http.StatusBadRequest},
+		{"Invalid - non-positive interval", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "-1m", true, 0, http.StatusBadRequest},
+		{"Invalid - interval finer than rollup resolution", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "30s", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.Interval, testCase.interval)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.ResourceName, common.Start, common.End)
+			c.SetParamValues(testCase.deviceName, testCase.resourceName, testCase.start, testCase.end)
+			err = rc.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res dataResponses.MultiReadingAggregatesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Equal(t, testCase.interval, res.Interval, "Interval not as expected")
+				assert.Len(t, res.Aggregates, testCase.expectedCount, "Aggregate count not as expected")
+			}
+		})
+	}
+}
+
+func TestReadingsByCursor(t *testing.T) {
+	totalCount := uint32(1)
+	cursor := dataModels.Cursor{Origin: TestOriginTime, Id: "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"}
+	conds := dataModels.ReadingQueryConditions{DeviceName: TestDeviceName, Start: 0, End: math.MaxInt64}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingsByCursor", conds, dataModels.Cursor{}, 1).Return([]models.Reading{persistedReading}, nil)
+	dbClientMock.On("ReadingsByCursor", conds, cursor, 20).Return([]models.Reading{}, nil)
+	dbClientMock.On("ReadingCountByQueryConditions", conds).Return(totalCount, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name               string
+		cursor             string
+		offset             string
+		limit              string
+		skipCount          string
+		errorExpected      bool
+		expectedCount      int
+		expectedTotalCount uint32
+		expectedNextCursor string
+		expectedStatusCode int
+	}{
+		{"Valid - first page", "", "", "1", "", false, 1, totalCount, dataModels.Cursor{Origin: TestOriginTime, Id: persistedReading.Id}.Token(), http.StatusOK},
+		{"Valid - next page", cursor.Token(), "", "", "", false, 0, totalCount, "", http.StatusOK},
+		{"Valid - skip total count", cursor.Token(), "", "", "true", false, 0, 0, "", http.StatusOK},
+		{"Invalid - invalid cursor", "aaa", "", "", "", true, 0, 0, "", http.StatusBadRequest},
+		{"Invalid - cursor with offset", cursor.Token(), "1", "", "", true, 0, 0, "", http.StatusBadRequest},
+		{"Invalid - invalid skipCount", cursor.Token(), "", "", "aaa", true, 0, 0, "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiReadingByDeviceNameRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.Cursor, testCase.cursor)
+			if testCase.offset != "" {
+				query.Add(common.Offset, testCase.offset)
+			}
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			if testCase.skipCount != "" {
+				query.Add(constants.SkipCount, testCase.skipCount)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(TestDeviceName)
+			err = rc.ReadingsByDeviceName(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res dataResponses.MultiReadingsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.Len(t, res.Readings, testCase.expectedCount, "Reading count not as expected")
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+				assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+			}
+		})
+	}
+}
+
+func TestReadingsByFilter(t *testing.T) {
+	totalCount := uint32(1)
+	lower, upper := 10.0, 20.0
+	betweenConds := dataModels.ReadingQueryConditions{DeviceName: TestDeviceName, Start: 0, End: math.MaxInt64,
+		ReadingFilter: dataModels.ReadingFilter{ValueMin: &lower, ValueMinInclusive: true, ValueMax: &upper, ValueMaxInclusive: true}}
+	gtTagsConds := dataModels.ReadingQueryConditions{DeviceName: TestDeviceName, Start: 0, End: math.MaxInt64,
+		ReadingFilter: dataModels.ReadingFilter{ValueMin: &lower, Tags: map[string]string{"site": "a", "line": "1"}}}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingCountByQueryConditions", betweenConds).Return(totalCount, nil)
+	dbClientMock.On("ReadingsByQueryConditions", betweenConds, 0, 20).Return([]models.Reading{persistedReading}, nil)
+	dbClientMock.On("ReadingCountByQueryConditions", gtTagsConds).Return(totalCount, nil)
+	dbClientMock.On("ReadingsByCursor", gtTagsConds, dataModels.Cursor{}, 1).Return([]models.Reading{persistedReading}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name               string
+		query              map[string]string
+		errorExpected      bool
+		expectedCount      int
+		expectedNextCursor string
+		expectedStatusCode int
+	}{
+		{"Valid - between", map[string]string{constants.Between: "10,20"}, false, 1, "", http.StatusOK},
+		{"Valid - gt and tags with cursor", map[string]string{constants.Gt: "10", constants.Tags: "site:a,line:1", constants.Cursor: "", common.Limit: "1"},
+			false, 1, dataModels.Cursor{Origin: TestOriginTime, Id: persistedReading.Id}.Token(), http.StatusOK},
+		{"Invalid - invalid gt", map[string]string{constants.Gt: "aaa"}, true, 0, "", http.StatusBadRequest},
+		{"Invalid - between with lt", map[string]string{constants.Between: "10,20", constants.Lt: "30"}, true, 0, "", http.StatusBadRequest},
+		{"Invalid - between without max", map[string]string{constants.Between: "10"}, true, 0, "", http.StatusBadRequest},
+		{"Invalid - lower bound greater than upper bound", map[string]string{constants.Gt: "20", constants.Lt: "10"}, true, 0, "", http.StatusBadRequest},
+		{"Invalid - tag without value", map[string]string{constants.Tags: "site"}, true, 0, "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiReadingByDeviceNameRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			for k, v := range testCase.query {
+				query.Add(k, v)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(TestDeviceName)
+			err = rc.ReadingsByDeviceName(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res dataResponses.MultiReadingsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.Len(t, res.Readings, testCase.expectedCount, "Reading count not as expected")
+				assert.Equal(t, totalCount, res.TotalCount, "Total count not as expected")
+				assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+			}
+		})
+	}
+}
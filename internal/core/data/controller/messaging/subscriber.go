@@ -7,9 +7,6 @@ package messaging
 
 import (
 	"context"
-	"fmt"
-	"net/url"
-	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
@@ -18,8 +15,6 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
-	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
-	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
 
@@ -64,20 +59,12 @@ func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
 				lc.Error(e.Error())
 			case msgEnvelope := <-messages:
 				lc.Debugf("Event received from MessageBus. Topic: %s, Correlation-id: %s", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID)
-				var event requests.AddEventRequest
-				event, err = types.GetMsgPayload[requests.AddEventRequest](msgEnvelope)
+				err = app.AddEventFromMessage(msgEnvelope, ctx, dic)
 				if err != nil {
-					lc.Errorf("fail to unmarshal event, %v", err)
-					break
-				}
-				err = validateEvent(msgEnvelope.ReceivedTopic, event.Event)
-				if err != nil {
-					lc.Error(err.Error())
-					break
-				}
-				err = app.AddEvent(requests.AddEventReqToEventModel(event), ctx, dic)
-				if err != nil {
-					lc.Errorf("fail to persist the event, %v", err)
+					lc.Errorf("fail to process the event from topic %s, Correlation-id: %s, %v", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID, err)
+					if application.IsDeadLetter(err) {
+						app.AddDeadLetter(msgEnvelope, err, dic)
+					}
 				}
 			}
 		}
@@ -85,39 +72,3 @@ func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
 
 	return nil
 }
-
-func validateEvent(messageTopic string, e dtos.Event) errors.EdgeX {
-	// Parse messageTopic by the pattern `edgex/events/device/<device-service-name>/<device-profile-name>/<device-name>/<source-name>`
-	fields := strings.Split(messageTopic, "/")
-
-	// assumes a non-empty base topic with events/device/<device-service-name>/<device-profile-name>/<device-name>/<source-name>
-	if len(fields) < 6 {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid message topic %s", messageTopic), nil)
-	}
-
-	len := len(fields)
-	profileName, err := url.PathUnescape(fields[len-3])
-	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
-	}
-	deviceName, err := url.PathUnescape(fields[len-2])
-	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
-	}
-	sourceName, err := url.PathUnescape(fields[len-1])
-	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
-	}
-
-	// Check whether the event fields match the message topic
-	if e.ProfileName != profileName {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's profileName %s mismatches with the name %s received in topic", e.ProfileName, profileName), nil)
-	}
-	if e.DeviceName != deviceName {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's deviceName %s mismatches with the name %s received in topic", e.DeviceName, deviceName), nil)
-	}
-	if e.SourceName != sourceName {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's sourceName %s mismatches with the name %s received in topic", e.SourceName, sourceName), nil)
-	}
-	return nil
-}
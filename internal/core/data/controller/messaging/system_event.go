@@ -29,6 +29,11 @@ func SubscribeSystemEvents(ctx context.Context, dic *di.Container) errors.EdgeX
 		SetPath(messageBusInfo.GetBaseTopicPrefix()).SetPath(common.SystemEventPublishTopic).SetPath(common.CoreMetaDataServiceKey).
 		SetPath(common.DeviceSystemEventType).SetPath("#").BuildPath()
 	lc.Infof("Subscribing to System Events on topic: %s", deviceDeletionSystemEventTopic)
+	// device profile event edgex/system-events/core-metadata/deviceprofile/<action>/<device profile name>
+	deviceProfileSystemEventTopic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(messageBusInfo.GetBaseTopicPrefix()).SetPath(common.SystemEventPublishTopic).SetPath(common.CoreMetaDataServiceKey).
+		SetPath(common.DeviceProfileSystemEventType).SetPath("#").BuildPath()
+	lc.Infof("Subscribing to System Events on topic: %s", deviceProfileSystemEventTopic)
 
 	messages := make(chan types.MessageEnvelope, 1)
 	messageErrors := make(chan error, 1)
@@ -37,6 +42,10 @@ func SubscribeSystemEvents(ctx context.Context, dic *di.Container) errors.EdgeX
 			Topic:    deviceDeletionSystemEventTopic,
 			Messages: messages,
 		},
+		{
+			Topic:    deviceProfileSystemEventTopic,
+			Messages: messages,
+		},
 	}
 
 	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
@@ -68,6 +77,11 @@ func SubscribeSystemEvents(ctx context.Context, dic *di.Container) errors.EdgeX
 					if err != nil {
 						lc.Error(err.Error(), common.CorrelationHeader, msgEnvelope.CorrelationID)
 					}
+				case common.DeviceProfileSystemEventType:
+					err = deviceProfileSystemEventAction(systemEvent, dic)
+					if err != nil {
+						lc.Error(err.Error(), common.CorrelationHeader, msgEnvelope.CorrelationID)
+					}
 				}
 			}
 		}
@@ -95,6 +109,10 @@ func deviceSystemEventAction(systemEvent dtos.SystemEvent, dic *di.Container) er
 
 	case common.SystemEventActionDelete:
 		deviceStore.Remove(device.Name)
+		err = application.CoreDataAppFrom(dic.Get).DeleteLatestReadingsByDeviceName(device.Name, dic)
+		if err != nil {
+			lc.Errorf("Failed to remove the latest readings of the Device '%s', %v", device.Name, err)
+		}
 
 		if !dataContainer.ConfigurationFrom(dic.Get).Writable.EventPurge {
 			return nil
@@ -108,3 +126,21 @@ func deviceSystemEventAction(systemEvent dtos.SystemEvent, dic *di.Container) er
 	}
 	return nil
 }
+
+// deviceProfileSystemEventAction keeps the cached device resources used by the reading validation fresh
+func deviceProfileSystemEventAction(systemEvent dtos.SystemEvent, dic *di.Container) error {
+	var profile dtos.DeviceProfile
+	err := systemEvent.DecodeDetails(&profile)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s system event details: %s", systemEvent.Type, err.Error())
+	}
+
+	app := application.CoreDataAppFrom(dic.Get)
+	switch systemEvent.Action {
+	case common.SystemEventActionUpdate:
+		app.UpdateDeviceProfileCache(dtos.ToDeviceProfileModel(profile))
+	case common.SystemEventActionDelete:
+		app.RemoveDeviceProfileCache(profile.Name)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// ReadingAggregate contains the statistics of the numeric readings within a time bucket
+type ReadingAggregate struct {
+	BucketStart int64   `json:"bucketStart"`
+	Count       uint32  `json:"count"`
+	Min         float64 `json:"min"`
+	Max         float64 `json:"max"`
+	Avg         float64 `json:"avg"`
+	Sum         float64 `json:"sum"`
+	First       float64 `json:"first"`
+	Last        float64 `json:"last"`
+}
+
+// FromReadingAggregateModelToDTO transforms the ReadingAggregate Model to the ReadingAggregate DTO
+func FromReadingAggregateModelToDTO(a models.ReadingAggregate) ReadingAggregate {
+	return ReadingAggregate{
+		BucketStart: a.BucketStart,
+		Count:       a.Count,
+		Min:         a.Min,
+		Max:         a.Max,
+		Avg:         a.Avg,
+		Sum:         a.Sum,
+		First:       a.First,
+		Last:        a.Last,
+	}
+}
@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// DeadLetter is a message which core data failed to decode or which doesn't conform to the contracts, the Payload is the
+// raw message payload encoded in base64
+type DeadLetter struct {
+	Id            string `json:"id"`
+	Created       int64  `json:"created"`
+	Topic         string `json:"topic"`
+	CorrelationId string `json:"correlationId,omitempty"`
+	ContentType   string `json:"contentType,omitempty"`
+	Payload       []byte `json:"payload"`
+	Error         string `json:"error"`
+}
+
+// FromDeadLetterModelToDTO transforms the DeadLetter Model to the DeadLetter DTO
+func FromDeadLetterModelToDTO(d models.DeadLetter) DeadLetter {
+	return DeadLetter{
+		Id:            d.Id,
+		Created:       d.Created,
+		Topic:         d.Topic,
+		CorrelationId: d.CorrelationId,
+		ContentType:   d.ContentType,
+		Payload:       d.Payload,
+		Error:         d.Error,
+	}
+}
@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+)
+
+// MultiReadingAggregatesResponse defines the Response Content for GET multiple reading aggregate DTOs.
+type MultiReadingAggregatesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	DeviceName                        string                  `json:"deviceName"`
+	ResourceName                      string                  `json:"resourceName"`
+	Interval                          string                  `json:"interval"`
+	Aggregates                        []dtos.ReadingAggregate `json:"aggregates"`
+}
+
+func NewMultiReadingAggregatesResponse(requestId string, message string, statusCode int, deviceName string, resourceName string,
+	interval string, aggregates []dtos.ReadingAggregate) MultiReadingAggregatesResponse {
+	return MultiReadingAggregatesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, uint32(len(aggregates))),
+		DeviceName:                 deviceName,
+		ResourceName:               resourceName,
+		Interval:                   interval,
+		Aggregates:                 aggregates,
+	}
+}
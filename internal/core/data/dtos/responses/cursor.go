@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+)
+
+// MultiEventsResponse extends the MultiEventsResponse of go-mod-core-contracts with the continuation token of the
+// cursor-based pagination
+type MultiEventsResponse struct {
+	responses.MultiEventsResponse `json:",inline"`
+	NextCursor                    string `json:"nextCursor,omitempty"`
+}
+
+// MultiReadingsResponse extends the MultiReadingsResponse of go-mod-core-contracts with the continuation token of the
+// cursor-based pagination
+type MultiReadingsResponse struct {
+	responses.MultiReadingsResponse `json:",inline"`
+	NextCursor                      string `json:"nextCursor,omitempty"`
+}
+
+func NewMultiEventsResponse(requestId string, message string, statusCode int, totalCount uint32, events []dtos.Event, nextCursor string) MultiEventsResponse {
+	return MultiEventsResponse{
+		MultiEventsResponse: responses.NewMultiEventsResponse(requestId, message, statusCode, totalCount, events),
+		NextCursor:          nextCursor,
+	}
+}
+
+func NewMultiReadingsResponse(requestId string, message string, statusCode int, totalCount uint32, readings []dtos.BaseReading, nextCursor string) MultiReadingsResponse {
+	return MultiReadingsResponse{
+		MultiReadingsResponse: responses.NewMultiReadingsResponse(requestId, message, statusCode, totalCount, readings),
+		NextCursor:            nextCursor,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+)
+
+// DeadLetterResponse defines the Response Content for GET dead letter DTO.
+type DeadLetterResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeadLetter          dtos.DeadLetter `json:"deadLetter"`
+}
+
+func NewDeadLetterResponse(requestId string, message string, statusCode int, deadLetter dtos.DeadLetter) DeadLetterResponse {
+	return DeadLetterResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		DeadLetter:   deadLetter,
+	}
+}
+
+// MultiDeadLettersResponse defines the Response Content for GET multiple dead letter DTOs.
+type MultiDeadLettersResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	DeadLetters                       []dtos.DeadLetter `json:"deadLetters"`
+}
+
+func NewMultiDeadLettersResponse(requestId string, message string, statusCode int, totalCount uint32, deadLetters []dtos.DeadLetter) MultiDeadLettersResponse {
+	return MultiDeadLettersResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		DeadLetters:                deadLetters,
+	}
+}
@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// The retention rules which purge the events and readings, named after the fields of the Retention configuration
+const (
+	RetentionRuleMaxAge          = "MaxAge"
+	RetentionRuleMaxEventCount   = "MaxEventCount"
+	RetentionRuleMaxReadingCount = "MaxReadingCount"
+)
+
+// RetentionPurge describes the events and readings purged by a retention rule, and it is published as the details of
+// the retention purge system event. The database clients delete the events and readings asynchronously, so the counts
+// are the events and readings matched by the rule when the purge is requested rather than the ones eventually deleted.
+type RetentionPurge struct {
+	Rule                string `json:"rule"`
+	DeviceName          string `json:"deviceName,omitempty"`
+	Before              int64  `json:"before"`
+	MatchedEventCount   uint32 `json:"matchedEventCount"`
+	MatchedReadingCount uint32 `json:"matchedReadingCount"`
+}
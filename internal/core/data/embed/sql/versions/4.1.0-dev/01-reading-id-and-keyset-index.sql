@@ -0,0 +1,14 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_data.reading.id stores the reading id, the existing readings are assigned with random ids
+ALTER TABLE core_data.reading ADD COLUMN IF NOT EXISTS id UUID DEFAULT gen_random_uuid();
+
+-- the (origin, id) indexes are used by the cursor-based pagination of events and readings
+CREATE INDEX IF NOT EXISTS idx_event_origin_id
+    ON core_data.event(origin, id);
+
+CREATE INDEX IF NOT EXISTS idx_reading_origin_id
+    ON core_data.reading(origin, id);
@@ -0,0 +1,26 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_data.reading_rollup is used to store the statistics of the aged numeric readings per time bucket, which replace the raw readings
+CREATE TABLE IF NOT EXISTS core_data.reading_rollup (
+    devicename TEXT NOT NULL,
+    profilename TEXT,
+    resourcename TEXT NOT NULL,
+    valuetype TEXT DEFAULT '',
+    units TEXT DEFAULT '',
+    resolution BIGINT NOT NULL,
+    bucketstart BIGINT NOT NULL,
+    count BIGINT NOT NULL,
+    min DOUBLE PRECISION,
+    max DOUBLE PRECISION,
+    avg DOUBLE PRECISION,
+    sum DOUBLE PRECISION,
+    first DOUBLE PRECISION,
+    last DOUBLE PRECISION,
+    UNIQUE (devicename, resourcename, resolution, bucketstart)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reading_rollup_bucketstart
+    ON core_data.reading_rollup(bucketstart);
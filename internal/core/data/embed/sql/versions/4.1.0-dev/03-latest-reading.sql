@@ -0,0 +1,13 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_data.latest_reading is used to persist the last known reading of each device resource
+CREATE TABLE IF NOT EXISTS core_data.latest_reading (
+    devicename TEXT NOT NULL,
+    resourcename TEXT NOT NULL,
+    origin BIGINT NOT NULL,
+    content JSONB NOT NULL,
+    PRIMARY KEY (devicename, resourcename)
+);
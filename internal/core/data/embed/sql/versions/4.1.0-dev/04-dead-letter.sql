@@ -0,0 +1,18 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_data.dead_letter is used to store the messages which core data failed to decode or which do not conform to the contracts
+CREATE TABLE IF NOT EXISTS core_data.dead_letter (
+    id UUID PRIMARY KEY,
+    created BIGINT NOT NULL,
+    topic TEXT NOT NULL,
+    correlationid TEXT,
+    contenttype TEXT,
+    payload BYTEA,
+    error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letter_created
+    ON core_data.dead_letter(created);
@@ -1,19 +1,24 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package interfaces
 
 import (
+	"context"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 )
 
 type DBClient interface {
 	CloseSession()
 
 	AddEvent(e model.Event) (model.Event, errors.EdgeX)
+	AddEvents(events []model.Event) ([]model.Event, errors.EdgeX)
 	EventById(id string) (model.Event, errors.EdgeX)
 	DeleteEventById(id string) errors.EdgeX
 	EventTotalCount() (uint32, errors.EdgeX)
@@ -27,6 +32,8 @@ type DBClient interface {
 	EventsByTimeRange(start int64, end int64, offset int, limit int) ([]model.Event, errors.EdgeX)
 	DeleteEventsByAge(age int64) errors.EdgeX
 	DeleteEventsByAgeAndDeviceNameAndSourceName(age int64, deviceName, sourceName string) errors.EdgeX
+	DeleteEventsByAgeAndDeviceName(age int64, deviceName string) errors.EdgeX
+	DeleteEventsByAgeAndExcludedDeviceNames(age int64, deviceNames []string) errors.EdgeX
 	ReadingTotalCount() (uint32, errors.EdgeX)
 	AllReadings(offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingsByTimeRange(start int64, end int64, offset int, limit int) ([]model.Reading, errors.EdgeX)
@@ -46,6 +53,26 @@ type DBClient interface {
 	ReadingsByDeviceNameAndTimeRange(deviceName string, start int64, end int64, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingCountByDeviceNameAndTimeRange(deviceName string, start int64, end int64) (uint32, errors.EdgeX)
 	LatestReadingByOffset(offset uint32) (model.Reading, errors.EdgeX)
+	ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval int64) ([]dataModels.ReadingAggregate, errors.EdgeX)
+	EventsByCursor(conds dataModels.EventQueryConditions, cursor dataModels.Cursor, limit int) ([]model.Event, errors.EdgeX)
+	EventCountByQueryConditions(conds dataModels.EventQueryConditions) (uint32, errors.EdgeX)
+	ReadingsByCursor(conds dataModels.ReadingQueryConditions, cursor dataModels.Cursor, limit int) ([]model.Reading, errors.EdgeX)
+	ReadingsByQueryConditions(conds dataModels.ReadingQueryConditions, offset int, limit int) ([]model.Reading, errors.EdgeX)
+	ReadingCountByQueryConditions(conds dataModels.ReadingQueryConditions) (uint32, errors.EdgeX)
+	StreamEvents(ctx context.Context, conds dataModels.EventQueryConditions, handler func(model.Event) error) errors.EdgeX
+	StreamReadings(ctx context.Context, conds dataModels.ReadingQueryConditions, handler func(model.Reading) error) errors.EdgeX
+	RollupReadings(before int64, resolution int64) (uint32, errors.EdgeX)
+	ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64) ([]dataModels.ReadingRollup, errors.EdgeX)
+	DeleteReadingRollupsByAge(age int64) errors.EdgeX
+	UpsertLatestReadings(readings []model.Reading) errors.EdgeX
+	AllLatestReadings() ([]model.Reading, errors.EdgeX)
+	DeleteLatestReadingsByDeviceName(deviceName string) errors.EdgeX
+	AddDeadLetter(d dataModels.DeadLetter) (dataModels.DeadLetter, errors.EdgeX)
+	DeadLetterById(id string) (dataModels.DeadLetter, errors.EdgeX)
+	AllDeadLetters(offset int, limit int) ([]dataModels.DeadLetter, errors.EdgeX)
+	DeadLetterTotalCount() (uint32, errors.EdgeX)
+	DeleteDeadLetterById(id string) errors.EdgeX
+	TrimDeadLetters(maxCount uint32) errors.EdgeX
 	LatestEventByDeviceNameAndSourceNameAndOffset(deviceName string, sourceName string, offset uint32) (model.Event, errors.EdgeX)
 	LatestEventByDeviceNameAndSourceNameAndAgeAndOffset(deviceName string, sourceName string, age int64, offset uint32) (model.Event, errors.EdgeX)
 }
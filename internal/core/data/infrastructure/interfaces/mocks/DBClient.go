@@ -3,11 +3,15 @@
 package mocks
 
 import (
+	context "context"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
 
-	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	models "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
+	v4models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -15,26 +19,56 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddDeadLetter provides a mock function with given fields: d
+func (_m *DBClient) AddDeadLetter(d models.DeadLetter) (models.DeadLetter, errors.EdgeX) {
+	ret := _m.Called(d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDeadLetter")
+	}
+
+	var r0 models.DeadLetter
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.DeadLetter) (models.DeadLetter, errors.EdgeX)); ok {
+		return rf(d)
+	}
+	if rf, ok := ret.Get(0).(func(models.DeadLetter) models.DeadLetter); ok {
+		r0 = rf(d)
+	} else {
+		r0 = ret.Get(0).(models.DeadLetter)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.DeadLetter) errors.EdgeX); ok {
+		r1 = rf(d)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddEvent provides a mock function with given fields: e
-func (_m *DBClient) AddEvent(e models.Event) (models.Event, errors.EdgeX) {
+func (_m *DBClient) AddEvent(e v4models.Event) (v4models.Event, errors.EdgeX) {
 	ret := _m.Called(e)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddEvent")
 	}
 
-	var r0 models.Event
+	var r0 v4models.Event
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.Event) (models.Event, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(v4models.Event) (v4models.Event, errors.EdgeX)); ok {
 		return rf(e)
 	}
-	if rf, ok := ret.Get(0).(func(models.Event) models.Event); ok {
+	if rf, ok := ret.Get(0).(func(v4models.Event) v4models.Event); ok {
 		r0 = rf(e)
 	} else {
-		r0 = ret.Get(0).(models.Event)
+		r0 = ret.Get(0).(v4models.Event)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Event) errors.EdgeX); ok {
+	if rf, ok := ret.Get(1).(func(v4models.Event) errors.EdgeX); ok {
 		r1 = rf(e)
 	} else {
 		if ret.Get(1) != nil {
@@ -45,24 +79,88 @@ func (_m *DBClient) AddEvent(e models.Event) (models.Event, errors.EdgeX) {
 	return r0, r1
 }
 
+// AddEvents provides a mock function with given fields: events
+func (_m *DBClient) AddEvents(events []v4models.Event) ([]v4models.Event, errors.EdgeX) {
+	ret := _m.Called(events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEvents")
+	}
+
+	var r0 []v4models.Event
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]v4models.Event) ([]v4models.Event, errors.EdgeX)); ok {
+		return rf(events)
+	}
+	if rf, ok := ret.Get(0).(func([]v4models.Event) []v4models.Event); ok {
+		r0 = rf(events)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v4models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]v4models.Event) errors.EdgeX); ok {
+		r1 = rf(events)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllDeadLetters provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeadLetters(offset int, limit int) ([]models.DeadLetter, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllDeadLetters")
+	}
+
+	var r0 []models.DeadLetter
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]models.DeadLetter, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []models.DeadLetter); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeadLetter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllEvents provides a mock function with given fields: offset, limit
-func (_m *DBClient) AllEvents(offset int, limit int) ([]models.Event, errors.EdgeX) {
+func (_m *DBClient) AllEvents(offset int, limit int) ([]v4models.Event, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AllEvents")
 	}
 
-	var r0 []models.Event
+	var r0 []v4models.Event
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int) ([]models.Event, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int) ([]v4models.Event, errors.EdgeX)); ok {
 		return rf(offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []models.Event); ok {
+	if rf, ok := ret.Get(0).(func(int, int) []v4models.Event); ok {
 		r0 = rf(offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Event)
+			r0 = ret.Get(0).([]v4models.Event)
 		}
 	}
 
@@ -77,24 +175,56 @@ func (_m *DBClient) AllEvents(offset int, limit int) ([]models.Event, errors.Edg
 	return r0, r1
 }
 
+// AllLatestReadings provides a mock function with given fields:
+func (_m *DBClient) AllLatestReadings() ([]v4models.Reading, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllLatestReadings")
+	}
+
+	var r0 []v4models.Reading
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() ([]v4models.Reading, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []v4models.Reading); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v4models.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllReadings provides a mock function with given fields: offset, limit
-func (_m *DBClient) AllReadings(offset int, limit int) ([]models.Reading, errors.EdgeX) {
+func (_m *DBClient) AllReadings(offset int, limit int) ([]v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AllReadings")
 	}
 
-	var r0 []models.Reading
+	var r0 []v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int) ([]models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int) ([]v4models.Reading, errors.EdgeX)); ok {
 		return rf(offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(int, int) []v4models.Reading); ok {
 		r0 = rf(offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Reading)
+			r0 = ret.Get(0).([]v4models.Reading)
 		}
 	}
 
@@ -114,6 +244,86 @@ func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeadLetterById provides a mock function with given fields: id
+func (_m *DBClient) DeadLetterById(id string) (models.DeadLetter, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterById")
+	}
+
+	var r0 models.DeadLetter
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (models.DeadLetter, errors.EdgeX)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.DeadLetter); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(models.DeadLetter)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeadLetterTotalCount provides a mock function with given fields:
+func (_m *DBClient) DeadLetterTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterTotalCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() (uint32, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDeadLetterById provides a mock function with given fields: id
+func (_m *DBClient) DeleteDeadLetterById(id string) errors.EdgeX {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeadLetterById")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteEventById provides a mock function with given fields: id
 func (_m *DBClient) DeleteEventById(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -154,6 +364,26 @@ func (_m *DBClient) DeleteEventsByAge(age int64) errors.EdgeX {
 	return r0
 }
 
+// DeleteEventsByAgeAndDeviceName provides a mock function with given fields: age, deviceName
+func (_m *DBClient) DeleteEventsByAgeAndDeviceName(age int64, deviceName string) errors.EdgeX {
+	ret := _m.Called(age, deviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEventsByAgeAndDeviceName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, string) errors.EdgeX); ok {
+		r0 = rf(age, deviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteEventsByAgeAndDeviceNameAndSourceName provides a mock function with given fields: age, deviceName, sourceName
 func (_m *DBClient) DeleteEventsByAgeAndDeviceNameAndSourceName(age int64, deviceName string, sourceName string) errors.EdgeX {
 	ret := _m.Called(age, deviceName, sourceName)
@@ -174,6 +404,26 @@ func (_m *DBClient) DeleteEventsByAgeAndDeviceNameAndSourceName(age int64, devic
 	return r0
 }
 
+// DeleteEventsByAgeAndExcludedDeviceNames provides a mock function with given fields: age, deviceNames
+func (_m *DBClient) DeleteEventsByAgeAndExcludedDeviceNames(age int64, deviceNames []string) errors.EdgeX {
+	ret := _m.Called(age, deviceNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEventsByAgeAndExcludedDeviceNames")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, []string) errors.EdgeX); ok {
+		r0 = rf(age, deviceNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteEventsByDeviceName provides a mock function with given fields: deviceName
 func (_m *DBClient) DeleteEventsByDeviceName(deviceName string) errors.EdgeX {
 	ret := _m.Called(deviceName)
@@ -214,23 +464,63 @@ func (_m *DBClient) DeleteEventsByDeviceNameAndSourceName(deviceName string, sou
 	return r0
 }
 
+// DeleteLatestReadingsByDeviceName provides a mock function with given fields: deviceName
+func (_m *DBClient) DeleteLatestReadingsByDeviceName(deviceName string) errors.EdgeX {
+	ret := _m.Called(deviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLatestReadingsByDeviceName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(deviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteReadingRollupsByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteReadingRollupsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReadingRollupsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // EventById provides a mock function with given fields: id
-func (_m *DBClient) EventById(id string) (models.Event, errors.EdgeX) {
+func (_m *DBClient) EventById(id string) (v4models.Event, errors.EdgeX) {
 	ret := _m.Called(id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EventById")
 	}
 
-	var r0 models.Event
+	var r0 v4models.Event
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) (models.Event, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string) (v4models.Event, errors.EdgeX)); ok {
 		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.Event); ok {
+	if rf, ok := ret.Get(0).(func(string) v4models.Event); ok {
 		r0 = rf(id)
 	} else {
-		r0 = ret.Get(0).(models.Event)
+		r0 = ret.Get(0).(v4models.Event)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
@@ -304,6 +594,36 @@ func (_m *DBClient) EventCountByDeviceNameAndSourceNameAndLimit(deviceName strin
 	return r0, r1
 }
 
+// EventCountByQueryConditions provides a mock function with given fields: conds
+func (_m *DBClient) EventCountByQueryConditions(conds models.EventQueryConditions) (uint32, errors.EdgeX) {
+	ret := _m.Called(conds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EventCountByQueryConditions")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.EventQueryConditions) (uint32, errors.EdgeX)); ok {
+		return rf(conds)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventQueryConditions) uint32); ok {
+		r0 = rf(conds)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventQueryConditions) errors.EdgeX); ok {
+		r1 = rf(conds)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventCountByTimeRange provides a mock function with given fields: start, end
 func (_m *DBClient) EventCountByTimeRange(start int64, end int64) (uint32, errors.EdgeX) {
 	ret := _m.Called(start, end)
@@ -364,24 +684,56 @@ func (_m *DBClient) EventTotalCount() (uint32, errors.EdgeX) {
 	return r0, r1
 }
 
+// EventsByCursor provides a mock function with given fields: conds, cursor, limit
+func (_m *DBClient) EventsByCursor(conds models.EventQueryConditions, cursor models.Cursor, limit int) ([]v4models.Event, errors.EdgeX) {
+	ret := _m.Called(conds, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EventsByCursor")
+	}
+
+	var r0 []v4models.Event
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.EventQueryConditions, models.Cursor, int) ([]v4models.Event, errors.EdgeX)); ok {
+		return rf(conds, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventQueryConditions, models.Cursor, int) []v4models.Event); ok {
+		r0 = rf(conds, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v4models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventQueryConditions, models.Cursor, int) errors.EdgeX); ok {
+		r1 = rf(conds, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventsByDeviceName provides a mock function with given fields: offset, limit, name
-func (_m *DBClient) EventsByDeviceName(offset int, limit int, name string) ([]models.Event, errors.EdgeX) {
+func (_m *DBClient) EventsByDeviceName(offset int, limit int, name string) ([]v4models.Event, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EventsByDeviceName")
 	}
 
-	var r0 []models.Event
+	var r0 []v4models.Event
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.Event, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]v4models.Event, errors.EdgeX)); ok {
 		return rf(offset, limit, name)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.Event); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) []v4models.Event); ok {
 		r0 = rf(offset, limit, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Event)
+			r0 = ret.Get(0).([]v4models.Event)
 		}
 	}
 
@@ -397,23 +749,23 @@ func (_m *DBClient) EventsByDeviceName(offset int, limit int, name string) ([]mo
 }
 
 // EventsByTimeRange provides a mock function with given fields: start, end, offset, limit
-func (_m *DBClient) EventsByTimeRange(start int64, end int64, offset int, limit int) ([]models.Event, errors.EdgeX) {
+func (_m *DBClient) EventsByTimeRange(start int64, end int64, offset int, limit int) ([]v4models.Event, errors.EdgeX) {
 	ret := _m.Called(start, end, offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EventsByTimeRange")
 	}
 
-	var r0 []models.Event
+	var r0 []v4models.Event
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int64, int64, int, int) ([]models.Event, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int64, int64, int, int) ([]v4models.Event, errors.EdgeX)); ok {
 		return rf(start, end, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(int64, int64, int, int) []models.Event); ok {
+	if rf, ok := ret.Get(0).(func(int64, int64, int, int) []v4models.Event); ok {
 		r0 = rf(start, end, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Event)
+			r0 = ret.Get(0).([]v4models.Event)
 		}
 	}
 
@@ -429,22 +781,22 @@ func (_m *DBClient) EventsByTimeRange(start int64, end int64, offset int, limit
 }
 
 // LatestEventByDeviceNameAndSourceNameAndAgeAndOffset provides a mock function with given fields: deviceName, sourceName, age, offset
-func (_m *DBClient) LatestEventByDeviceNameAndSourceNameAndAgeAndOffset(deviceName string, sourceName string, age int64, offset uint32) (models.Event, errors.EdgeX) {
+func (_m *DBClient) LatestEventByDeviceNameAndSourceNameAndAgeAndOffset(deviceName string, sourceName string, age int64, offset uint32) (v4models.Event, errors.EdgeX) {
 	ret := _m.Called(deviceName, sourceName, age, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for LatestEventByDeviceNameAndSourceNameAndAgeAndOffset")
 	}
 
-	var r0 models.Event
+	var r0 v4models.Event
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string, string, int64, uint32) (models.Event, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string, string, int64, uint32) (v4models.Event, errors.EdgeX)); ok {
 		return rf(deviceName, sourceName, age, offset)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, int64, uint32) models.Event); ok {
+	if rf, ok := ret.Get(0).(func(string, string, int64, uint32) v4models.Event); ok {
 		r0 = rf(deviceName, sourceName, age, offset)
 	} else {
-		r0 = ret.Get(0).(models.Event)
+		r0 = ret.Get(0).(v4models.Event)
 	}
 
 	if rf, ok := ret.Get(1).(func(string, string, int64, uint32) errors.EdgeX); ok {
@@ -459,22 +811,22 @@ func (_m *DBClient) LatestEventByDeviceNameAndSourceNameAndAgeAndOffset(deviceNa
 }
 
 // LatestEventByDeviceNameAndSourceNameAndOffset provides a mock function with given fields: deviceName, sourceName, offset
-func (_m *DBClient) LatestEventByDeviceNameAndSourceNameAndOffset(deviceName string, sourceName string, offset uint32) (models.Event, errors.EdgeX) {
+func (_m *DBClient) LatestEventByDeviceNameAndSourceNameAndOffset(deviceName string, sourceName string, offset uint32) (v4models.Event, errors.EdgeX) {
 	ret := _m.Called(deviceName, sourceName, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for LatestEventByDeviceNameAndSourceNameAndOffset")
 	}
 
-	var r0 models.Event
+	var r0 v4models.Event
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string, string, uint32) (models.Event, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string, string, uint32) (v4models.Event, errors.EdgeX)); ok {
 		return rf(deviceName, sourceName, offset)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, uint32) models.Event); ok {
+	if rf, ok := ret.Get(0).(func(string, string, uint32) v4models.Event); ok {
 		r0 = rf(deviceName, sourceName, offset)
 	} else {
-		r0 = ret.Get(0).(models.Event)
+		r0 = ret.Get(0).(v4models.Event)
 	}
 
 	if rf, ok := ret.Get(1).(func(string, string, uint32) errors.EdgeX); ok {
@@ -489,23 +841,23 @@ func (_m *DBClient) LatestEventByDeviceNameAndSourceNameAndOffset(deviceName str
 }
 
 // LatestReadingByOffset provides a mock function with given fields: offset
-func (_m *DBClient) LatestReadingByOffset(offset uint32) (models.Reading, errors.EdgeX) {
+func (_m *DBClient) LatestReadingByOffset(offset uint32) (v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for LatestReadingByOffset")
 	}
 
-	var r0 models.Reading
+	var r0 v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(uint32) (models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(uint32) (v4models.Reading, errors.EdgeX)); ok {
 		return rf(offset)
 	}
-	if rf, ok := ret.Get(0).(func(uint32) models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(uint32) v4models.Reading); ok {
 		r0 = rf(offset)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(models.Reading)
+			r0 = ret.Get(0).(v4models.Reading)
 		}
 	}
 
@@ -520,6 +872,38 @@ func (_m *DBClient) LatestReadingByOffset(offset uint32) (models.Reading, errors
 	return r0, r1
 }
 
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange provides a mock function with given fields: deviceName, resourceName, start, end, interval
+func (_m *DBClient) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval int64) ([]models.ReadingAggregate, errors.EdgeX) {
+	ret := _m.Called(deviceName, resourceName, start, end, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange")
+	}
+
+	var r0 []models.ReadingAggregate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int64) ([]models.ReadingAggregate, errors.EdgeX)); ok {
+		return rf(deviceName, resourceName, start, end, interval)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int64) []models.ReadingAggregate); ok {
+		r0 = rf(deviceName, resourceName, start, end, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReadingAggregate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64, int64, int64) errors.EdgeX); ok {
+		r1 = rf(deviceName, resourceName, start, end, interval)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingCountByDeviceName provides a mock function with given fields: deviceName
 func (_m *DBClient) ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX) {
 	ret := _m.Called(deviceName)
@@ -670,6 +1054,36 @@ func (_m *DBClient) ReadingCountByDeviceNameAndTimeRange(deviceName string, star
 	return r0, r1
 }
 
+// ReadingCountByQueryConditions provides a mock function with given fields: conds
+func (_m *DBClient) ReadingCountByQueryConditions(conds models.ReadingQueryConditions) (uint32, errors.EdgeX) {
+	ret := _m.Called(conds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingCountByQueryConditions")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.ReadingQueryConditions) (uint32, errors.EdgeX)); ok {
+		return rf(conds)
+	}
+	if rf, ok := ret.Get(0).(func(models.ReadingQueryConditions) uint32); ok {
+		r0 = rf(conds)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ReadingQueryConditions) errors.EdgeX); ok {
+		r1 = rf(conds)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingCountByResourceName provides a mock function with given fields: resourceName
 func (_m *DBClient) ReadingCountByResourceName(resourceName string) (uint32, errors.EdgeX) {
 	ret := _m.Called(resourceName)
@@ -760,6 +1174,38 @@ func (_m *DBClient) ReadingCountByTimeRange(start int64, end int64) (uint32, err
 	return r0, r1
 }
 
+// ReadingRollupsByDeviceNameAndResourceNameAndTimeRange provides a mock function with given fields: deviceName, resourceName, start, end
+func (_m *DBClient) ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64) ([]models.ReadingRollup, errors.EdgeX) {
+	ret := _m.Called(deviceName, resourceName, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingRollupsByDeviceNameAndResourceNameAndTimeRange")
+	}
+
+	var r0 []models.ReadingRollup
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64) ([]models.ReadingRollup, errors.EdgeX)); ok {
+		return rf(deviceName, resourceName, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64) []models.ReadingRollup); ok {
+		r0 = rf(deviceName, resourceName, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReadingRollup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64, int64) errors.EdgeX); ok {
+		r1 = rf(deviceName, resourceName, start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingTotalCount provides a mock function with given fields:
 func (_m *DBClient) ReadingTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -790,24 +1236,56 @@ func (_m *DBClient) ReadingTotalCount() (uint32, errors.EdgeX) {
 	return r0, r1
 }
 
+// ReadingsByCursor provides a mock function with given fields: conds, cursor, limit
+func (_m *DBClient) ReadingsByCursor(conds models.ReadingQueryConditions, cursor models.Cursor, limit int) ([]v4models.Reading, errors.EdgeX) {
+	ret := _m.Called(conds, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingsByCursor")
+	}
+
+	var r0 []v4models.Reading
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.ReadingQueryConditions, models.Cursor, int) ([]v4models.Reading, errors.EdgeX)); ok {
+		return rf(conds, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(models.ReadingQueryConditions, models.Cursor, int) []v4models.Reading); ok {
+		r0 = rf(conds, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v4models.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ReadingQueryConditions, models.Cursor, int) errors.EdgeX); ok {
+		r1 = rf(conds, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingsByDeviceName provides a mock function with given fields: offset, limit, name
-func (_m *DBClient) ReadingsByDeviceName(offset int, limit int, name string) ([]models.Reading, errors.EdgeX) {
+func (_m *DBClient) ReadingsByDeviceName(offset int, limit int, name string) ([]v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadingsByDeviceName")
 	}
 
-	var r0 []models.Reading
+	var r0 []v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]v4models.Reading, errors.EdgeX)); ok {
 		return rf(offset, limit, name)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) []v4models.Reading); ok {
 		r0 = rf(offset, limit, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Reading)
+			r0 = ret.Get(0).([]v4models.Reading)
 		}
 	}
 
@@ -823,23 +1301,23 @@ func (_m *DBClient) ReadingsByDeviceName(offset int, limit int, name string) ([]
 }
 
 // ReadingsByDeviceNameAndResourceName provides a mock function with given fields: deviceName, resourceName, offset, limit
-func (_m *DBClient) ReadingsByDeviceNameAndResourceName(deviceName string, resourceName string, offset int, limit int) ([]models.Reading, errors.EdgeX) {
+func (_m *DBClient) ReadingsByDeviceNameAndResourceName(deviceName string, resourceName string, offset int, limit int) ([]v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(deviceName, resourceName, offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadingsByDeviceNameAndResourceName")
 	}
 
-	var r0 []models.Reading
+	var r0 []v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]v4models.Reading, errors.EdgeX)); ok {
 		return rf(deviceName, resourceName, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, int, int) []models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []v4models.Reading); ok {
 		r0 = rf(deviceName, resourceName, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Reading)
+			r0 = ret.Get(0).([]v4models.Reading)
 		}
 	}
 
@@ -855,23 +1333,23 @@ func (_m *DBClient) ReadingsByDeviceNameAndResourceName(deviceName string, resou
 }
 
 // ReadingsByDeviceNameAndResourceNameAndTimeRange provides a mock function with given fields: deviceName, resourceName, start, end, offset, limit
-func (_m *DBClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, offset int, limit int) ([]models.Reading, errors.EdgeX) {
+func (_m *DBClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, offset int, limit int) ([]v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(deviceName, resourceName, start, end, offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadingsByDeviceNameAndResourceNameAndTimeRange")
 	}
 
-	var r0 []models.Reading
+	var r0 []v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int, int) ([]models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int, int) ([]v4models.Reading, errors.EdgeX)); ok {
 		return rf(deviceName, resourceName, start, end, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int, int) []models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int, int) []v4models.Reading); ok {
 		r0 = rf(deviceName, resourceName, start, end, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Reading)
+			r0 = ret.Get(0).([]v4models.Reading)
 		}
 	}
 
@@ -887,23 +1365,23 @@ func (_m *DBClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName s
 }
 
 // ReadingsByDeviceNameAndResourceNamesAndTimeRange provides a mock function with given fields: deviceName, resourceNames, start, end, offset, limit
-func (_m *DBClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName string, resourceNames []string, start int64, end int64, offset int, limit int) ([]models.Reading, errors.EdgeX) {
+func (_m *DBClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName string, resourceNames []string, start int64, end int64, offset int, limit int) ([]v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(deviceName, resourceNames, start, end, offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadingsByDeviceNameAndResourceNamesAndTimeRange")
 	}
 
-	var r0 []models.Reading
+	var r0 []v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string, []string, int64, int64, int, int) ([]models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string, []string, int64, int64, int, int) ([]v4models.Reading, errors.EdgeX)); ok {
 		return rf(deviceName, resourceNames, start, end, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(string, []string, int64, int64, int, int) []models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(string, []string, int64, int64, int, int) []v4models.Reading); ok {
 		r0 = rf(deviceName, resourceNames, start, end, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Reading)
+			r0 = ret.Get(0).([]v4models.Reading)
 		}
 	}
 
@@ -919,23 +1397,23 @@ func (_m *DBClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName
 }
 
 // ReadingsByDeviceNameAndTimeRange provides a mock function with given fields: deviceName, start, end, offset, limit
-func (_m *DBClient) ReadingsByDeviceNameAndTimeRange(deviceName string, start int64, end int64, offset int, limit int) ([]models.Reading, errors.EdgeX) {
+func (_m *DBClient) ReadingsByDeviceNameAndTimeRange(deviceName string, start int64, end int64, offset int, limit int) ([]v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(deviceName, start, end, offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadingsByDeviceNameAndTimeRange")
 	}
 
-	var r0 []models.Reading
+	var r0 []v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) ([]models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) ([]v4models.Reading, errors.EdgeX)); ok {
 		return rf(deviceName, start, end, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) []models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) []v4models.Reading); ok {
 		r0 = rf(deviceName, start, end, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Reading)
+			r0 = ret.Get(0).([]v4models.Reading)
 		}
 	}
 
@@ -950,24 +1428,56 @@ func (_m *DBClient) ReadingsByDeviceNameAndTimeRange(deviceName string, start in
 	return r0, r1
 }
 
+// ReadingsByQueryConditions provides a mock function with given fields: conds, offset, limit
+func (_m *DBClient) ReadingsByQueryConditions(conds models.ReadingQueryConditions, offset int, limit int) ([]v4models.Reading, errors.EdgeX) {
+	ret := _m.Called(conds, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingsByQueryConditions")
+	}
+
+	var r0 []v4models.Reading
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.ReadingQueryConditions, int, int) ([]v4models.Reading, errors.EdgeX)); ok {
+		return rf(conds, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(models.ReadingQueryConditions, int, int) []v4models.Reading); ok {
+		r0 = rf(conds, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v4models.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ReadingQueryConditions, int, int) errors.EdgeX); ok {
+		r1 = rf(conds, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingsByResourceName provides a mock function with given fields: offset, limit, resourceName
-func (_m *DBClient) ReadingsByResourceName(offset int, limit int, resourceName string) ([]models.Reading, errors.EdgeX) {
+func (_m *DBClient) ReadingsByResourceName(offset int, limit int, resourceName string) ([]v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(offset, limit, resourceName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadingsByResourceName")
 	}
 
-	var r0 []models.Reading
+	var r0 []v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]v4models.Reading, errors.EdgeX)); ok {
 		return rf(offset, limit, resourceName)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) []v4models.Reading); ok {
 		r0 = rf(offset, limit, resourceName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Reading)
+			r0 = ret.Get(0).([]v4models.Reading)
 		}
 	}
 
@@ -983,23 +1493,23 @@ func (_m *DBClient) ReadingsByResourceName(offset int, limit int, resourceName s
 }
 
 // ReadingsByResourceNameAndTimeRange provides a mock function with given fields: resourceName, start, end, offset, limit
-func (_m *DBClient) ReadingsByResourceNameAndTimeRange(resourceName string, start int64, end int64, offset int, limit int) ([]models.Reading, errors.EdgeX) {
+func (_m *DBClient) ReadingsByResourceNameAndTimeRange(resourceName string, start int64, end int64, offset int, limit int) ([]v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(resourceName, start, end, offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadingsByResourceNameAndTimeRange")
 	}
 
-	var r0 []models.Reading
+	var r0 []v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) ([]models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) ([]v4models.Reading, errors.EdgeX)); ok {
 		return rf(resourceName, start, end, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) []models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) []v4models.Reading); ok {
 		r0 = rf(resourceName, start, end, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Reading)
+			r0 = ret.Get(0).([]v4models.Reading)
 		}
 	}
 
@@ -1015,23 +1525,23 @@ func (_m *DBClient) ReadingsByResourceNameAndTimeRange(resourceName string, star
 }
 
 // ReadingsByTimeRange provides a mock function with given fields: start, end, offset, limit
-func (_m *DBClient) ReadingsByTimeRange(start int64, end int64, offset int, limit int) ([]models.Reading, errors.EdgeX) {
+func (_m *DBClient) ReadingsByTimeRange(start int64, end int64, offset int, limit int) ([]v4models.Reading, errors.EdgeX) {
 	ret := _m.Called(start, end, offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadingsByTimeRange")
 	}
 
-	var r0 []models.Reading
+	var r0 []v4models.Reading
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int64, int64, int, int) ([]models.Reading, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int64, int64, int, int) ([]v4models.Reading, errors.EdgeX)); ok {
 		return rf(start, end, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(int64, int64, int, int) []models.Reading); ok {
+	if rf, ok := ret.Get(0).(func(int64, int64, int, int) []v4models.Reading); ok {
 		r0 = rf(start, end, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Reading)
+			r0 = ret.Get(0).([]v4models.Reading)
 		}
 	}
 
@@ -1046,6 +1556,116 @@ func (_m *DBClient) ReadingsByTimeRange(start int64, end int64, offset int, limi
 	return r0, r1
 }
 
+// RollupReadings provides a mock function with given fields: before, resolution
+func (_m *DBClient) RollupReadings(before int64, resolution int64) (uint32, errors.EdgeX) {
+	ret := _m.Called(before, resolution)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollupReadings")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, int64) (uint32, errors.EdgeX)); ok {
+		return rf(before, resolution)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) uint32); ok {
+		r0 = rf(before, resolution)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) errors.EdgeX); ok {
+		r1 = rf(before, resolution)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// StreamEvents provides a mock function with given fields: ctx, conds, handler
+func (_m *DBClient) StreamEvents(ctx context.Context, conds models.EventQueryConditions, handler func(v4models.Event) error) errors.EdgeX {
+	ret := _m.Called(ctx, conds, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamEvents")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.EventQueryConditions, func(v4models.Event) error) errors.EdgeX); ok {
+		r0 = rf(ctx, conds, handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// StreamReadings provides a mock function with given fields: ctx, conds, handler
+func (_m *DBClient) StreamReadings(ctx context.Context, conds models.ReadingQueryConditions, handler func(v4models.Reading) error) errors.EdgeX {
+	ret := _m.Called(ctx, conds, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamReadings")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReadingQueryConditions, func(v4models.Reading) error) errors.EdgeX); ok {
+		r0 = rf(ctx, conds, handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// TrimDeadLetters provides a mock function with given fields: maxCount
+func (_m *DBClient) TrimDeadLetters(maxCount uint32) errors.EdgeX {
+	ret := _m.Called(maxCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrimDeadLetters")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(uint32) errors.EdgeX); ok {
+		r0 = rf(maxCount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertLatestReadings provides a mock function with given fields: readings
+func (_m *DBClient) UpsertLatestReadings(readings []v4models.Reading) errors.EdgeX {
+	ret := _m.Called(readings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertLatestReadings")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]v4models.Reading) errors.EdgeX); ok {
+		r0 = rf(readings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDBClient(t interface {
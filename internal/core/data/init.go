@@ -53,7 +53,13 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 	LoadRestRoutes(b.router, dic, b.serviceName)
 
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
-	err := messaging.SubscribeEvents(ctx, dic)
+	err := application.ValidateReadingConfig(container.ConfigurationFrom(dic.Get).Validation)
+	if err != nil {
+		lc.Errorf("Invalid reading validation configuration, %v", err)
+		return false
+	}
+
+	err = messaging.SubscribeEvents(ctx, dic)
 	if err != nil {
 		lc.Errorf("Failed to subscribe events from message bus, %v", err)
 		return false
@@ -70,6 +76,12 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 		return false
 	}
 
+	err = application.CoreDataAppFrom(dic.Get).AsyncPersistLatestReadings(ctx, wg, dic)
+	if err != nil {
+		lc.Errorf("Failed to run latest reading persistence, %v", err)
+		return false
+	}
+
 	err = application.AsyncPurgeEvent(ctx, dic)
 	if err != nil {
 		lc.Errorf("Failed to run event purging process, %v", err)
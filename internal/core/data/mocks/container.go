@@ -21,6 +21,7 @@ import (
 func NewMockDIC() *di.Container {
 	msgClient := &mocks.MessageClient{}
 	msgClient.On("PublishWithSizeLimit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	msgClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
 
 	return di.NewContainer(di.ServiceConstructorMap{
 		dataContainer.ConfigurationName: func(get di.Get) interface{} {
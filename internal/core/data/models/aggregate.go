@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// ReadingAggregate contains the statistics of the numeric readings whose origin falls into the time bucket
+// [BucketStart, BucketStart + interval)
+type ReadingAggregate struct {
+	BucketStart int64
+	Count       uint32
+	Min         float64
+	Max         float64
+	Avg         float64
+	Sum         float64
+	First       float64
+	Last        float64
+}
+
+// NumericValueTypes lists the reading value types whose values can be aggregated
+var NumericValueTypes = []string{
+	common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+	common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+	common.ValueTypeFloat32, common.ValueTypeFloat64,
+}
+
+// IsNumericValueType checks whether the reading value type is one of the NumericValueTypes
+func IsNumericValueType(valueType string) bool {
+	return slices.Contains(NumericValueTypes, valueType)
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const cursorSeparator = ","
+
+// Cursor is the keyset position of the last event or reading returned in the previous page. Events and readings are
+// sorted in descending order of (Origin, Id), so the next page starts from the record right after the cursor.
+type Cursor struct {
+	Origin int64
+	Id     string
+}
+
+// IsEmpty checks whether the cursor points to nothing, which means querying from the latest record
+func (c Cursor) IsEmpty() bool {
+	return c.Id == ""
+}
+
+// Token encodes the cursor into an opaque continuation token
+func (c Cursor) Token() string {
+	if c.IsEmpty() {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.Origin, 10) + cursorSeparator + c.Id))
+}
+
+// ParseCursorToken decodes the opaque continuation token into the cursor, an empty token results in an empty cursor
+func ParseCursorToken(token string) (Cursor, errors.EdgeX) {
+	if token == "" {
+		return Cursor{}, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid cursor %s", token), err)
+	}
+	originStr, id, found := strings.Cut(string(decoded), cursorSeparator)
+	if !found || id == "" {
+		return Cursor{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid cursor %s", token), nil)
+	}
+	origin, err := strconv.ParseInt(originStr, 10, 64)
+	if err != nil {
+		return Cursor{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid cursor %s", token), err)
+	}
+	return Cursor{Origin: origin, Id: id}, nil
+}
+
+// EventQueryConditions contains the conditions to filter the events, the empty string fields are not used as conditions
+type EventQueryConditions struct {
+	DeviceName string
+	Start      int64
+	End        int64
+}
+
+// ReadingQueryConditions contains the conditions to filter the readings, the empty string or slice fields are not used
+// as conditions. ResourceNames takes precedence over ResourceName when both are specified.
+type ReadingQueryConditions struct {
+	DeviceName    string
+	ResourceName  string
+	ResourceNames []string
+	Start         int64
+	End           int64
+	ReadingFilter
+}
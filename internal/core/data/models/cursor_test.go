@@ -0,0 +1,45 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorToken(t *testing.T) {
+	cursor := Cursor{Origin: 1616728256236000000, Id: "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"}
+
+	parsed, err := ParseCursorToken(cursor.Token())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, parsed)
+
+	assert.Empty(t, Cursor{}.Token())
+	parsed, err = ParseCursorToken("")
+	require.NoError(t, err)
+	assert.True(t, parsed.IsEmpty())
+}
+
+func TestParseCursorToken_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"invalid base64", "!!!"},
+		{"no separator", base64.RawURLEncoding.EncodeToString([]byte("123"))},
+		{"empty id", base64.RawURLEncoding.EncodeToString([]byte("123,"))},
+		{"invalid origin", base64.RawURLEncoding.EncodeToString([]byte("abc,id"))},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ParseCursorToken(testCase.token)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DeadLetter is a message received from the message bus which core data failed to decode or which doesn't conform to the
+// contracts, kept with the raw payload so that it can be inspected and replayed later
+type DeadLetter struct {
+	Id            string
+	Created       int64
+	Topic         string
+	CorrelationId string
+	ContentType   string
+	Payload       []byte
+	Error         string
+}
@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// ReadingFilter contains the conditions to filter the readings by value and tags, the nil or empty fields are not used
+// as conditions. Once a value bound is specified, only the numeric SimpleReadings are matched.
+type ReadingFilter struct {
+	// ValueMin is the lower bound of the reading value, which is exclusive unless ValueMinInclusive is true
+	ValueMin          *float64
+	ValueMinInclusive bool
+	// ValueMax is the upper bound of the reading value, which is exclusive unless ValueMaxInclusive is true
+	ValueMax          *float64
+	ValueMaxInclusive bool
+	// Tags contains the tag key/value pairs that the readings must contain, the tag values are compared as strings
+	Tags map[string]string
+}
+
+// IsEmpty checks whether the filter contains no condition
+func (f ReadingFilter) IsEmpty() bool {
+	return !f.HasValueBound() && len(f.Tags) == 0
+}
+
+// HasValueBound checks whether the filter bounds the reading value
+func (f ReadingFilter) HasValueBound() bool {
+	return f.ValueMin != nil || f.ValueMax != nil
+}
+
+// Matches checks whether the reading matches all the conditions of the filter
+func (f ReadingFilter) Matches(r models.Reading) bool {
+	for key, value := range f.Tags {
+		tagValue, ok := r.GetBaseReading().Tags[key].(string)
+		if !ok || tagValue != value {
+			return false
+		}
+	}
+	if !f.HasValueBound() {
+		return true
+	}
+
+	simpleReading, ok := r.(models.SimpleReading)
+	if !ok || !IsNumericValueType(simpleReading.ValueType) {
+		return false
+	}
+	value, err := strconv.ParseFloat(simpleReading.Value, 64)
+	if err != nil {
+		return false
+	}
+	return f.matchesValue(value)
+}
+
+func (f ReadingFilter) matchesValue(value float64) bool {
+	if f.ValueMin != nil && (value < *f.ValueMin || (value == *f.ValueMin && !f.ValueMinInclusive)) {
+		return false
+	}
+	if f.ValueMax != nil && (value > *f.ValueMax || (value == *f.ValueMax && !f.ValueMaxInclusive)) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadingFilterMatches(t *testing.T) {
+	lower, upper := 10.0, 20.0
+	reading := func(valueType string, value string, tags map[string]any) models.Reading {
+		return models.SimpleReading{BaseReading: models.BaseReading{ValueType: valueType, Tags: tags}, Value: value}
+	}
+
+	tests := []struct {
+		name     string
+		filter   ReadingFilter
+		reading  models.Reading
+		expected bool
+	}{
+		{"empty filter", ReadingFilter{}, reading(common.ValueTypeString, "abc", nil), true},
+		{"greater than lower bound", ReadingFilter{ValueMin: &lower}, reading(common.ValueTypeInt32, "11", nil), true},
+		{"equal to exclusive lower bound", ReadingFilter{ValueMin: &lower}, reading(common.ValueTypeInt32, "10", nil), false},
+		{"equal to inclusive lower bound", ReadingFilter{ValueMin: &lower, ValueMinInclusive: true}, reading(common.ValueTypeFloat64, "10", nil), true},
+		{"equal to exclusive upper bound", ReadingFilter{ValueMax: &upper}, reading(common.ValueTypeFloat32, "20", nil), false},
+		{"equal to inclusive upper bound", ReadingFilter{ValueMax: &upper, ValueMaxInclusive: true}, reading(common.ValueTypeUint8, "20", nil), true},
+		{"out of bounds", ReadingFilter{ValueMin: &lower, ValueMax: &upper}, reading(common.ValueTypeInt64, "21", nil), false},
+		{"non-numeric value type", ReadingFilter{ValueMin: &lower}, reading(common.ValueTypeString, "15", nil), false},
+		{"binary reading", ReadingFilter{ValueMin: &lower}, models.BinaryReading{}, false},
+		{"matched tags", ReadingFilter{Tags: map[string]string{"site": "a"}}, reading(common.ValueTypeString, "abc", map[string]any{"site": "a", "line": "1"}), true},
+		{"unmatched tag value", ReadingFilter{Tags: map[string]string{"site": "a"}}, reading(common.ValueTypeString, "abc", map[string]any{"site": "b"}), false},
+		{"missing tag", ReadingFilter{Tags: map[string]string{"site": "a"}}, reading(common.ValueTypeString, "abc", nil), false},
+		{"matched tags and value", ReadingFilter{ValueMin: &lower, Tags: map[string]string{"site": "a"}}, reading(common.ValueTypeInt8, "15", map[string]any{"site": "a"}), true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.filter.Matches(testCase.reading))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ReadingRollup contains the statistics of the numeric readings of a device resource whose origin falls into the time
+// bucket [BucketStart, BucketStart + Resolution), the rollups replace the raw readings once they are aged
+type ReadingRollup struct {
+	DeviceName   string
+	ProfileName  string
+	ResourceName string
+	ValueType    string
+	Units        string
+	// Resolution is the length of the time bucket in nanoseconds
+	Resolution int64
+	ReadingAggregate
+}
+
+// Merge combines the aggregate of the earlier readings with the aggregate of the later readings in the same time bucket,
+// the bucket start of the earlier aggregate is kept
+func (a ReadingAggregate) Merge(later ReadingAggregate) ReadingAggregate {
+	if later.Count == 0 {
+		return a
+	}
+	if a.Count == 0 {
+		later.BucketStart = a.BucketStart
+		return later
+	}
+
+	merged := ReadingAggregate{
+		BucketStart: a.BucketStart,
+		Count:       a.Count + later.Count,
+		Min:         min(a.Min, later.Min),
+		Max:         max(a.Max, later.Max),
+		Sum:         a.Sum + later.Sum,
+		First:       a.First,
+		Last:        later.Last,
+	}
+	merged.Avg = merged.Sum / float64(merged.Count)
+	return merged
+}
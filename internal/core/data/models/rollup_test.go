@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadingAggregateMerge(t *testing.T) {
+	earlier := ReadingAggregate{BucketStart: 0, Count: 2, Min: 1, Max: 3, Avg: 2, Sum: 4, First: 3, Last: 1}
+	later := ReadingAggregate{BucketStart: 60, Count: 2, Min: 0, Max: 2, Avg: 1, Sum: 2, First: 0, Last: 2}
+
+	merged := earlier.Merge(later)
+	assert.Equal(t, ReadingAggregate{BucketStart: 0, Count: 4, Min: 0, Max: 3, Avg: 1.5, Sum: 6, First: 3, Last: 2}, merged)
+
+	assert.Equal(t, earlier, earlier.Merge(ReadingAggregate{}))
+	assert.Equal(t, ReadingAggregate{BucketStart: 120, Count: 2, Min: 0, Max: 2, Avg: 1, Sum: 2, First: 0, Last: 2},
+		ReadingAggregate{BucketStart: 120}.Merge(later))
+}
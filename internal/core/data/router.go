@@ -13,6 +13,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
 	dataController "github.com/edgexfoundry/edgex-go/internal/core/data/controller/http"
 
 	"github.com/labstack/echo/v4"
@@ -35,6 +36,8 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiEventByDeviceNameRoute, ec.EventsByDeviceName, authenticationHook)
 	r.DELETE(common.ApiEventByDeviceNameRoute, ec.DeleteEventsByDeviceName, authenticationHook)
 	r.GET(common.ApiEventByTimeRangeRoute, ec.EventsByTimeRange, authenticationHook)
+	r.POST(constants.ApiEventBatchRoute, ec.AddEvents, authenticationHook)
+	r.GET(constants.ApiEventExportRoute, ec.ExportEvents, authenticationHook)
 	r.DELETE(common.ApiEventByAgeRoute, ec.DeleteEventsByAge, authenticationHook) // TODO: Add authentication to support-scheduler
 
 	// Readings
@@ -49,4 +52,15 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiReadingByDeviceNameAndResourceNameRoute, rc.ReadingsByDeviceNameAndResourceName, authenticationHook)
 	r.GET(common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeRoute, rc.ReadingsByDeviceNameAndResourceNameAndTimeRange, authenticationHook)
 	r.GET(common.ApiReadingByDeviceNameAndTimeRangeRoute, rc.ReadingsByDeviceNameAndResourceNamesAndTimeRange, authenticationHook)
+	r.GET(constants.ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute, rc.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange, authenticationHook)
+	r.GET(constants.ApiReadingExportRoute, rc.ExportReadings, authenticationHook)
+	r.GET(constants.ApiLatestReadingsByDeviceNameRoute, rc.LatestReadingsByDeviceName, authenticationHook)
+	r.GET(constants.ApiLatestReadingByDeviceNameAndResourceNameRoute, rc.LatestReadingByDeviceNameAndResourceName, authenticationHook)
+
+	// Dead Letters
+	dc := dataController.NewDeadLetterController(dic)
+	r.GET(constants.ApiAllDeadLetterRoute, dc.AllDeadLetters, authenticationHook)
+	r.GET(constants.ApiDeadLetterIdRoute, dc.DeadLetterById, authenticationHook)
+	r.DELETE(constants.ApiDeadLetterIdRoute, dc.DeleteDeadLetterById, authenticationHook)
+	r.POST(constants.ApiDeadLetterReplayByIdRoute, dc.ReplayDeadLetter, authenticationHook)
 }
@@ -0,0 +1,368 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// ExportMetadata exports the device services, device profiles, devices and provision watchers with any of the labels,
+// or all of them if no label is given. The device services and device profiles used by the exported devices and
+// provision watchers are always exported, so that the bundle can be imported on its own.
+func ExportMetadata(labels []string, dic *di.Container) (bundle metadataDTOs.Bundle, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	services, err := dbClient.AllDeviceServices(0, -1, labels)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeXWrapper(err)
+	}
+	profiles, err := dbClient.AllDeviceProfiles(0, -1, labels)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices, err := dbClient.AllDevices(0, -1, labels)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeXWrapper(err)
+	}
+	watchers, err := dbClient.AllProvisionWatchers(0, -1, labels)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// collect the device services and device profiles used by the exported entities but not having any of the labels
+	var serviceNames, profileNames []string
+	for _, d := range devices {
+		serviceNames = append(serviceNames, d.ServiceName)
+		profileNames = append(profileNames, d.ProfileName)
+	}
+	for _, pw := range watchers {
+		serviceNames = append(serviceNames, pw.ServiceName)
+		profileNames = append(profileNames, pw.DiscoveredDevice.ProfileName)
+	}
+	for _, name := range serviceNames {
+		if name == "" || slices.ContainsFunc(services, func(s models.DeviceService) bool { return s.Name == name }) {
+			continue
+		}
+		s, err := dbClient.DeviceServiceByName(name)
+		if err != nil {
+			return bundle, errors.NewCommonEdgeXWrapper(err)
+		}
+		services = append(services, s)
+	}
+	for _, name := range profileNames {
+		if name == "" || slices.ContainsFunc(profiles, func(p models.DeviceProfile) bool { return p.Name == name }) {
+			continue
+		}
+		p, err := dbClient.DeviceProfileByName(name)
+		if err != nil {
+			return bundle, errors.NewCommonEdgeXWrapper(err)
+		}
+		profiles = append(profiles, p)
+	}
+
+	bundle.ApiVersion = common.ApiVersion
+	for _, s := range services {
+		bundle.DeviceServices = append(bundle.DeviceServices, dtos.FromDeviceServiceModelToDTO(s))
+	}
+	for _, p := range profiles {
+		bundle.DeviceProfiles = append(bundle.DeviceProfiles, dtos.FromDeviceProfileModelToDTO(p))
+	}
+	for _, d := range devices {
+		bundle.Devices = append(bundle.Devices, dtos.FromDeviceModelToDTO(d))
+	}
+	for _, pw := range watchers {
+		bundle.ProvisionWatchers = append(bundle.ProvisionWatchers, dtos.FromProvisionWatcherModelToDTO(pw))
+	}
+	return bundle, nil
+}
+
+// importEntry is an entity of the imported bundle, which is either added or used to update the existing entity of the
+// same name
+type importEntry struct {
+	entityType string
+	name       string
+	exists     bool
+	err        errors.EdgeX
+	add        func() errors.EdgeX
+	update     func() errors.EdgeX
+}
+
+// ImportMetadata imports the entities of the bundle. The ids and timestamps of the entities are ignored, and the entities
+// are matched with the existing ones by name, which are skipped, overwritten or fail the whole import according to the
+// conflict policy. The capacity is checked against all the imported devices before importing any of them. The entities
+// are validated without being imported in the dry run. The result of each entity is reported.
+func ImportMetadata(ctx context.Context, bundle metadataDTOs.Bundle, conflictPolicy string, dryRun bool, bypassValidation bool, dic *di.Container) ([]metadataDTOs.ImportResult, errors.EdgeX) {
+	config := container.ConfigurationFrom(dic.Get)
+
+	var entries []*importEntry
+	services := make(map[string]bool)
+	profiles := make(map[string]models.DeviceProfile)
+	for _, dto := range bundle.DeviceServices {
+		e := deviceServiceImportEntry(ctx, dto, dic)
+		if e.err == nil {
+			services[e.name] = true
+		}
+		entries = appendImportEntry(entries, e)
+	}
+	for _, dto := range bundle.DeviceProfiles {
+		e, p := deviceProfileImportEntry(ctx, dto, dic)
+		if e.err == nil {
+			profiles[e.name] = p
+		}
+		entries = appendImportEntry(entries, e)
+	}
+	var devices []models.Device
+	oldProfileNames := make(map[string]string)
+	for _, dto := range bundle.Devices {
+		e, d, oldProfileName := deviceImportEntry(ctx, dto, services, profiles, bypassValidation, dic)
+		entries = appendImportEntry(entries, e)
+		if e.err == nil && (!e.exists || conflictPolicy == constants.ConflictPolicyOverwrite) {
+			devices = append(devices, d)
+			if e.exists {
+				oldProfileNames[d.Name] = oldProfileName
+			}
+		}
+	}
+	for _, dto := range bundle.ProvisionWatchers {
+		entries = appendImportEntry(entries, provisionWatcherImportEntry(ctx, dto, services, profiles, dic))
+	}
+
+	if conflictPolicy == constants.ConflictPolicyFail {
+		var conflicts []string
+		for _, e := range entries {
+			if e.err == nil && e.exists {
+				conflicts = append(conflicts, fmt.Sprintf("%s '%s'", e.entityType, e.name))
+			}
+		}
+		if len(conflicts) > 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("the imported entities already exist: %s", strings.Join(conflicts, ", ")), nil)
+		}
+	}
+	if len(devices) > 0 && (config.Writable.MaxDevices > 0 || config.Writable.MaxResources > 0) {
+		if err := checkCapacityWithDevices(devices, oldProfileNames, profiles, "import", dic); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	results := make([]metadataDTOs.ImportResult, 0, len(entries))
+	for _, e := range entries {
+		result := metadataDTOs.ImportResult{EntityType: e.entityType, Name: e.name, StatusCode: http.StatusOK}
+		err := e.err
+		switch {
+		case err != nil:
+		case !e.exists:
+			result.Action = common.SystemEventActionAdd
+			if !dryRun {
+				result.StatusCode = http.StatusCreated
+				err = e.add()
+			}
+		case conflictPolicy == constants.ConflictPolicyOverwrite:
+			result.Action = common.SystemEventActionUpdate
+			if !dryRun {
+				err = e.update()
+			}
+		default:
+			result.Action = constants.ImportActionSkip
+			result.Message = fmt.Sprintf("%s '%s' already exists", e.entityType, e.name)
+		}
+		if err != nil {
+			result.StatusCode = err.Code()
+			result.Message = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// appendImportEntry appends the entry, which fails if an entity of the same type and name has been imported already
+func appendImportEntry(entries []*importEntry, e *importEntry) []*importEntry {
+	if e.err == nil && slices.ContainsFunc(entries, func(existing *importEntry) bool {
+		return existing.entityType == e.entityType && existing.name == e.name
+	}) {
+		e.err = errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("%s '%s' is imported more than once", e.entityType, e.name), nil)
+	}
+	return append(entries, e)
+}
+
+// checkImportedEntityExists checks whether the entity of the name exists by querying it, the entity is returned if exists
+func checkImportedEntityExists[T any](query func(string) (T, errors.EdgeX), name string) (entity T, exists bool, err errors.EdgeX) {
+	entity, err = query(name)
+	if err == nil {
+		return entity, true, nil
+	} else if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		return entity, false, nil
+	}
+	return entity, false, errors.NewCommonEdgeXWrapper(err)
+}
+
+// checkImportedReference checks whether the referenced entity exists or is imported along with the referencing one
+func checkImportedReference(entityType string, name string, imported bool, exists func(string) (bool, errors.EdgeX)) errors.EdgeX {
+	if name == "" || imported {
+		return nil
+	}
+	ok, err := exists(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	} else if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s '%s' does not exists", entityType, name), nil)
+	}
+	return nil
+}
+
+func deviceServiceImportEntry(ctx context.Context, dto dtos.DeviceService, dic *di.Container) *importEntry {
+	dbClient := container.DBClientFrom(dic.Get)
+	e := &importEntry{entityType: common.DeviceServiceSystemEventType, name: dto.Name}
+
+	dto.Id = ""
+	dto.DBTimestamp = dtos.DBTimestamp{}
+	if err := requests.NewAddDeviceServiceRequest(dto).Validate(); err != nil {
+		e.err = errors.NewCommonEdgeX(errors.KindContractInvalid, "", err)
+		return e
+	}
+	s := dtos.ToDeviceServiceModel(dto)
+
+	var existing models.DeviceService
+	existing, e.exists, e.err = checkImportedEntityExists(dbClient.DeviceServiceByName, s.Name)
+	e.add = func() errors.EdgeX {
+		_, err := AddDeviceService(s, ctx, dic)
+		return err
+	}
+	e.update = func() errors.EdgeX {
+		s.Id = existing.Id
+		s.Created = existing.Created
+		return updateDeviceServiceInDB(s, ctx, dic)
+	}
+	return e
+}
+
+func deviceProfileImportEntry(ctx context.Context, dto dtos.DeviceProfile, dic *di.Container) (*importEntry, models.DeviceProfile) {
+	dbClient := container.DBClientFrom(dic.Get)
+	e := &importEntry{entityType: common.DeviceProfileSystemEventType, name: dto.Name}
+
+	dto.Id = ""
+	dto.DBTimestamp = dtos.DBTimestamp{}
+	if err := requests.NewDeviceProfileRequest(dto).Validate(); err != nil {
+		e.err = errors.NewCommonEdgeX(errors.KindContractInvalid, "", err)
+		return e, models.DeviceProfile{}
+	}
+	// normalize the value types as the add device profile API does
+	for i, resource := range dto.DeviceResources {
+		valueType, err := common.NormalizeValueType(resource.Properties.ValueType)
+		if err != nil {
+			e.err = errors.NewCommonEdgeXWrapper(err)
+			return e, models.DeviceProfile{}
+		}
+		dto.DeviceResources[i].Properties.ValueType = valueType
+	}
+	p := dtos.ToDeviceProfileModel(dto)
+
+	var existing models.DeviceProfile
+	existing, e.exists, e.err = checkImportedEntityExists(dbClient.DeviceProfileByName, p.Name)
+	e.add = func() errors.EdgeX {
+		_, err := AddDeviceProfile(p, ctx, dic)
+		return err
+	}
+	e.update = func() errors.EdgeX {
+		if container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges {
+			return errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil)
+		}
+		p.Id = existing.Id
+		p.Created = existing.Created
+		return UpdateDeviceProfile(p, ctx, dic)
+	}
+	return e, p
+}
+
+func deviceImportEntry(ctx context.Context, dto dtos.Device, services map[string]bool, profiles map[string]models.DeviceProfile, bypassValidation bool, dic *di.Container) (*importEntry, models.Device, string) {
+	dbClient := container.DBClientFrom(dic.Get)
+	e := &importEntry{entityType: common.DeviceSystemEventType, name: dto.Name}
+
+	dto.Id = ""
+	dto.DBTimestamp = dtos.DBTimestamp{}
+	if dto.Properties == nil {
+		dto.Properties = make(map[string]any)
+	}
+	if err := requests.NewAddDeviceRequest(dto).Validate(); err != nil {
+		e.err = errors.NewCommonEdgeX(errors.KindContractInvalid, "", err)
+		return e, models.Device{}, ""
+	}
+	d := dtos.ToDeviceModel(dto)
+
+	if e.err = checkImportedReference(common.DeviceServiceSystemEventType, d.ServiceName, services[d.ServiceName], dbClient.DeviceServiceNameExists); e.err != nil {
+		return e, d, ""
+	}
+	_, imported := profiles[d.ProfileName]
+	if e.err = checkImportedReference(common.DeviceProfileSystemEventType, d.ProfileName, imported, dbClient.DeviceProfileNameExists); e.err != nil {
+		return e, d, ""
+	}
+
+	var existing models.Device
+	existing, e.exists, e.err = checkImportedEntityExists(dbClient.DeviceByName, d.Name)
+	e.add = func() errors.EdgeX {
+		_, err := AddDevice(d, ctx, dic, bypassValidation, false)
+		return err
+	}
+	e.update = func() errors.EdgeX {
+		// the existing device is updated when the device is added forcibly
+		_, err := AddDevice(d, ctx, dic, bypassValidation, true)
+		return err
+	}
+	return e, d, existing.ProfileName
+}
+
+func provisionWatcherImportEntry(ctx context.Context, dto dtos.ProvisionWatcher, services map[string]bool, profiles map[string]models.DeviceProfile, dic *di.Container) *importEntry {
+	dbClient := container.DBClientFrom(dic.Get)
+	e := &importEntry{entityType: common.ProvisionWatcherSystemEventType, name: dto.Name}
+
+	dto.Id = ""
+	dto.DBTimestamp = dtos.DBTimestamp{}
+	req := requests.NewAddProvisionWatcherRequest(dto)
+	if err := req.Validate(); err != nil {
+		e.err = errors.NewCommonEdgeX(errors.KindContractInvalid, "", err)
+		return e
+	}
+	pw := dtos.ToProvisionWatcherModel(req.ProvisionWatcher)
+
+	if e.err = checkImportedReference(common.DeviceServiceSystemEventType, pw.ServiceName, services[pw.ServiceName], dbClient.DeviceServiceNameExists); e.err != nil {
+		return e
+	}
+	_, imported := profiles[pw.DiscoveredDevice.ProfileName]
+	if e.err = checkImportedReference(common.DeviceProfileSystemEventType, pw.DiscoveredDevice.ProfileName, imported, dbClient.DeviceProfileNameExists); e.err != nil {
+		return e
+	}
+
+	var existing models.ProvisionWatcher
+	existing, e.exists, e.err = checkImportedEntityExists(dbClient.ProvisionWatcherByName, pw.Name)
+	e.add = func() errors.EdgeX {
+		_, err := AddProvisionWatcher(pw, ctx, dic)
+		return err
+	}
+	e.update = func() errors.EdgeX {
+		pw.Id = existing.Id
+		pw.Created = existing.Created
+		var oldServiceName string
+		if pw.ServiceName != existing.ServiceName {
+			oldServiceName = existing.ServiceName
+		}
+		return updateProvisionWatcherInDB(pw, oldServiceName, ctx, dic)
+	}
+	return e
+}
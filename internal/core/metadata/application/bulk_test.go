@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+)
+
+func TestExportMetadata(t *testing.T) {
+	label := "site1"
+	service := models.DeviceService{Name: "testService", Labels: []string{label}}
+	otherService := models.DeviceService{Name: "otherService"}
+	profile := models.DeviceProfile{Name: "testProfile"}
+	device := models.Device{Name: "testDevice", ServiceName: otherService.Name, ProfileName: profile.Name, Labels: []string{label}}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceServices", 0, -1, []string{label}).Return([]models.DeviceService{service}, nil)
+	dbClientMock.On("AllDeviceProfiles", 0, -1, []string{label}).Return([]models.DeviceProfile{}, nil)
+	dbClientMock.On("AllDevices", 0, -1, []string{label}).Return([]models.Device{device}, nil)
+	dbClientMock.On("AllProvisionWatchers", 0, -1, []string{label}).Return([]models.ProvisionWatcher{}, nil)
+	dbClientMock.On("DeviceServiceByName", otherService.Name).Return(otherService, nil)
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	bundle, err := ExportMetadata([]string{label}, dic)
+	require.NoError(t, err)
+	assert.Equal(t, common.ApiVersion, bundle.ApiVersion)
+	assert.Equal(t, []dtos.DeviceService{dtos.FromDeviceServiceModelToDTO(service), dtos.FromDeviceServiceModelToDTO(otherService)}, bundle.DeviceServices)
+	assert.Equal(t, []dtos.DeviceProfile{dtos.FromDeviceProfileModelToDTO(profile)}, bundle.DeviceProfiles)
+	assert.Equal(t, []dtos.Device{dtos.FromDeviceModelToDTO(device)}, bundle.Devices)
+	assert.Empty(t, bundle.ProvisionWatchers)
+}
+
+func TestImportMetadata(t *testing.T) {
+	service := dtos.DeviceService{Name: "testService", BaseAddress: "http://localhost:59900", AdminState: models.Unlocked}
+	profile := dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "testProfile"}, DeviceResources: []dtos.DeviceResource{
+		{Name: "temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+		{Name: "humidity", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+	}}
+	newDevice := dtos.Device{Name: "newDevice", ServiceName: service.Name, ProfileName: profile.Name, AdminState: models.Unlocked,
+		OperatingState: models.Up, Protocols: map[string]dtos.ProtocolProperties{"other": {}}}
+	existingDevice := newDevice
+	existingDevice.Name = "existingDevice"
+	invalidDevice := newDevice
+	invalidDevice.Name = "invalidDevice"
+	invalidDevice.ServiceName = "notFound"
+	bundle := metadataDTOs.Bundle{
+		DeviceServices: []dtos.DeviceService{service},
+		DeviceProfiles: []dtos.DeviceProfile{profile},
+		Devices:        []dtos.Device{newDevice, existingDevice, invalidDevice},
+	}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceServiceByName", service.Name).Return(dtos.ToDeviceServiceModel(service), nil)
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(models.DeviceProfile{}, notFound)
+	dbClientMock.On("DeviceProfileByName", "oldProfile").Return(models.DeviceProfile{DeviceResources: []models.DeviceResource{{}}}, nil)
+	dbClientMock.On("DeviceServiceNameExists", "notFound").Return(false, nil)
+	dbClientMock.On("DeviceByName", newDevice.Name).Return(models.Device{}, notFound)
+	dbClientMock.On("DeviceByName", existingDevice.Name).Return(models.Device{Name: existingDevice.Name, ProfileName: "oldProfile"}, nil)
+	dbClientMock.On("DeviceCountByLabels", []string(nil)).Return(uint32(1), nil)
+	dbClientMock.On("InUseResourceCount").Return(uint32(1), nil)
+
+	tests := []struct {
+		name                string
+		conflictPolicy      string
+		maxDevices          uint32
+		maxResources        uint32
+		errorExpected       bool
+		expectedErrKind     errors.ErrKind
+		expectedStatusCodes map[string]int
+		expectedActions     map[string]string
+	}{
+		{"valid - skip", constants.ConflictPolicySkip, 0, 0, false, "",
+			map[string]int{service.Name: http.StatusOK, profile.Name: http.StatusOK, newDevice.Name: http.StatusOK, existingDevice.Name: http.StatusOK, invalidDevice.Name: http.StatusBadRequest},
+			map[string]string{service.Name: constants.ImportActionSkip, profile.Name: common.SystemEventActionAdd, newDevice.Name: common.SystemEventActionAdd, existingDevice.Name: constants.ImportActionSkip, invalidDevice.Name: ""}},
+		{"valid - overwrite", constants.ConflictPolicyOverwrite, 0, 0, false, "",
+			map[string]int{service.Name: http.StatusOK, profile.Name: http.StatusOK, newDevice.Name: http.StatusOK, existingDevice.Name: http.StatusOK, invalidDevice.Name: http.StatusBadRequest},
+			map[string]string{service.Name: common.SystemEventActionUpdate, profile.Name: common.SystemEventActionAdd, newDevice.Name: common.SystemEventActionAdd, existingDevice.Name: common.SystemEventActionUpdate, invalidDevice.Name: ""}},
+		{"valid - within capacity", constants.ConflictPolicyOverwrite, 2, 4, false, "", nil, nil},
+		{"invalid - conflict", constants.ConflictPolicyFail, 0, 0, true, errors.KindDuplicateName, nil, nil},
+		{"invalid - exceed max devices", constants.ConflictPolicySkip, 1, 0, true, errors.KindContractInvalid, nil, nil},
+		{"invalid - exceed max resources", constants.ConflictPolicyOverwrite, 0, 3, true, errors.KindContractInvalid, nil, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return &config.ConfigurationStruct{Writable: config.WritableInfo{MaxDevices: testCase.maxDevices, MaxResources: testCase.maxResources}}
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				container.CapacityCheckLockName: func(get di.Get) interface{} {
+					return utils.NewCapacityCheckLock()
+				},
+			})
+
+			results, err := ImportMetadata(context.Background(), bundle, testCase.conflictPolicy, true, false, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, 5)
+			if testCase.expectedStatusCodes == nil {
+				return
+			}
+			statusCodes := make(map[string]int)
+			actions := make(map[string]string)
+			for _, result := range results {
+				statusCodes[result.Name] = result.StatusCode
+				actions[result.Name] = result.Action
+			}
+			assert.Equal(t, testCase.expectedStatusCodes, statusCodes)
+			assert.Equal(t, testCase.expectedActions, actions)
+		})
+	}
+}
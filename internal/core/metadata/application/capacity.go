@@ -154,3 +154,61 @@ func resourceCountByProfile(profileName string, dic *di.Container) (uint32, erro
 	}
 	return uint32(len(profile.DeviceResources)), nil
 }
+
+// checkCapacityWithDevices checks the capacity for adding or updating the devices as a whole, the operation is either
+// importing or migrating the devices and is only used in the error messages. The devices named in oldProfileNames replace
+// the existing ones using those device profiles, and the others are new devices. The resource count of a device profile
+// is taken from the given profiles first, i.e. the imported profiles which are imported before the devices.
+func checkCapacityWithDevices(devices []models.Device, oldProfileNames map[string]string, profiles map[string]models.DeviceProfile, operation string, dic *di.Container) errors.EdgeX {
+	config := container.ConfigurationFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	lock := container.CapacityCheckLockFrom(dic.Get)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// the device count is only checked when there are new devices, e.g. the migrated devices replace themselves
+	newDeviceCount := uint32(len(devices) - len(oldProfileNames))
+	if config.Writable.MaxDevices > 0 && newDeviceCount > 0 {
+		deviceCount, err := dbClient.DeviceCountByLabels(nil)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), "query device count failed", err)
+		}
+		if deviceCount+newDeviceCount > config.Writable.MaxDevices {
+			return errors.NewCommonEdgeX(
+				errors.KindContractInvalid,
+				fmt.Sprintf("the existing total number of device is '%d', %s '%d' new devices will exceed the maximum limitation '%d'", deviceCount, operation, newDeviceCount, config.Writable.MaxDevices), nil)
+		}
+	}
+	if config.Writable.MaxResources > 0 {
+		totalInUseResourceCount, err := dbClient.InUseResourceCount()
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), "query in use resource count failed", err)
+		}
+		count := int64(totalInUseResourceCount)
+		for _, d := range devices {
+			if p, ok := profiles[d.ProfileName]; ok {
+				count += int64(len(p.DeviceResources))
+			} else {
+				newProfileResourceCount, err := resourceCountByProfile(d.ProfileName, dic)
+				if err != nil {
+					return errors.NewCommonEdgeX(errors.Kind(err), "get resource count failed", err)
+				}
+				count += int64(newProfileResourceCount)
+			}
+			if oldProfileName, ok := oldProfileNames[d.Name]; ok {
+				oldProfileResourceCount, err := resourceCountByProfile(oldProfileName, dic)
+				if err != nil {
+					return errors.NewCommonEdgeX(errors.Kind(err), "get resource count failed", err)
+				}
+				count -= int64(oldProfileResourceCount)
+			}
+		}
+		if count > int64(config.Writable.MaxResources) {
+			return errors.NewCommonEdgeX(
+				errors.KindContractInvalid,
+				fmt.Sprintf("'%d' resources is in use, %s '%d' devices will increase to '%d' resources and exceed the maximum limitation '%d'",
+					totalInUseResourceCount, operation, len(devices), count, config.Writable.MaxResources), nil)
+		}
+	}
+	return nil
+}
@@ -106,6 +106,7 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container, bypassVa
 	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(addedDevice)
+	recordRevision(ctx, common.DeviceSystemEventType, d.Name, common.SystemEventActionAdd, deviceDTO, dic)
 	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, d.ServiceName, deviceDTO, ctx, dic)
 
 	return addedDevice.Id, nil
@@ -173,6 +174,7 @@ func DeleteDeviceByName(name string, ctx context.Context, dic *di.Container) err
 	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
+	recordRevision(ctx, common.DeviceSystemEventType, device.Name, common.SystemEventActionDelete, deviceDTO, dic)
 	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionDelete, device.ServiceName, deviceDTO, ctx, dic)
 
 	return nil
@@ -292,6 +294,7 @@ func updateDeviceInDB(device models.Device, oldServiceName string, ctx context.C
 	)
 
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
+	recordRevision(ctx, common.DeviceSystemEventType, device.Name, common.SystemEventActionUpdate, deviceDTO, dic)
 	if oldServiceName != "" {
 		go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, oldServiceName, deviceDTO, ctx, dic)
 	}
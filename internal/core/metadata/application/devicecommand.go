@@ -11,6 +11,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -42,6 +43,7 @@ func AddDeviceProfileDeviceCommand(profileName string, deviceCommand models.Devi
 	}
 
 	lc.Debugf("DeviceProfile deviceCommands added on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionUpdate, profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -77,6 +79,7 @@ func PatchDeviceProfileDeviceCommand(profileName string, dto dtos.UpdateDeviceCo
 
 	lc.Debugf("DeviceProfile deviceCommands patched on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionUpdate, profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -132,6 +135,7 @@ func DeleteDeviceCommandByName(profileName string, commandName string, ctx conte
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionUpdate, profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 	return nil
 }
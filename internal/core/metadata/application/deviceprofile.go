@@ -48,6 +48,7 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 	)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(addedDeviceProfile)
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionAdd, profileDTO, dic)
 	go publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
 
 	return addedDeviceProfile.Id, nil
@@ -87,6 +88,7 @@ func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Co
 	}
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionUpdate, profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -149,8 +151,13 @@ func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Contain
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	err = deleteDeviceProfileVersionByProfileName(name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionDelete, profileDTO, dic)
 	go publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
 
 	return nil
@@ -284,6 +291,7 @@ func PatchDeviceProfileBasicInfo(ctx context.Context, dto dtos.UpdateDeviceProfi
 	)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(deviceProfile)
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionUpdate, profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -0,0 +1,283 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// versionedProfileNameSeparator separates the device profile name and the version in the name of the device profile
+// storing the content of the version
+const versionedProfileNameSeparator = "@"
+
+// versionedProfileName returns the name of the device profile storing the content of the version
+func versionedProfileName(name string, version string) string {
+	return name + versionedProfileNameSeparator + version
+}
+
+// AddDeviceProfileVersion adds the version of the device profile, the content of the version is the given profile or
+// the current content of the device profile, and is stored side by side with the other versions as a new device profile
+func AddDeviceProfileVersion(v metadataModels.DeviceProfileVersion, profile *dtos.DeviceProfile, ctx context.Context, dic *di.Container) (id string, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	content, err := dbClient.DeviceProfileByName(v.Name)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if profile != nil {
+		content = dtos.ToDeviceProfileModel(*profile)
+	}
+	v.ProfileName = versionedProfileName(v.Name, v.Version)
+	content.Id = ""
+	content.DBTimestamp = models.DBTimestamp{}
+	content.Name = v.ProfileName
+
+	exists, err := dbClient.DeviceProfileNameExists(v.ProfileName)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	} else if exists {
+		return "", errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("version '%s' of device profile '%s' already exists", v.Version, v.Name), nil)
+	}
+	if _, err = AddDeviceProfile(content, ctx, dic); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	addedVersion, err := dbClient.AddDeviceProfileVersion(v)
+	if err != nil {
+		// remove the device profile added for the version so that the version can be added again
+		if deleteErr := dbClient.DeleteDeviceProfileByName(v.ProfileName); deleteErr != nil {
+			lc.Errorf("failed to delete device profile '%s' of the version which is not added: %v", v.ProfileName, deleteErr)
+		}
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceProfileVersion created on DB successfully. DeviceProfileVersion ID: %s, Correlation-ID: %s ",
+		addedVersion.Id,
+		correlation.FromContext(ctx),
+	)
+	return addedVersion.Id, nil
+}
+
+// DeviceProfileVersionsByName queries the versions of the device profile with offset and limit, the latest ones come
+// first
+func DeviceProfileVersionsByName(offset int, limit int, name string, dic *di.Container) (versions []metadataDTOs.DeviceProfileVersion, totalCount uint32, err errors.EdgeX) {
+	if name == "" {
+		return versions, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.DeviceProfileVersionCountByName(name)
+	if err != nil {
+		return versions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []metadataDTOs.DeviceProfileVersion{}, totalCount, err
+	}
+
+	vs, err := dbClient.DeviceProfileVersionsByName(offset, limit, name)
+	if err != nil {
+		return versions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.FromDeviceProfileVersionModelsToDTOs(vs), totalCount, nil
+}
+
+// DeviceProfileVersionByNameAndVersion queries the version of the device profile
+func DeviceProfileVersionByNameAndVersion(name string, version string, dic *di.Container) (v metadataDTOs.DeviceProfileVersion, err errors.EdgeX) {
+	if name == "" || version == "" {
+		return v, errors.NewCommonEdgeX(errors.KindContractInvalid, "name or version is empty", nil)
+	}
+	model, err := container.DBClientFrom(dic.Get).DeviceProfileVersionByProfileName(versionedProfileName(name, version))
+	if err != nil {
+		return v, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.FromDeviceProfileVersionModelToDTO(model), nil
+}
+
+// DeleteDeviceProfileVersion deletes the version of the device profile together with the device profile storing its
+// content, which fails if any device or provision watcher still uses the version
+func DeleteDeviceProfileVersion(name string, version string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	v, err := DeviceProfileVersionByNameAndVersion(name, version, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return DeleteDeviceProfileByName(v.ProfileName, ctx, dic)
+}
+
+// deleteDeviceProfileVersionByProfileName deletes the version stored by the deleted device profile, only the device
+// profiles named with the versionedProfileNameSeparator can store the versions
+func deleteDeviceProfileVersionByProfileName(profileName string, dic *di.Container) errors.EdgeX {
+	if !strings.Contains(profileName, versionedProfileNameSeparator) {
+		return nil
+	}
+	return container.DBClientFrom(dic.Get).DeleteDeviceProfileVersionByProfileName(profileName)
+}
+
+// MigrateDevicesToDeviceProfileVersion switches the devices from the device profile or any of its versions to the given
+// version, all the devices using the device profile or any of its versions are migrated if no device names are given.
+// Each device is checked against the resource compatibility and validated by its device service unless bypassValidation
+// is true, and a device failing the checks is reported in its result without affecting the others. The capacity is then
+// checked once for all the devices passing the checks, which are not switched at all if the capacity is exceeded. The
+// devices are only checked in the dry run.
+func MigrateDevicesToDeviceProfileVersion(ctx context.Context, name string, version string, deviceNames []string, dryRun bool, bypassValidation bool, dic *di.Container) ([]metadataDTOs.DeviceMigration, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	target, err := DeviceProfileVersionByNameAndVersion(name, version, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	targetProfile, err := dbClient.DeviceProfileByName(target.ProfileName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// the device profile and all of its versions from which the devices can be migrated
+	versions, err := dbClient.DeviceProfileVersionsByName(0, -1, name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	profileNames := []string{name}
+	for _, v := range versions {
+		profileNames = append(profileNames, v.ProfileName)
+	}
+
+	var devices []models.Device
+	var migrations []metadataDTOs.DeviceMigration
+	if len(deviceNames) == 0 {
+		for _, profileName := range profileNames {
+			if profileName == target.ProfileName {
+				continue
+			}
+			ds, err := dbClient.DevicesByProfileName(0, -1, profileName)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			devices = append(devices, ds...)
+		}
+	} else {
+		for _, deviceName := range deviceNames {
+			d, err := dbClient.DeviceByName(deviceName)
+			if err != nil {
+				migrations = append(migrations, deviceMigrationResult(models.Device{Name: deviceName}, target.ProfileName, err))
+				continue
+			}
+			devices = append(devices, d)
+		}
+	}
+
+	// the devices passing the checks, which are switched to the target device profile, and their old device profiles
+	var switched []models.Device
+	oldProfileNames := make(map[string]string)
+	profiles := map[string]models.DeviceProfile{target.ProfileName: targetProfile}
+	for _, d := range devices {
+		if d.ProfileName == target.ProfileName {
+			migrations = append(migrations, deviceMigrationResult(d, target.ProfileName, nil))
+			continue
+		}
+		if err := checkDeviceMigration(d, targetProfile, profileNames, profiles, bypassValidation, dic); err != nil {
+			migrations = append(migrations, deviceMigrationResult(d, target.ProfileName, err))
+			continue
+		}
+		oldProfileNames[d.Name] = d.ProfileName
+		d.ProfileName = target.ProfileName
+		switched = append(switched, d)
+	}
+
+	// the devices are switched as a whole so that the capacity can't be exceeded by part of them
+	var capacityErr errors.EdgeX
+	if len(switched) > 0 && (config.Writable.MaxDevices > 0 || config.Writable.MaxResources > 0) {
+		capacityErr = checkCapacityWithDevices(switched, oldProfileNames, profiles, "migrate", dic)
+	}
+	for _, d := range switched {
+		migrateErr := capacityErr
+		if migrateErr == nil && !dryRun {
+			migrateErr = updateDeviceInDB(d, "", ctx, dic)
+		}
+		migration := deviceMigrationResult(d, target.ProfileName, migrateErr)
+		migration.FromProfileName = oldProfileNames[d.Name]
+		migrations = append(migrations, migration)
+	}
+	return migrations, nil
+}
+
+// checkDeviceMigration checks whether the device can be switched to the target device profile
+func checkDeviceMigration(d models.Device, target models.DeviceProfile, profileNames []string, profiles map[string]models.DeviceProfile, bypassValidation bool, dic *di.Container) errors.EdgeX {
+	if !slices.Contains(profileNames, d.ProfileName) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device '%s' uses device profile '%s' rather than any version of device profile '%s'", d.Name, d.ProfileName, profileNames[0]), nil)
+	}
+
+	profile, ok := profiles[d.ProfileName]
+	if !ok {
+		var err errors.EdgeX
+		profile, err = container.DBClientFrom(dic.Get).DeviceProfileByName(d.ProfileName)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		profiles[d.ProfileName] = profile
+	}
+	if err := checkProfileCompatibility(profile, target); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	d.ProfileName = target.Name
+	if err := validateParentProfileAndAutoEvent(dic, d); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if !bypassValidation {
+		if err := validateDeviceCallback(dtos.FromDeviceModelToDTO(d), dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// checkProfileCompatibility checks whether the devices can be switched from the old device profile to the new one, the
+// device resources existing in both profiles must keep the same value type so that the readings and commands of the
+// devices keep the same meaning
+func checkProfileCompatibility(oldProfile models.DeviceProfile, newProfile models.DeviceProfile) errors.EdgeX {
+	for _, r := range newProfile.DeviceResources {
+		i := slices.IndexFunc(oldProfile.DeviceResources, func(old models.DeviceResource) bool {
+			return old.Name == r.Name
+		})
+		if i >= 0 && oldProfile.DeviceResources[i].Properties.ValueType != r.Properties.ValueType {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the value type of device resource '%s' is changed from '%s' in device profile '%s' to '%s' in device profile '%s'",
+					r.Name, oldProfile.DeviceResources[i].Properties.ValueType, oldProfile.Name, r.Properties.ValueType, newProfile.Name), nil)
+		}
+	}
+	return nil
+}
+
+func deviceMigrationResult(d models.Device, toProfileName string, err errors.EdgeX) metadataDTOs.DeviceMigration {
+	migration := metadataDTOs.DeviceMigration{
+		DeviceName:      d.Name,
+		FromProfileName: d.ProfileName,
+		ToProfileName:   toProfileName,
+		StatusCode:      http.StatusOK,
+	}
+	if err != nil {
+		migration.StatusCode = err.Code()
+		migration.Message = err.Error()
+	}
+	return migration
+}
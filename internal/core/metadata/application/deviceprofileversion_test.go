@@ -0,0 +1,220 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+)
+
+func TestAddDeviceProfileVersion(t *testing.T) {
+	profileName := "testProfile"
+	base := models.DeviceProfile{Id: "baseId", Name: profileName, Manufacturer: "IOTech"}
+	expectedProfile := models.DeviceProfile{Name: profileName + "@2", Manufacturer: "IOTech"}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profileName).Return(base, nil)
+	dbClientMock.On("DeviceProfileByName", "notFound").Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DeviceProfileNameExists", profileName+"@1").Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", profileName+"@2").Return(false, nil)
+	dbClientMock.On("AddDeviceProfile", expectedProfile).Return(expectedProfile, nil)
+	dbClientMock.On("AddDeviceProfileVersion", mock.Anything).Return(metadataModels.DeviceProfileVersion{Id: "versionId"}, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name            string
+		profileName     string
+		version         string
+		errorExpected   bool
+		expectedErrKind errors.ErrKind
+	}{
+		{"valid", profileName, "2", false, ""},
+		{"invalid - device profile not found", "notFound", "2", true, errors.KindEntityDoesNotExist},
+		{"invalid - version exists", profileName, "1", true, errors.KindDuplicateName},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := metadataModels.DeviceProfileVersion{Name: testCase.profileName, Version: testCase.version}
+			id, err := AddDeviceProfileVersion(v, nil, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "versionId", id)
+				dbClientMock.AssertCalled(t, "AddDeviceProfile", expectedProfile)
+				dbClientMock.AssertCalled(t, "AddDeviceProfileVersion", metadataModels.DeviceProfileVersion{Name: profileName, Version: "2", ProfileName: profileName + "@2"})
+			}
+		})
+	}
+}
+
+func TestMigrateDevicesToDeviceProfileVersion(t *testing.T) {
+	profileName := "testProfile"
+	v1ProfileName := profileName + "@1"
+	v2ProfileName := profileName + "@2"
+	rejectingService := "rejectingService"
+	resource := func(name string, valueType string) models.DeviceResource {
+		return models.DeviceResource{Name: name, Properties: models.ResourceProperties{ValueType: valueType}}
+	}
+	base := models.DeviceProfile{Name: profileName, DeviceResources: []models.DeviceResource{resource("temperature", common.ValueTypeFloat32)}}
+	v1 := models.DeviceProfile{Name: v1ProfileName, DeviceResources: []models.DeviceResource{resource("temperature", common.ValueTypeInt32)}}
+	v2 := models.DeviceProfile{Name: v2ProfileName, DeviceResources: []models.DeviceResource{
+		resource("temperature", common.ValueTypeFloat32), resource("humidity", common.ValueTypeFloat32),
+	}}
+	baseDevice := models.Device{Name: "baseDevice", ProfileName: profileName}
+	anotherBaseDevice := models.Device{Name: "anotherBaseDevice", ProfileName: profileName}
+	rejectedDevice := models.Device{Name: "rejectedDevice", ProfileName: profileName, ServiceName: rejectingService}
+	v1Device := models.Device{Name: "v1Device", ProfileName: v1ProfileName}
+	v2Device := models.Device{Name: "v2Device", ProfileName: v2ProfileName}
+	otherDevice := models.Device{Name: "otherDevice", ProfileName: "otherProfile"}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileVersionByProfileName", v2ProfileName).Return(
+		metadataModels.DeviceProfileVersion{Name: profileName, Version: "2", ProfileName: v2ProfileName}, nil)
+	dbClientMock.On("DeviceProfileVersionByProfileName", profileName+"@3").Return(
+		metadataModels.DeviceProfileVersion{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DeviceProfileVersionsByName", 0, -1, profileName).Return([]metadataModels.DeviceProfileVersion{
+		{Name: profileName, Version: "2", ProfileName: v2ProfileName},
+		{Name: profileName, Version: "1", ProfileName: v1ProfileName},
+	}, nil)
+	dbClientMock.On("DeviceProfileByName", profileName).Return(base, nil)
+	dbClientMock.On("DeviceProfileByName", v1ProfileName).Return(v1, nil)
+	dbClientMock.On("DeviceProfileByName", v2ProfileName).Return(v2, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, profileName).Return([]models.Device{baseDevice}, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, v1ProfileName).Return([]models.Device{v1Device}, nil)
+	for _, d := range []models.Device{baseDevice, anotherBaseDevice, rejectedDevice, v1Device, v2Device, otherDevice} {
+		dbClientMock.On("DeviceByName", d.Name).Return(d, nil)
+	}
+	dbClientMock.On("DeviceByName", "notFound").Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("UpdateDevice", mock.Anything).Return(nil)
+	// each device migrated from the base device profile adds one resource
+	dbClientMock.On("InUseResourceCount").Return(uint32(10), nil)
+
+	// the device service rejecting the devices fails the validation
+	messagingMock := &messagingMocks.MessageClient{}
+	messagingMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	messagingMock.On("Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(request types.MessageEnvelope, requestTopic string, _ string, _ time.Duration) (*types.MessageEnvelope, error) {
+			if strings.Contains(requestTopic, rejectingService) {
+				response := types.NewMessageEnvelopeWithError(request.RequestID, "validation failed")
+				return &response, nil
+			}
+			response, err := types.NewMessageEnvelopeForResponse(nil, request.RequestID, request.CorrelationID, common.ContentTypeJSON)
+			return &response, err
+		})
+	configuration := &config.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{RequestTimeout: "5s"}}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return messagingMock
+		},
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.CapacityCheckLockName: func(get di.Get) interface{} {
+			return utils.NewCapacityCheckLock()
+		},
+	})
+
+	tests := []struct {
+		name                string
+		version             string
+		deviceNames         []string
+		dryRun              bool
+		bypassValidation    bool
+		maxResources        uint32
+		errorExpected       bool
+		expectedStatusCodes map[string]int
+		expectedUpdates     []string
+	}{
+		{"valid - all devices of the device profile", "2", nil, false, false, 0, false,
+			map[string]int{baseDevice.Name: http.StatusOK, v1Device.Name: http.StatusBadRequest}, []string{baseDevice.Name}},
+		{"valid - dry run", "2", []string{baseDevice.Name}, true, false, 0, false,
+			map[string]int{baseDevice.Name: http.StatusOK}, nil},
+		{"valid - selected devices", "2", []string{baseDevice.Name, v2Device.Name, otherDevice.Name, "notFound"}, false, false, 0, false,
+			map[string]int{baseDevice.Name: http.StatusOK, v2Device.Name: http.StatusOK, otherDevice.Name: http.StatusBadRequest, "notFound": http.StatusNotFound}, []string{baseDevice.Name}},
+		{"valid - within the capacity", "2", []string{baseDevice.Name, anotherBaseDevice.Name}, false, false, 12, false,
+			map[string]int{baseDevice.Name: http.StatusOK, anotherBaseDevice.Name: http.StatusOK}, []string{baseDevice.Name, anotherBaseDevice.Name}},
+		{"valid - device rejected by the device service", "2", []string{baseDevice.Name, rejectedDevice.Name}, false, false, 0, false,
+			map[string]int{baseDevice.Name: http.StatusOK, rejectedDevice.Name: http.StatusInternalServerError}, []string{baseDevice.Name}},
+		{"valid - device service validation bypassed", "2", []string{rejectedDevice.Name}, false, true, 0, false,
+			map[string]int{rejectedDevice.Name: http.StatusOK}, []string{rejectedDevice.Name}},
+		{"valid - capacity exceeded by the devices as a whole", "2", []string{baseDevice.Name, anotherBaseDevice.Name}, false, false, 11, false,
+			map[string]int{baseDevice.Name: http.StatusBadRequest, anotherBaseDevice.Name: http.StatusBadRequest}, nil},
+		{"valid - capacity exceeded in the dry run", "2", []string{baseDevice.Name, anotherBaseDevice.Name}, true, false, 11, false,
+			map[string]int{baseDevice.Name: http.StatusBadRequest, anotherBaseDevice.Name: http.StatusBadRequest}, nil},
+		{"invalid - version not found", "3", nil, false, false, 0, true, nil, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock.Calls = nil
+			configuration.Writable.MaxResources = testCase.maxResources
+			migrations, err := MigrateDevicesToDeviceProfileVersion(context.Background(), profileName, testCase.version, testCase.deviceNames, testCase.dryRun, testCase.bypassValidation, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			statusCodes := make(map[string]int)
+			for _, m := range migrations {
+				assert.Equal(t, v2ProfileName, m.ToProfileName)
+				statusCodes[m.DeviceName] = m.StatusCode
+				if testCase.maxResources > 0 && m.StatusCode == http.StatusBadRequest {
+					assert.Contains(t, m.Message, fmt.Sprintf("migrate '%d' devices", len(testCase.deviceNames)), "the capacity error should describe the migration")
+				}
+			}
+			assert.Equal(t, testCase.expectedStatusCodes, statusCodes)
+			var updates []string
+			for _, call := range dbClientMock.Calls {
+				if call.Method == "UpdateDevice" {
+					d := call.Arguments.Get(0).(models.Device)
+					assert.Equal(t, v2ProfileName, d.ProfileName)
+					updates = append(updates, d.Name)
+				}
+			}
+			assert.Equal(t, testCase.expectedUpdates, updates)
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -87,6 +88,7 @@ func AddDeviceProfileResource(profileName string, resource models.DeviceResource
 	}
 
 	lc.Debugf("DeviceProfile deviceResources added on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionUpdate, profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -122,6 +124,7 @@ func PatchDeviceProfileResource(profileName string, dto dtos.UpdateDeviceResourc
 
 	lc.Debugf("DeviceProfile deviceResources patched on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionUpdate, profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -177,6 +180,7 @@ func DeleteDeviceResourceByName(profileName string, resourceName string, ctx con
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	recordRevision(ctx, common.DeviceProfileSystemEventType, profileDTO.Name, common.SystemEventActionUpdate, profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 	return nil
 }
@@ -40,6 +40,7 @@ func AddDeviceService(d models.DeviceService, ctx context.Context, dic *di.Conta
 		correlationId,
 	)
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(d)
+	recordRevision(ctx, common.DeviceServiceSystemEventType, d.Name, common.SystemEventActionAdd, DeviceServiceDTO, dic)
 	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionAdd, d.Name, DeviceServiceDTO, ctx, dic)
 	return addedDeviceService.Id, nil
 }
@@ -61,7 +62,6 @@ func DeviceServiceByName(name string, ctx context.Context, dic *di.Container) (d
 // PatchDeviceService executes the PATCH operation with the device service DTO to replace the old data
 func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
-	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
 	deviceService, err := deviceServiceByDTO(dbClient, dto)
 	if err != nil {
@@ -70,7 +70,16 @@ func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *
 
 	requests.ReplaceDeviceServiceModelFieldsWithDTO(&deviceService, dto)
 
-	err = dbClient.UpdateDeviceService(deviceService)
+	return updateDeviceServiceInDB(deviceService, ctx, dic)
+}
+
+// updateDeviceServiceInDB calls the UpdateDeviceService method from the infrastructure layer and publishes the
+// "update device service" system event at last
+func updateDeviceServiceInDB(deviceService models.DeviceService, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	err := dbClient.UpdateDeviceService(deviceService)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -80,6 +89,7 @@ func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *
 		correlation.FromContext(ctx),
 	)
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(deviceService)
+	recordRevision(ctx, common.DeviceServiceSystemEventType, deviceService.Name, common.SystemEventActionUpdate, DeviceServiceDTO, dic)
 	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionUpdate, deviceService.Name, DeviceServiceDTO, ctx, dic)
 	return nil
 }
@@ -134,6 +144,7 @@ func DeleteDeviceServiceByName(name string, ctx context.Context, dic *di.Contain
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(deviceService)
+	recordRevision(ctx, common.DeviceServiceSystemEventType, deviceService.Name, common.SystemEventActionDelete, DeviceServiceDTO, dic)
 	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionDelete, deviceService.Name, DeviceServiceDTO, ctx, dic)
 	return nil
 }
@@ -49,7 +49,9 @@ func AddProvisionWatcher(pw models.ProvisionWatcher, ctx context.Context, dic *d
 		addProvisionWatcher.Id,
 		correlationId,
 	)
-	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionAdd, pw.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
+	pwDTO := dtos.FromProvisionWatcherModelToDTO(pw)
+	recordRevision(ctx, common.ProvisionWatcherSystemEventType, pw.Name, common.SystemEventActionAdd, pwDTO, dic)
+	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionAdd, pw.ServiceName, pwDTO, ctx, dic)
 	return addProvisionWatcher.Id, nil
 }
 
@@ -161,14 +163,15 @@ func DeleteProvisionWatcherByName(ctx context.Context, name string, dic *di.Cont
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionDelete, pw.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
+	pwDTO := dtos.FromProvisionWatcherModelToDTO(pw)
+	recordRevision(ctx, common.ProvisionWatcherSystemEventType, pw.Name, common.SystemEventActionDelete, pwDTO, dic)
+	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionDelete, pw.ServiceName, pwDTO, ctx, dic)
 	return nil
 }
 
 // PatchProvisionWatcher executes the PATCH operation with the provisionWatcher DTO to replace the old data
 func PatchProvisionWatcher(ctx context.Context, dto dtos.UpdateProvisionWatcher, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
-	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
 	pw, err := provisionWatcherByDTO(dbClient, dto)
 	if err != nil {
@@ -183,17 +186,28 @@ func PatchProvisionWatcher(ctx context.Context, dto dtos.UpdateProvisionWatcher,
 
 	requests.ReplaceProvisionWatcherModelFieldsWithDTO(&pw, dto)
 
-	err = dbClient.UpdateProvisionWatcher(pw)
+	return updateProvisionWatcherInDB(pw, oldServiceName, ctx, dic)
+}
+
+// updateProvisionWatcherInDB calls the UpdateProvisionWatcher method from the infrastructure layer and publishes the
+// "update provision watcher" system event at last
+func updateProvisionWatcherInDB(pw models.ProvisionWatcher, oldServiceName string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	err := dbClient.UpdateProvisionWatcher(pw)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	lc.Debugf("ProvisionWatcher patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
 
+	pwDTO := dtos.FromProvisionWatcherModelToDTO(pw)
+	recordRevision(ctx, common.ProvisionWatcherSystemEventType, pw.Name, common.SystemEventActionUpdate, pwDTO, dic)
 	if oldServiceName != "" {
-		go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, oldServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
+		go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, oldServiceName, pwDTO, ctx, dic)
 	}
-	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, pw.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
+	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, pw.ServiceName, pwDTO, ctx, dic)
 	return nil
 }
 
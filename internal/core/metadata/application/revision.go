@@ -0,0 +1,340 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// the operations of the revision changes as defined by the JSON Patch
+const (
+	revisionChangeAdd     = "add"
+	revisionChangeRemove  = "remove"
+	revisionChangeReplace = "replace"
+)
+
+// the fields maintained by the database are not the changes made by the callers
+var revisionIgnoredFields = []string{"id", "created", "modified"}
+
+// rollbackVersionKey is the context key of the version being rolled back to, which marks the revision recorded by the
+// rollback
+type rollbackVersionKey struct{}
+
+// recordRevision records the change of the entity as a new revision if the change log is enabled. The entity DTO is the
+// entity after the change, or before the change for the delete action. Since the change has been made, the failure of
+// the recording is logged rather than returned.
+func recordRevision(ctx context.Context, entityType, entityName, action string, dto any, dic *di.Container) {
+	if !container.ConfigurationFrom(dic.Get).ChangeLog.Enabled {
+		return
+	}
+	err := addRevision(ctx, entityType, entityName, action, dto, dic)
+	if err != nil {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		lc.Errorf("failed to record the revision of %s '%s', Correlation-ID: %s, err: %v", entityType, entityName, correlation.FromContext(ctx), err)
+	}
+}
+
+func addRevision(ctx context.Context, entityType, entityName, action string, dto any, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	content, err := json.Marshal(dto)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the entity", err)
+	}
+	revision := metadataModels.Revision{
+		EntityType:    entityType,
+		EntityName:    entityName,
+		Action:        action,
+		Content:       content,
+		CorrelationId: correlation.FromContext(ctx),
+		Caller:        utils.CallerFromContext(ctx),
+	}
+	if version, ok := ctx.Value(rollbackVersionKey{}).(int64); ok {
+		revision.RollbackVersion = version
+	}
+
+	// the changes are made against the latest revision, or an empty entity if the entity was deleted or the change log
+	// was enabled after the entity was added
+	previous := map[string]any{}
+	latest, edgeXerr := dbClient.RevisionsByEntity(0, 1, entityType, entityName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(latest) > 0 && latest[0].Action != common.SystemEventActionDelete {
+		_ = json.Unmarshal(latest[0].Content, &previous)
+	}
+	current := map[string]any{}
+	if err = json.Unmarshal(content, &current); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the entity", err)
+	}
+	if action == common.SystemEventActionDelete {
+		previous, current = current, map[string]any{}
+	}
+	for _, field := range revisionIgnoredFields {
+		delete(previous, field)
+		delete(current, field)
+	}
+	revision.Changes = revisionChanges("", previous, current)
+
+	// the version is allocated by the database, so that the concurrent changes of the entity get distinct versions
+	revision, edgeXerr = dbClient.AddRevision(revision)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if config.ChangeLog.MaxRevisions > 0 && revision.Version > int64(config.ChangeLog.MaxRevisions) {
+		edgeXerr = dbClient.DeleteRevisionsByEntityBeforeVersion(entityType, entityName, revision.Version-int64(config.ChangeLog.MaxRevisions))
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	return nil
+}
+
+// revisionChanges returns the changes from the old JSON value to the new one at the given JSON Pointer. The objects are
+// compared field by field recursively, while the other values including the arrays are compared as a whole.
+func revisionChanges(path string, oldValue, newValue any) []metadataModels.RevisionChange {
+	oldObject, oldIsObject := oldValue.(map[string]any)
+	newObject, newIsObject := newValue.(map[string]any)
+	if !oldIsObject || !newIsObject {
+		if reflect.DeepEqual(oldValue, newValue) {
+			return nil
+		}
+		return []metadataModels.RevisionChange{{Path: path, Op: revisionChangeReplace, OldValue: oldValue, NewValue: newValue}}
+	}
+
+	var changes []metadataModels.RevisionChange
+	fields := slices.Sorted(maps.Keys(oldObject))
+	for field := range maps.Keys(newObject) {
+		if _, ok := oldObject[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+	for _, field := range fields {
+		fieldPath := path + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(field)
+		o, inOld := oldObject[field]
+		n, inNew := newObject[field]
+		switch {
+		case !inOld:
+			changes = append(changes, metadataModels.RevisionChange{Path: fieldPath, Op: revisionChangeAdd, NewValue: n})
+		case !inNew:
+			changes = append(changes, metadataModels.RevisionChange{Path: fieldPath, Op: revisionChangeRemove, OldValue: o})
+		default:
+			changes = append(changes, revisionChanges(fieldPath, o, n)...)
+		}
+	}
+	return changes
+}
+
+func validateEntityType(entityType string) errors.EdgeX {
+	switch entityType {
+	case common.DeviceSystemEventType, common.DeviceProfileSystemEventType, common.DeviceServiceSystemEventType, common.ProvisionWatcherSystemEventType:
+		return nil
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown entity type '%s', expected one of %s, %s, %s and %s", entityType,
+			common.DeviceSystemEventType, common.DeviceProfileSystemEventType, common.DeviceServiceSystemEventType, common.ProvisionWatcherSystemEventType), nil)
+	}
+}
+
+// AllRevisions queries the revisions of all the entities with offset and limit, the latest ones come first
+func AllRevisions(offset int, limit int, dic *di.Container) (revisions []metadataDTOs.Revision, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.RevisionTotalCount()
+	if err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []metadataDTOs.Revision{}, totalCount, err
+	}
+
+	rs, err := dbClient.AllRevisions(offset, limit)
+	if err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.FromRevisionModelsToDTOs(rs), totalCount, nil
+}
+
+// RevisionsByEntity queries the revisions of the entity with offset and limit, sorted in descending order of version
+func RevisionsByEntity(offset int, limit int, entityType string, entityName string, dic *di.Container) (revisions []metadataDTOs.Revision, totalCount uint32, err errors.EdgeX) {
+	if err = validateEntityType(entityType); err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	if entityName == "" {
+		return revisions, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.RevisionCountByEntity(entityType, entityName)
+	if err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []metadataDTOs.Revision{}, totalCount, err
+	}
+
+	rs, err := dbClient.RevisionsByEntity(offset, limit, entityType, entityName)
+	if err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.FromRevisionModelsToDTOs(rs), totalCount, nil
+}
+
+// RevisionByVersion queries the revision of the entity by version
+func RevisionByVersion(entityType string, entityName string, version int64, dic *di.Container) (revision metadataDTOs.Revision, err errors.EdgeX) {
+	if err = validateEntityType(entityType); err != nil {
+		return revision, errors.NewCommonEdgeXWrapper(err)
+	}
+	if entityName == "" {
+		return revision, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	r, err := container.DBClientFrom(dic.Get).RevisionByVersion(entityType, entityName, version)
+	if err != nil {
+		return revision, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.FromRevisionModelToDTO(r), nil
+}
+
+// RollbackRevision rolls the entity back to the content of the revision, and the entity is added again if it has been
+// deleted. The rollback goes through the same validations as the add and update requests, and is recorded as a new
+// revision referring to the version rolled back to.
+func RollbackRevision(ctx context.Context, entityType string, entityName string, version int64, dic *di.Container) errors.EdgeX {
+	revision, err := RevisionByVersion(entityType, entityName, version, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if revision.Action == common.SystemEventActionDelete {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("revision %d is the deletion of %s '%s', roll back to an earlier revision instead", version, entityType, entityName), nil)
+	}
+
+	ctx = context.WithValue(ctx, rollbackVersionKey{}, version)
+	switch entityType {
+	case common.DeviceSystemEventType:
+		var device dtos.Device
+		if err = decodeRevisionContent(revision, &device); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		err = rollbackDevice(ctx, dtos.ToDeviceModel(device), dic)
+	case common.DeviceProfileSystemEventType:
+		var profile dtos.DeviceProfile
+		if err = decodeRevisionContent(revision, &profile); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		err = rollbackDeviceProfile(ctx, profile, dic)
+	case common.DeviceServiceSystemEventType:
+		var service dtos.DeviceService
+		if err = decodeRevisionContent(revision, &service); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		err = rollbackDeviceService(ctx, service, dic)
+	case common.ProvisionWatcherSystemEventType:
+		var pw dtos.ProvisionWatcher
+		if err = decodeRevisionContent(revision, &pw); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		err = rollbackProvisionWatcher(ctx, pw, dic)
+	}
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to roll %s '%s' back to revision %d", entityType, entityName, version), err)
+	}
+	return nil
+}
+
+func decodeRevisionContent(revision metadataDTOs.Revision, dto any) errors.EdgeX {
+	if err := json.Unmarshal(revision.Content, dto); err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to decode the content of revision %d", revision.Version), err)
+	}
+	return nil
+}
+
+func rollbackDevice(ctx context.Context, device models.Device, dic *di.Container) errors.EdgeX {
+	// the id and created fields of the existing device are kept, or new ones are assigned if the device is added again
+	device.Id = ""
+	device.Created = 0
+	// force to replace the existing device as a whole, the validation of the device service is bypassed since the
+	// content had been accepted
+	_, err := AddDevice(device, ctx, dic, true, true)
+	return err
+}
+
+func rollbackDeviceProfile(ctx context.Context, profile dtos.DeviceProfile, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	exists, err := dbClient.DeviceProfileNameExists(profile.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	profile.Id = ""
+	if !exists {
+		_, err = AddDeviceProfile(dtos.ToDeviceProfileModel(profile), ctx, dic)
+		return err
+	}
+	if container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil)
+	}
+	return UpdateDeviceProfile(dtos.ToDeviceProfileModel(profile), ctx, dic)
+}
+
+func rollbackDeviceService(ctx context.Context, service dtos.DeviceService, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	exists, err := dbClient.DeviceServiceNameExists(service.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	ds := dtos.ToDeviceServiceModel(service)
+	if !exists {
+		ds.Id = ""
+		_, err = AddDeviceService(ds, ctx, dic)
+		return err
+	}
+	existing, err := dbClient.DeviceServiceByName(service.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	ds.Id = existing.Id
+	ds.Created = existing.Created
+	return updateDeviceServiceInDB(ds, ctx, dic)
+}
+
+func rollbackProvisionWatcher(ctx context.Context, watcher dtos.ProvisionWatcher, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	pw := dtos.ToProvisionWatcherModel(watcher)
+	existing, err := dbClient.ProvisionWatcherByName(watcher.Name)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		pw.Id = ""
+		_, err = AddProvisionWatcher(pw, ctx, dic)
+		return err
+	} else if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	pw.Id = existing.Id
+	pw.Created = existing.Created
+	var oldServiceName string
+	if pw.ServiceName != existing.ServiceName {
+		oldServiceName = existing.ServiceName
+	}
+	return updateProvisionWatcherInDB(pw, oldServiceName, ctx, dic)
+}
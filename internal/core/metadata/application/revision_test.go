@@ -0,0 +1,202 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+func mockRevisionDic(dbClientMock *mocks.DBClient, maxRevisions uint32) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					LogLevel: "DEBUG",
+				},
+				ChangeLog: config.ChangeLogInfo{
+					Enabled:      true,
+					MaxRevisions: maxRevisions,
+				},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+}
+
+func TestRevisionChanges(t *testing.T) {
+	decode := func(s string) any {
+		var v any
+		require.NoError(t, json.Unmarshal([]byte(s), &v))
+		return v
+	}
+
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		expected []metadataModels.RevisionChange
+	}{
+		{"no changes", `{"name":"d1","labels":["a"]}`, `{"name":"d1","labels":["a"]}`, nil},
+		{"added field", `{}`, `{"name":"d1"}`,
+			[]metadataModels.RevisionChange{{Path: "/name", Op: revisionChangeAdd, NewValue: "d1"}}},
+		{"removed field", `{"name":"d1"}`, `{}`,
+			[]metadataModels.RevisionChange{{Path: "/name", Op: revisionChangeRemove, OldValue: "d1"}}},
+		{"replaced nested field", `{"protocols":{"modbus":{"port":"502"}}}`, `{"protocols":{"modbus":{"port":"503"}}}`,
+			[]metadataModels.RevisionChange{{Path: "/protocols/modbus/port", Op: revisionChangeReplace, OldValue: "502", NewValue: "503"}}},
+		{"replaced array as a whole", `{"labels":["a","b"]}`, `{"labels":["a"]}`,
+			[]metadataModels.RevisionChange{{Path: "/labels", Op: revisionChangeReplace, OldValue: []any{"a", "b"}, NewValue: []any{"a"}}}},
+		{"escaped field names and sorted paths", `{"b":1,"a/b":1}`, `{"b":2,"a~b":1}`,
+			[]metadataModels.RevisionChange{
+				{Path: "/a~1b", Op: revisionChangeRemove, OldValue: float64(1)},
+				{Path: "/a~0b", Op: revisionChangeAdd, NewValue: float64(1)},
+				{Path: "/b", Op: revisionChangeReplace, OldValue: float64(1), NewValue: float64(2)},
+			}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			changes := revisionChanges("", decode(testCase.old), decode(testCase.new))
+			assert.Equal(t, testCase.expected, changes)
+		})
+	}
+}
+
+func TestRecordRevision(t *testing.T) {
+	deviceName := "testDevice"
+	previousContent, err := json.Marshal(dtos.Device{Id: "id1", Name: deviceName, ServiceName: "oldService"})
+	require.NoError(t, err)
+	device := dtos.Device{Id: "id1", Name: deviceName, ServiceName: "newService", DBTimestamp: dtos.DBTimestamp{Modified: 1}}
+
+	tests := []struct {
+		name             string
+		action           string
+		latest           []metadataModels.Revision
+		maxRevisions     uint32
+		expectedVersion  int64
+		expectedChanges  []metadataModels.RevisionChange
+		expectedDeletion bool
+	}{
+		{"first revision", common.SystemEventActionAdd, nil, 0, 1,
+			[]metadataModels.RevisionChange{
+				{Path: "/adminState", Op: revisionChangeAdd, NewValue: ""},
+				{Path: "/name", Op: revisionChangeAdd, NewValue: deviceName},
+				{Path: "/operatingState", Op: revisionChangeAdd, NewValue: ""},
+				{Path: "/properties", Op: revisionChangeAdd, NewValue: nil},
+				{Path: "/protocols", Op: revisionChangeAdd, NewValue: nil},
+				{Path: "/serviceName", Op: revisionChangeAdd, NewValue: "newService"},
+			}, false},
+		{"update against the latest revision", common.SystemEventActionUpdate,
+			[]metadataModels.Revision{{Version: 3, Action: common.SystemEventActionUpdate, Content: previousContent}}, 0, 4,
+			[]metadataModels.RevisionChange{{Path: "/serviceName", Op: revisionChangeReplace, OldValue: "oldService", NewValue: "newService"}}, false},
+		{"retention of the revisions", common.SystemEventActionUpdate,
+			[]metadataModels.Revision{{Version: 3, Action: common.SystemEventActionUpdate, Content: previousContent}}, 2, 4,
+			[]metadataModels.RevisionChange{{Path: "/serviceName", Op: revisionChangeReplace, OldValue: "oldService", NewValue: "newService"}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var recorded metadataModels.Revision
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("RevisionsByEntity", 0, 1, common.DeviceSystemEventType, deviceName).Return(testCase.latest, nil)
+			// the version is allocated by the database
+			dbClientMock.On("AddRevision", mock.Anything).Return(func(r metadataModels.Revision) (metadataModels.Revision, errors.EdgeX) {
+				recorded = r
+				r.Version = testCase.expectedVersion
+				return r, nil
+			})
+			dbClientMock.On("DeleteRevisionsByEntityBeforeVersion", common.DeviceSystemEventType, deviceName, mock.Anything).Return(nil)
+			dic := mockRevisionDic(dbClientMock, testCase.maxRevisions)
+
+			ctx := context.WithValue(context.Background(), common.CorrelationHeader, "correlationId") // nolint: staticcheck
+			recordRevision(ctx, common.DeviceSystemEventType, deviceName, testCase.action, device, dic)
+
+			assert.Zero(t, recorded.Version, "the version is not allocated by the application")
+			assert.Equal(t, testCase.action, recorded.Action)
+			assert.Equal(t, testCase.expectedChanges, recorded.Changes)
+			assert.Equal(t, correlation.FromContext(ctx), recorded.CorrelationId)
+			if testCase.expectedDeletion {
+				dbClientMock.AssertCalled(t, "DeleteRevisionsByEntityBeforeVersion", common.DeviceSystemEventType, deviceName, testCase.expectedVersion-int64(testCase.maxRevisions))
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeleteRevisionsByEntityBeforeVersion", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestRollbackRevision(t *testing.T) {
+	serviceName := "testService"
+	deletedServiceName := "deletedService"
+	content, err := json.Marshal(dtos.DeviceService{Id: "oldId", Name: serviceName, BaseAddress: "http://old:59900", AdminState: models.Unlocked})
+	require.NoError(t, err)
+	existing := models.DeviceService{Id: "id1", DBTimestamp: models.DBTimestamp{Created: 100}, Name: serviceName, BaseAddress: "http://new:59900", AdminState: models.Unlocked}
+	expected := models.DeviceService{Id: "id1", DBTimestamp: models.DBTimestamp{Created: 100}, Name: serviceName, BaseAddress: "http://old:59900", AdminState: models.Unlocked, Properties: map[string]any{}}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("RevisionByVersion", common.DeviceServiceSystemEventType, serviceName, int64(1)).Return(
+		metadataModels.Revision{Version: 1, Action: common.SystemEventActionAdd, Content: content}, nil)
+	dbClientMock.On("RevisionByVersion", common.DeviceServiceSystemEventType, serviceName, int64(2)).Return(
+		metadataModels.Revision{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("RevisionByVersion", common.DeviceServiceSystemEventType, deletedServiceName, int64(2)).Return(
+		metadataModels.Revision{Version: 2, Action: common.SystemEventActionDelete, Content: content}, nil)
+	dbClientMock.On("DeviceServiceNameExists", serviceName).Return(true, nil)
+	dbClientMock.On("DeviceServiceByName", serviceName).Return(existing, nil)
+	dbClientMock.On("UpdateDeviceService", expected).Return(nil)
+	dbClientMock.On("RevisionsByEntity", 0, 1, common.DeviceServiceSystemEventType, serviceName).Return(
+		[]metadataModels.Revision{{Version: 3, Action: common.SystemEventActionUpdate, Content: content}}, nil)
+	dbClientMock.On("AddRevision", mock.MatchedBy(func(r metadataModels.Revision) bool {
+		return r.RollbackVersion == 1
+	})).Return(metadataModels.Revision{}, nil)
+	dic := mockRevisionDic(dbClientMock, 0)
+
+	tests := []struct {
+		name            string
+		entityType      string
+		entityName      string
+		version         int64
+		errorExpected   bool
+		expectedErrKind errors.ErrKind
+	}{
+		{"valid - roll back device service", common.DeviceServiceSystemEventType, serviceName, 1, false, ""},
+		{"invalid - unknown entity type", "unknown", serviceName, 1, true, errors.KindContractInvalid},
+		{"invalid - revision not found", common.DeviceServiceSystemEventType, serviceName, 2, true, errors.KindEntityDoesNotExist},
+		{"invalid - roll back to a deletion", common.DeviceServiceSystemEventType, deletedServiceName, 2, true, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := RollbackRevision(context.Background(), testCase.entityType, testCase.entityName, testCase.version, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+				dbClientMock.AssertCalled(t, "UpdateDeviceService", expected)
+				dbClientMock.AssertCalled(t, "AddRevision", mock.Anything)
+			}
+		})
+	}
+}
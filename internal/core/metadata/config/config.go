@@ -29,6 +29,7 @@ type ConfigurationStruct struct {
 	Service    bootstrapConfig.ServiceInfo
 	MessageBus bootstrapConfig.MessageBusInfo
 	UoM        UoM
+	ChangeLog  ChangeLogInfo
 }
 
 type WritableInfo struct {
@@ -54,6 +55,13 @@ type UoM struct {
 	UoMFile string
 }
 
+// ChangeLogInfo defines whether the changes of the devices, device profiles, device services and provision watchers are
+// recorded as revisions, and how many revisions are kept for each of them
+type ChangeLogInfo struct {
+	Enabled      bool
+	MaxRevisions uint32
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
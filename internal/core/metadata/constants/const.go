@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// new constants relates to EdgeX Core Metadata service and will be added to go-mod-core-contracts in the future
+
+// Constants related to defined routes in the v3 service APIs
+const (
+	ApiRevisionRoute          = common.ApiBase + "/" + Revision
+	ApiAllRevisionRoute       = ApiRevisionRoute + "/" + common.All
+	ApiRevisionsByEntityRoute = ApiRevisionRoute + "/:" + EntityType + "/" + common.Name + "/:" + common.Name
+	ApiRevisionByVersionRoute = ApiRevisionsByEntityRoute + "/" + Version + "/:" + Version
+	ApiRollbackRevisionRoute  = ApiRevisionByVersionRoute + "/" + Rollback
+
+	ApiDeviceProfileVersionRoute                 = common.ApiDeviceProfileRoute + "/" + Version
+	ApiDeviceProfileVersionsByNameRoute          = ApiDeviceProfileVersionRoute + "/" + common.Name + "/:" + common.Name
+	ApiDeviceProfileVersionByNameAndVersionRoute = ApiDeviceProfileVersionsByNameRoute + "/" + Version + "/:" + Version
+	ApiMigrateDeviceProfileVersionRoute          = ApiDeviceProfileVersionByNameAndVersionRoute + "/" + Migrate
+
+	ApiBulkRoute       = common.ApiBase + "/" + Bulk
+	ApiBulkExportRoute = ApiBulkRoute + "/" + Export
+	ApiBulkImportRoute = ApiBulkRoute + "/" + Import
+)
+
+// Constants related to defined url path names and parameters in the v3 service APIs
+const (
+	Revision   = "revision"
+	EntityType = "entityType"
+	Version    = "version"
+	Rollback   = "rollback"
+	Migrate    = "migrate"
+
+	Bulk           = "bulk"
+	Export         = "export"
+	Import         = "import"
+	DryRun         = "dryRun"
+	ConflictPolicy = "conflictPolicy"
+)
+
+// Constants related to the bulk import of the metadata
+const (
+	// ConflictPolicySkip keeps the existing entity when an imported entity has the same name
+	ConflictPolicySkip = "skip"
+	// ConflictPolicyOverwrite replaces the existing entity with the imported one of the same name
+	ConflictPolicyOverwrite = "overwrite"
+	// ConflictPolicyFail rejects the whole import when any imported entity has the same name as an existing one
+	ConflictPolicyFail = "fail"
+
+	// ImportActionSkip is the action of the imported entity which is skipped due to the conflict, the other actions
+	// are the add and update system event actions
+	ImportActionSkip = "skip"
+
+	ContentTypeCSV = "text/csv"
+)
@@ -0,0 +1,188 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	goio "io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// deviceCSVColumns are the device fields which can be imported from the CSV columns
+var deviceCSVColumns = []string{"name", "parent", "description", "adminState", "operatingState", "labels", "serviceName",
+	"profileName", "location", "autoEvents", "protocols", "tags", "properties"}
+
+// deviceCSVJSONColumns are the device fields of the object or array type, which are in JSON in the CSV columns
+var deviceCSVJSONColumns = []string{"location", "autoEvents", "protocols", "tags", "properties"}
+
+type BulkController struct {
+	jsonDtoReader io.DtoReader
+	yamlDtoReader io.DtoReader
+	dic           *di.Container
+}
+
+// NewBulkController creates and initializes a BulkController
+func NewBulkController(dic *di.Container) *BulkController {
+	return &BulkController{
+		jsonDtoReader: io.NewJsonDtoReader(),
+		yamlDtoReader: io.NewYamlDtoReader(),
+		dic:           dic,
+	}
+}
+
+// ExportMetadata handles the GET request of exporting the metadata with any of the labels as a bundle, which is in YAML
+// if accepted by the client or JSON otherwise
+func (bc *BulkController) ExportMetadata(c echo.Context) error {
+	lc := container.LoggingClientFrom(bc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	labels := utils.ParseQueryStringToStrings(c, common.Labels, common.CommaSeparator)
+	bundle, err := application.ExportMetadata(labels, bc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	if r.Header.Get(common.Accept) == common.ContentTypeYAML {
+		w.Header().Set(common.CorrelationHeader, correlation.FromContext(ctx))
+		return pkg.EncodeAndWriteYamlResponse(bundle, w, lc)
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(bundle, w, lc)
+}
+
+// ImportMetadata handles the POST request of importing the bundle in JSON or YAML, or the devices in CSV, according to
+// the content type of the request. The result of each imported entity is reported in the response.
+func (bc *BulkController) ImportMetadata(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(bc.dic.Get)
+	ctx := r.Context()
+
+	dryRun := utils.ParseQueryStringToString(r, constants.DryRun, common.ValueFalse) == common.ValueTrue
+	bypassValidation := utils.ParseQueryStringToString(r, bypassValidationQueryParam, common.ValueFalse) == common.ValueTrue
+	conflictPolicy := utils.ParseQueryStringToString(r, constants.ConflictPolicy, constants.ConflictPolicyFail)
+	switch conflictPolicy {
+	case constants.ConflictPolicySkip, constants.ConflictPolicyOverwrite, constants.ConflictPolicyFail:
+	default:
+		return utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("querystring %s's value %s is not one of %s, %s or %s", constants.ConflictPolicy, conflictPolicy,
+				constants.ConflictPolicySkip, constants.ConflictPolicyOverwrite, constants.ConflictPolicyFail), nil), "")
+	}
+
+	var bundle metadataDTOs.Bundle
+	var err errors.EdgeX
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get(common.ContentType))
+	switch contentType {
+	case common.ContentTypeYAML:
+		err = bc.yamlDtoReader.Read(r.Body, &bundle)
+	case constants.ContentTypeCSV:
+		bundle.Devices, err = readDeviceCSV(r.Body)
+	default:
+		if err = bc.jsonDtoReader.Read(r.Body, &bundle); err != nil {
+			err = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the bundle as JSON", err)
+		}
+	}
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	results, err := application.ImportMetadata(ctx, bundle, conflictPolicy, dryRun, bypassValidation, bc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewImportResponse("", "", http.StatusOK, dryRun, results)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// readDeviceCSV reads the devices from the CSV document, whose header row names the device field of each column. The
+// labels are separated by commas, and the fields of the object or array type are in JSON. The devices are UNLOCKED and
+// UP unless the states are given.
+func readDeviceCSV(reader goio.Reader) ([]dtos.Device, errors.EdgeX) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the header row of the CSV document", err)
+	}
+	for _, column := range header {
+		if !slices.Contains(deviceCSVColumns, column) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("unknown column '%s' in the CSV document, the columns can only be %s", column, strings.Join(deviceCSVColumns, ", ")), nil)
+		}
+	}
+
+	var devices []dtos.Device
+	for row := 2; ; row++ {
+		record, err := csvReader.Read()
+		if err == goio.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the CSV document", err)
+		}
+
+		// the fields of the row are decoded from JSON into the device DTO
+		fields := map[string]any{
+			"adminState":     models.Unlocked,
+			"operatingState": models.Up,
+		}
+		for i, column := range header {
+			value := strings.TrimSpace(record[i])
+			switch {
+			case value == "":
+			case column == "labels":
+				labels := strings.Split(value, common.CommaSeparator)
+				for j := range labels {
+					labels[j] = strings.TrimSpace(labels[j])
+				}
+				fields[column] = labels
+			case slices.Contains(deviceCSVJSONColumns, column):
+				fields[column] = json.RawMessage(value)
+			default:
+				fields[column] = value
+			}
+		}
+		data, err := json.Marshal(fields)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid JSON value in row %d of the CSV document", row), err)
+		}
+		var d dtos.Device
+		if err = json.Unmarshal(data, &d); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode row %d of the CSV document as a device", row), err)
+		}
+		devices = append(devices, d)
+	}
+	return devices, nil
+}
@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+)
+
+func TestBulkController_ExportMetadata(t *testing.T) {
+	service := models.DeviceService{Id: ExampleUUID, Name: "testService", BaseAddress: "http://localhost:59900", AdminState: models.Unlocked}
+	device := models.Device{Id: ExampleUUID, Name: "testDevice", ServiceName: service.Name, AdminState: models.Unlocked, OperatingState: models.Up,
+		Protocols:   map[string]models.ProtocolProperties{"modbus-tcp": {"Address": "localhost", "Port": "502"}},
+		DBTimestamp: models.DBTimestamp{Created: 1700000000000}}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceServices", 0, -1, []string(nil)).Return([]models.DeviceService{service}, nil)
+	dbClientMock.On("AllDeviceProfiles", 0, -1, []string(nil)).Return([]models.DeviceProfile{}, nil)
+	dbClientMock.On("AllDevices", 0, -1, []string(nil)).Return([]models.Device{device}, nil)
+	dbClientMock.On("AllProvisionWatchers", 0, -1, []string(nil)).Return([]models.ProvisionWatcher{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewBulkController(dic)
+	assert.NotNil(t, controller)
+
+	expected := metadataDTOs.Bundle{
+		ApiVersion:     common.ApiVersion,
+		DeviceServices: []dtos.DeviceService{dtos.FromDeviceServiceModelToDTO(service)},
+		Devices:        []dtos.Device{dtos.FromDeviceModelToDTO(device)},
+	}
+	// the empty auto events are omitted in the exported bundle
+	expected.Devices[0].AutoEvents = nil
+
+	tests := []struct {
+		name                string
+		accept              string
+		expectedContentType string
+	}{
+		{"Valid - export as JSON", common.ContentTypeJSON, common.ContentTypeJSON},
+		{"Valid - export as YAML", common.ContentTypeYAML, common.ContentTypeYAML},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiBulkExportRoute, http.NoBody)
+			require.NoError(t, err)
+			req.Header.Set(common.Accept, testCase.accept)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.ExportMetadata(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedContentType, recorder.Header().Get(common.ContentType))
+			var bundle metadataDTOs.Bundle
+			if testCase.accept == common.ContentTypeYAML {
+				assert.Contains(t, recorder.Body.String(), "serviceName: testService")
+				assert.Contains(t, recorder.Body.String(), "created: 1700000000000")
+				err = yaml.Unmarshal(recorder.Body.Bytes(), &bundle)
+			} else {
+				err = json.Unmarshal(recorder.Body.Bytes(), &bundle)
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expected, bundle)
+		})
+	}
+}
+
+func TestBulkController_ImportMetadata(t *testing.T) {
+	validCSV := "name,serviceName,profileName,labels,protocols\n" +
+		`testDevice,testService,testProfile,"site1, floor2","{""modbus-tcp"":{""Address"":""localhost"",""Port"":""502""}}"` + "\n"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", "testService").Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", "testProfile").Return(true, nil)
+	dbClientMock.On("DeviceByName", "testDevice").Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewBulkController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		contentType        string
+		query              string
+		body               string
+		expectedStatusCode int
+	}{
+		{"Valid - dry run CSV", constants.ContentTypeCSV, "?dryRun=true", validCSV, http.StatusOK},
+		{"Valid - dry run YAML", common.ContentTypeYAML, "?dryRun=true&conflictPolicy=skip",
+			"apiVersion: v3\ndevices:\n  - name: testDevice\n    serviceName: testService\n    profileName: testProfile\n    adminState: UNLOCKED\n    operatingState: UP\n    protocols:\n      other: {}\n",
+			http.StatusOK},
+		{"Invalid - unknown CSV column", constants.ContentTypeCSV, "", "name,unknown\ntestDevice,value\n", http.StatusBadRequest},
+		{"Invalid - invalid JSON in CSV", constants.ContentTypeCSV, "", "name,protocols\ntestDevice,{invalid\n", http.StatusBadRequest},
+		{"Invalid - invalid conflict policy", common.ContentTypeJSON, "?conflictPolicy=invalid", "{}", http.StatusBadRequest},
+		{"Invalid - invalid JSON", common.ContentTypeJSON, "", "{", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, constants.ApiBulkImportRoute+testCase.query, strings.NewReader(testCase.body))
+			require.NoError(t, err)
+			req.Header.Set(common.ContentType, testCase.contentType)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.ImportMetadata(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responseDTO.ImportResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.True(t, res.DryRun)
+				require.Len(t, res.Results, 1)
+				assert.Equal(t, metadataDTOs.ImportResult{EntityType: common.DeviceSystemEventType, Name: "testDevice",
+					Action: common.SystemEventActionAdd, StatusCode: http.StatusOK}, res.Results[0])
+			} else {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	requestDTO "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/requests"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type DeviceProfileVersionController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewDeviceProfileVersionController creates and initializes a DeviceProfileVersionController
+func NewDeviceProfileVersionController(dic *di.Container) *DeviceProfileVersionController {
+	return &DeviceProfileVersionController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+func (dc *DeviceProfileVersionController) AddDeviceProfileVersion(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requestDTO.AddDeviceProfileVersionRequest
+	err := dc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var addResponses []interface{}
+	for _, req := range reqDTOs {
+		var response interface{}
+		newId, err := application.AddDeviceProfileVersion(dtos.ToDeviceProfileVersionModel(req.ProfileVersion), req.Profile, ctx, dc.dic)
+		if err == nil {
+			response = commonDTO.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, newId)
+		} else {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(req.RequestId, err.Error(), err.Code())
+		}
+		addResponses = append(addResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(addResponses, w, lc)
+}
+
+func (dc *DeviceProfileVersionController) DeviceProfileVersionsByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	name := c.Param(common.Name)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	versions, totalCount, err := application.DeviceProfileVersionsByName(offset, limit, name, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiDeviceProfileVersionsResponse("", "", http.StatusOK, totalCount, versions)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (dc *DeviceProfileVersionController) DeviceProfileVersionByNameAndVersion(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+	version := c.Param(constants.Version)
+
+	v, err := application.DeviceProfileVersionByNameAndVersion(name, version, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewDeviceProfileVersionResponse("", "", http.StatusOK, v)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (dc *DeviceProfileVersionController) DeleteDeviceProfileVersion(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+	version := c.Param(constants.Version)
+
+	err := application.DeleteDeviceProfileVersion(name, version, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// MigrateDevices handles the POST request of switching the devices over to the version of the device profile, the
+// result of each device is reported in the response
+func (dc *DeviceProfileVersionController) MigrateDevices(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+	version := c.Param(constants.Version)
+
+	// Query params
+	bypassValidation := utils.ParseQueryStringToString(r, bypassValidationQueryParam, common.ValueFalse) == common.ValueTrue
+
+	var reqDTO requestDTO.MigrateDevicesRequest
+	err := dc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	migrations, err := application.MigrateDevicesToDeviceProfileVersion(ctx, name, version, reqDTO.DeviceNames, reqDTO.DryRun, bypassValidation, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+	}
+
+	response := responseDTO.NewMigrateDevicesResponse(reqDTO.RequestId, "", http.StatusOK, migrations)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+func TestDeviceProfileVersionController_AddDeviceProfileVersion(t *testing.T) {
+	profileName := "testProfile"
+	profile := models.DeviceProfile{Id: "baseId", Name: profileName}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profileName).Return(profile, nil)
+	dbClientMock.On("DeviceProfileNameExists", profileName+"@2").Return(false, nil)
+	dbClientMock.On("AddDeviceProfile", mock.Anything).Return(models.DeviceProfile{}, nil)
+	dbClientMock.On("AddDeviceProfileVersion", mock.Anything).Return(metadataModels.DeviceProfileVersion{Id: ExampleUUID}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileVersionController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		body               string
+		expectedStatusCode int
+	}{
+		{"Valid - add version", `[{"apiVersion":"v3","profileVersion":{"name":"testProfile","version":"2"}}]`, http.StatusCreated},
+		{"Invalid - invalid version", `[{"apiVersion":"v3","profileVersion":{"name":"testProfile","version":"2/3"}}]`, http.StatusBadRequest},
+		{"Invalid - profile name mismatch", `[{"apiVersion":"v3","profileVersion":{"name":"testProfile","version":"2"},"profile":{"name":"otherProfile"}}]`, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, constants.ApiDeviceProfileVersionRoute, strings.NewReader(testCase.body))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AddDeviceProfileVersion(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.expectedStatusCode == http.StatusBadRequest {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res []commonDTO.BaseWithIdResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+				require.Len(t, res, 1)
+				assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "Response status code not as expected")
+				assert.Equal(t, ExampleUUID, res[0].Id, "Id not as expected")
+			}
+		})
+	}
+}
+
+func TestDeviceProfileVersionController_MigrateDevices(t *testing.T) {
+	profileName := "testProfile"
+	v2ProfileName := profileName + "@2"
+	device := models.Device{Name: "testDevice", ProfileName: profileName}
+	migratedDevice := device
+	migratedDevice.ProfileName = v2ProfileName
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileVersionByProfileName", v2ProfileName).Return(
+		metadataModels.DeviceProfileVersion{Name: profileName, Version: "2", ProfileName: v2ProfileName}, nil)
+	dbClientMock.On("DeviceProfileVersionByProfileName", profileName+"@3").Return(
+		metadataModels.DeviceProfileVersion{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DeviceProfileVersionsByName", 0, -1, profileName).Return(
+		[]metadataModels.DeviceProfileVersion{{Name: profileName, Version: "2", ProfileName: v2ProfileName}}, nil)
+	dbClientMock.On("DeviceProfileByName", profileName).Return(models.DeviceProfile{Name: profileName}, nil)
+	dbClientMock.On("DeviceProfileByName", v2ProfileName).Return(models.DeviceProfile{Name: v2ProfileName}, nil)
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("UpdateDevice", migratedDevice).Return(nil)
+	// the device service accepts the migrated device
+	messagingMock := &messagingMocks.MessageClient{}
+	messagingMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	messagingMock.On("Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(request types.MessageEnvelope, _ string, _ string, _ time.Duration) (*types.MessageEnvelope, error) {
+			response, err := types.NewMessageEnvelopeForResponse(nil, request.RequestID, request.CorrelationID, common.ContentTypeJSON)
+			return &response, err
+		})
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return messagingMock
+		},
+	})
+	controller := NewDeviceProfileVersionController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		version            string
+		body               string
+		expectedStatusCode int
+	}{
+		{"Valid - migrate device", "2", `{"apiVersion":"v3","deviceNames":["testDevice"]}`, http.StatusOK},
+		{"Invalid - version not found", "3", `{"apiVersion":"v3","deviceNames":["testDevice"]}`, http.StatusNotFound},
+		{"Invalid - empty device name", "2", `{"apiVersion":"v3","deviceNames":[" "]}`, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, constants.ApiMigrateDeviceProfileVersionRoute, strings.NewReader(testCase.body))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, constants.Version)
+			c.SetParamValues(profileName, testCase.version)
+			err = controller.MigrateDevices(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responseDTO.MigrateDevicesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Len(t, res.Migrations, 1)
+				assert.Equal(t, http.StatusOK, res.Migrations[0].StatusCode, "Migration status code not as expected")
+				assert.Equal(t, profileName, res.Migrations[0].FromProfileName)
+				assert.Equal(t, v2ProfileName, res.Migrations[0].ToProfileName)
+			} else {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+func TestRevisionController_RevisionsByEntity(t *testing.T) {
+	deviceName := "testDevice"
+	revisions := []metadataModels.Revision{
+		{Id: "id2", EntityType: common.DeviceSystemEventType, EntityName: deviceName, Version: 2, Action: common.SystemEventActionUpdate},
+		{Id: "id1", EntityType: common.DeviceSystemEventType, EntityName: deviceName, Version: 1, Action: common.SystemEventActionAdd},
+	}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("RevisionCountByEntity", common.DeviceSystemEventType, deviceName).Return(uint32(2), nil)
+	dbClientMock.On("RevisionsByEntity", 0, 20, common.DeviceSystemEventType, deviceName).Return(revisions, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewRevisionController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		entityType         string
+		entityName         string
+		errorExpected      bool
+		expectedCount      int
+		expectedTotalCount uint32
+		expectedStatusCode int
+	}{
+		{"Valid - revisions of device", common.DeviceSystemEventType, deviceName, false, 2, 2, http.StatusOK},
+		{"Invalid - unknown entity type", "unknown", deviceName, true, 0, 0, http.StatusBadRequest},
+		{"Invalid - empty name", common.DeviceSystemEventType, "", true, 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiRevisionsByEntityRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.EntityType, common.Name)
+			c.SetParamValues(testCase.entityType, testCase.entityName)
+			err = controller.RevisionsByEntity(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res responseDTO.MultiRevisionsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedCount, len(res.Revisions), "Revision count not as expected")
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+}
+
+func TestRevisionController_RevisionByVersion(t *testing.T) {
+	deviceName := "testDevice"
+	revision := metadataModels.Revision{Id: "id1", EntityType: common.DeviceSystemEventType, EntityName: deviceName, Version: 1, Action: common.SystemEventActionAdd}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("RevisionByVersion", common.DeviceSystemEventType, deviceName, int64(1)).Return(revision, nil)
+	dbClientMock.On("RevisionByVersion", common.DeviceSystemEventType, deviceName, int64(2)).Return(metadataModels.Revision{},
+		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "revision doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewRevisionController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		version            string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - find revision by version", "1", false, http.StatusOK},
+		{"Invalid - revision not found", "2", true, http.StatusNotFound},
+		{"Invalid - non-numeric version", "latest", true, http.StatusBadRequest},
+		{"Invalid - zero version", "0", true, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiRevisionByVersionRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.EntityType, common.Name, constants.Version)
+			c.SetParamValues(common.DeviceSystemEventType, deviceName, testCase.version)
+			err = controller.RevisionByVersion(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res responseDTO.RevisionResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, revision.Version, res.Revision.Version, "Version not as expected")
+				assert.Equal(t, deviceName, res.Revision.EntityName, "Entity name not as expected")
+			}
+		})
+	}
+}
+
+func TestRevisionController_RollbackRevision(t *testing.T) {
+	deviceName := "testDevice"
+	deletion := metadataModels.Revision{Id: "id2", EntityType: common.DeviceSystemEventType, EntityName: deviceName, Version: 2,
+		Action: common.SystemEventActionDelete, Content: json.RawMessage(`{"name":"testDevice"}`)}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("RevisionByVersion", common.DeviceSystemEventType, deviceName, int64(2)).Return(deletion, nil)
+	dbClientMock.On("RevisionByVersion", common.DeviceSystemEventType, deviceName, int64(3)).Return(metadataModels.Revision{},
+		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "revision doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewRevisionController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		version            string
+		expectedStatusCode int
+	}{
+		{"Invalid - roll back to a deletion", "2", http.StatusBadRequest},
+		{"Invalid - revision not found", "3", http.StatusNotFound},
+		{"Invalid - non-numeric version", "latest", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, constants.ApiRollbackRevisionRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.EntityType, common.Name, constants.Version)
+			c.SetParamValues(common.DeviceSystemEventType, deviceName, testCase.version)
+			err = controller.RollbackRevision(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+		})
+	}
+}
@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"gopkg.in/yaml.v3"
+)
+
+// Bundle is the exported metadata, which can be imported again. The entities are imported in the order of the fields
+// so that the device services and device profiles are in place before the devices and provision watchers using them.
+type Bundle struct {
+	ApiVersion        string                  `json:"apiVersion"`
+	DeviceServices    []dtos.DeviceService    `json:"deviceServices,omitempty"`
+	DeviceProfiles    []dtos.DeviceProfile    `json:"deviceProfiles,omitempty"`
+	Devices           []dtos.Device           `json:"devices,omitempty"`
+	ProvisionWatchers []dtos.ProvisionWatcher `json:"provisionWatchers,omitempty"`
+}
+
+// ImportResult is the result of importing an entity of the bundle
+type ImportResult struct {
+	EntityType string `json:"entityType"`
+	Name       string `json:"name"`
+	Action     string `json:"action,omitempty"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message,omitempty"`
+}
+
+// bundleAlias prevents the JSON encoding from recursively calling the YAML marshaller and unmarshaller of the Bundle
+type bundleAlias Bundle
+
+// MarshalYAML implements the yaml.Marshaler interface. Not all the DTOs come with yaml tags, so the bundle is encoded
+// as JSON first to keep the same field names in both formats.
+func (b Bundle) MarshalYAML() (any, error) {
+	data, err := json.Marshal(bundleAlias(b))
+	if err != nil {
+		return nil, err
+	}
+	// decode into a node rather than a map to keep the order of the fields and the types of the numbers
+	var node yaml.Node
+	if err = yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	if len(node.Content) == 0 {
+		return nil, nil
+	}
+	resetNodeStyle(node.Content[0])
+	return node.Content[0], nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface, the YAML document is decoded with the same field names as
+// the JSON one
+func (b *Bundle) UnmarshalYAML(node *yaml.Node) error {
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, (*bundleAlias)(b))
+}
+
+// resetNodeStyle drops the flow and quoted styles coming from the JSON document, so that the node is encoded in the
+// block style like other YAML documents
+func resetNodeStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetNodeStyle(n)
+	}
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// DeviceProfileVersion is an explicit version of a device profile, whose content is stored as the device profile named
+// ProfileName
+type DeviceProfileVersion struct {
+	Id          string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Created     int64  `json:"created,omitempty"`
+	Name        string `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Version     string `json:"version" validate:"required,edgex-dto-rfc3986-unreserved-chars"`
+	ProfileName string `json:"profileName,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// DeviceMigration is the result of switching a device from one version of the device profile to another
+type DeviceMigration struct {
+	DeviceName      string `json:"deviceName"`
+	FromProfileName string `json:"fromProfileName"`
+	ToProfileName   string `json:"toProfileName"`
+	StatusCode      int    `json:"statusCode"`
+	Message         string `json:"message,omitempty"`
+}
+
+// ToDeviceProfileVersionModel transforms the DeviceProfileVersion DTO to the DeviceProfileVersion model
+func ToDeviceProfileVersionModel(dto DeviceProfileVersion) models.DeviceProfileVersion {
+	return models.DeviceProfileVersion{
+		Id:          dto.Id,
+		Created:     dto.Created,
+		Name:        dto.Name,
+		Version:     dto.Version,
+		ProfileName: dto.ProfileName,
+		Description: dto.Description,
+	}
+}
+
+// FromDeviceProfileVersionModelToDTO transforms the DeviceProfileVersion model to the DeviceProfileVersion DTO
+func FromDeviceProfileVersionModelToDTO(v models.DeviceProfileVersion) DeviceProfileVersion {
+	return DeviceProfileVersion{
+		Id:          v.Id,
+		Created:     v.Created,
+		Name:        v.Name,
+		Version:     v.Version,
+		ProfileName: v.ProfileName,
+		Description: v.Description,
+	}
+}
+
+// FromDeviceProfileVersionModelsToDTOs transforms the DeviceProfileVersion models to the DeviceProfileVersion DTOs
+func FromDeviceProfileVersionModelsToDTOs(versions []models.DeviceProfileVersion) []DeviceProfileVersion {
+	dtos := make([]DeviceProfileVersion, len(versions))
+	for i, v := range versions {
+		dtos[i] = FromDeviceProfileVersionModelToDTO(v)
+	}
+	return dtos
+}
@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	contractsDTOs "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// AddDeviceProfileVersionRequest defines the Request Content for POST DeviceProfileVersion. The content of the version
+// is the given profile, or the current content of the device profile if the profile is not given.
+type AddDeviceProfileVersionRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	ProfileVersion        dtos.DeviceProfileVersion    `json:"profileVersion"`
+	Profile               *contractsDTOs.DeviceProfile `json:"profile,omitempty"`
+}
+
+// Validate satisfies the Validator interface
+func (a *AddDeviceProfileVersionRequest) Validate() error {
+	err := common.Validate(a)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddDeviceProfileVersionRequest", err)
+	}
+	if a.Profile == nil {
+		return nil
+	}
+	if a.Profile.Name != a.ProfileVersion.Name {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the name of the profile must be the name of the versioned device profile", nil)
+	}
+	return a.Profile.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDeviceProfileVersionRequest type
+func (a *AddDeviceProfileVersionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		ProfileVersion dtos.DeviceProfileVersion
+		Profile        *contractsDTOs.DeviceProfile
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*a = AddDeviceProfileVersionRequest(alias)
+
+	// validate AddDeviceProfileVersionRequest DTO
+	if err := a.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// Normalize resource's value type
+	if a.Profile != nil {
+		for i, resource := range a.Profile.DeviceResources {
+			valueType, err := common.NormalizeValueType(resource.Properties.ValueType)
+			if err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+			a.Profile.DeviceResources[i].Properties.ValueType = valueType
+		}
+	}
+	return nil
+}
+
+// MigrateDevicesRequest defines the Request Content for POST DeviceProfileVersion migrate, all the devices using any
+// version of the device profile are migrated if the DeviceNames is empty
+type MigrateDevicesRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	DeviceNames           []string `json:"deviceNames,omitempty" validate:"dive,edgex-dto-none-empty-string"`
+	DryRun                bool     `json:"dryRun"`
+}
+
+// Validate satisfies the Validator interface
+func (m *MigrateDevicesRequest) Validate() error {
+	err := common.Validate(m)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid MigrateDevicesRequest", err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the MigrateDevicesRequest type
+func (m *MigrateDevicesRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		DeviceNames []string
+		DryRun      bool
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*m = MigrateDevicesRequest(alias)
+
+	// validate MigrateDevicesRequest DTO
+	if err := m.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// ImportResponse defines the Response Content for POST bulk import.
+type ImportResponse struct {
+	common.BaseResponse `json:",inline"`
+	DryRun              bool                `json:"dryRun"`
+	Results             []dtos.ImportResult `json:"results"`
+}
+
+func NewImportResponse(requestId string, message string, statusCode int, dryRun bool, results []dtos.ImportResult) ImportResponse {
+	return ImportResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		DryRun:       dryRun,
+		Results:      results,
+	}
+}
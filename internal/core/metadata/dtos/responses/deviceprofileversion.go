@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// DeviceProfileVersionResponse defines the Response Content for GET DeviceProfileVersion DTO.
+type DeviceProfileVersionResponse struct {
+	common.BaseResponse `json:",inline"`
+	ProfileVersion      dtos.DeviceProfileVersion `json:"profileVersion"`
+}
+
+func NewDeviceProfileVersionResponse(requestId string, message string, statusCode int, v dtos.DeviceProfileVersion) DeviceProfileVersionResponse {
+	return DeviceProfileVersionResponse{
+		BaseResponse:   common.NewBaseResponse(requestId, message, statusCode),
+		ProfileVersion: v,
+	}
+}
+
+// MultiDeviceProfileVersionsResponse defines the Response Content for GET multiple DeviceProfileVersion DTOs.
+type MultiDeviceProfileVersionsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	ProfileVersions                   []dtos.DeviceProfileVersion `json:"profileVersions"`
+}
+
+func NewMultiDeviceProfileVersionsResponse(requestId string, message string, statusCode int, totalCount uint32, versions []dtos.DeviceProfileVersion) MultiDeviceProfileVersionsResponse {
+	return MultiDeviceProfileVersionsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		ProfileVersions:            versions,
+	}
+}
+
+// MigrateDevicesResponse defines the Response Content for POST DeviceProfileVersion migrate.
+type MigrateDevicesResponse struct {
+	common.BaseResponse `json:",inline"`
+	Migrations          []dtos.DeviceMigration `json:"migrations"`
+}
+
+func NewMigrateDevicesResponse(requestId string, message string, statusCode int, migrations []dtos.DeviceMigration) MigrateDevicesResponse {
+	return MigrateDevicesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Migrations:   migrations,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// RevisionResponse defines the Response Content for GET Revision DTO.
+type RevisionResponse struct {
+	common.BaseResponse `json:",inline"`
+	Revision            dtos.Revision `json:"revision"`
+}
+
+func NewRevisionResponse(requestId string, message string, statusCode int, revision dtos.Revision) RevisionResponse {
+	return RevisionResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Revision:     revision,
+	}
+}
+
+// MultiRevisionsResponse defines the Response Content for GET multiple Revision DTOs.
+type MultiRevisionsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Revisions                         []dtos.Revision `json:"revisions"`
+}
+
+func NewMultiRevisionsResponse(requestId string, message string, statusCode int, totalCount uint32, revisions []dtos.Revision) MultiRevisionsResponse {
+	return MultiRevisionsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Revisions:                  revisions,
+	}
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// Revision is a versioned change of a device, device profile, device service or provision watcher
+type Revision struct {
+	Id              string           `json:"id"`
+	Created         int64            `json:"created"`
+	EntityType      string           `json:"entityType"`
+	EntityName      string           `json:"entityName"`
+	Version         int64            `json:"version"`
+	Action          string           `json:"action"`
+	RollbackVersion int64            `json:"rollbackVersion,omitempty"`
+	Changes         []RevisionChange `json:"changes"`
+	Content         json.RawMessage  `json:"content,omitempty"`
+	CorrelationId   string           `json:"correlationId,omitempty"`
+	Caller          string           `json:"caller,omitempty"`
+}
+
+// RevisionChange is a changed field of the entity identified by the JSON Pointer
+type RevisionChange struct {
+	Path     string `json:"path"`
+	Op       string `json:"op"`
+	OldValue any    `json:"oldValue,omitempty"`
+	NewValue any    `json:"newValue,omitempty"`
+}
+
+// FromRevisionModelToDTO transforms the Revision Model to the Revision DTO
+func FromRevisionModelToDTO(r models.Revision) Revision {
+	changes := make([]RevisionChange, len(r.Changes))
+	for i, c := range r.Changes {
+		changes[i] = RevisionChange{Path: c.Path, Op: c.Op, OldValue: c.OldValue, NewValue: c.NewValue}
+	}
+	return Revision{
+		Id:              r.Id,
+		Created:         r.Created,
+		EntityType:      r.EntityType,
+		EntityName:      r.EntityName,
+		Version:         r.Version,
+		Action:          r.Action,
+		RollbackVersion: r.RollbackVersion,
+		Changes:         changes,
+		Content:         r.Content,
+		CorrelationId:   r.CorrelationId,
+		Caller:          r.Caller,
+	}
+}
+
+// FromRevisionModelsToDTOs transforms the Revision Models to the Revision DTOs
+func FromRevisionModelsToDTOs(revisions []models.Revision) []Revision {
+	dtos := make([]Revision, len(revisions))
+	for i, r := range revisions {
+		dtos[i] = FromRevisionModelToDTO(r)
+	}
+	return dtos
+}
@@ -0,0 +1,15 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_metadata.revision is used to store the change log of the devices, device profiles, device services and provision watchers
+CREATE TABLE IF NOT EXISTS core_metadata.revision (
+    id UUID PRIMARY KEY,
+    entity_type TEXT NOT NULL,
+    entity_name TEXT NOT NULL,
+    version BIGINT NOT NULL,
+    content JSONB NOT NULL,
+    created timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    UNIQUE (entity_type, entity_name, version)
+);
@@ -0,0 +1,13 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_metadata.device_profile_version is used to store the versions of the device profiles, the content of each version is stored in core_metadata.device_profile
+CREATE TABLE IF NOT EXISTS core_metadata.device_profile_version (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    profilename TEXT NOT NULL UNIQUE,
+    content JSONB NOT NULL,
+    created timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc')
+);
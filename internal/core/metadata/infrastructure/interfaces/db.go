@@ -8,6 +8,8 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 )
 
 type DBClient interface {
@@ -66,4 +68,18 @@ type DBClient interface {
 	ProvisionWatcherCountByLabels(labels []string) (uint32, errors.EdgeX)
 	ProvisionWatcherCountByServiceName(name string) (uint32, errors.EdgeX)
 	ProvisionWatcherCountByProfileName(name string) (uint32, errors.EdgeX)
+
+	AddRevision(r metadataModels.Revision) (metadataModels.Revision, errors.EdgeX)
+	RevisionByVersion(entityType string, entityName string, version int64) (metadataModels.Revision, errors.EdgeX)
+	AllRevisions(offset int, limit int) ([]metadataModels.Revision, errors.EdgeX)
+	RevisionTotalCount() (uint32, errors.EdgeX)
+	RevisionsByEntity(offset int, limit int, entityType string, entityName string) ([]metadataModels.Revision, errors.EdgeX)
+	RevisionCountByEntity(entityType string, entityName string) (uint32, errors.EdgeX)
+	DeleteRevisionsByEntityBeforeVersion(entityType string, entityName string, version int64) errors.EdgeX
+
+	AddDeviceProfileVersion(v metadataModels.DeviceProfileVersion) (metadataModels.DeviceProfileVersion, errors.EdgeX)
+	DeviceProfileVersionByProfileName(profileName string) (metadataModels.DeviceProfileVersion, errors.EdgeX)
+	DeviceProfileVersionsByName(offset int, limit int, name string) ([]metadataModels.DeviceProfileVersion, errors.EdgeX)
+	DeviceProfileVersionCountByName(name string) (uint32, errors.EdgeX)
+	DeleteDeviceProfileVersionByProfileName(profileName string) errors.EdgeX
 }
@@ -5,6 +5,8 @@ package mocks
 import (
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
+	metadatamodels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
@@ -75,6 +77,36 @@ func (_m *DBClient) AddDeviceProfile(e models.DeviceProfile) (models.DeviceProfi
 	return r0, r1
 }
 
+// AddDeviceProfileVersion provides a mock function with given fields: v
+func (_m *DBClient) AddDeviceProfileVersion(v metadatamodels.DeviceProfileVersion) (metadatamodels.DeviceProfileVersion, errors.EdgeX) {
+	ret := _m.Called(v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDeviceProfileVersion")
+	}
+
+	var r0 metadatamodels.DeviceProfileVersion
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(metadatamodels.DeviceProfileVersion) (metadatamodels.DeviceProfileVersion, errors.EdgeX)); ok {
+		return rf(v)
+	}
+	if rf, ok := ret.Get(0).(func(metadatamodels.DeviceProfileVersion) metadatamodels.DeviceProfileVersion); ok {
+		r0 = rf(v)
+	} else {
+		r0 = ret.Get(0).(metadatamodels.DeviceProfileVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(metadatamodels.DeviceProfileVersion) errors.EdgeX); ok {
+		r1 = rf(v)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddDeviceService provides a mock function with given fields: ds
 func (_m *DBClient) AddDeviceService(ds models.DeviceService) (models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(ds)
@@ -135,6 +167,36 @@ func (_m *DBClient) AddProvisionWatcher(pw models.ProvisionWatcher) (models.Prov
 	return r0, r1
 }
 
+// AddRevision provides a mock function with given fields: r
+func (_m *DBClient) AddRevision(r metadatamodels.Revision) (metadatamodels.Revision, errors.EdgeX) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRevision")
+	}
+
+	var r0 metadatamodels.Revision
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(metadatamodels.Revision) (metadatamodels.Revision, errors.EdgeX)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(metadatamodels.Revision) metadatamodels.Revision); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(metadatamodels.Revision)
+	}
+
+	if rf, ok := ret.Get(1).(func(metadatamodels.Revision) errors.EdgeX); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDeviceProfiles provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllDeviceProfiles(offset int, limit int, labels []string) ([]models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -263,6 +325,38 @@ func (_m *DBClient) AllProvisionWatchers(offset int, limit int, labels []string)
 	return r0, r1
 }
 
+// AllRevisions provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllRevisions(offset int, limit int) ([]metadatamodels.Revision, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllRevisions")
+	}
+
+	var r0 []metadatamodels.Revision
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]metadatamodels.Revision, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []metadatamodels.Revision); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metadatamodels.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
@@ -348,6 +442,26 @@ func (_m *DBClient) DeleteDeviceProfileByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteDeviceProfileVersionByProfileName provides a mock function with given fields: profileName
+func (_m *DBClient) DeleteDeviceProfileVersionByProfileName(profileName string) errors.EdgeX {
+	ret := _m.Called(profileName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeviceProfileVersionByProfileName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(profileName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteDeviceServiceById provides a mock function with given fields: id
 func (_m *DBClient) DeleteDeviceServiceById(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -408,6 +522,26 @@ func (_m *DBClient) DeleteProvisionWatcherByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteRevisionsByEntityBeforeVersion provides a mock function with given fields: entityType, entityName, version
+func (_m *DBClient) DeleteRevisionsByEntityBeforeVersion(entityType string, entityName string, version int64) errors.EdgeX {
+	ret := _m.Called(entityType, entityName, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRevisionsByEntityBeforeVersion")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string, int64) errors.EdgeX); ok {
+		r0 = rf(entityType, entityName, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeviceById provides a mock function with given fields: id
 func (_m *DBClient) DeviceById(id string) (models.Device, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -828,6 +962,98 @@ func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceProfileVersionByProfileName provides a mock function with given fields: profileName
+func (_m *DBClient) DeviceProfileVersionByProfileName(profileName string) (metadatamodels.DeviceProfileVersion, errors.EdgeX) {
+	ret := _m.Called(profileName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceProfileVersionByProfileName")
+	}
+
+	var r0 metadatamodels.DeviceProfileVersion
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (metadatamodels.DeviceProfileVersion, errors.EdgeX)); ok {
+		return rf(profileName)
+	}
+	if rf, ok := ret.Get(0).(func(string) metadatamodels.DeviceProfileVersion); ok {
+		r0 = rf(profileName)
+	} else {
+		r0 = ret.Get(0).(metadatamodels.DeviceProfileVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(profileName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileVersionCountByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceProfileVersionCountByName(name string) (uint32, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceProfileVersionCountByName")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (uint32, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileVersionsByName provides a mock function with given fields: offset, limit, name
+func (_m *DBClient) DeviceProfileVersionsByName(offset int, limit int, name string) ([]metadatamodels.DeviceProfileVersion, errors.EdgeX) {
+	ret := _m.Called(offset, limit, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceProfileVersionsByName")
+	}
+
+	var r0 []metadatamodels.DeviceProfileVersion
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]metadatamodels.DeviceProfileVersion, errors.EdgeX)); ok {
+		return rf(offset, limit, name)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []metadatamodels.DeviceProfileVersion); ok {
+		r0 = rf(offset, limit, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metadatamodels.DeviceProfileVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceProfilesByManufacturer provides a mock function with given fields: offset, limit, manufacturer
 func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, manufacturer)
@@ -1391,6 +1617,128 @@ func (_m *DBClient) ProvisionWatchersByServiceName(offset int, limit int, name s
 	return r0, r1
 }
 
+// RevisionByVersion provides a mock function with given fields: entityType, entityName, version
+func (_m *DBClient) RevisionByVersion(entityType string, entityName string, version int64) (metadatamodels.Revision, errors.EdgeX) {
+	ret := _m.Called(entityType, entityName, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevisionByVersion")
+	}
+
+	var r0 metadatamodels.Revision
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string, int64) (metadatamodels.Revision, errors.EdgeX)); ok {
+		return rf(entityType, entityName, version)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64) metadatamodels.Revision); ok {
+		r0 = rf(entityType, entityName, version)
+	} else {
+		r0 = ret.Get(0).(metadatamodels.Revision)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64) errors.EdgeX); ok {
+		r1 = rf(entityType, entityName, version)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// RevisionCountByEntity provides a mock function with given fields: entityType, entityName
+func (_m *DBClient) RevisionCountByEntity(entityType string, entityName string) (uint32, errors.EdgeX) {
+	ret := _m.Called(entityType, entityName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevisionCountByEntity")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) (uint32, errors.EdgeX)); ok {
+		return rf(entityType, entityName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) uint32); ok {
+		r0 = rf(entityType, entityName)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) errors.EdgeX); ok {
+		r1 = rf(entityType, entityName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// RevisionTotalCount provides a mock function with given fields:
+func (_m *DBClient) RevisionTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevisionTotalCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() (uint32, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// RevisionsByEntity provides a mock function with given fields: offset, limit, entityType, entityName
+func (_m *DBClient) RevisionsByEntity(offset int, limit int, entityType string, entityName string) ([]metadatamodels.Revision, errors.EdgeX) {
+	ret := _m.Called(offset, limit, entityType, entityName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevisionsByEntity")
+	}
+
+	var r0 []metadatamodels.Revision
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]metadatamodels.Revision, errors.EdgeX)); ok {
+		return rf(offset, limit, entityType, entityName)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []metadatamodels.Revision); ok {
+		r0 = rf(offset, limit, entityType, entityName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metadatamodels.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, entityType, entityName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // UpdateDevice provides a mock function with given fields: d
 func (_m *DBClient) UpdateDevice(d models.Device) errors.EdgeX {
 	ret := _m.Called(d)
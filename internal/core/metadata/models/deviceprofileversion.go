@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DeviceProfileVersion is an explicit version of a device profile. The content of each version is stored side by side
+// as a separate device profile named ProfileName, so a device is pinned to the version by using ProfileName as its
+// profile.
+type DeviceProfileVersion struct {
+	Id      string
+	Created int64
+	// Name is the name of the device profile which is versioned
+	Name        string
+	Version     string
+	ProfileName string
+	Description string
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import "encoding/json"
+
+// Revision is a versioned change of a device, device profile, device service or provision watcher. The revisions of an
+// entity are identified by the entity type and name and numbered from 1 in the order of the changes.
+type Revision struct {
+	Id         string
+	Created    int64
+	EntityType string
+	EntityName string
+	Version    int64
+	// Action is the add, update or delete action which made the change
+	Action string
+	// RollbackVersion is the version rolled back to if the change was made by a rollback
+	RollbackVersion int64
+	// Changes is the diff between the content of the previous revision and this one
+	Changes []RevisionChange
+	// Content is the entity DTO after the change, or before the change if the entity was deleted
+	Content       json.RawMessage
+	CorrelationId string
+	// Caller is the identity of the caller taken from the JWT of the request, empty if the caller is unknown
+	Caller string
+}
+
+// RevisionChange is a changed field of the entity, where Path is the JSON Pointer of the field and Op is one of add,
+// remove and replace as in the JSON Patch
+type RevisionChange struct {
+	Path     string
+	Op       string
+	OldValue any
+	NewValue any
+}
@@ -14,7 +14,9 @@ import (
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	metadataController "github.com/edgexfoundry/edgex-go/internal/core/metadata/controller/http"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/labstack/echo/v4"
 )
@@ -22,6 +24,9 @@ import (
 func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	authenticationHook := handlers.AutoConfigAuthenticationFunc(dic)
 
+	// record the caller of the requests in the revisions of the entities
+	r.Use(utils.CallerToContext)
+
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
 
@@ -44,6 +49,19 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.PATCH(common.ApiDeviceProfileBasicInfoRoute, dc.PatchDeviceProfileBasicInfo, authenticationHook)
 	r.GET(common.ApiAllDeviceProfileBasicInfoRoute, dc.AllDeviceProfileBasicInfos, authenticationHook)
 
+	// Device Profile Version
+	dpv := metadataController.NewDeviceProfileVersionController(dic)
+	r.POST(constants.ApiDeviceProfileVersionRoute, dpv.AddDeviceProfileVersion, authenticationHook)
+	r.GET(constants.ApiDeviceProfileVersionsByNameRoute, dpv.DeviceProfileVersionsByName, authenticationHook)
+	r.GET(constants.ApiDeviceProfileVersionByNameAndVersionRoute, dpv.DeviceProfileVersionByNameAndVersion, authenticationHook)
+	r.DELETE(constants.ApiDeviceProfileVersionByNameAndVersionRoute, dpv.DeleteDeviceProfileVersion, authenticationHook)
+	r.POST(constants.ApiMigrateDeviceProfileVersionRoute, dpv.MigrateDevices, authenticationHook)
+
+	// Bulk
+	bc := metadataController.NewBulkController(dic)
+	r.GET(constants.ApiBulkExportRoute, bc.ExportMetadata, authenticationHook)
+	r.POST(constants.ApiBulkImportRoute, bc.ImportMetadata, authenticationHook)
+
 	// Device Resource
 	dr := metadataController.NewDeviceResourceController(dic)
 	r.GET(common.ApiDeviceResourceByProfileAndResourceRoute, dr.DeviceResourceByProfileNameAndResourceName, authenticationHook)
@@ -85,4 +103,11 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiAllProvisionWatcherRoute, pwc.AllProvisionWatchers, authenticationHook)
 	r.DELETE(common.ApiProvisionWatcherByNameRoute, pwc.DeleteProvisionWatcherByName, authenticationHook)
 	r.PATCH(common.ApiProvisionWatcherRoute, pwc.PatchProvisionWatcher, authenticationHook)
+
+	// Revision
+	rc := metadataController.NewRevisionController(dic)
+	r.GET(constants.ApiAllRevisionRoute, rc.AllRevisions, authenticationHook)
+	r.GET(constants.ApiRevisionsByEntityRoute, rc.RevisionsByEntity, authenticationHook)
+	r.GET(constants.ApiRevisionByVersionRoute, rc.RevisionByVersion, authenticationHook)
+	r.POST(constants.ApiRollbackRevisionRoute, rc.RollbackRevision, authenticationHook)
 }
@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddAuditRecord adds a new audit record
+func (c *Client) AddAuditRecord(ctx context.Context, record commandModels.AuditRecord) (commandModels.AuditRecord, errors.EdgeX) {
+	if record.Id == "" {
+		record.Id = uuid.New().String()
+	}
+	if record.Created == 0 {
+		record.Created = pkgCommon.MakeTimestamp()
+	}
+
+	recordJSONBytes, err := json.Marshal(record)
+	if err != nil {
+		return record, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal audit record for Postgres persistence", err)
+	}
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(auditRecordTableName, idCol, auditDeviceNameCol, statusCol, contentCol, createdCol),
+		record.Id, record.DeviceName, record.Status, recordJSONBytes, time.UnixMilli(record.Created).UTC())
+	if err != nil {
+		return record, pgClient.WrapDBError("failed to insert audit record", err)
+	}
+	return record, nil
+}
+
+// AuditRecords queries the audit records by the optional device name and status with the given time range, offset and
+// limit, sorted in descending order of created timestamp
+func (c *Client) AuditRecords(ctx context.Context, deviceName, status string, start, end int64, offset, limit int) ([]commandModels.AuditRecord, errors.EdgeX) {
+	startTime, endTime, offset, validLimit, err := getValidTimeRangeParameters(start, end, offset, limit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	columns, args := auditRecordConditions(deviceName, status)
+	args = append([]any{startTime, endTime}, args...)
+	args = append(args, offset, validLimit)
+	records, err := queryAuditRecords(ctx, c.ConnPool, sqlQueryContentWithPaginationAndTimeRangeDescByCol(auditRecordTableName, createdCol, createdCol, columns...), args...)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query audit records by device name '%s' and status '%s'", deviceName, status), err)
+	}
+	return records, nil
+}
+
+// AuditRecordCount returns the count of the audit records by the optional device name and status with the given time range
+func (c *Client) AuditRecordCount(ctx context.Context, deviceName, status string, start, end int64) (uint32, errors.EdgeX) {
+	startTime, endTime := getUTCStartAndEndTime(start, end)
+	columns, args := auditRecordConditions(deviceName, status)
+	args = append([]any{startTime, endTime}, args...)
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCountByTimeRangeCol(auditRecordTableName, createdCol, nil, columns...), args...)
+}
+
+// LatestAuditRecordByOffset queries the audit record at the given offset in descending order of created timestamp
+func (c *Client) LatestAuditRecordByOffset(ctx context.Context, offset uint32) (commandModels.AuditRecord, errors.EdgeX) {
+	records, err := queryAuditRecords(ctx, c.ConnPool, sqlQueryContentWithPaginationDescByCol(auditRecordTableName, createdCol), offset, 1)
+	if err != nil {
+		return commandModels.AuditRecord{}, errors.NewCommonEdgeX(errors.Kind(err), "failed to query audit records", err)
+	}
+	if len(records) == 0 {
+		return commandModels.AuditRecord{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no audit record found with offset '%d'", offset), nil)
+	}
+	return records[0], nil
+}
+
+// DeleteAuditRecordsByAge deletes the audit records older than the given age in milliseconds
+func (c *Client) DeleteAuditRecordsByAge(ctx context.Context, age int64) errors.EdgeX {
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByAge(auditRecordTableName), age)
+	if err != nil {
+		return pgClient.WrapDBError("failed to delete audit records by age", err)
+	}
+	return nil
+}
+
+// auditRecordConditions returns the columns and the arguments to filter the audit records, the empty device name and
+// status are not filtered
+func auditRecordConditions(deviceName, status string) ([]string, []any) {
+	var columns []string
+	var args []any
+	if deviceName != "" {
+		columns = append(columns, auditDeviceNameCol)
+		args = append(args, deviceName)
+	}
+	if status != "" {
+		columns = append(columns, statusCol)
+		args = append(args, status)
+	}
+	return columns, args
+}
+
+func queryAuditRecords(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]commandModels.AuditRecord, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from audit record table", err)
+	}
+
+	records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (commandModels.AuditRecord, error) {
+		var r commandModels.AuditRecord
+		scanErr := row.Scan(&r)
+		return r, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to AuditRecord model", err)
+	}
+	return records, nil
+}
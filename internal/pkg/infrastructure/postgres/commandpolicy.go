@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddCommandPolicy adds a new command policy to the database
+func (c *Client) AddCommandPolicy(ctx context.Context, p commandModels.CommandPolicy) (commandModels.CommandPolicy, errors.EdgeX) {
+	if len(p.Id) == 0 {
+		p.Id = uuid.New().String()
+	}
+
+	exists, edgexErr := checkCommandPolicyExists(ctx, c.ConnPool, p.Name)
+	if edgexErr != nil {
+		return p, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	if exists {
+		return p, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command policy name '%s' already exists", p.Name), nil)
+	}
+
+	timestamp := time.Now().UTC().UnixMilli()
+	p.Created = timestamp
+	p.Modified = timestamp
+	dataBytes, err := json.Marshal(p)
+	if err != nil {
+		return p, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal CommandPolicy model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(commandPolicyTableName, idCol, contentCol), p.Id, dataBytes)
+	if err != nil {
+		return p, pgClient.WrapDBError("failed to insert row to core_command.command_policy table", err)
+	}
+	return p, nil
+}
+
+// AllCommandPolicies queries the command policies with the given offset and limit
+func (c *Client) AllCommandPolicies(ctx context.Context, offset, limit int) ([]commandModels.CommandPolicy, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	policies, err := queryCommandPolicies(ctx, c.ConnPool, sqlQueryContentWithPagination(commandPolicyTableName), offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to query all command policies", err)
+	}
+	return policies, nil
+}
+
+// CommandPolicyTotalCount returns the total count of command policies
+func (c *Client) CommandPolicyTotalCount(ctx context.Context) (uint32, errors.EdgeX) {
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCount(commandPolicyTableName))
+}
+
+// CommandPolicyById queries the command policy by id
+func (c *Client) CommandPolicyById(ctx context.Context, id string) (commandModels.CommandPolicy, errors.EdgeX) {
+	policy, err := queryCommandPolicy(ctx, c.ConnPool, sqlQueryAllById(commandPolicyTableName), id)
+	if err != nil {
+		return policy, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query command policy by id '%s'", id), err)
+	}
+	return policy, nil
+}
+
+// CommandPolicyByName queries the command policy by name
+func (c *Client) CommandPolicyByName(ctx context.Context, name string) (commandModels.CommandPolicy, errors.EdgeX) {
+	queryObj := map[string]any{nameField: name}
+	policy, err := queryCommandPolicy(ctx, c.ConnPool, sqlQueryContentByJSONField(commandPolicyTableName), queryObj)
+	if err != nil {
+		return policy, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query command policy by name '%s'", name), err)
+	}
+	return policy, nil
+}
+
+// UpdateCommandPolicy updates the command policy
+func (c *Client) UpdateCommandPolicy(ctx context.Context, p commandModels.CommandPolicy) errors.EdgeX {
+	p.Modified = time.Now().UTC().UnixMilli()
+	dataBytes, err := json.Marshal(p)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal CommandPolicy model", err)
+	}
+
+	queryObj := map[string]any{nameField: p.Name}
+	_, err = c.ConnPool.Exec(ctx, sqlUpdateColsByJSONCondCol(commandPolicyTableName, contentCol), dataBytes, queryObj)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update row by command policy name '%s' from core_command.command_policy table", p.Name), err)
+	}
+	return nil
+}
+
+// DeleteCommandPolicyByName deletes the command policy by name
+func (c *Client) DeleteCommandPolicyByName(ctx context.Context, name string) errors.EdgeX {
+	queryObj := map[string]any{nameField: name}
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONField(commandPolicyTableName), queryObj)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete command policy by name %s", name), err)
+	}
+	return nil
+}
+
+func checkCommandPolicyExists(ctx context.Context, connPool *pgxpool.Pool, name string) (bool, errors.EdgeX) {
+	var exists bool
+	queryObj := map[string]any{nameField: name}
+	err := connPool.QueryRow(ctx, sqlCheckExistsByJSONField(commandPolicyTableName), queryObj).Scan(&exists)
+	if err != nil {
+		return false, pgClient.WrapDBError(fmt.Sprintf("failed to query row by name '%s' from core_command.command_policy table", name), err)
+	}
+	return exists, nil
+}
+
+func queryCommandPolicy(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) (commandModels.CommandPolicy, errors.EdgeX) {
+	var policy commandModels.CommandPolicy
+	row := connPool.QueryRow(ctx, sql, args...)
+
+	if err := row.Scan(&policy); err != nil {
+		return policy, pgClient.WrapDBError("failed to query command policy", err)
+	}
+	return policy, nil
+}
+
+func queryCommandPolicies(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]commandModels.CommandPolicy, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from core_command.command_policy table", err)
+	}
+
+	policies, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (commandModels.CommandPolicy, error) {
+		var p commandModels.CommandPolicy
+		scanErr := row.Scan(&p)
+		return p, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to CommandPolicy model", err)
+	}
+	return policies, nil
+}
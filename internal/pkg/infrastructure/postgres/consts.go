@@ -6,6 +6,7 @@
 package postgres
 
 import (
+	command "github.com/edgexfoundry/edgex-go/internal/core/command/embed"
 	data "github.com/edgexfoundry/edgex-go/internal/core/data/embed"
 	keeper "github.com/edgexfoundry/edgex-go/internal/core/keeper/embed"
 	metadata "github.com/edgexfoundry/edgex-go/internal/core/metadata/embed"
@@ -16,21 +17,35 @@ import (
 
 // constants relate to the postgres db table names
 const (
-	configTableName               = keeper.SchemaName + ".config"
-	eventTableName                = data.SchemaName + ".event"
-	deviceInfoTableName           = data.SchemaName + ".device_info"
-	deviceServiceTableName        = metadata.SchemaName + ".device_service"
-	deviceProfileTableName        = metadata.SchemaName + ".device_profile"
-	deviceTableName               = metadata.SchemaName + ".device"
-	provisionWatcherTableName     = metadata.SchemaName + ".provision_watcher"
-	notificationTableName         = notifications.SchemaName + ".notification"
-	readingTableName              = data.SchemaName + ".reading"
-	registryTableName             = keeper.SchemaName + ".registry"
-	scheduleActionRecordTableName = scheduler.SchemaName + ".record"
-	scheduleJobTableName          = scheduler.SchemaName + ".job"
-	subscriptionTableName         = notifications.SchemaName + ".subscription"
-	transmissionTableName         = notifications.SchemaName + ".transmission"
-	keyStoreTableName             = proxyauth.SchemaName + ".key_store"
+	configTableName                  = keeper.SchemaName + ".config"
+	eventTableName                   = data.SchemaName + ".event"
+	deviceInfoTableName              = data.SchemaName + ".device_info"
+	deviceServiceTableName           = metadata.SchemaName + ".device_service"
+	deviceProfileTableName           = metadata.SchemaName + ".device_profile"
+	deviceTableName                  = metadata.SchemaName + ".device"
+	provisionWatcherTableName        = metadata.SchemaName + ".provision_watcher"
+	revisionTableName                = metadata.SchemaName + ".revision"
+	deviceProfileVersionTableName    = metadata.SchemaName + ".device_profile_version"
+	notificationTableName            = notifications.SchemaName + ".notification"
+	readingTableName                 = data.SchemaName + ".reading"
+	readingRollupTableName           = data.SchemaName + ".reading_rollup"
+	registryTableName                = keeper.SchemaName + ".registry"
+	scheduleActionRecordTableName    = scheduler.SchemaName + ".record"
+	scheduleJobTableName             = scheduler.SchemaName + ".job"
+	subscriptionTableName            = notifications.SchemaName + ".subscription"
+	transmissionTableName            = notifications.SchemaName + ".transmission"
+	transmissionJobTableName         = notifications.SchemaName + ".transmission_job"
+	notificationTemplateTableName    = notifications.SchemaName + ".notification_template"
+	transmissionSuppressionTableName = notifications.SchemaName + ".transmission_suppression"
+	subscriptionThrottleTableName    = notifications.SchemaName + ".subscription_throttle"
+	externalAddressTableName         = notifications.SchemaName + ".subscription_external_address"
+	keyStoreTableName                = proxyauth.SchemaName + ".key_store"
+	latestReadingTableName           = data.SchemaName + ".latest_reading"
+	deadLetterTableName              = data.SchemaName + ".dead_letter"
+	auditRecordTableName             = command.SchemaName + ".audit_record"
+	commandPolicyTableName           = command.SchemaName + ".command_policy"
+	deferredCommandTableName         = command.SchemaName + ".deferred_command"
+	deviceSnapshotTableName          = command.SchemaName + ".device_snapshot"
 )
 
 // constants relate to the common db table column names
@@ -59,6 +74,13 @@ const (
 	binaryValueCol    = "binaryvalue"
 	mediaTypeCol      = "mediatype"
 	objectValueCol    = "objectvalue"
+	resolutionCol     = "resolution"
+	bucketStartCol    = "bucketstart"
+	topicCol          = "topic"
+	correlationIdCol  = "correlationid"
+	contentTypeCol    = "contenttype"
+	payloadCol        = "payload"
+	errorCol          = "error"
 )
 
 // constants relate to the keeper postgres db table column names
@@ -74,9 +96,25 @@ const (
 	scheduledAtCol = "scheduled_at"
 )
 
+// constants relate to the audit record and device snapshot postgres db table column names
+const (
+	auditDeviceNameCol = "device_name"
+)
+
+// constants relate to the revision postgres db table column names
+const (
+	entityTypeCol = "entity_type"
+	entityNameCol = "entity_name"
+	versionCol    = "version"
+)
+
 // constants relate to the notification postgres db table column names
 const (
-	notificationIdCol = "notification_id"
+	notificationIdCol   = "notification_id"
+	nextAttemptAtCol    = "next_attempt_at"
+	transmissionIdCol   = "transmission_id"
+	suppressedCol       = "suppressed"
+	subscriptionNameCol = "subscription_name"
 )
 
 // constants relate to the field names in the content column
@@ -97,4 +135,5 @@ const (
 	statusField           = "Status"
 	subscriptionNameField = "SubscriptionName"
 	acknowledgedField     = "Acknowledged"
+	versionField          = "Version"
 )
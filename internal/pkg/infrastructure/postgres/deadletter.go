@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	stdErrs "errors"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+var deadLetterColumns = []string{idCol, createdCol, topicCol, correlationIdCol, contentTypeCol, payloadCol, errorCol}
+
+// AddDeadLetter adds a new dead letter
+func (c *Client) AddDeadLetter(d dataModels.DeadLetter) (dataModels.DeadLetter, errors.EdgeX) {
+	if d.Id == "" {
+		d.Id = uuid.New().String()
+	}
+	if d.Created == 0 {
+		d.Created = pkgCommon.MakeTimestamp()
+	}
+
+	_, err := c.ConnPool.Exec(context.Background(), sqlInsert(deadLetterTableName, deadLetterColumns...),
+		d.Id, d.Created, d.Topic, d.CorrelationId, d.ContentType, d.Payload, d.Error)
+	if err != nil {
+		return d, pgClient.WrapDBError("failed to insert dead letter", err)
+	}
+	return d, nil
+}
+
+// DeadLetterById gets a dead letter by id
+func (c *Client) DeadLetterById(id string) (dataModels.DeadLetter, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryFieldsByCol(deadLetterTableName, deadLetterColumns, idCol), id)
+	if err != nil {
+		return dataModels.DeadLetter{}, pgClient.WrapDBError(fmt.Sprintf("failed to query dead letter with id '%s'", id), err)
+	}
+	d, err := pgx.CollectExactlyOneRow(rows, deadLetterFromRow)
+	if err != nil {
+		if stdErrs.Is(err, pgx.ErrNoRows) {
+			return d, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no dead letter with id '%s' found", id), err)
+		}
+		return d, pgClient.WrapDBError(fmt.Sprintf("failed to query dead letter with id '%s'", id), err)
+	}
+	return d, nil
+}
+
+// AllDeadLetters queries the dead letters with the given offset and limit, sorted in descending order of created timestamp
+func (c *Client) AllDeadLetters(offset int, limit int) ([]dataModels.DeadLetter, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryAllWithPaginationDescByCol(deadLetterTableName, createdCol), offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query all dead letters", err)
+	}
+	deadLetters, err := pgx.CollectRows(rows, deadLetterFromRow)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect dead letters", err)
+	}
+	return deadLetters, nil
+}
+
+// DeadLetterTotalCount returns the total count of the dead letters
+func (c *Client) DeadLetterTotalCount() (uint32, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCount(deadLetterTableName))
+}
+
+// DeleteDeadLetterById deletes a dead letter by id
+func (c *Client) DeleteDeadLetterById(id string) errors.EdgeX {
+	result, err := c.ConnPool.Exec(context.Background(), sqlDeleteById(deadLetterTableName), id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete dead letter with id '%s'", id), err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no dead letter with id '%s' found", id), nil)
+	}
+	return nil
+}
+
+// TrimDeadLetters deletes the oldest dead letters so that at most maxCount dead letters are kept
+func (c *Client) TrimDeadLetters(maxCount uint32) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteExceedingCountDescByCol(deadLetterTableName, createdCol), maxCount)
+	if err != nil {
+		return pgClient.WrapDBError("failed to trim dead letters", err)
+	}
+	return nil
+}
+
+func deadLetterFromRow(row pgx.CollectableRow) (dataModels.DeadLetter, error) {
+	var d dataModels.DeadLetter
+	err := row.Scan(&d.Id, &d.Created, &d.Topic, &d.CorrelationId, &d.ContentType, &d.Payload, &d.Error)
+	return d, err
+}
@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddDeferredCommand adds a new deferred command
+func (c *Client) AddDeferredCommand(ctx context.Context, command commandModels.DeferredCommand) (commandModels.DeferredCommand, errors.EdgeX) {
+	if command.Id == "" {
+		command.Id = uuid.New().String()
+	}
+	if command.Created == 0 {
+		command.Created = pkgCommon.MakeTimestamp()
+	}
+	command.Modified = command.Created
+
+	dataBytes, err := json.Marshal(command)
+	if err != nil {
+		return command, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal deferred command for Postgres persistence", err)
+	}
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(deferredCommandTableName, idCol, statusCol, contentCol, createdCol),
+		command.Id, command.Status, dataBytes, time.UnixMilli(command.Created).UTC())
+	if err != nil {
+		return command, pgClient.WrapDBError("failed to insert deferred command", err)
+	}
+	return command, nil
+}
+
+// UpdateDeferredCommand updates the status and content of the deferred command
+func (c *Client) UpdateDeferredCommand(ctx context.Context, command commandModels.DeferredCommand) errors.EdgeX {
+	command.Modified = pkgCommon.MakeTimestamp()
+	dataBytes, err := json.Marshal(command)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal deferred command for Postgres persistence", err)
+	}
+	_, err = c.ConnPool.Exec(ctx, sqlUpdateColsByCondCol(deferredCommandTableName, idCol, statusCol, contentCol), command.Status, dataBytes, command.Id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update deferred command by id '%s'", command.Id), err)
+	}
+	return nil
+}
+
+// DeferredCommandById queries the deferred command by id
+func (c *Client) DeferredCommandById(ctx context.Context, id string) (commandModels.DeferredCommand, errors.EdgeX) {
+	var command commandModels.DeferredCommand
+	err := c.ConnPool.QueryRow(ctx, sqlQueryContentById(deferredCommandTableName), id).Scan(&command)
+	if err != nil {
+		return command, pgClient.WrapDBError(fmt.Sprintf("failed to query deferred command by id '%s'", id), err)
+	}
+	return command, nil
+}
+
+// DeferredCommandsByStatus queries the deferred commands by the optional status with the given offset and limit, sorted
+// in descending order of created timestamp
+func (c *Client) DeferredCommandsByStatus(ctx context.Context, status string, offset, limit int) ([]commandModels.DeferredCommand, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	var commands []commandModels.DeferredCommand
+	var err errors.EdgeX
+	if status == "" {
+		commands, err = queryDeferredCommands(ctx, c.ConnPool, sqlQueryContentWithPaginationDescByCol(deferredCommandTableName, createdCol), offset, validLimit)
+	} else {
+		commands, err = queryDeferredCommands(ctx, c.ConnPool, sqlQueryContentByColWithPaginationDescByCol(deferredCommandTableName, createdCol, statusCol), status, offset, validLimit)
+	}
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query deferred commands by status '%s'", status), err)
+	}
+	return commands, nil
+}
+
+// DeferredCommandCountByStatus returns the count of the deferred commands by the optional status
+func (c *Client) DeferredCommandCountByStatus(ctx context.Context, status string) (uint32, errors.EdgeX) {
+	if status == "" {
+		return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCount(deferredCommandTableName))
+	}
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCountByCol(deferredCommandTableName, statusCol), status)
+}
+
+// DeleteDeferredCommandsByAge deletes the deferred commands which are no longer pending and older than the given age in milliseconds
+func (c *Client) DeleteDeferredCommandsByAge(ctx context.Context, age int64) errors.EdgeX {
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByAgeAndExcludedCol(deferredCommandTableName, statusCol), age, commandModels.DeferredCommandStatusPending)
+	if err != nil {
+		return pgClient.WrapDBError("failed to delete deferred commands by age", err)
+	}
+	return nil
+}
+
+func queryDeferredCommands(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]commandModels.DeferredCommand, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from deferred command table", err)
+	}
+
+	commands, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (commandModels.DeferredCommand, error) {
+		var c commandModels.DeferredCommand
+		scanErr := row.Scan(&c)
+		return c, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to DeferredCommand model", err)
+	}
+	return commands, nil
+}
@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddDeviceProfileVersion adds a new device profile version, the profile name of the version must be unique
+func (c *Client) AddDeviceProfileVersion(v metadataModels.DeviceProfileVersion) (metadataModels.DeviceProfileVersion, errors.EdgeX) {
+	if v.Id == "" {
+		v.Id = uuid.New().String()
+	}
+	if v.Created == 0 {
+		v.Created = pkgCommon.MakeTimestamp()
+	}
+
+	dataBytes, err := json.Marshal(v)
+	if err != nil {
+		return v, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile version for Postgres persistence", err)
+	}
+	_, err = c.ConnPool.Exec(context.Background(), sqlInsert(deviceProfileVersionTableName, idCol, nameCol, profileNameCol, contentCol, createdCol),
+		v.Id, v.Name, v.ProfileName, dataBytes, time.UnixMilli(v.Created).UTC())
+	if err != nil {
+		return v, pgClient.WrapDBError(fmt.Sprintf("failed to insert version '%s' of device profile '%s'", v.Version, v.Name), err)
+	}
+	return v, nil
+}
+
+// DeviceProfileVersionByProfileName queries the device profile version by the name of the profile storing its content
+func (c *Client) DeviceProfileVersionByProfileName(profileName string) (metadataModels.DeviceProfileVersion, errors.EdgeX) {
+	var v metadataModels.DeviceProfileVersion
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(deviceProfileVersionTableName, []string{contentCol}, profileNameCol), profileName).Scan(&v)
+	if err != nil {
+		return v, pgClient.WrapDBError(fmt.Sprintf("failed to query device profile version by profile name '%s'", profileName), err)
+	}
+	return v, nil
+}
+
+// DeviceProfileVersionsByName queries the versions of the device profile with the given offset and limit, sorted in
+// descending order of created timestamp
+func (c *Client) DeviceProfileVersionsByName(offset int, limit int, name string) ([]metadataModels.DeviceProfileVersion, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	versions, err := queryDeviceProfileVersions(context.Background(), c.ConnPool,
+		sqlQueryContentByColWithPaginationDescByCol(deviceProfileVersionTableName, createdCol, nameCol), name, offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query versions of device profile '%s'", name), err)
+	}
+	return versions, nil
+}
+
+// DeviceProfileVersionCountByName returns the count of the versions of the device profile
+func (c *Client) DeviceProfileVersionCountByName(name string) (uint32, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByCol(deviceProfileVersionTableName, nameCol), name)
+}
+
+// DeleteDeviceProfileVersionByProfileName deletes the device profile version by the name of the profile storing its
+// content
+func (c *Client) DeleteDeviceProfileVersionByProfileName(profileName string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(deviceProfileVersionTableName, profileNameCol), profileName)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete device profile version by profile name '%s'", profileName), err)
+	}
+	return nil
+}
+
+func queryDeviceProfileVersions(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]metadataModels.DeviceProfileVersion, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from device profile version table", err)
+	}
+
+	versions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (metadataModels.DeviceProfileVersion, error) {
+		var v metadataModels.DeviceProfileVersion
+		scanErr := row.Scan(&v)
+		return v, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to DeviceProfileVersion model", err)
+	}
+	return versions, nil
+}
@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddDeviceSnapshot adds a new device snapshot
+func (c *Client) AddDeviceSnapshot(ctx context.Context, snapshot commandModels.DeviceSnapshot) (commandModels.DeviceSnapshot, errors.EdgeX) {
+	if snapshot.Id == "" {
+		snapshot.Id = uuid.New().String()
+	}
+	if snapshot.Created == 0 {
+		snapshot.Created = pkgCommon.MakeTimestamp()
+	}
+
+	dataBytes, err := json.Marshal(snapshot)
+	if err != nil {
+		return snapshot, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device snapshot for Postgres persistence", err)
+	}
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(deviceSnapshotTableName, idCol, auditDeviceNameCol, contentCol, createdCol),
+		snapshot.Id, snapshot.DeviceName, dataBytes, time.UnixMilli(snapshot.Created).UTC())
+	if err != nil {
+		return snapshot, pgClient.WrapDBError("failed to insert device snapshot", err)
+	}
+	return snapshot, nil
+}
+
+// DeviceSnapshotById queries the device snapshot by id
+func (c *Client) DeviceSnapshotById(ctx context.Context, id string) (commandModels.DeviceSnapshot, errors.EdgeX) {
+	var snapshot commandModels.DeviceSnapshot
+	err := c.ConnPool.QueryRow(ctx, sqlQueryContentById(deviceSnapshotTableName), id).Scan(&snapshot)
+	if err != nil {
+		return snapshot, pgClient.WrapDBError(fmt.Sprintf("failed to query device snapshot by id '%s'", id), err)
+	}
+	return snapshot, nil
+}
+
+// DeviceSnapshotsByDeviceName queries the device snapshots by the optional device name with the given offset and limit,
+// sorted in descending order of created timestamp
+func (c *Client) DeviceSnapshotsByDeviceName(ctx context.Context, deviceName string, offset, limit int) ([]commandModels.DeviceSnapshot, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	var snapshots []commandModels.DeviceSnapshot
+	var err errors.EdgeX
+	if deviceName == "" {
+		snapshots, err = queryDeviceSnapshots(ctx, c.ConnPool, sqlQueryContentWithPaginationDescByCol(deviceSnapshotTableName, createdCol), offset, validLimit)
+	} else {
+		snapshots, err = queryDeviceSnapshots(ctx, c.ConnPool, sqlQueryContentByColWithPaginationDescByCol(deviceSnapshotTableName, createdCol, auditDeviceNameCol), deviceName, offset, validLimit)
+	}
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query device snapshots by device name '%s'", deviceName), err)
+	}
+	return snapshots, nil
+}
+
+// DeviceSnapshotCountByDeviceName returns the count of the device snapshots by the optional device name
+func (c *Client) DeviceSnapshotCountByDeviceName(ctx context.Context, deviceName string) (uint32, errors.EdgeX) {
+	if deviceName == "" {
+		return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCount(deviceSnapshotTableName))
+	}
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCountByCol(deviceSnapshotTableName, auditDeviceNameCol), deviceName)
+}
+
+// DeleteDeviceSnapshotById deletes the device snapshot by id
+func (c *Client) DeleteDeviceSnapshotById(ctx context.Context, id string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteById(deviceSnapshotTableName), id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete device snapshot by id '%s'", id), err)
+	}
+	return nil
+}
+
+func queryDeviceSnapshots(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]commandModels.DeviceSnapshot, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from device snapshot table", err)
+	}
+
+	snapshots, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (commandModels.DeviceSnapshot, error) {
+		var s commandModels.DeviceSnapshot
+		scanErr := row.Scan(&s)
+		return s, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to DeviceSnapshot model", err)
+	}
+	return snapshots, nil
+}
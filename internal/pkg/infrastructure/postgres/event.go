@@ -9,8 +9,11 @@ import (
 	"context"
 	stdErrs "errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -21,6 +24,9 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// streamEventBatchSize is the number of the events queried in a batch by StreamEvents
+const streamEventBatchSize = 500
+
 // AllEvents queries the events with the given range, offset, and limit
 func (c *Client) AllEvents(offset, limit int) ([]model.Event, errors.EdgeX) {
 	ctx := context.Background()
@@ -86,6 +92,70 @@ func (c *Client) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	return event, nil
 }
 
+// AddEvents adds the events and their readings in a single transaction, either all the events are added or none of them
+func (c *Client) AddEvents(events []model.Event) ([]model.Event, errors.EdgeX) {
+	ctx := context.Background()
+
+	addedEvents := make([]model.Event, len(events))
+	deviceInfoIds := make([]int, len(events))
+	ids := make(map[string]struct{}, len(events))
+	for i, e := range events {
+		if e.Id == "" {
+			e.Id = uuid.NewString()
+		} else if _, err := uuid.Parse(e.Id); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindInvalidId, fmt.Sprintf("uuid %s parsing failed", e.Id), err)
+		}
+		if _, exists := ids[e.Id]; exists {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("Event Id %s is duplicated", e.Id), nil)
+		}
+		ids[e.Id] = struct{}{}
+		addedEvents[i] = e
+	}
+	// check the stored events before COPY, which fails the whole batch with the unique violation otherwise
+	existingId, err := c.firstExistingEventId(ctx, addedEvents)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if existingId != "" {
+		return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("Event Id %s exists", existingId), nil)
+	}
+
+	for i, e := range addedEvents {
+		deviceInfoId, err := c.deviceInfoIdByEvent(e)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		deviceInfoIds[i] = deviceInfoId
+	}
+
+	pgxErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		// insert events in batch
+		_, pgxErr := tx.CopyFrom(
+			ctx,
+			strings.Split(eventTableName, "."),
+			[]string{idCol, deviceInfoIdFKCol, originCol},
+			pgx.CopyFromSlice(len(addedEvents), func(i int) ([]any, error) {
+				return []any{addedEvents[i].Id, deviceInfoIds[i], addedEvents[i].Origin}, nil
+			}),
+		)
+		if pgxErr != nil {
+			return pgClient.WrapDBError("failed to insert events in batch", pgxErr)
+		}
+
+		// insert the readings of all the events in batch
+		pgxErr = c.addEventsReadingsInTx(tx, addedEvents)
+		if pgxErr != nil {
+			return errors.NewCommonEdgeXWrapper(pgxErr)
+		}
+		return nil
+	})
+	if pgxErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(pgxErr)
+	}
+
+	return addedEvents, nil
+}
+
 // EventById gets an event by id
 func (c *Client) EventById(id string) (model.Event, errors.EdgeX) {
 	ctx := context.Background()
@@ -270,19 +340,51 @@ func (c *Client) DeleteEventsByAgeAndDeviceNameAndSourceName(age int64, deviceNa
 	return c.deleteEventsByAgeAndConditions(age, []string{deviceNameCol, sourceNameCol}, []any{deviceName, sourceName})
 }
 
+// DeleteEventsByAgeAndDeviceName deletes the specific device's events and their corresponding readings that are older than age
+// This function is implemented to starts up two goroutines to delete readings and events in the background to achieve better performance
+func (c *Client) DeleteEventsByAgeAndDeviceName(age int64, deviceName string) errors.EdgeX {
+	return c.deleteEventsByAgeAndConditions(age, []string{deviceNameCol}, []any{deviceName})
+}
+
+// DeleteEventsByAgeAndExcludedDeviceNames deletes events and their corresponding readings that are older than age, except
+// the events of the specified devices
+// This function is implemented to starts up two goroutines to delete readings and events in the background to achieve better performance
+func (c *Client) DeleteEventsByAgeAndExcludedDeviceNames(age int64, deviceNames []string) errors.EdgeX {
+	if len(deviceNames) == 0 {
+		return c.DeleteEventsByAge(age)
+	}
+	expireTimestamp := time.Now().UnixNano() - age
+	c.deleteEventsAndReadingsInBackground(
+		age,
+		sqlDeleteEventsByTimeRangeAndExcludedColumn(originCol, deviceNameCol),
+		sqlQueryEventIdFieldByTimeRangeAndExcludedColumn(originCol, deviceNameCol),
+		[]any{expireTimestamp, deviceNames},
+	)
+	return nil
+}
+
 // deleteEventsByAgeAndConditions deletes events and their corresponding readings that are older than age
 // This function is implemented to starts up two goroutines to delete readings and events in the background to achieve better performance
 func (c *Client) deleteEventsByAgeAndConditions(age int64, cols []string, values []any) errors.EdgeX {
-	ctx := context.Background()
 	expireTimestamp := time.Now().UnixNano() - age
-	sqlStatement := sqlDeleteEventsByTimeRangeAndColumn(originCol, cols...)
-	args := append([]any{expireTimestamp}, values...)
+	c.deleteEventsAndReadingsInBackground(
+		age,
+		sqlDeleteEventsByTimeRangeAndColumn(originCol, cols...),
+		// select the event ids within the origin time range from event table as the sub-query of deleting readings
+		sqlQueryEventIdFieldByTimeRangeAndConditions(originCol, cols...),
+		append([]any{expireTimestamp}, values...),
+	)
+	return nil
+}
+
+// deleteEventsAndReadingsInBackground deletes the events by the sql statement and their corresponding readings by the event
+// ids sub-query in a transaction in the background
+func (c *Client) deleteEventsAndReadingsInBackground(age int64, sqlStatement, subSqlStatement string, args []any) {
+	ctx := context.Background()
 
 	go func() {
 		// delete events and readings in a transaction
 		_ = pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
-			// select the event ids within the origin time range from event table as the sub-query of deleting readings
-			subSqlStatement := sqlQueryEventIdFieldByTimeRangeAndConditions(originCol, cols...)
 			if err := deleteReadingsBySubQuery(ctx, tx, subSqlStatement, args...); err != nil {
 				c.loggingClient.Errorf("failed delete readings by age '%d' nanoseconds: %v", age, err)
 				return err
@@ -296,8 +398,122 @@ func (c *Client) deleteEventsByAgeAndConditions(age int64, cols []string, values
 			return nil
 		})
 	}()
+}
 
-	return nil
+// EventsByCursor query events by the query conditions and the limit, starting after the cursor. Events are sorted in descending order of origin and id.
+func (c *Client) EventsByCursor(conds dataModels.EventQueryConditions, cursor dataModels.Cursor, limit int) ([]model.Event, errors.EdgeX) {
+	whereCondition, args, edgeXerr := eventQueryConditionsToWhereCond(conds)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if !cursor.IsEmpty() {
+		if _, err := uuid.Parse(cursor.Id); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the id of the cursor as uuid", err)
+		}
+		whereCondition += " AND " + constructKeysetDescCond("event."+originCol, "event."+idCol, len(args))
+		args = append(args, cursor.Origin, cursor.Id)
+	}
+	_, validLimit := getValidOffsetAndLimit(0, limit)
+	args = append(args, validLimit)
+
+	events, err := queryEvents(context.Background(), c.ConnPool, sqlQueryAllEventByCondAndLimitDescByKeyset(whereCondition, len(args)), args...)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return events, nil
+}
+
+// firstExistingEventId returns the id of one of the events stored already, or empty string if none of them is stored
+func (c *Client) firstExistingEventId(ctx context.Context, events []model.Event) (string, errors.EdgeX) {
+	ids := make([]string, len(events))
+	for i, e := range events {
+		ids[i] = e.Id
+	}
+	var existingId string
+	err := c.ConnPool.QueryRow(ctx, sqlQueryEventIdByIds(), ids).Scan(&existingId)
+	if stdErrs.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	} else if err != nil {
+		return "", pgClient.WrapDBError("failed to query the existing event ids", err)
+	}
+	return existingId, nil
+}
+
+// StreamEvents queries the events by the query conditions in ascending order of origin and id, and passes the events
+// to the handler one by one, the iteration stops when the handler returns error. The events are queried in batches by
+// the (origin, id) keyset, and the readings of a batch are queried in one statement after the event rows are closed, so
+// that a stream holds only one connection at a time.
+func (c *Client) StreamEvents(ctx context.Context, conds dataModels.EventQueryConditions, handler func(model.Event) error) errors.EdgeX {
+	whereCondition, args, edgeXerr := eventQueryConditionsToWhereCond(conds)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	var last *model.Event
+	for {
+		batchCondition, batchArgs := whereCondition, slices.Clone(args)
+		if last != nil {
+			batchCondition += " AND " + constructKeysetAscCond("event."+originCol, "event."+idCol, len(batchArgs))
+			batchArgs = append(batchArgs, last.Origin, last.Id)
+		}
+		batchArgs = append(batchArgs, streamEventBatchSize)
+
+		rows, err := c.ConnPool.Query(ctx, sqlQueryAllEventByCondAndLimitAscByKeyset(batchCondition, len(batchArgs)), batchArgs...)
+		if err != nil {
+			return pgClient.WrapDBError("failed to query events", err)
+		}
+		events, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[model.Event])
+		if err != nil {
+			return pgClient.WrapDBError("failed to scan events", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		eventIds := make([]string, len(events))
+		for i, e := range events {
+			eventIds[i] = e.Id
+		}
+		readings, edgeXerr := queryReadingsByEventIds(ctx, c.ConnPool, eventIds)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, e := range events {
+			e.Readings = readings[e.Id]
+			if err = handler(e); err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+		if len(events) < streamEventBatchSize {
+			return nil
+		}
+		last = &events[len(events)-1]
+	}
+}
+
+// EventCountByQueryConditions returns the count of events by the query conditions from db
+func (c *Client) EventCountByQueryConditions(conds dataModels.EventQueryConditions) (uint32, errors.EdgeX) {
+	whereCondition, args, edgeXerr := eventQueryConditionsToWhereCond(conds)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountEventByCond(whereCondition), args...)
+}
+
+// eventQueryConditionsToWhereCond converts the event query conditions to the where condition and the corresponding args
+func eventQueryConditionsToWhereCond(conds dataModels.EventQueryConditions) (string, []any, errors.EdgeX) {
+	start, end, edgeXerr := getValidStartAndEnd(conds.Start, conds.End)
+	if edgeXerr != nil {
+		return "", nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	var cols []string
+	args := []any{start, end}
+	if conds.DeviceName != "" {
+		cols = append(cols, deviceNameCol)
+		args = append(args, conds.DeviceName)
+	}
+	return constructWhereCondWithTimeRange("event."+originCol, "event."+originCol, nil, cols...), args, nil
 }
 
 // queryEvents queries the data rows with given sql statement and passed args, and unmarshal the data rows to the Event model slice
@@ -309,20 +525,7 @@ func queryEvents(ctx context.Context, connPool *pgxpool.Pool, sql string, args .
 
 	var events []model.Event
 	events, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Event, error) {
-		event, err := pgx.RowToStructByNameLax[model.Event](row)
-		if err != nil {
-			return model.Event{}, err
-		}
-
-		// query reading by the specific even_id and origin descending
-		readings, err := queryReadings(ctx, connPool, sqlQueryAllReadingAndDescWithConds(originCol, eventIdFKCol), event.Id)
-
-		if err != nil {
-			return model.Event{}, err
-		}
-
-		event.Readings = readings
-		return event, nil
+		return eventFromRow(ctx, connPool, row)
 	})
 
 	if err != nil {
@@ -332,6 +535,24 @@ func queryEvents(ctx context.Context, connPool *pgxpool.Pool, sql string, args .
 	return events, nil
 }
 
+// eventFromRow converts the data row to the Event model and queries the readings of the event
+func eventFromRow(ctx context.Context, connPool *pgxpool.Pool, row pgx.CollectableRow) (model.Event, error) {
+	event, err := pgx.RowToStructByNameLax[model.Event](row)
+	if err != nil {
+		return model.Event{}, err
+	}
+
+	// query reading by the specific even_id and origin descending
+	readings, err := queryReadings(ctx, connPool, sqlQueryAllReadingAndDescWithConds(originCol, eventIdFKCol), event.Id)
+
+	if err != nil {
+		return model.Event{}, err
+	}
+
+	event.Readings = readings
+	return event, nil
+}
+
 // deleteEvents delete the data rows with given sql statement and passed args in a db transaction
 func deleteEvents(ctx context.Context, tx pgx.Tx, sqlStatement string, args ...any) errors.EdgeX {
 	commandTag, err := tx.Exec(
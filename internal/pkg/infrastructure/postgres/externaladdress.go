@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	stdErrs "errors"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/jackc/pgx/v5"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// SetSubscriptionExternalAddresses adds the external addresses of the subscription, or replaces the existing ones
+func (c *Client) SetSubscriptionExternalAddresses(addresses notificationModels.SubscriptionExternalAddresses) (notificationModels.SubscriptionExternalAddresses, errors.EdgeX) {
+	addresses.Created = pkgCommon.MakeTimestamp()
+	addresses.Modified = addresses.Created
+	dataBytes, err := json.Marshal(addresses)
+	if err != nil {
+		return addresses, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal subscription external addresses for Postgres persistence", err)
+	}
+	err = c.ConnPool.QueryRow(context.Background(), sqlUpsertBySubscriptionName(externalAddressTableName), addresses.SubscriptionName, dataBytes).Scan(&addresses)
+	if err != nil {
+		return addresses, pgClient.WrapDBError(fmt.Sprintf("failed to set the external addresses of subscription '%s'", addresses.SubscriptionName), err)
+	}
+	return addresses, nil
+}
+
+// SubscriptionExternalAddressesByName queries the external addresses of the subscription by the subscription name
+func (c *Client) SubscriptionExternalAddressesByName(subscriptionName string) (addresses notificationModels.SubscriptionExternalAddresses, edgeXerr errors.EdgeX) {
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(externalAddressTableName, []string{contentCol}, subscriptionNameCol), subscriptionName).Scan(&addresses)
+	if err != nil {
+		if stdErrs.Is(err, pgx.ErrNoRows) {
+			return addresses, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no external addresses of subscription '%s' found", subscriptionName), err)
+		}
+		return addresses, pgClient.WrapDBError(fmt.Sprintf("failed to query the external addresses of subscription '%s'", subscriptionName), err)
+	}
+	return addresses, nil
+}
+
+// AllSubscriptionExternalAddresses queries the external addresses of all the subscriptions
+func (c *Client) AllSubscriptionExternalAddresses() ([]notificationModels.SubscriptionExternalAddresses, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContent(externalAddressTableName))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query all subscription external addresses", err)
+	}
+	allAddresses, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationModels.SubscriptionExternalAddresses, error) {
+		var a notificationModels.SubscriptionExternalAddresses
+		scanErr := row.Scan(&a)
+		return a, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to SubscriptionExternalAddresses model", err)
+	}
+	return allAddresses, nil
+}
+
+// DeleteSubscriptionExternalAddressesByName deletes the external addresses of the subscription by the subscription name
+func (c *Client) DeleteSubscriptionExternalAddressesByName(subscriptionName string) errors.EdgeX {
+	result, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(externalAddressTableName, subscriptionNameCol), subscriptionName)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the external addresses of subscription '%s'", subscriptionName), err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no external addresses of subscription '%s' found", subscriptionName), nil)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/jackc/pgx/v5"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// UpsertLatestReadings stores the latest readings of the device resources in one batch, the stored reading of a device
+// resource is replaced only if the new one has a later or equal origin
+func (c *Client) UpsertLatestReadings(readings []model.Reading) errors.EdgeX {
+	if len(readings) == 0 {
+		return nil
+	}
+
+	b := &pgx.Batch{}
+	for _, r := range readings {
+		baseReading := r.GetBaseReading()
+		content, err := json.Marshal(dtos.FromReadingModelToDTO(r))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal the latest reading", err)
+		}
+		b.Queue(sqlUpsertLatestReading(), baseReading.DeviceName, baseReading.ResourceName, baseReading.Origin, content)
+	}
+	err := c.ConnPool.SendBatch(context.Background(), b).Close()
+	if err != nil {
+		return pgClient.WrapDBError("failed to upsert the latest readings", err)
+	}
+	return nil
+}
+
+// AllLatestReadings queries the latest readings of all the device resources
+func (c *Client) AllLatestReadings() ([]model.Reading, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContent(latestReadingTableName))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query the latest readings", err)
+	}
+
+	readings, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Reading, error) {
+		var reading dtos.BaseReading
+		err := row.Scan(&reading)
+		if err != nil {
+			return nil, err
+		}
+		return dtos.ToReadingModel(reading), nil
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect the latest readings", err)
+	}
+	return readings, nil
+}
+
+// DeleteLatestReadingsByDeviceName deletes the latest readings of all the resources of the specified device
+func (c *Client) DeleteLatestReadingsByDeviceName(deviceName string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(latestReadingTableName, deviceNameCol), deviceName)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the latest readings of device '%s'", deviceName), err)
+	}
+	return nil
+}
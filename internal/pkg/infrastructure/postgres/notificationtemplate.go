@@ -0,0 +1,155 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddNotificationTemplate adds a new notification template to the database
+func (c *Client) AddNotificationTemplate(t notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	ctx := context.Background()
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+
+	exists, edgexErr := checkNotificationTemplateExists(ctx, c.ConnPool, t.Name)
+	if edgexErr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("notification template name '%s' already exists", t.Name), nil)
+	}
+
+	timestamp := time.Now().UTC().UnixMilli()
+	t.Created = timestamp
+	t.Modified = timestamp
+	dataBytes, err := json.Marshal(t)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal NotificationTemplate model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(notificationTemplateTableName, idCol, contentCol), t.Id, dataBytes)
+	if err != nil {
+		return t, pgClient.WrapDBError("failed to insert row to support_notifications.notification_template table", err)
+	}
+	return t, nil
+}
+
+// AllNotificationTemplates queries the notification templates with the given offset and limit
+func (c *Client) AllNotificationTemplates(offset, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	ctx := context.Background()
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	templates, err := queryNotificationTemplates(ctx, c.ConnPool, sqlQueryContentWithPagination(notificationTemplateTableName), offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to query all notification templates", err)
+	}
+	return templates, nil
+}
+
+// NotificationTemplateTotalCount returns the total count of notification templates
+func (c *Client) NotificationTemplateTotalCount() (uint32, errors.EdgeX) {
+	ctx := context.Background()
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCount(notificationTemplateTableName))
+}
+
+// NotificationTemplateById queries the notification template by id
+func (c *Client) NotificationTemplateById(id string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	ctx := context.Background()
+	template, err := queryNotificationTemplate(ctx, c.ConnPool, sqlQueryAllById(notificationTemplateTableName), id)
+	if err != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query notification template by id '%s'", id), err)
+	}
+	return template, nil
+}
+
+// NotificationTemplateByName queries the notification template by name
+func (c *Client) NotificationTemplateByName(name string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	ctx := context.Background()
+	queryObj := map[string]any{nameField: name}
+	template, err := queryNotificationTemplate(ctx, c.ConnPool, sqlQueryContentByJSONField(notificationTemplateTableName), queryObj)
+	if err != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query notification template by name '%s'", name), err)
+	}
+	return template, nil
+}
+
+// UpdateNotificationTemplate updates the notification template
+func (c *Client) UpdateNotificationTemplate(t notificationModels.NotificationTemplate) errors.EdgeX {
+	ctx := context.Background()
+	t.Modified = time.Now().UTC().UnixMilli()
+	dataBytes, err := json.Marshal(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal NotificationTemplate model", err)
+	}
+
+	queryObj := map[string]any{nameField: t.Name}
+	_, err = c.ConnPool.Exec(ctx, sqlUpdateColsByJSONCondCol(notificationTemplateTableName, contentCol), dataBytes, queryObj)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update row by notification template name '%s' from support_notifications.notification_template table", t.Name), err)
+	}
+	return nil
+}
+
+// DeleteNotificationTemplateByName deletes the notification template by name
+func (c *Client) DeleteNotificationTemplateByName(name string) errors.EdgeX {
+	ctx := context.Background()
+	queryObj := map[string]any{nameField: name}
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONField(notificationTemplateTableName), queryObj)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete notification template by name %s", name), err)
+	}
+	return nil
+}
+
+func checkNotificationTemplateExists(ctx context.Context, connPool *pgxpool.Pool, name string) (bool, errors.EdgeX) {
+	var exists bool
+	queryObj := map[string]any{nameField: name}
+	err := connPool.QueryRow(ctx, sqlCheckExistsByJSONField(notificationTemplateTableName), queryObj).Scan(&exists)
+	if err != nil {
+		return false, pgClient.WrapDBError(fmt.Sprintf("failed to query row by name '%s' from support_notifications.notification_template table", name), err)
+	}
+	return exists, nil
+}
+
+func queryNotificationTemplate(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	var template notificationModels.NotificationTemplate
+	row := connPool.QueryRow(ctx, sql, args...)
+
+	if err := row.Scan(&template); err != nil {
+		return template, pgClient.WrapDBError("failed to query notification template", err)
+	}
+	return template, nil
+}
+
+func queryNotificationTemplates(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from support_notifications.notification_template table", err)
+	}
+
+	templates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationModels.NotificationTemplate, error) {
+		var t notificationModels.NotificationTemplate
+		scanErr := row.Scan(&t)
+		return t, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to NotificationTemplate model", err)
+	}
+	return templates, nil
+}
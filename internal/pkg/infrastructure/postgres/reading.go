@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,8 +9,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
 	dbModels "github.com/edgexfoundry/edgex-go/internal/pkg/infrastructure/postgres/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -21,9 +23,12 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// streamReadingBatchSize is the number of the readings queried in a batch by StreamReadings
+const streamReadingBatchSize = 500
+
 var (
 	// insertReadingCols defines the reading table columns in slice used in inserting readings
-	insertReadingCols = []string{eventIdFKCol, deviceInfoIdFKCol, originCol, valueCol, binaryValueCol, objectValueCol}
+	insertReadingCols = []string{idCol, eventIdFKCol, deviceInfoIdFKCol, originCol, valueCol, binaryValueCol, objectValueCol}
 )
 
 func (c *Client) ReadingTotalCount() (uint32, errors.EdgeX) {
@@ -228,57 +233,191 @@ func (c *Client) LatestReadingByOffset(offset uint32) (model.Reading, errors.Edg
 	return readings[0], nil
 }
 
-// queryReadings queries the data rows with given sql statement and passed args, converts the rows to map and unmarshal the data rows to the Reading model slice
-func queryReadings(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]model.Reading, errors.EdgeX) {
-	rows, err := connPool.Query(ctx, sql, args...)
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange computes the statistics of the numeric readings by the specified device and resource,
+// origin within the time range, grouped by the fixed time buckets of the interval in nanoseconds
+func (c *Client) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval int64) ([]dataModels.ReadingAggregate, errors.EdgeX) {
+	start, end, edgeXerr := getValidStartAndEnd(start, end)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if interval <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "interval must be greater than 0", nil)
+	}
+
+	sqlStatement := sqlQueryReadingAggregatesByTimeRangeCol(originCol, deviceNameCol, resourceNameCol)
+	rows, err := c.ConnPool.Query(context.Background(), sqlStatement, start, end, deviceName, resourceName, interval, dataModels.NumericValueTypes)
 	if err != nil {
-		return nil, pgClient.WrapDBError("query failed", err)
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to aggregate readings by device '%s' and resource '%s'", deviceName, resourceName), err)
 	}
 
-	readings, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Reading, error) {
-		var reading model.Reading
+	aggregates, err := pgx.CollectRows(rows, pgx.RowToStructByName[dataModels.ReadingAggregate])
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect reading aggregates", err)
+	}
+	return aggregates, nil
+}
 
-		readingDBModel, err := pgx.RowToStructByNameLax[dbModels.Reading](row)
-		if err != nil {
-			return nil, pgClient.WrapDBError("failed to convert row to map", err)
+// ReadingsByCursor query readings by the query conditions and the limit, starting after the cursor. Readings are sorted in descending order of origin and id.
+func (c *Client) ReadingsByCursor(conds dataModels.ReadingQueryConditions, cursor dataModels.Cursor, limit int) ([]model.Reading, errors.EdgeX) {
+	whereCondition, args, edgeXerr := readingQueryConditionsToWhereCond(conds)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if !cursor.IsEmpty() {
+		if _, err := uuid.Parse(cursor.Id); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the id of the cursor as uuid", err)
 		}
+		whereCondition += " AND " + constructKeysetDescCond("reading."+originCol, "reading."+idCol, len(args))
+		args = append(args, cursor.Origin, cursor.Id)
+	}
+	_, validLimit := getValidOffsetAndLimit(0, limit)
+	args = append(args, validLimit)
+
+	readings, err := queryReadings(context.Background(), c.ConnPool, sqlQueryAllReadingByCondAndLimitDescByKeyset(whereCondition, len(args)), args...)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return readings, nil
+}
 
-		// convert the BaseReading fields to BaseReading struct defined in contract
-		baseReading := readingDBModel.GetBaseReading()
+// ReadingsByQueryConditions query readings by the query conditions with offset and limit. Readings are sorted in descending order of origin and id.
+func (c *Client) ReadingsByQueryConditions(conds dataModels.ReadingQueryConditions, offset int, limit int) ([]model.Reading, errors.EdgeX) {
+	whereCondition, args, edgeXerr := readingQueryConditionsToWhereCond(conds)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	args = append(args, offset, validLimit)
 
-		if readingDBModel.BinaryValue != nil {
-			// reading type is BinaryReading
-			binaryReading := model.BinaryReading{
-				BaseReading: baseReading,
-				MediaType:   *readingDBModel.MediaType,
-				BinaryValue: readingDBModel.BinaryValue,
-			}
-			reading = binaryReading
-		} else if readingDBModel.ObjectValue != nil {
-			// reading type is ObjectReading
-			objReading := model.ObjectReading{
-				BaseReading: baseReading,
-				ObjectValue: readingDBModel.ObjectValue,
+	readings, err := queryReadings(context.Background(), c.ConnPool, sqlQueryAllReadingByCondAndOffsetLimitDesc(whereCondition, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return readings, nil
+}
+
+// StreamReadings queries the readings by the query conditions in ascending order of origin and id, and passes the readings
+// to the handler one by one, the iteration stops when the handler returns error. The readings are queried in batches by
+// the (origin, id) keyset and each batch is passed to the handler after its rows are closed, so that a stream holds a
+// pooled connection only while querying a batch rather than while the handler writes the readings.
+func (c *Client) StreamReadings(ctx context.Context, conds dataModels.ReadingQueryConditions, handler func(model.Reading) error) errors.EdgeX {
+	whereCondition, args, edgeXerr := readingQueryConditionsToWhereCond(conds)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	var last *model.BaseReading
+	for {
+		batchCondition, batchArgs := whereCondition, slices.Clone(args)
+		if last != nil {
+			batchCondition += " AND " + constructKeysetAscCond("reading."+originCol, "reading."+idCol, len(batchArgs))
+			batchArgs = append(batchArgs, last.Origin, last.Id)
+		}
+		batchArgs = append(batchArgs, streamReadingBatchSize)
+
+		readings, edgeXerr := queryReadings(ctx, c.ConnPool, sqlQueryAllReadingByCondAndLimitAscByKeyset(batchCondition, len(batchArgs)), batchArgs...)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, reading := range readings {
+			if err := handler(reading); err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
 			}
-			reading = objReading
-		} else if readingDBModel.Value != nil {
-			// reading type is SimpleReading
-			simpleReading := model.SimpleReading{
-				BaseReading: baseReading,
-				Value:       *readingDBModel.Value,
+		}
+		if len(readings) < streamReadingBatchSize {
+			return nil
+		}
+		lastReading := readings[len(readings)-1].GetBaseReading()
+		last = &lastReading
+	}
+}
+
+// ReadingCountByQueryConditions returns the count of readings by the query conditions from db
+func (c *Client) ReadingCountByQueryConditions(conds dataModels.ReadingQueryConditions) (uint32, errors.EdgeX) {
+	whereCondition, args, edgeXerr := readingQueryConditionsToWhereCond(conds)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountReadingByCond(whereCondition), args...)
+}
+
+// readingQueryConditionsToWhereCond converts the reading query conditions to the where condition and the corresponding args
+func readingQueryConditionsToWhereCond(conds dataModels.ReadingQueryConditions) (string, []any, errors.EdgeX) {
+	start, end, edgeXerr := getValidStartAndEnd(conds.Start, conds.End)
+	if edgeXerr != nil {
+		return "", nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	var cols, arrayCols []string
+	args := []any{start, end}
+	if conds.DeviceName != "" {
+		cols = append(cols, deviceNameCol)
+		args = append(args, conds.DeviceName)
+	}
+	if len(conds.ResourceNames) > 0 {
+		cols = append(cols, resourceNameCol)
+		arrayCols = append(arrayCols, resourceNameCol)
+		args = append(args, conds.ResourceNames)
+	} else if conds.ResourceName != "" {
+		cols = append(cols, resourceNameCol)
+		args = append(args, conds.ResourceName)
+	}
+	whereCondition := constructWhereCondWithTimeRange("reading."+originCol, "reading."+originCol, arrayCols, cols...)
+
+	filterCondition, args, edgeXerr := readingFilterToWhereCond(conds.ReadingFilter, args)
+	if edgeXerr != nil {
+		return "", nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if filterCondition != "" {
+		whereCondition += " AND " + filterCondition
+	}
+	return whereCondition, args, nil
+}
+
+// readingFilterToWhereCond converts the reading filter to the where condition whose parameters follow the passed args,
+// and returns the condition along with the args appended with the filter values
+func readingFilterToWhereCond(filter dataModels.ReadingFilter, args []any) (string, []any, errors.EdgeX) {
+	var conditions []string
+	if filter.HasValueBound() {
+		// the CASE expression guarantees that only the numeric values are cast, as the evaluation order of AND is not guaranteed
+		args = append(args, dataModels.NumericValueTypes)
+		numericValue := fmt.Sprintf("CASE WHEN %s = ANY ($%d) THEN reading.%s::numeric END", valueTypeCol, len(args), valueCol)
+		if filter.ValueMin != nil {
+			args = append(args, *filter.ValueMin)
+			operator := ">"
+			if filter.ValueMinInclusive {
+				operator = ">="
 			}
-			reading = simpleReading
-		} else {
-			// reading type is NullReading
-			nullReading := model.NullReading{
-				BaseReading: baseReading,
-				Value:       nil,
+			conditions = append(conditions, fmt.Sprintf("%s %s $%d", numericValue, operator, len(args)))
+		}
+		if filter.ValueMax != nil {
+			args = append(args, *filter.ValueMax)
+			operator := "<"
+			if filter.ValueMaxInclusive {
+				operator = "<="
 			}
-			reading = nullReading
+			conditions = append(conditions, fmt.Sprintf("%s %s $%d", numericValue, operator, len(args)))
 		}
+	}
+	if len(filter.Tags) > 0 {
+		tagsBytes, err := json.Marshal(filter.Tags)
+		if err != nil {
+			return "", nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal the tags of the reading filter", err)
+		}
+		args = append(args, string(tagsBytes))
+		conditions = append(conditions, fmt.Sprintf("device_info.%s @> $%d::jsonb", tagsCol, len(args)))
+	}
+	return strings.Join(conditions, " AND "), args, nil
+}
 
-		return reading, nil
-	})
+// queryReadings queries the data rows with given sql statement and passed args, converts the rows to map and unmarshal the data rows to the Reading model slice
+func queryReadings(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]model.Reading, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("query failed", err)
+	}
+
+	readings, err := pgx.CollectRows(rows, readingFromRow)
 
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
@@ -287,6 +426,74 @@ func queryReadings(ctx context.Context, connPool *pgxpool.Pool, sql string, args
 	return readings, nil
 }
 
+// readingFromRow converts the data row to the BinaryReading/ObjectReading/SimpleReading/NullReading struct based on the reading value
+func readingFromRow(row pgx.CollectableRow) (model.Reading, error) {
+	readingDBModel, err := pgx.RowToStructByNameLax[dbModels.Reading](row)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to convert row to map", err)
+	}
+	return readingFromDBModel(readingDBModel), nil
+}
+
+// readingFromDBModel converts the reading db model to the BinaryReading/ObjectReading/SimpleReading/NullReading struct
+func readingFromDBModel(readingDBModel dbModels.Reading) model.Reading {
+	var reading model.Reading
+
+	// convert the BaseReading fields to BaseReading struct defined in contract
+	baseReading := readingDBModel.GetBaseReading()
+
+	if readingDBModel.BinaryValue != nil {
+		// reading type is BinaryReading
+		binaryReading := model.BinaryReading{
+			BaseReading: baseReading,
+			MediaType:   *readingDBModel.MediaType,
+			BinaryValue: readingDBModel.BinaryValue,
+		}
+		reading = binaryReading
+	} else if readingDBModel.ObjectValue != nil {
+		// reading type is ObjectReading
+		objReading := model.ObjectReading{
+			BaseReading: baseReading,
+			ObjectValue: readingDBModel.ObjectValue,
+		}
+		reading = objReading
+	} else if readingDBModel.Value != nil {
+		// reading type is SimpleReading
+		simpleReading := model.SimpleReading{
+			BaseReading: baseReading,
+			Value:       *readingDBModel.Value,
+		}
+		reading = simpleReading
+	} else {
+		// reading type is NullReading
+		nullReading := model.NullReading{
+			BaseReading: baseReading,
+			Value:       nil,
+		}
+		reading = nullReading
+	}
+
+	return reading
+}
+
+// queryReadingsByEventIds queries the readings of the events in one statement, and returns them by the event id in
+// descending order of origin
+func queryReadingsByEventIds(ctx context.Context, connPool *pgxpool.Pool, eventIds []string) (map[string][]model.Reading, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sqlQueryAllReadingByEventIds(), eventIds)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query readings by event ids", err)
+	}
+	dbReadings, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[dbModels.Reading])
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to scan readings", err)
+	}
+	readings := make(map[string][]model.Reading, len(eventIds))
+	for _, r := range dbReadings {
+		readings[r.EventId] = append(readings[r.EventId], readingFromDBModel(r))
+	}
+	return readings, nil
+}
+
 // deleteReadingsByOriginAndEventId delete the data rows with given sql statement and passed args
 func deleteReadingsByOriginAndEventId(ctx context.Context, tx pgx.Tx, origin int64, eventId string) errors.EdgeX {
 	sqlStatement := sqlDeleteTimeRangeByColumn(readingTableName, originCol, eventIdFKCol)
@@ -324,55 +531,64 @@ func deleteReadingsBySubQuery(ctx context.Context, tx pgx.Tx, subQuerySql string
 	return nil
 }
 
-// addReadingsInTx converts reading interface to BinaryReading/ObjectReading/SimpleReading structs first based on the reading value type
-// and then perform the CopyFromSlice transaction to insert readings in batch
+// addReadingsInTx inserts the readings of the event with the specified id in batch within the transaction
 func (c *Client) addReadingsInTx(tx pgx.Tx, readings []model.Reading, eventId string) error {
-	var readingDBModels []dbModels.Reading
+	return c.addEventsReadingsInTx(tx, []model.Event{{Id: eventId, Readings: readings}})
+}
 
-	for _, r := range readings {
-		baseReading := r.GetBaseReading()
-		if baseReading.Id == "" {
-			baseReading.Id = uuid.New().String()
-		} else {
-			_, err := uuid.Parse(baseReading.Id)
-			if err != nil {
-				return errors.NewCommonEdgeX(errors.KindInvalidId, "uuid parsing failed", err)
+// addEventsReadingsInTx converts reading interface to BinaryReading/ObjectReading/SimpleReading structs first based on the reading value type
+// and then perform the CopyFromSlice transaction to insert the readings of all the events in batch
+func (c *Client) addEventsReadingsInTx(tx pgx.Tx, events []model.Event) error {
+	var readingDBModels []dbModels.Reading
+	var eventIds []string
+
+	for _, e := range events {
+		for _, r := range e.Readings {
+			baseReading := r.GetBaseReading()
+			if baseReading.Id == "" {
+				baseReading.Id = uuid.New().String()
+			} else {
+				_, err := uuid.Parse(baseReading.Id)
+				if err != nil {
+					return errors.NewCommonEdgeX(errors.KindInvalidId, "uuid parsing failed", err)
+				}
 			}
-		}
 
-		var readingDBModel dbModels.Reading
-		switch contractReadingModel := r.(type) {
-		case model.BinaryReading:
-			// convert BinaryReading struct to Reading DB model
-			readingDBModel = dbModels.Reading{
-				BaseReading: baseReading,
-				BinaryReading: dbModels.BinaryReading{
-					BinaryValue: contractReadingModel.BinaryValue,
-					MediaType:   &contractReadingModel.MediaType,
-				},
-			}
-		case model.ObjectReading:
-			// convert ObjectReading struct to Reading DB model
-			readingDBModel = dbModels.Reading{
-				BaseReading: baseReading,
-				ObjectReading: dbModels.ObjectReading{
-					ObjectValue: contractReadingModel.ObjectValue,
-				},
-			}
-		case model.SimpleReading:
-			// convert SimpleReading struct to Reading DB model
-			readingDBModel = dbModels.Reading{
-				BaseReading:   baseReading,
-				SimpleReading: dbModels.SimpleReading{Value: &contractReadingModel.Value},
-			}
-		case model.NullReading:
-			readingDBModel = dbModels.Reading{
-				BaseReading: baseReading,
+			var readingDBModel dbModels.Reading
+			switch contractReadingModel := r.(type) {
+			case model.BinaryReading:
+				// convert BinaryReading struct to Reading DB model
+				readingDBModel = dbModels.Reading{
+					BaseReading: baseReading,
+					BinaryReading: dbModels.BinaryReading{
+						BinaryValue: contractReadingModel.BinaryValue,
+						MediaType:   &contractReadingModel.MediaType,
+					},
+				}
+			case model.ObjectReading:
+				// convert ObjectReading struct to Reading DB model
+				readingDBModel = dbModels.Reading{
+					BaseReading: baseReading,
+					ObjectReading: dbModels.ObjectReading{
+						ObjectValue: contractReadingModel.ObjectValue,
+					},
+				}
+			case model.SimpleReading:
+				// convert SimpleReading struct to Reading DB model
+				readingDBModel = dbModels.Reading{
+					BaseReading:   baseReading,
+					SimpleReading: dbModels.SimpleReading{Value: &contractReadingModel.Value},
+				}
+			case model.NullReading:
+				readingDBModel = dbModels.Reading{
+					BaseReading: baseReading,
+				}
+			default:
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert reading to none of BinaryReading/ObjectReading/SimpleReading structs", nil)
 			}
-		default:
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert reading to none of BinaryReading/ObjectReading/SimpleReading structs", nil)
+			readingDBModels = append(readingDBModels, readingDBModel)
+			eventIds = append(eventIds, e.Id)
 		}
-		readingDBModels = append(readingDBModels, readingDBModel)
 	}
 
 	// insert readingDBModels slice in batch
@@ -398,7 +614,8 @@ func (c *Client) addReadingsInTx(tx pgx.Tx, readings []model.Reading, eventId st
 			}
 
 			return []any{
-				eventId,
+				r.Id,
+				eventIds[i],
 				deviceInfoId,
 				r.Origin,
 				r.Value,
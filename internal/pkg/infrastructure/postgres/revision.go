@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// maxRevisionInsertAttempts is how many times the insertion of a revision is attempted when its version is taken by a
+// concurrent insertion
+const maxRevisionInsertAttempts = 5
+
+// AddRevision adds a new revision with the next version of the entity, which is allocated by the insertion itself, and
+// returns the revision with the allocated version
+func (c *Client) AddRevision(r metadataModels.Revision) (metadataModels.Revision, errors.EdgeX) {
+	if r.Id == "" {
+		r.Id = uuid.New().String()
+	}
+	if r.Created == 0 {
+		r.Created = pkgCommon.MakeTimestamp()
+	}
+
+	dataBytes, err := json.Marshal(r)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal revision for Postgres persistence", err)
+	}
+	for attempt := 1; ; attempt++ {
+		err = c.ConnPool.QueryRow(context.Background(), sqlInsertRevisionWithNextVersion(),
+			r.Id, r.EntityType, r.EntityName, dataBytes, time.UnixMilli(r.Created).UTC()).Scan(&r.Version)
+		if err == nil {
+			return r, nil
+		}
+		edgeXerr := pgClient.WrapDBError(fmt.Sprintf("failed to insert revision of %s '%s'", r.EntityType, r.EntityName), err)
+		// the version is taken by a concurrent insertion, so the next one is allocated again
+		if errors.Kind(edgeXerr) != errors.KindDuplicateName || attempt == maxRevisionInsertAttempts {
+			return r, edgeXerr
+		}
+	}
+}
+
+// RevisionByVersion queries the revision of the entity by version
+func (c *Client) RevisionByVersion(entityType string, entityName string, version int64) (metadataModels.Revision, errors.EdgeX) {
+	var r metadataModels.Revision
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(revisionTableName, []string{contentCol}, entityTypeCol, entityNameCol, versionCol),
+		entityType, entityName, version).Scan(&r)
+	if err != nil {
+		return r, pgClient.WrapDBError(fmt.Sprintf("failed to query revision %d of %s '%s'", version, entityType, entityName), err)
+	}
+	return r, nil
+}
+
+// AllRevisions queries the revisions of all the entities with the given offset and limit, sorted in descending order of
+// created timestamp
+func (c *Client) AllRevisions(offset int, limit int) ([]metadataModels.Revision, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	revisions, err := queryRevisions(context.Background(), c.ConnPool, sqlQueryContentWithPaginationDescByCol(revisionTableName, createdCol), offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to query all revisions", err)
+	}
+	return revisions, nil
+}
+
+// RevisionTotalCount returns the total count of the revisions
+func (c *Client) RevisionTotalCount() (uint32, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCount(revisionTableName))
+}
+
+// RevisionsByEntity queries the revisions of the entity with the given offset and limit, sorted in descending order of
+// version
+func (c *Client) RevisionsByEntity(offset int, limit int, entityType string, entityName string) ([]metadataModels.Revision, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	revisions, err := queryRevisions(context.Background(), c.ConnPool, sqlQueryContentByColWithPaginationDescByCol(revisionTableName, versionCol, entityTypeCol, entityNameCol),
+		entityType, entityName, offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query revisions of %s '%s'", entityType, entityName), err)
+	}
+	return revisions, nil
+}
+
+// RevisionCountByEntity returns the count of the revisions of the entity
+func (c *Client) RevisionCountByEntity(entityType string, entityName string) (uint32, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByCol(revisionTableName, entityTypeCol, entityNameCol), entityType, entityName)
+}
+
+// DeleteRevisionsByEntityBeforeVersion deletes the revisions of the entity whose version is less than or equal to the
+// given version
+func (c *Client) DeleteRevisionsByEntityBeforeVersion(entityType string, entityName string, version int64) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColsAndUpperLimitCol(revisionTableName, versionCol, entityTypeCol, entityNameCol),
+		entityType, entityName, version)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete revisions of %s '%s' before version %d", entityType, entityName, version), err)
+	}
+	return nil
+}
+
+func queryRevisions(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]metadataModels.Revision, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from revision table", err)
+	}
+
+	revisions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (metadataModels.Revision, error) {
+		var r metadataModels.Revision
+		scanErr := row.Scan(&r)
+		return r, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to Revision model", err)
+	}
+	return revisions, nil
+}
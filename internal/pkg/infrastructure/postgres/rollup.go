@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/jackc/pgx/v5"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// RollupReadings replaces the numeric readings whose origin is before the specified timestamp with the reading rollups of
+// the resolution in nanoseconds, and deletes the events which have no readings left. The rollups, the reading deletion and
+// the event deletion are done in one transaction, and the count of the rolled up readings is returned.
+func (c *Client) RollupReadings(before int64, resolution int64) (uint32, errors.EdgeX) {
+	if resolution <= 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "resolution must be greater than 0", nil)
+	}
+
+	var count int64
+	ctx := context.Background()
+	err := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, sqlInsertReadingRollupsByTimeRangeCol(originCol), before, resolution, dataModels.NumericValueTypes)
+		if err != nil {
+			return pgClient.WrapDBError("failed to insert reading rollups", err)
+		}
+		result, err := tx.Exec(ctx, sqlDeleteNumericReadingsByTimeRangeCol(originCol), before, dataModels.NumericValueTypes)
+		if err != nil {
+			return pgClient.WrapDBError("failed to delete rolled up readings", err)
+		}
+		count = result.RowsAffected()
+		_, err = tx.Exec(ctx, sqlDeleteEventsWithoutReadingsByTimeRangeCol(originCol), before)
+		if err != nil {
+			return pgClient.WrapDBError("failed to delete events without readings", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to roll up readings before %d", before), err)
+	}
+	return uint32(count), nil
+}
+
+// ReadingRollupsByDeviceNameAndResourceNameAndTimeRange queries the reading rollups by the specified device and resource,
+// bucket start within the time range, sorted in ascending order of bucket start
+func (c *Client) ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64) ([]dataModels.ReadingRollup, errors.EdgeX) {
+	start, end, edgeXerr := getValidStartAndEnd(start, end)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	sqlStatement := sqlQueryReadingRollupsByTimeRangeCol(bucketStartCol, deviceNameCol, resourceNameCol)
+	rows, err := c.ConnPool.Query(context.Background(), sqlStatement, start, end, deviceName, resourceName)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query reading rollups by device '%s' and resource '%s'", deviceName, resourceName), err)
+	}
+
+	rollups, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (dataModels.ReadingRollup, error) {
+		var r dataModels.ReadingRollup
+		err := row.Scan(&r.DeviceName, &r.ProfileName, &r.ResourceName, &r.ValueType, &r.Units, &r.Resolution, &r.BucketStart,
+			&r.Count, &r.Min, &r.Max, &r.Avg, &r.Sum, &r.First, &r.Last)
+		return r, err
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect reading rollups", err)
+	}
+	return rollups, nil
+}
+
+// DeleteReadingRollupsByAge deletes the reading rollups whose bucket start is older than age
+func (c *Client) DeleteReadingRollupsByAge(age int64) errors.EdgeX {
+	expireTimestamp := time.Now().UnixNano() - age
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteTimeRangeByColumn(readingRollupTableName, bucketStartCol), expireTimestamp)
+	if err != nil {
+		return pgClient.WrapDBError("failed to delete reading rollups by age", err)
+	}
+	return nil
+}
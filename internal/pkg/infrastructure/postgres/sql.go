@@ -16,7 +16,7 @@ import (
 
 const (
 	eventColumns   = "event.id, devicename, profilename, sourcename, origin, tags"
-	readingColumns = "event_id, origin, value, binaryvalue, objectvalue, devicename, profilename, resourcename, valuetype, units, mediatype, tags"
+	readingColumns = "reading.id, event_id, origin, value, binaryvalue, objectvalue, devicename, profilename, resourcename, valuetype, units, mediatype, tags"
 )
 
 // ----------------------------------------------------------------------------------
@@ -37,6 +37,69 @@ func sqlInsert(table string, columns ...string) string {
 	return fmt.Sprintf("INSERT INTO %s(%s) VALUES (%s)", table, columnNames, valueNames)
 }
 
+// sqlInsertReadingRollupsByTimeRangeCol returns the SQL statement for rolling up the numeric readings whose timeRangeCol
+// is before the first parameter into the time buckets of the resolution at the second parameter, the numeric value types
+// are passed as the third parameter. The statistics are merged into the existing rollup of the same time bucket.
+func sqlInsertReadingRollupsByTimeRangeCol(timeRangeCol string) string {
+	numericValue := fmt.Sprintf("reading.%s::double precision", valueCol)
+	return fmt.Sprintf(
+		`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, count, min, max, avg, sum, first, last)
+		SELECT %s, MAX(%s), %s, MAX(%s), MAX(%s), $2, (reading.%s / $2) * $2 AS bucket, COUNT(*), MIN(%s), MAX(%s), AVG(%s), SUM(%s),
+		(ARRAY_AGG(%s ORDER BY reading.%s ASC))[1], (ARRAY_AGG(%s ORDER BY reading.%s DESC))[1]
+		FROM %s JOIN %s on reading.device_info_id = device_info.id
+		WHERE reading.%s < $1 AND %s = ANY ($3) AND reading.%s IS NOT NULL
+		GROUP BY %s, %s, bucket
+		ON CONFLICT (%s, %s, %s, %s) DO UPDATE SET count = reading_rollup.count + EXCLUDED.count,
+		min = LEAST(reading_rollup.min, EXCLUDED.min), max = GREATEST(reading_rollup.max, EXCLUDED.max),
+		sum = reading_rollup.sum + EXCLUDED.sum, avg = (reading_rollup.sum + EXCLUDED.sum) / (reading_rollup.count + EXCLUDED.count),
+		last = EXCLUDED.last`,
+		readingRollupTableName, deviceNameCol, profileNameCol, resourceNameCol, valueTypeCol, unitsCol, resolutionCol, bucketStartCol,
+		deviceNameCol, profileNameCol, resourceNameCol, valueTypeCol, unitsCol, timeRangeCol, numericValue, numericValue, numericValue, numericValue,
+		numericValue, timeRangeCol, numericValue, timeRangeCol,
+		readingTableName, deviceInfoTableName,
+		timeRangeCol, valueTypeCol, valueCol,
+		deviceNameCol, resourceNameCol,
+		deviceNameCol, resourceNameCol, resolutionCol, bucketStartCol)
+}
+
+// sqlUpsertLatestReading returns the SQL statement for inserting the latest reading of a device resource, or replacing the
+// existing one unless it has a later origin
+func sqlUpsertLatestReading() string {
+	return fmt.Sprintf(
+		`INSERT INTO %s (%s, %s, %s, %s) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (%s, %s) DO UPDATE SET %s = EXCLUDED.%s, %s = EXCLUDED.%s WHERE latest_reading.%s <= EXCLUDED.%s`,
+		latestReadingTableName, deviceNameCol, resourceNameCol, originCol, contentCol,
+		deviceNameCol, resourceNameCol, originCol, originCol, contentCol, contentCol, originCol, originCol)
+}
+
+// sqlInsertRevisionWithNextVersion returns the SQL statement for inserting a new revision with the next version of the
+// entity, i.e. the latest version plus one, which is set into the content as well and returned. The concurrent insertion
+// of the same version fails on the unique constraint of the entity and version.
+func sqlInsertRevisionWithNextVersion() string {
+	return fmt.Sprintf(
+		`INSERT INTO %s (%s, %s, %s, %s, %s, %s)
+		SELECT $1, $2, $3, next.%s, jsonb_set($4::jsonb, '{%s}', to_jsonb(next.%s)), $5
+		FROM (SELECT COALESCE(MAX(%s), 0) + 1 AS %s FROM %s WHERE %s = $2 AND %s = $3) AS next
+		RETURNING %s`,
+		revisionTableName, idCol, entityTypeCol, entityNameCol, versionCol, contentCol, createdCol,
+		versionCol, versionField, versionCol,
+		versionCol, versionCol, revisionTableName, entityTypeCol, entityNameCol,
+		versionCol)
+}
+
+// sqlUpsertBySubscriptionName returns the SQL statement for inserting the content of a subscription into the table keyed
+// by the subscription name, or replacing the existing one while keeping its created timestamp, and returns the stored
+// content
+func sqlUpsertBySubscriptionName(table string) string {
+	return fmt.Sprintf(
+		`INSERT INTO %s AS existing (%s, %s) VALUES ($1, $2)
+		ON CONFLICT (%s) DO UPDATE SET %s = jsonb_set(EXCLUDED.%s, '{%s}', existing.%s->'%s')
+		RETURNING %s`,
+		table, subscriptionNameCol, contentCol,
+		subscriptionNameCol, contentCol, contentCol, createdField, contentCol, createdField,
+		contentCol)
+}
+
 // ----------------------------------------------------------------------------------
 // SQL statements for SELECT operations
 // ----------------------------------------------------------------------------------
@@ -188,6 +251,98 @@ func sqlQueryAllReadingWithPaginationAndTimeRangeDescByCol(timeRangeCol string,
 		columnCount+3, columnCount+4)
 }
 
+// sqlQueryReadingAggregatesByTimeRangeCol returns the SQL statement for computing the count/min/max/avg/sum/first/last of the
+// numeric reading values within a time range by timeRangeCol and the given columns, grouped by the fixed time buckets
+// starting from the lower limit of the time range
+func sqlQueryReadingAggregatesByTimeRangeCol(timeRangeCol string, columns ...string) string {
+	whereCondition := constructWhereCondWithTimeRange(timeRangeCol, timeRangeCol, nil, columns...)
+	// note that this is a prepared statement with parameters beginning with two timeRangeCol and then columns conditions,
+	// so the bucket interval and the numeric value types are the third and forth parameters after the columns
+	intervalParam := len(columns) + 3
+	valueTypesParam := len(columns) + 4
+	bucketStart := fmt.Sprintf("$1 + ((reading.%s - $1) / $%d) * $%d", timeRangeCol, intervalParam, intervalParam)
+	numericValue := fmt.Sprintf("reading.%s::double precision", valueCol)
+
+	return fmt.Sprintf(
+		`SELECT %s AS bucketstart, COUNT(*) AS count, MIN(%s) AS min, MAX(%s) AS max, AVG(%s) AS avg, SUM(%s) AS sum,
+		(ARRAY_AGG(%s ORDER BY reading.%s ASC))[1] AS first, (ARRAY_AGG(%s ORDER BY reading.%s DESC))[1] AS last
+		FROM %s JOIN %s on reading.device_info_id = device_info.id
+		WHERE %s AND %s = ANY ($%d) AND reading.%s IS NOT NULL
+		GROUP BY bucketstart ORDER BY bucketstart`,
+		bucketStart, numericValue, numericValue, numericValue, numericValue,
+		numericValue, timeRangeCol, numericValue, timeRangeCol,
+		readingTableName, deviceInfoTableName,
+		whereCondition, valueTypeCol, valueTypesParam, valueCol)
+}
+
+// sqlQueryReadingRollupsByTimeRangeCol returns the SQL statement for selecting the reading rollups within a time range by
+// timeRangeCol and the given columns, sorted in ascending order of timeRangeCol
+func sqlQueryReadingRollupsByTimeRangeCol(timeRangeCol string, columns ...string) string {
+	whereCondition := constructWhereCondWithTimeRange(timeRangeCol, timeRangeCol, nil, columns...)
+	return fmt.Sprintf(
+		"SELECT %s, %s, %s, %s, %s, %s, %s, count, min, max, avg, sum, first, last FROM %s WHERE %s ORDER BY %s, %s",
+		deviceNameCol, profileNameCol, resourceNameCol, valueTypeCol, unitsCol, resolutionCol, bucketStartCol,
+		readingRollupTableName, whereCondition, timeRangeCol, resolutionCol)
+}
+
+// sqlQueryAllEventByCondAndLimitDescByKeyset returns the SQL statement for selecting the rows from the event table by the given where condition
+// and the LIMIT parameter at limitParam position, descending by the (origin, id) keyset
+func sqlQueryAllEventByCondAndLimitDescByKeyset(whereCondition string, limitParam int) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s join %s on event.device_info_id = device_info.id WHERE %s ORDER BY event.%s DESC, event.%s DESC LIMIT $%d",
+		eventColumns, eventTableName, deviceInfoTableName, whereCondition, originCol, idCol, limitParam)
+}
+
+// sqlQueryAllReadingByCondAndLimitDescByKeyset returns the SQL statement for selecting the rows from the reading table by the given where condition
+// and the LIMIT parameter at limitParam position, descending by the (origin, id) keyset
+func sqlQueryAllReadingByCondAndLimitDescByKeyset(whereCondition string, limitParam int) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s join %s on reading.device_info_id = device_info.id WHERE %s ORDER BY reading.%s DESC, reading.%s DESC LIMIT $%d",
+		readingColumns, readingTableName, deviceInfoTableName, whereCondition, originCol, idCol, limitParam)
+}
+
+// sqlQueryAllReadingByCondAndOffsetLimitDesc returns the SQL statement for selecting the rows from the reading table by the given where condition
+// and the OFFSET and LIMIT parameters at offsetParam and limitParam positions, descending by the (origin, id) keyset
+func sqlQueryAllReadingByCondAndOffsetLimitDesc(whereCondition string, offsetParam int, limitParam int) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s join %s on reading.device_info_id = device_info.id WHERE %s ORDER BY reading.%s DESC, reading.%s DESC OFFSET $%d LIMIT $%d",
+		readingColumns, readingTableName, deviceInfoTableName, whereCondition, originCol, idCol, offsetParam, limitParam)
+}
+
+// sqlQueryAllEventByCondAndLimitAscByKeyset returns the SQL statement for selecting the rows from the event table by the given where condition
+// and the LIMIT parameter at limitParam position, ascending by the (origin, id) keyset
+func sqlQueryAllEventByCondAndLimitAscByKeyset(whereCondition string, limitParam int) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s join %s on event.device_info_id = device_info.id WHERE %s ORDER BY event.%s, event.%s LIMIT $%d",
+		eventColumns, eventTableName, deviceInfoTableName, whereCondition, originCol, idCol, limitParam)
+}
+
+// sqlQueryEventIdByIds returns the SQL statement for selecting one of the event ids in the uuid array parameter
+func sqlQueryEventIdByIds() string {
+	return fmt.Sprintf("SELECT %s FROM %s WHERE %s = ANY($1::uuid[]) LIMIT 1", idCol, eventTableName, idCol)
+}
+
+// sqlQueryFieldsByIdsCol returns the SQL statement for selecting the given fields of rows from the table whose idCol is
+// in the uuid array parameter
+func sqlQueryFieldsByIdsCol(table string, idCol string, fields ...string) string {
+	return fmt.Sprintf("SELECT %s FROM %s WHERE %s = ANY($1::uuid[])", strings.Join(fields, ", "), table, idCol)
+}
+
+// sqlQueryAllReadingByEventIds returns the SQL statement for selecting the readings of the events whose ids are in the
+// uuid array parameter, descending by origin
+func sqlQueryAllReadingByEventIds() string {
+	return fmt.Sprintf("SELECT %s FROM %s JOIN %s on reading.device_info_id = device_info.id WHERE %s = ANY($1::uuid[]) ORDER BY %s DESC",
+		readingColumns, readingTableName, deviceInfoTableName, eventIdFKCol, originCol)
+}
+
+// sqlQueryAllReadingByCondAndLimitAscByKeyset returns the SQL statement for selecting at most the limit parameter rows from the reading
+// table by the given where condition, ascending by the (origin, id) keyset
+func sqlQueryAllReadingByCondAndLimitAscByKeyset(whereCondition string, limitParam int) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s join %s on reading.device_info_id = device_info.id WHERE %s ORDER BY reading.%s, reading.%s LIMIT $%d",
+		readingColumns, readingTableName, deviceInfoTableName, whereCondition, originCol, idCol, limitParam)
+}
+
 // sqlQueryAllByStatusWithPaginationAndTimeRange returns the SQL statement for selecting all rows from the table by status with pagination and a time range.
 func sqlQueryAllByStatusWithPaginationAndTimeRange(table string) string {
 	return fmt.Sprintf("SELECT * FROM %s WHERE %s = $1 AND %s >= $2 AND %s <= $3 ORDER BY %s OFFSET $4 LIMIT $5", table, statusCol, createdCol, createdCol, createdCol)
@@ -242,6 +397,40 @@ func sqlQueryContentWithTimeRangeAndPagination(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s WHERE COALESCE((content->>'%s')::bigint, 0) BETWEEN $1 AND $2 AND content @> $3::jsonb ORDER BY COALESCE((content->>'%s')::bigint, 0) OFFSET $4 LIMIT $5", table, createdField, createdField)
 }
 
+// sqlQueryContentWithPaginationDescByCol returns the SQL statement for selecting content column from the table with pagination and desc by descCol
+func sqlQueryContentWithPaginationDescByCol(table string, descCol string) string {
+	return fmt.Sprintf("SELECT content FROM %s ORDER BY %s DESC OFFSET $1 LIMIT $2", table, descCol)
+}
+
+// sqlQueryContentWithPaginationAndTimeRangeDescByCol returns the SQL statement for selecting content column from the table
+// by the given columns with pagination and a time range by timeRangeCol, desc by descCol
+func sqlQueryContentWithPaginationAndTimeRangeDescByCol(table string, timeRangeCol string, descCol string, columns ...string) string {
+	whereCondition := constructWhereCondWithTimeRange(timeRangeCol, timeRangeCol, nil, columns...)
+	columnCount := len(columns)
+	return fmt.Sprintf(
+		"SELECT content FROM %s WHERE %s ORDER BY %s DESC OFFSET $%d LIMIT $%d",
+		table, whereCondition, descCol,
+		// note that this is a prepared statement with parameters beginning with the time range
+		// and then columns conditions, so adding 3 and 4 for OFFSET, LIMIT parameters, respectively
+		columnCount+3, columnCount+4)
+}
+
+// sqlQueryContentByColWithPaginationDescByCol returns the SQL statement for selecting content column from the table by the
+// given columns with pagination, desc by descCol
+func sqlQueryContentByColWithPaginationDescByCol(table string, descCol string, columns ...string) string {
+	whereCondition := constructWhereCondition(columns...)
+	columnCount := len(columns)
+	return fmt.Sprintf("SELECT content FROM %s WHERE %s ORDER BY %s DESC OFFSET $%d LIMIT $%d",
+		table, whereCondition, descCol, columnCount+1, columnCount+2)
+}
+
+// sqlQueryContentByUpperLimitColAsc returns the SQL statement for selecting content column from the table whose
+// upperLimitCol is less than or equal to the first parameter, in ascending order of upperLimitCol with the limit of the
+// second parameter
+func sqlQueryContentByUpperLimitColAsc(table string, upperLimitCol string) string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE %s <= $1 ORDER BY %s LIMIT $2", table, upperLimitCol, upperLimitCol)
+}
+
 // sqlQueryContentByJSONField returns the SQL statement for selecting content column in the table by the given JSON query string
 func sqlQueryContentByJSONField(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s WHERE content @> $1::jsonb", table)
@@ -311,12 +500,28 @@ func sqlQueryCountReadingByTimeRangeCol(timeRangeCol string, arrayColNames []str
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s join %s on reading.device_info_id = device_info.id WHERE %s", readingTableName, deviceInfoTableName, whereCondition)
 }
 
+// sqlQueryCountEventByCond returns the SQL statement for counting the number of rows in the event table by the given where condition
+func sqlQueryCountEventByCond(whereCondition string) string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s join %s on event.device_info_id = device_info.id WHERE %s", eventTableName, deviceInfoTableName, whereCondition)
+}
+
+// sqlQueryCountReadingByCond returns the SQL statement for counting the number of rows in the reading table by the given where condition
+func sqlQueryCountReadingByCond(whereCondition string) string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s join %s on reading.device_info_id = device_info.id WHERE %s", readingTableName, deviceInfoTableName, whereCondition)
+}
+
 // sqlQueryCountByColAndLikePat returns the SQL statement for counting the number of rows by the given column name with LIKE pattern.
 func sqlQueryCountByColAndLikePat(table string, columns ...string) string {
 	whereCondition := constructWhereLikeCond(columns...)
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, whereCondition)
 }
 
+// sqlQueryCountByCol returns the SQL statement for counting the number of rows in the table by the given columns.
+func sqlQueryCountByCol(table string, columns ...string) string {
+	whereCondition := constructWhereCondition(columns...)
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, whereCondition)
+}
+
 // sqlQueryCountByJSONField returns the SQL statement for counting the number of rows in the table by the given JSON query string
 func sqlQueryCountByJSONField(table string) string {
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE content @> $1::jsonb", table)
@@ -352,6 +557,13 @@ func sqlQueryEventIdFieldByTimeRangeAndConditions(timeRangeCol string, cols ...s
 	return fmt.Sprintf("SELECT event.id FROM %s JOIN %s on event.device_info_id = device_info.id WHERE %s", eventTableName, deviceInfoTableName, whereCondition)
 }
 
+// sqlQueryEventIdFieldByTimeRangeAndExcludedColumn returns the SQL statement for selecting the event ids within the time range,
+// except the ones whose column value is one of the values in the array parameter
+func sqlQueryEventIdFieldByTimeRangeAndExcludedColumn(upperLimitTimeRangeCol string, excludedCol string) string {
+	return fmt.Sprintf("SELECT event.id FROM %s JOIN %s on event.device_info_id = device_info.id WHERE %s <= $1 AND %s <> ALL ($2)",
+		eventTableName, deviceInfoTableName, upperLimitTimeRangeCol, excludedCol)
+}
+
 // ----------------------------------------------------------------------------------
 // SQL statements for UPDATE operations
 // ----------------------------------------------------------------------------------
@@ -384,11 +596,30 @@ func sqlDeleteById(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s = $1", table, idCol)
 }
 
+// sqlDeleteByColsAndUpperLimitCol returns the SQL statement for deleting the rows from the table by the given columns,
+// whose upperLimitCol is less than or equal to the parameter after the columns
+func sqlDeleteByColsAndUpperLimitCol(table string, upperLimitCol string, columns ...string) string {
+	whereCondition := constructWhereCondition(columns...)
+	return fmt.Sprintf("DELETE FROM %s WHERE %s AND %s <= $%d", table, whereCondition, upperLimitCol, len(columns)+1)
+}
+
+// sqlDeleteExceedingCountDescByCol returns the SQL statement for deleting the rows beyond the count specified by the
+// first parameter from the table, the rows are kept in descending order of descCol
+func sqlDeleteExceedingCountDescByCol(table string, descCol string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE %s IN (SELECT %s FROM %s ORDER BY %s DESC OFFSET $1)", table, idCol, idCol, table, descCol)
+}
+
 // sqlDeleteByAge returns the SQL statement for deleting rows from the table by created timestamp.
 func sqlDeleteByAge(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s < NOW() - INTERVAL '1 millisecond' * $1", table, createdCol)
 }
 
+// sqlDeleteByAgeAndExcludedCol returns the SQL statement for deleting rows from the table by created timestamp, the rows
+// whose excludedCol equals to the second parameter are kept.
+func sqlDeleteByAgeAndExcludedCol(table string, excludedCol string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE %s < NOW() - INTERVAL '1 millisecond' * $1 AND %s <> $2", table, createdCol, excludedCol)
+}
+
 // sqlDeleteByContentAge returns the SQL statement for deleting rows from the table by created timestamp from content column.
 func sqlDeleteByContentAge(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE COALESCE((content->>'%s')::bigint, 0) < (EXTRACT(EPOCH FROM NOW()) * 1000)::bigint - $1", table, createdField)
@@ -423,6 +654,27 @@ func sqlDeleteEventsByTimeRangeAndColumn(upperLimitTimeRangeCol string, cols ...
 	return fmt.Sprintf("DELETE FROM %s USING %s WHERE event.device_info_id = device_info.id AND %s", eventTableName, deviceInfoTableName, whereCondition)
 }
 
+// sqlDeleteEventsByTimeRangeAndExcludedColumn returns the SQL statement for deleting the events within the time range,
+// except the ones whose column value is one of the values in the array parameter
+func sqlDeleteEventsByTimeRangeAndExcludedColumn(upperLimitTimeRangeCol string, excludedCol string) string {
+	return fmt.Sprintf("DELETE FROM %s USING %s WHERE event.device_info_id = device_info.id AND %s <= $1 AND %s <> ALL ($2)",
+		eventTableName, deviceInfoTableName, upperLimitTimeRangeCol, excludedCol)
+}
+
+// sqlDeleteNumericReadingsByTimeRangeCol returns the SQL statement for deleting the numeric readings whose timeRangeCol is
+// before the first parameter, the numeric value types are passed as the second parameter
+func sqlDeleteNumericReadingsByTimeRangeCol(timeRangeCol string) string {
+	return fmt.Sprintf("DELETE FROM %s USING %s WHERE reading.device_info_id = device_info.id AND reading.%s < $1 AND %s = ANY ($2) AND reading.%s IS NOT NULL",
+		readingTableName, deviceInfoTableName, timeRangeCol, valueTypeCol, valueCol)
+}
+
+// sqlDeleteEventsWithoutReadingsByTimeRangeCol returns the SQL statement for deleting the events whose timeRangeCol is before the
+// first parameter and have no readings left
+func sqlDeleteEventsWithoutReadingsByTimeRangeCol(timeRangeCol string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE event.%s < $1 AND NOT EXISTS (SELECT 1 FROM %s WHERE reading.%s = event.%s)",
+		eventTableName, timeRangeCol, readingTableName, eventIdFKCol, idCol)
+}
+
 // sqlDeleteByColumn returns the SQL statement for deleting rows from the table by the specified column
 func sqlDeleteByColumns(table string, cols ...string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s", table, constructWhereCondition(cols...))
@@ -486,6 +738,17 @@ func constructWhereCondWithTimeRange(lowerLimitTimeRangeCol, upperLimitTimeRange
 	return strings.Join(conditions, " AND ")
 }
 
+// constructKeysetDescCond constructs the condition to select the rows after the (originCol, idCol) keyset in descending order,
+// the keyset values are the two parameters following the paramCount parameters of the other conditions
+func constructKeysetDescCond(originCol, idCol string, paramCount int) string {
+	return fmt.Sprintf("(%s, %s) < ($%d, $%d)", originCol, idCol, paramCount+1, paramCount+2)
+}
+
+// constructKeysetAscCond constructs the WHERE condition selecting the rows after the (origin, id) keyset in ascending order
+func constructKeysetAscCond(originCol, idCol string, paramCount int) string {
+	return fmt.Sprintf("(%s, %s) > ($%d, $%d)", originCol, idCol, paramCount+1, paramCount+2)
+}
+
 // constructWhereLikeCond constructs the WHERE condition for the given columns with LIKE operator
 func constructWhereLikeCond(columns ...string) string {
 	columnCount := len(columns)
@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	stdErrs "errors"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/jackc/pgx/v5"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// SetSubscriptionThrottle adds the throttle of the subscription, or replaces the existing one
+func (c *Client) SetSubscriptionThrottle(throttle notificationModels.SubscriptionThrottle) (notificationModels.SubscriptionThrottle, errors.EdgeX) {
+	throttle.Created = pkgCommon.MakeTimestamp()
+	throttle.Modified = throttle.Created
+	dataBytes, err := json.Marshal(throttle)
+	if err != nil {
+		return throttle, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal subscription throttle for Postgres persistence", err)
+	}
+	err = c.ConnPool.QueryRow(context.Background(), sqlUpsertBySubscriptionName(subscriptionThrottleTableName), throttle.SubscriptionName, dataBytes).Scan(&throttle)
+	if err != nil {
+		return throttle, pgClient.WrapDBError(fmt.Sprintf("failed to set the throttle of subscription '%s'", throttle.SubscriptionName), err)
+	}
+	return throttle, nil
+}
+
+// SubscriptionThrottleByName queries the throttle of the subscription by the subscription name
+func (c *Client) SubscriptionThrottleByName(subscriptionName string) (throttle notificationModels.SubscriptionThrottle, edgeXerr errors.EdgeX) {
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(subscriptionThrottleTableName, []string{contentCol}, subscriptionNameCol), subscriptionName).Scan(&throttle)
+	if err != nil {
+		if stdErrs.Is(err, pgx.ErrNoRows) {
+			return throttle, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no throttle of subscription '%s' found", subscriptionName), err)
+		}
+		return throttle, pgClient.WrapDBError(fmt.Sprintf("failed to query the throttle of subscription '%s'", subscriptionName), err)
+	}
+	return throttle, nil
+}
+
+// AllSubscriptionThrottles queries the throttles of all the subscriptions
+func (c *Client) AllSubscriptionThrottles() ([]notificationModels.SubscriptionThrottle, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContent(subscriptionThrottleTableName))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query all subscription throttles", err)
+	}
+	throttles, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationModels.SubscriptionThrottle, error) {
+		var t notificationModels.SubscriptionThrottle
+		scanErr := row.Scan(&t)
+		return t, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to SubscriptionThrottle model", err)
+	}
+	return throttles, nil
+}
+
+// DeleteSubscriptionThrottleByName deletes the throttle of the subscription by the subscription name
+func (c *Client) DeleteSubscriptionThrottleByName(subscriptionName string) errors.EdgeX {
+	result, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(subscriptionThrottleTableName, subscriptionNameCol), subscriptionName)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the throttle of subscription '%s'", subscriptionName), err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no throttle of subscription '%s' found", subscriptionName), nil)
+	}
+	return nil
+}
@@ -66,6 +66,29 @@ func (c *Client) UpdateTransmission(t models.Transmission) errors.EdgeX {
 	return nil
 }
 
+// TransmissionSuppressedCounts queries the numbers of the suppressed notifications reported by the transmissions of the
+// given ids, the transmissions reporting none are not included
+func (c *Client) TransmissionSuppressedCounts(ids []string) (map[string]int, errors.EdgeX) {
+	counts := make(map[string]int)
+	if len(ids) == 0 {
+		return counts, nil
+	}
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryFieldsByIdsCol(transmissionSuppressionTableName, transmissionIdCol, transmissionIdCol, suppressedCol), ids)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from transmission suppression table", err)
+	}
+	var id string
+	var suppressed int
+	_, err = pgx.ForEachRow(rows, []any{&id, &suppressed}, func() error {
+		counts[id] = suppressed
+		return nil
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to scan rows from transmission suppression table", err)
+	}
+	return counts, nil
+}
+
 // TransmissionById queries the transmission by id
 func (c *Client) TransmissionById(id string) (models.Transmission, errors.EdgeX) {
 	transmission, err := queryTransmission(context.Background(), c.ConnPool, sqlQueryContentById(transmissionTableName), id)
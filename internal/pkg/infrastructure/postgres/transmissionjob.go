@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddTransmissionJob adds a new transmission job to the transmission queue
+func (c *Client) AddTransmissionJob(job notificationModels.TransmissionJob) (notificationModels.TransmissionJob, errors.EdgeX) {
+	if job.Id == "" {
+		job.Id = uuid.New().String()
+	}
+	if job.Created == 0 {
+		job.Created = pkgCommon.MakeTimestamp()
+	}
+	job.Modified = job.Created
+
+	dataBytes, err := json.Marshal(job)
+	if err != nil {
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal transmission job for Postgres persistence", err)
+	}
+	_, err = c.ConnPool.Exec(context.Background(), sqlInsert(transmissionJobTableName, idCol, notificationIdCol, nextAttemptAtCol, contentCol, createdCol),
+		job.Id, job.Transmission.NotificationId, time.UnixMilli(job.NextAttemptAt).UTC(), dataBytes, time.UnixMilli(job.Created).UTC())
+	if err != nil {
+		return job, pgClient.WrapDBError("failed to insert transmission job", err)
+	}
+	return job, nil
+}
+
+// UpdateTransmissionJob updates the transmission and the next attempt of the transmission job
+func (c *Client) UpdateTransmissionJob(job notificationModels.TransmissionJob) errors.EdgeX {
+	job.Modified = pkgCommon.MakeTimestamp()
+	dataBytes, err := json.Marshal(job)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal transmission job for Postgres persistence", err)
+	}
+	_, err = c.ConnPool.Exec(context.Background(), sqlUpdateColsByCondCol(transmissionJobTableName, idCol, nextAttemptAtCol, contentCol),
+		time.UnixMilli(job.NextAttemptAt).UTC(), dataBytes, job.Id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update transmission job by id '%s'", job.Id), err)
+	}
+	return nil
+}
+
+// AddTransmissionOfJob adds the transmission of the job with the suppressed count of the job, and updates the job with
+// the added transmission in one transaction
+func (c *Client) AddTransmissionOfJob(job notificationModels.TransmissionJob) (notificationModels.TransmissionJob, errors.EdgeX) {
+	ctx := context.Background()
+	if len(job.Transmission.Id) == 0 {
+		job.Transmission.Id = uuid.New().String()
+	}
+	job.Transmission.Created = time.Now().UTC().UnixMilli()
+	job.Modified = pkgCommon.MakeTimestamp()
+
+	transBytes, err := json.Marshal(job.Transmission)
+	if err != nil {
+		return job, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal Transmission model", err)
+	}
+	jobBytes, err := json.Marshal(job)
+	if err != nil {
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal transmission job for Postgres persistence", err)
+	}
+	err = pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, sqlInsert(transmissionTableName, idCol, notificationIdCol, contentCol), job.Transmission.Id, job.Transmission.NotificationId, transBytes)
+		if err != nil {
+			return pgClient.WrapDBError("failed to insert row to transmission table", err)
+		}
+		if job.Suppressed > 0 {
+			_, err = tx.Exec(ctx, sqlInsert(transmissionSuppressionTableName, transmissionIdCol, suppressedCol), job.Transmission.Id, job.Suppressed)
+			if err != nil {
+				return pgClient.WrapDBError("failed to insert row to transmission suppression table", err)
+			}
+		}
+		result, err := tx.Exec(ctx, sqlUpdateColsByCondCol(transmissionJobTableName, idCol, nextAttemptAtCol, contentCol),
+			time.UnixMilli(job.NextAttemptAt).UTC(), jobBytes, job.Id)
+		if err != nil {
+			return pgClient.WrapDBError(fmt.Sprintf("failed to update transmission job by id '%s'", job.Id), err)
+		}
+		if result.RowsAffected() == 0 {
+			return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("transmission job '%s' does not exist", job.Id), nil)
+		}
+		return nil
+	})
+	if err != nil {
+		return job, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to add the transmission of transmission job '%s'", job.Id), err)
+	}
+	return job, nil
+}
+
+// DeleteTransmissionJobById deletes the transmission job by id
+func (c *Client) DeleteTransmissionJobById(id string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteById(transmissionJobTableName), id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete transmission job by id '%s'", id), err)
+	}
+	return nil
+}
+
+// AllTransmissionJobs queries the transmission jobs with the given offset and limit, sorted in descending order of
+// created timestamp
+func (c *Client) AllTransmissionJobs(offset, limit int) ([]notificationModels.TransmissionJob, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	jobs, err := queryTransmissionJobs(context.Background(), c.ConnPool, sqlQueryContentWithPaginationDescByCol(transmissionJobTableName, createdCol), offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to query all transmission jobs", err)
+	}
+	return jobs, nil
+}
+
+// DueTransmissionJobs queries at most limit transmission jobs whose next attempt is due by the end timestamp in
+// milliseconds, sorted in ascending order of the next attempt
+func (c *Client) DueTransmissionJobs(end int64, limit int) ([]notificationModels.TransmissionJob, errors.EdgeX) {
+	_, validLimit := getValidOffsetAndLimit(0, limit)
+	jobs, err := queryTransmissionJobs(context.Background(), c.ConnPool, sqlQueryContentByUpperLimitColAsc(transmissionJobTableName, nextAttemptAtCol),
+		time.UnixMilli(end).UTC(), validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query transmission jobs due by %d", end), err)
+	}
+	return jobs, nil
+}
+
+func queryTransmissionJobs(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]notificationModels.TransmissionJob, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from transmission job table", err)
+	}
+
+	jobs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationModels.TransmissionJob, error) {
+		var j notificationModels.TransmissionJob
+		scanErr := row.Scan(&j)
+		return j, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to TransmissionJob model", err)
+	}
+	return jobs, nil
+}
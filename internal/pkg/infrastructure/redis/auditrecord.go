@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	// AuditRecordCollection is the sorted set of all the audit record stored keys scored by the created timestamp
+	AuditRecordCollection           = "cc|ar"
+	AuditRecordCollectionDeviceName = AuditRecordCollection + DBKeySeparator + common.Device + DBKeySeparator + common.Name
+	AuditRecordCollectionStatus     = AuditRecordCollection + DBKeySeparator + common.Status
+	// auditRecordDeleteBatchSize is the number of the audit records deleted in one transaction when purging by age
+	auditRecordDeleteBatchSize = 1000
+)
+
+// auditRecordStoredKey returns the audit record's stored key which combines the collection name and object id
+func auditRecordStoredKey(id string) string {
+	return CreateKey(AuditRecordCollection, id)
+}
+
+// auditRecordCollectionKey returns the key of the sorted set indexing the audit records of the given device name and
+// status, the empty device name and status are not filtered
+func auditRecordCollectionKey(deviceName, status string) string {
+	switch {
+	case deviceName != "" && status != "":
+		return CreateKey(AuditRecordCollectionDeviceName, deviceName, common.Status, status)
+	case deviceName != "":
+		return CreateKey(AuditRecordCollectionDeviceName, deviceName)
+	case status != "":
+		return CreateKey(AuditRecordCollectionStatus, status)
+	default:
+		return AuditRecordCollection
+	}
+}
+
+// auditRecordCollectionKeys returns all the sorted sets which index the audit record
+func auditRecordCollectionKeys(r commandModels.AuditRecord) []string {
+	return []string{
+		auditRecordCollectionKey("", ""),
+		auditRecordCollectionKey(r.DeviceName, ""),
+		auditRecordCollectionKey("", r.Status),
+		auditRecordCollectionKey(r.DeviceName, r.Status),
+	}
+}
+
+// addAuditRecord adds a new audit record into DB
+func addAuditRecord(conn redis.Conn, r commandModels.AuditRecord) (commandModels.AuditRecord, errors.EdgeX) {
+	if r.Id == "" {
+		r.Id = uuid.New().String()
+	}
+	if r.Created == 0 {
+		r.Created = pkgCommon.MakeTimestamp()
+	}
+
+	m, err := json.Marshal(r)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal audit record for Redis persistence", err)
+	}
+	storedKey := auditRecordStoredKey(r.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	for _, key := range auditRecordCollectionKeys(r) {
+		_ = conn.Send(ZADD, key, r.Created, storedKey)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindDatabaseError, "audit record creation failed", err)
+	}
+	return r, nil
+}
+
+// auditRecords queries the audit records by the optional device name and status with the given time range, offset and
+// limit, sorted in descending order of created timestamp
+func auditRecords(conn redis.Conn, deviceName, status string, start, end int64, offset, limit int) ([]commandModels.AuditRecord, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByScoreRange(conn, auditRecordCollectionKey(deviceName, status), start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToAuditRecords(objects)
+}
+
+// latestAuditRecordByOffset queries the audit record at the given offset in descending order of created timestamp
+func latestAuditRecordByOffset(conn redis.Conn, offset uint32) (commandModels.AuditRecord, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, AuditRecordCollection, int(offset), 1)
+	if edgeXerr != nil {
+		return commandModels.AuditRecord{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	records, edgeXerr := convertObjectsToAuditRecords(objects)
+	if edgeXerr != nil {
+		return commandModels.AuditRecord{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(records) == 0 {
+		return commandModels.AuditRecord{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no audit record found with offset '%d'", offset), nil)
+	}
+	return records[0], nil
+}
+
+// deleteAuditRecordsByAge deletes the audit records older than the given age in milliseconds batch by batch
+func deleteAuditRecordsByAge(conn redis.Conn, age int64) errors.EdgeX {
+	expireTimestamp := time.Now().UnixMilli() - age
+	for {
+		// ZRANGEBYSCORE key min max LIMIT offset count, the deleted records are removed from the sorted set so the offset is always 0
+		ids, err := redis.Values(conn.Do(ZRANGEBYSCORE, AuditRecordCollection, InfiniteMin, expireTimestamp, LIMIT, 0, auditRecordDeleteBatchSize))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "query the audit records to be deleted failed", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		objects, edgeXerr := getObjectsByIds(conn, ids)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		records, edgeXerr := convertObjectsToAuditRecords(objects)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+
+		_ = conn.Send(MULTI)
+		for _, r := range records {
+			storedKey := auditRecordStoredKey(r.Id)
+			_ = conn.Send(DEL, storedKey)
+			for _, key := range auditRecordCollectionKeys(r) {
+				_ = conn.Send(ZREM, key, storedKey)
+			}
+		}
+		// remove the keys whose objects are already gone as well, otherwise they would be queried again and again
+		_ = conn.Send(ZREM, append([]any{AuditRecordCollection}, ids...)...)
+		_, err = conn.Do(EXEC)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "audit record deletion failed", err)
+		}
+		if len(ids) < auditRecordDeleteBatchSize {
+			return nil
+		}
+	}
+}
+
+func convertObjectsToAuditRecords(objects [][]byte) ([]commandModels.AuditRecord, errors.EdgeX) {
+	records := make([]commandModels.AuditRecord, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &records[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "audit record format parsing failed from the database", err)
+		}
+	}
+	return records, nil
+}
@@ -1,20 +1,26 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package redis
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/google/uuid"
 )
@@ -51,6 +57,25 @@ func (c *Client) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	return addEvent(conn, e)
 }
 
+// AddEvents adds the events and their readings in a single transaction, either all the events are added or none of them
+func (c *Client) AddEvents(events []model.Event) ([]model.Event, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	for i, e := range events {
+		if e.Id == "" {
+			events[i].Id = uuid.NewString()
+			continue
+		}
+		_, err := uuid.Parse(e.Id)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindInvalidId, fmt.Sprintf("uuid %s parsing failed", e.Id), err)
+		}
+	}
+
+	return addEvents(conn, events)
+}
+
 // EventById gets an event by id
 func (c *Client) EventById(id string) (event model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -757,6 +782,108 @@ func (c *Client) ReadingCountByDeviceNameAndTimeRange(deviceName string, start i
 	return count, nil
 }
 
+// EventsByCursor query events by the query conditions and the limit, starting after the cursor. Events are sorted in descending order of origin and id.
+func (c *Client) EventsByCursor(conds dataModels.EventQueryConditions, cursor dataModels.Cursor, limit int) ([]model.Event, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	events, edgeXerr := eventsByCursor(conn, conds, cursor, limit)
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events by conditions %+v, cursor %+v and limit %d", conds, cursor, limit), edgeXerr)
+	}
+	return events, nil
+}
+
+// EventCountByQueryConditions returns the count of events by the query conditions from the database
+func (c *Client) EventCountByQueryConditions(conds dataModels.EventQueryConditions) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberCountByScoreRange(conn, eventsKeyByQueryConditions(conds), conds.Start, conds.End)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// ReadingsByCursor query readings by the query conditions and the limit, starting after the cursor. Readings are sorted in descending order of origin and id.
+func (c *Client) ReadingsByCursor(conds dataModels.ReadingQueryConditions, cursor dataModels.Cursor, limit int) ([]model.Reading, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	readings, edgeXerr := readingsByCursor(conn, conds, cursor, limit, c.BatchSize)
+	if edgeXerr != nil {
+		return readings, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query readings by conditions %+v, cursor %+v and limit %d", conds, cursor, limit), edgeXerr)
+	}
+	return readings, nil
+}
+
+// ReadingsByQueryConditions query readings by the query conditions with offset and limit. Readings are sorted in descending order of origin.
+func (c *Client) ReadingsByQueryConditions(conds dataModels.ReadingQueryConditions, offset int, limit int) ([]model.Reading, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	readings, edgeXerr := readingsByQueryConditions(conn, conds, offset, limit, c.BatchSize)
+	if edgeXerr != nil {
+		return readings, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query readings by conditions %+v, offset %d and limit %d", conds, offset, limit), edgeXerr)
+	}
+	return readings, nil
+}
+
+// ReadingCountByQueryConditions returns the count of readings by the query conditions from the database
+func (c *Client) ReadingCountByQueryConditions(conds dataModels.ReadingQueryConditions) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := readingCountByQueryConditions(conn, conds, c.BatchSize)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// StreamEvents queries the events by the query conditions in ascending order of origin, and passes the events to the handler one by one
+func (c *Client) StreamEvents(ctx context.Context, conds dataModels.EventQueryConditions, handler func(model.Event) error) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := streamEvents(ctx, conn, conds, c.BatchSize, handler)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to stream events by conditions %+v", conds), edgeXerr)
+	}
+	return nil
+}
+
+// StreamReadings queries the readings by the query conditions in ascending order of origin, and passes the readings to the handler one by one
+func (c *Client) StreamReadings(ctx context.Context, conds dataModels.ReadingQueryConditions, handler func(model.Reading) error) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := streamReadings(ctx, conn, conds, c.BatchSize, handler)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to stream readings by conditions %+v", conds), edgeXerr)
+	}
+	return nil
+}
+
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange computes the statistics of the numeric readings by the specified device and resource,
+// origin within the time range, grouped by the fixed time buckets of the interval in nanoseconds
+func (c *Client) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval int64) ([]dataModels.ReadingAggregate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	aggregates, edgeXerr := readingAggregatesByDeviceNameAndResourceNameAndTimeRange(conn, deviceName, resourceName, start, end, interval, c.BatchSize)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to aggregate readings by deviceName %s, resourceName %s, and time range %v ~ %v", deviceName, resourceName, start, end), edgeXerr)
+	}
+
+	return aggregates, nil
+}
+
 // AddProvisionWatcher adds a new provision watcher
 func (c *Client) AddProvisionWatcher(pw model.ProvisionWatcher) (model.ProvisionWatcher, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1729,3 +1856,842 @@ func (c *Client) UpdateRegistration(r model.Registration) errors.EdgeX {
 
 	return nil
 }
+
+// RollupReadings replaces the numeric readings whose origin is before the specified timestamp with the reading rollups of
+// the resolution in nanoseconds, and deletes the events which have no readings left
+func (c *Client) RollupReadings(before int64, resolution int64) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := rollupReadings(conn, before, resolution, c.BatchSize)
+	if edgeXerr != nil {
+		return count, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to roll up readings before %d", before), edgeXerr)
+	}
+	return count, nil
+}
+
+// ReadingRollupsByDeviceNameAndResourceNameAndTimeRange queries the reading rollups by the specified device and resource,
+// bucket start within the time range, sorted in ascending order of bucket start
+func (c *Client) ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64) ([]dataModels.ReadingRollup, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	rollups, edgeXerr := readingRollupsByDeviceNameAndResourceNameAndTimeRange(conn, deviceName, resourceName, start, end, c.BatchSize)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query reading rollups by deviceName %s, resourceName %s, and time range %v ~ %v", deviceName, resourceName, start, end), edgeXerr)
+	}
+	return rollups, nil
+}
+
+// DeleteReadingRollupsByAge deletes the reading rollups whose bucket start is older than age
+func (c *Client) DeleteReadingRollupsByAge(age int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteReadingRollupsBefore(conn, time.Now().UnixNano()-age, c.BatchSize)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete reading rollups by age %d", age), edgeXerr)
+	}
+	return nil
+}
+
+// UpsertLatestReadings stores the latest readings of the device resources
+func (c *Client) UpsertLatestReadings(readings []model.Reading) errors.EdgeX {
+	if len(readings) == 0 {
+		return nil
+	}
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := upsertLatestReadings(conn, readings)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to upsert the latest readings", edgeXerr)
+	}
+	return nil
+}
+
+// AllLatestReadings queries the latest readings of all the device resources
+func (c *Client) AllLatestReadings() ([]model.Reading, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	readings, edgeXerr := allLatestReadings(conn)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query the latest readings", edgeXerr)
+	}
+	return readings, nil
+}
+
+// DeleteLatestReadingsByDeviceName deletes the latest readings of all the resources of the specified device
+func (c *Client) DeleteLatestReadingsByDeviceName(deviceName string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteLatestReadingsByDeviceName(conn, deviceName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the latest readings by device %s", deviceName), edgeXerr)
+	}
+	return nil
+}
+
+// AddDeadLetter adds a new dead letter
+func (c *Client) AddDeadLetter(d dataModels.DeadLetter) (dataModels.DeadLetter, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	added, edgeXerr := addDeadLetter(conn, d)
+	if edgeXerr != nil {
+		return added, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to add dead letter", edgeXerr)
+	}
+	return added, nil
+}
+
+// DeadLetterById gets a dead letter by id
+func (c *Client) DeadLetterById(id string) (dataModels.DeadLetter, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	d, edgeXerr := deadLetterById(conn, id)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query dead letter by id %s", id), edgeXerr)
+	}
+	return d, nil
+}
+
+// AllDeadLetters queries the dead letters with the given offset and limit, sorted in descending order of created timestamp
+func (c *Client) AllDeadLetters(offset int, limit int) ([]dataModels.DeadLetter, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deadLetters, edgeXerr := allDeadLetters(conn, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query all dead letters with offset %d and limit %d", offset, limit), edgeXerr)
+	}
+	return deadLetters, nil
+}
+
+// DeadLetterTotalCount returns the total count of the dead letters
+func (c *Client) DeadLetterTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DeadLettersCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteDeadLetterById deletes a dead letter by id
+func (c *Client) DeleteDeadLetterById(id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeadLetterById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete dead letter by id %s", id), edgeXerr)
+	}
+	return nil
+}
+
+// TrimDeadLetters deletes the oldest dead letters so that at most maxCount dead letters are kept
+func (c *Client) TrimDeadLetters(maxCount uint32) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := trimDeadLetters(conn, maxCount)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to trim dead letters to %d", maxCount), edgeXerr)
+	}
+	return nil
+}
+
+// AddAuditRecord adds a new audit record
+func (c *Client) AddAuditRecord(_ context.Context, record commandModels.AuditRecord) (commandModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	added, edgeXerr := addAuditRecord(conn, record)
+	if edgeXerr != nil {
+		return added, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to add audit record", edgeXerr)
+	}
+	return added, nil
+}
+
+// AuditRecords queries the audit records by the optional device name and status with the given time range, offset and
+// limit, sorted in descending order of created timestamp
+func (c *Client) AuditRecords(_ context.Context, deviceName, status string, start, end int64, offset, limit int) ([]commandModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, edgeXerr := auditRecords(conn, deviceName, status, start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query audit records by device name %s and status %s", deviceName, status), edgeXerr)
+	}
+	return records, nil
+}
+
+// AuditRecordCount returns the count of the audit records by the optional device name and status with the given time range
+func (c *Client) AuditRecordCount(_ context.Context, deviceName, status string, start, end int64) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberCountByScoreRange(conn, auditRecordCollectionKey(deviceName, status), start, end)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// LatestAuditRecordByOffset queries the audit record at the given offset in descending order of created timestamp
+func (c *Client) LatestAuditRecordByOffset(_ context.Context, offset uint32) (commandModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	record, edgeXerr := latestAuditRecordByOffset(conn, offset)
+	if edgeXerr != nil {
+		return record, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query audit record by offset %d", offset), edgeXerr)
+	}
+	return record, nil
+}
+
+// DeleteAuditRecordsByAge deletes the audit records older than the given age in milliseconds
+func (c *Client) DeleteAuditRecordsByAge(_ context.Context, age int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteAuditRecordsByAge(conn, age)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete audit records by age %d", age), edgeXerr)
+	}
+	return nil
+}
+
+// AddCommandPolicy adds a new command policy
+func (c *Client) AddCommandPolicy(_ context.Context, policy commandModels.CommandPolicy) (commandModels.CommandPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(policy.Id) == 0 {
+		policy.Id = uuid.New().String()
+	}
+	return addCommandPolicy(conn, policy)
+}
+
+// AllCommandPolicies queries the command policies with the given offset and limit
+func (c *Client) AllCommandPolicies(_ context.Context, offset, limit int) ([]commandModels.CommandPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	policies, edgeXerr := allCommandPolicies(conn, offset, limit)
+	if edgeXerr != nil {
+		return policies, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return policies, nil
+}
+
+// CommandPolicyTotalCount returns the total count of the command policies
+func (c *Client) CommandPolicyTotalCount(_ context.Context) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CommandPolicyCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// CommandPolicyById queries the command policy by id
+func (c *Client) CommandPolicyById(_ context.Context, id string) (commandModels.CommandPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	policy, edgeXerr := commandPolicyById(conn, id)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query command policy by id %s", id), edgeXerr)
+	}
+	return policy, nil
+}
+
+// CommandPolicyByName queries the command policy by name
+func (c *Client) CommandPolicyByName(_ context.Context, name string) (commandModels.CommandPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	policy, edgeXerr := commandPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query command policy by name %s", name), edgeXerr)
+	}
+	return policy, nil
+}
+
+// UpdateCommandPolicy updates the command policy
+func (c *Client) UpdateCommandPolicy(_ context.Context, policy commandModels.CommandPolicy) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateCommandPolicy(conn, policy)
+}
+
+// DeleteCommandPolicyByName deletes the command policy by name
+func (c *Client) DeleteCommandPolicyByName(_ context.Context, name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteCommandPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the command policy with name %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AddDeferredCommand adds a new deferred command
+func (c *Client) AddDeferredCommand(_ context.Context, command commandModels.DeferredCommand) (commandModels.DeferredCommand, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	command, edgeXerr := addDeferredCommand(conn, command)
+	if edgeXerr != nil {
+		return command, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return command, nil
+}
+
+// UpdateDeferredCommand updates the status and content of the deferred command
+func (c *Client) UpdateDeferredCommand(_ context.Context, command commandModels.DeferredCommand) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := updateDeferredCommand(conn, command)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update deferred command by id %s", command.Id), edgeXerr)
+	}
+	return nil
+}
+
+// DeferredCommandById queries the deferred command by id
+func (c *Client) DeferredCommandById(_ context.Context, id string) (commandModels.DeferredCommand, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	command, edgeXerr := deferredCommandById(conn, id)
+	if edgeXerr != nil {
+		return command, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query deferred command by id %s", id), edgeXerr)
+	}
+	return command, nil
+}
+
+// DeferredCommandsByStatus queries the deferred commands by the optional status with the given offset and limit, sorted
+// in descending order of created timestamp
+func (c *Client) DeferredCommandsByStatus(_ context.Context, status string, offset, limit int) ([]commandModels.DeferredCommand, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	commands, edgeXerr := deferredCommandsByStatus(conn, status, offset, limit)
+	if edgeXerr != nil {
+		return commands, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query deferred commands by status %s", status), edgeXerr)
+	}
+	return commands, nil
+}
+
+// DeferredCommandCountByStatus returns the count of the deferred commands by the optional status
+func (c *Client) DeferredCommandCountByStatus(_ context.Context, status string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, deferredCommandCollectionKey(status))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteDeferredCommandsByAge deletes the deferred commands which are no longer pending and older than the given age in milliseconds
+func (c *Client) DeleteDeferredCommandsByAge(_ context.Context, age int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeferredCommandsByAge(conn, age)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete deferred commands by age %d", age), edgeXerr)
+	}
+	return nil
+}
+
+// AddDeviceSnapshot adds a new device snapshot
+func (c *Client) AddDeviceSnapshot(_ context.Context, snapshot commandModels.DeviceSnapshot) (commandModels.DeviceSnapshot, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	snapshot, edgeXerr := addDeviceSnapshot(conn, snapshot)
+	if edgeXerr != nil {
+		return snapshot, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return snapshot, nil
+}
+
+// DeviceSnapshotById queries the device snapshot by id
+func (c *Client) DeviceSnapshotById(_ context.Context, id string) (commandModels.DeviceSnapshot, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	snapshot, edgeXerr := deviceSnapshotById(conn, id)
+	if edgeXerr != nil {
+		return snapshot, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device snapshot by id %s", id), edgeXerr)
+	}
+	return snapshot, nil
+}
+
+// DeviceSnapshotsByDeviceName queries the device snapshots by the optional device name with the given offset and limit,
+// sorted in descending order of created timestamp
+func (c *Client) DeviceSnapshotsByDeviceName(_ context.Context, deviceName string, offset, limit int) ([]commandModels.DeviceSnapshot, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	snapshots, edgeXerr := deviceSnapshotsByDeviceName(conn, deviceName, offset, limit)
+	if edgeXerr != nil {
+		return snapshots, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device snapshots by device name %s", deviceName), edgeXerr)
+	}
+	return snapshots, nil
+}
+
+// DeviceSnapshotCountByDeviceName returns the count of the device snapshots by the optional device name
+func (c *Client) DeviceSnapshotCountByDeviceName(_ context.Context, deviceName string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, deviceSnapshotCollectionKey(deviceName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteDeviceSnapshotById deletes the device snapshot by id
+func (c *Client) DeleteDeviceSnapshotById(_ context.Context, id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceSnapshotById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete device snapshot by id %s", id), edgeXerr)
+	}
+	return nil
+}
+
+// AddRevision adds a new revision with the next version of the entity, and returns the revision with the allocated
+// version
+func (c *Client) AddRevision(r metadataModels.Revision) (metadataModels.Revision, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	r, edgeXerr := addRevision(conn, r)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return r, nil
+}
+
+// RevisionByVersion queries the revision of the entity by version
+func (c *Client) RevisionByVersion(entityType string, entityName string, version int64) (metadataModels.Revision, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	r, edgeXerr := revisionByVersion(conn, entityType, entityName, version)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query revision %d of %s %s", version, entityType, entityName), edgeXerr)
+	}
+	return r, nil
+}
+
+// AllRevisions queries the revisions of all the entities with the given offset and limit, sorted in descending order of
+// created timestamp
+func (c *Client) AllRevisions(offset int, limit int) ([]metadataModels.Revision, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	revisions, edgeXerr := revisionsByKey(conn, RevisionCollection, offset, limit)
+	if edgeXerr != nil {
+		return revisions, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query all revisions", edgeXerr)
+	}
+	return revisions, nil
+}
+
+// RevisionTotalCount returns the total count of the revisions
+func (c *Client) RevisionTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, RevisionCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// RevisionsByEntity queries the revisions of the entity with the given offset and limit, sorted in descending order of
+// version
+func (c *Client) RevisionsByEntity(offset int, limit int, entityType string, entityName string) ([]metadataModels.Revision, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	revisions, edgeXerr := revisionsByKey(conn, revisionEntityKey(entityType, entityName), offset, limit)
+	if edgeXerr != nil {
+		return revisions, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query revisions of %s %s", entityType, entityName), edgeXerr)
+	}
+	return revisions, nil
+}
+
+// RevisionCountByEntity returns the count of the revisions of the entity
+func (c *Client) RevisionCountByEntity(entityType string, entityName string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, revisionEntityKey(entityType, entityName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteRevisionsByEntityBeforeVersion deletes the revisions of the entity whose version is less than or equal to the
+// given version
+func (c *Client) DeleteRevisionsByEntityBeforeVersion(entityType string, entityName string, version int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteRevisionsByEntityBeforeVersion(conn, entityType, entityName, version)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete revisions of %s %s before version %d", entityType, entityName, version), edgeXerr)
+	}
+	return nil
+}
+
+// AddDeviceProfileVersion adds a new device profile version, the profile name of the version must be unique
+func (c *Client) AddDeviceProfileVersion(v metadataModels.DeviceProfileVersion) (metadataModels.DeviceProfileVersion, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	addedVersion, edgeXerr := addDeviceProfileVersion(conn, v)
+	if edgeXerr != nil {
+		return addedVersion, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add version %s of device profile %s", v.Version, v.Name), edgeXerr)
+	}
+	return addedVersion, nil
+}
+
+// DeviceProfileVersionByProfileName queries the device profile version by the name of the profile storing its content
+func (c *Client) DeviceProfileVersionByProfileName(profileName string) (metadataModels.DeviceProfileVersion, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	v, edgeXerr := deviceProfileVersionByProfileName(conn, profileName)
+	if edgeXerr != nil {
+		return v, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device profile version by profile name %s", profileName), edgeXerr)
+	}
+	return v, nil
+}
+
+// DeviceProfileVersionsByName queries the versions of the device profile with the given offset and limit, sorted in
+// descending order of created timestamp
+func (c *Client) DeviceProfileVersionsByName(offset int, limit int, name string) ([]metadataModels.DeviceProfileVersion, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	versions, edgeXerr := deviceProfileVersionsByName(conn, offset, limit, name)
+	if edgeXerr != nil {
+		return versions, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query versions of device profile %s", name), edgeXerr)
+	}
+	return versions, nil
+}
+
+// DeviceProfileVersionCountByName returns the count of the versions of the device profile
+func (c *Client) DeviceProfileVersionCountByName(name string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceProfileVersionCollectionName, name))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteDeviceProfileVersionByProfileName deletes the device profile version by the name of the profile storing its
+// content
+func (c *Client) DeleteDeviceProfileVersionByProfileName(profileName string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceProfileVersionByProfileName(conn, profileName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete device profile version by profile name %s", profileName), edgeXerr)
+	}
+	return nil
+}
+
+// AddTransmissionJob adds a new transmission job to the transmission queue
+func (c *Client) AddTransmissionJob(job notificationModels.TransmissionJob) (notificationModels.TransmissionJob, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	job, edgeXerr := addTransmissionJob(conn, job)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return job, nil
+}
+
+// UpdateTransmissionJob updates the transmission and the next attempt of the transmission job
+func (c *Client) UpdateTransmissionJob(job notificationModels.TransmissionJob) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := updateTransmissionJob(conn, job)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update transmission job by id %s", job.Id), edgeXerr)
+	}
+	return nil
+}
+
+// AddTransmissionOfJob adds the transmission of the job and updates the job with the added transmission in one transaction
+func (c *Client) AddTransmissionOfJob(job notificationModels.TransmissionJob) (notificationModels.TransmissionJob, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(job.Transmission.Id) == 0 {
+		job.Transmission.Id = uuid.New().String()
+	}
+	job, edgeXerr := addTransmissionOfJob(conn, job)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add the transmission of transmission job %s", job.Id), edgeXerr)
+	}
+	return job, nil
+}
+
+// TransmissionSuppressedCounts queries the numbers of the suppressed notifications reported by the transmissions of the
+// given ids, the transmissions reporting none are not included
+func (c *Client) TransmissionSuppressedCounts(ids []string) (map[string]int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	counts, edgeXerr := transmissionSuppressedCounts(conn, ids)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return counts, nil
+}
+
+// SetSubscriptionThrottle adds the throttle of the subscription, or replaces the existing one
+func (c *Client) SetSubscriptionThrottle(throttle notificationModels.SubscriptionThrottle) (notificationModels.SubscriptionThrottle, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	throttle, edgeXerr := setSubscriptionThrottle(conn, throttle)
+	if edgeXerr != nil {
+		return throttle, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to set the throttle of subscription %s", throttle.SubscriptionName), edgeXerr)
+	}
+	return throttle, nil
+}
+
+// SubscriptionThrottleByName queries the throttle of the subscription by the subscription name
+func (c *Client) SubscriptionThrottleByName(subscriptionName string) (notificationModels.SubscriptionThrottle, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	throttle, edgeXerr := subscriptionThrottleByName(conn, subscriptionName)
+	if edgeXerr != nil {
+		return throttle, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the throttle of subscription %s", subscriptionName), edgeXerr)
+	}
+	return throttle, nil
+}
+
+// AllSubscriptionThrottles queries the throttles of all the subscriptions
+func (c *Client) AllSubscriptionThrottles() ([]notificationModels.SubscriptionThrottle, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	throttles, edgeXerr := allSubscriptionThrottles(conn)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return throttles, nil
+}
+
+// DeleteSubscriptionThrottleByName deletes the throttle of the subscription by the subscription name
+func (c *Client) DeleteSubscriptionThrottleByName(subscriptionName string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteSubscriptionThrottleByName(conn, subscriptionName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the throttle of subscription %s", subscriptionName), edgeXerr)
+	}
+	return nil
+}
+
+// SetSubscriptionExternalAddresses adds the external addresses of the subscription, or replaces the existing ones
+func (c *Client) SetSubscriptionExternalAddresses(addresses notificationModels.SubscriptionExternalAddresses) (notificationModels.SubscriptionExternalAddresses, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	addresses, edgeXerr := setSubscriptionExternalAddresses(conn, addresses)
+	if edgeXerr != nil {
+		return addresses, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to set the external addresses of subscription %s", addresses.SubscriptionName), edgeXerr)
+	}
+	return addresses, nil
+}
+
+// SubscriptionExternalAddressesByName queries the external addresses of the subscription by the subscription name
+func (c *Client) SubscriptionExternalAddressesByName(subscriptionName string) (notificationModels.SubscriptionExternalAddresses, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	addresses, edgeXerr := subscriptionExternalAddressesByName(conn, subscriptionName)
+	if edgeXerr != nil {
+		return addresses, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the external addresses of subscription %s", subscriptionName), edgeXerr)
+	}
+	return addresses, nil
+}
+
+// AllSubscriptionExternalAddresses queries the external addresses of all the subscriptions
+func (c *Client) AllSubscriptionExternalAddresses() ([]notificationModels.SubscriptionExternalAddresses, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	allAddresses, edgeXerr := allSubscriptionExternalAddresses(conn)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return allAddresses, nil
+}
+
+// DeleteSubscriptionExternalAddressesByName deletes the external addresses of the subscription by the subscription name
+func (c *Client) DeleteSubscriptionExternalAddressesByName(subscriptionName string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteSubscriptionExternalAddressesByName(conn, subscriptionName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the external addresses of subscription %s", subscriptionName), edgeXerr)
+	}
+	return nil
+}
+
+// DeleteTransmissionJobById deletes the transmission job by id
+func (c *Client) DeleteTransmissionJobById(id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteTransmissionJobById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete transmission job by id %s", id), edgeXerr)
+	}
+	return nil
+}
+
+// AllTransmissionJobs queries the transmission jobs with the given offset and limit, sorted in descending order of
+// created timestamp
+func (c *Client) AllTransmissionJobs(offset, limit int) ([]notificationModels.TransmissionJob, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	jobs, edgeXerr := allTransmissionJobs(conn, offset, limit)
+	if edgeXerr != nil {
+		return jobs, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query all transmission jobs", edgeXerr)
+	}
+	return jobs, nil
+}
+
+// DueTransmissionJobs queries at most limit transmission jobs whose next attempt is due by the end timestamp in
+// milliseconds, sorted in ascending order of the next attempt
+func (c *Client) DueTransmissionJobs(end int64, limit int) ([]notificationModels.TransmissionJob, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	jobs, edgeXerr := dueTransmissionJobs(conn, end, limit)
+	if edgeXerr != nil {
+		return jobs, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query transmission jobs due by %d", end), edgeXerr)
+	}
+	return jobs, nil
+}
+
+// AddNotificationTemplate adds a new notification template
+func (c *Client) AddNotificationTemplate(template notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(template.Id) == 0 {
+		template.Id = uuid.New().String()
+	}
+	return addNotificationTemplate(conn, template)
+}
+
+// AllNotificationTemplates queries the notification templates with the given offset and limit
+func (c *Client) AllNotificationTemplates(offset, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	templates, edgeXerr := allNotificationTemplates(conn, offset, limit)
+	if edgeXerr != nil {
+		return templates, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return templates, nil
+}
+
+// NotificationTemplateTotalCount returns the total count of the notification templates
+func (c *Client) NotificationTemplateTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, NotificationTemplateCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// NotificationTemplateById queries the notification template by id
+func (c *Client) NotificationTemplateById(id string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	template, edgeXerr := notificationTemplateById(conn, id)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query notification template by id %s", id), edgeXerr)
+	}
+	return template, nil
+}
+
+// NotificationTemplateByName queries the notification template by name
+func (c *Client) NotificationTemplateByName(name string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	template, edgeXerr := notificationTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query notification template by name %s", name), edgeXerr)
+	}
+	return template, nil
+}
+
+// UpdateNotificationTemplate updates the notification template
+func (c *Client) UpdateNotificationTemplate(template notificationModels.NotificationTemplate) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateNotificationTemplate(conn, template)
+}
+
+// DeleteNotificationTemplateByName deletes the notification template by name
+func (c *Client) DeleteNotificationTemplateByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteNotificationTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the notification template with name %s", name), edgeXerr)
+	}
+	return nil
+}
@@ -5,11 +5,13 @@
 
 package redis
 
+import commandInterfaces "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
 import dataInterfaces "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces"
 import metadataInterfaces "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
 import notificationsInterfaces "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces"
 
 // Check the implementation of Redis satisfies the DB client
+var _ commandInterfaces.DBClient = &Client{}
 var _ dataInterfaces.DBClient = &Client{}
 var _ metadataInterfaces.DBClient = &Client{}
 var _ notificationsInterfaces.DBClient = &Client{}
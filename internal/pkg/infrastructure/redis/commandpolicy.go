@@ -0,0 +1,158 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	CommandPolicyCollection     = "cc|cp"
+	CommandPolicyCollectionName = CommandPolicyCollection + DBKeySeparator + common.Name
+)
+
+// commandPolicyStoredKey returns the command policy's stored key which combines the collection name and object id
+func commandPolicyStoredKey(id string) string {
+	return CreateKey(CommandPolicyCollection, id)
+}
+
+// sendAddCommandPolicyCmd sends redis command for adding command policy
+func sendAddCommandPolicyCmd(conn redis.Conn, storedKey string, policy commandModels.CommandPolicy) errors.EdgeX {
+	m, err := json.Marshal(policy)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal command policy for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CommandPolicyCollection, policy.Created, storedKey)
+	_ = conn.Send(HSET, CommandPolicyCollectionName, policy.Name, storedKey)
+	return nil
+}
+
+// sendDeleteCommandPolicyCmd sends redis command to delete a command policy
+func sendDeleteCommandPolicyCmd(conn redis.Conn, storedKey string, policy commandModels.CommandPolicy) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, CommandPolicyCollection, storedKey)
+	_ = conn.Send(HDEL, CommandPolicyCollectionName, policy.Name)
+}
+
+// addCommandPolicy adds a new command policy into DB
+func addCommandPolicy(conn redis.Conn, policy commandModels.CommandPolicy) (commandModels.CommandPolicy, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, commandPolicyStoredKey(policy.Id))
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return policy, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command policy id %s already exists", policy.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, CommandPolicyCollectionName, policy.Name)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return policy, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command policy name %s already exists", policy.Name), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if policy.Created == 0 {
+		policy.Created = ts
+	}
+	policy.Modified = ts
+
+	storedKey := commandPolicyStoredKey(policy.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddCommandPolicyCmd(conn, storedKey, policy)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "command policy creation failed", err)
+	}
+
+	return policy, edgeXerr
+}
+
+// allCommandPolicies queries command policies by offset and limit
+func allCommandPolicies(conn redis.Conn, offset, limit int) ([]commandModels.CommandPolicy, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CommandPolicyCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	policies := make([]commandModels.CommandPolicy, len(objects))
+	for i, o := range objects {
+		p := commandModels.CommandPolicy{}
+		err := json.Unmarshal(o, &p)
+		if err != nil {
+			return []commandModels.CommandPolicy{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "command policy format parsing failed from the database", err)
+		}
+		policies[i] = p
+	}
+	return policies, nil
+}
+
+// commandPolicyById queries command policy by id
+func commandPolicyById(conn redis.Conn, id string) (policy commandModels.CommandPolicy, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, commandPolicyStoredKey(id), &policy)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// commandPolicyByName queries command policy by name
+func commandPolicyByName(conn redis.Conn, name string) (policy commandModels.CommandPolicy, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, CommandPolicyCollectionName, name, &policy)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// updateCommandPolicy updates a command policy
+func updateCommandPolicy(conn redis.Conn, policy commandModels.CommandPolicy) errors.EdgeX {
+	oldPolicy, edgeXerr := commandPolicyByName(conn, policy.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	policy.Modified = pkgCommon.MakeTimestamp()
+	storedKey := commandPolicyStoredKey(policy.Id)
+
+	_ = conn.Send(MULTI)
+	sendDeleteCommandPolicyCmd(conn, storedKey, oldPolicy)
+	edgeXerr = sendAddCommandPolicyCmd(conn, storedKey, policy)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "command policy update failed", err)
+	}
+	return nil
+}
+
+// deleteCommandPolicyByName deletes the command policy by name
+func deleteCommandPolicyByName(conn redis.Conn, name string) errors.EdgeX {
+	policy, edgeXerr := commandPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteCommandPolicyCmd(conn, commandPolicyStoredKey(policy.Id), policy)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "command policy deletion failed", err)
+	}
+	return nil
+}
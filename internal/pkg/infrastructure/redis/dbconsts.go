@@ -10,6 +10,8 @@ package redis
 const (
 	MULTI            = "MULTI"
 	SET              = "SET"
+	SETNX            = "SETNX"
+	INCR             = "INCR"
 	GET              = "GET"
 	EXISTS           = "EXISTS"
 	DEL              = "DEL"
@@ -18,13 +20,17 @@ const (
 	HSET             = "HSET"
 	HSETNX           = "HSETNX"
 	HGET             = "HGET"
+	HMGET            = "HMGET"
 	HEXISTS          = "HEXISTS"
 	HDEL             = "HDEL"
 	SADD             = "SADD"
 	SREM             = "SREM"
+	SMEMBERS         = "SMEMBERS"
+	HVALS            = "HVALS"
 	ZADD             = "ZADD"
 	ZREM             = "ZREM"
 	EXEC             = "EXEC"
+	DISCARD          = "DISCARD"
 	ZRANGE           = "ZRANGE"
 	ZREVRANGE        = "ZREVRANGE"
 	MGET             = "MGET"
@@ -40,6 +46,7 @@ const (
 	INFO             = "INFO"
 	MEMORY           = "MEMORY"
 	WEIGHTS          = "WEIGHTS"
+	WITHSCORES       = "WITHSCORES"
 )
 
 const (
@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// DeadLettersCollection is the sorted set of the dead letter stored keys scored by the created timestamp
+const DeadLettersCollection = "cd|dl"
+
+// deadLetterStoredKey returns the dead letter's stored key which combines the collection name and object id
+func deadLetterStoredKey(id string) string {
+	return CreateKey(DeadLettersCollection, id)
+}
+
+// addDeadLetter adds a new dead letter into DB
+func addDeadLetter(conn redis.Conn, d dataModels.DeadLetter) (dataModels.DeadLetter, errors.EdgeX) {
+	if d.Id == "" {
+		d.Id = uuid.New().String()
+	}
+	if d.Created == 0 {
+		d.Created = pkgCommon.MakeTimestamp()
+	}
+
+	m, err := json.Marshal(d)
+	if err != nil {
+		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal dead letter for Redis persistence", err)
+	}
+	storedKey := deadLetterStoredKey(d.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeadLettersCollection, d.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return d, errors.NewCommonEdgeX(errors.KindDatabaseError, "dead letter creation failed", err)
+	}
+	return d, nil
+}
+
+// deadLetterById queries dead letter by id from DB
+func deadLetterById(conn redis.Conn, id string) (d dataModels.DeadLetter, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, deadLetterStoredKey(id), &d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return d, nil
+}
+
+// allDeadLetters queries dead letters by offset and limit, sorted in descending order of created timestamp
+func allDeadLetters(conn redis.Conn, offset int, limit int) ([]dataModels.DeadLetter, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeadLettersCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deadLetters := make([]dataModels.DeadLetter, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &deadLetters[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "dead letter format parsing failed from the database", err)
+		}
+	}
+	return deadLetters, nil
+}
+
+// deleteDeadLetterByStoredKeys deletes the dead letters by the stored keys
+func deleteDeadLetterByStoredKeys(conn redis.Conn, storedKeys ...string) errors.EdgeX {
+	_ = conn.Send(MULTI)
+	for _, storedKey := range storedKeys {
+		_ = conn.Send(DEL, storedKey)
+		_ = conn.Send(ZREM, DeadLettersCollection, storedKey)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "dead letter deletion failed", err)
+	}
+	return nil
+}
+
+// deleteDeadLetterById deletes the dead letter by id
+func deleteDeadLetterById(conn redis.Conn, id string) errors.EdgeX {
+	exists, edgeXerr := objectIdExists(conn, deadLetterStoredKey(id))
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("dead letter id %s does not exist", id), nil)
+	}
+	return deleteDeadLetterByStoredKeys(conn, deadLetterStoredKey(id))
+}
+
+// trimDeadLetters deletes the oldest dead letters so that at most maxCount dead letters are kept
+func trimDeadLetters(conn redis.Conn, maxCount uint32) errors.EdgeX {
+	// the members before the last maxCount members in ascending order are the oldest ones to be deleted
+	storedKeys, err := redis.Strings(conn.Do(ZRANGE, DeadLettersCollection, 0, -int64(maxCount)-1))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query the dead letters to be trimmed failed", err)
+	}
+	if len(storedKeys) == 0 {
+		return nil
+	}
+	return deleteDeadLetterByStoredKeys(conn, storedKeys...)
+}
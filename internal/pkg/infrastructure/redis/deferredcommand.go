@@ -0,0 +1,155 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	// DeferredCommandCollection is the sorted set of all the deferred command stored keys scored by the created timestamp
+	DeferredCommandCollection       = "cc|dc"
+	DeferredCommandCollectionStatus = DeferredCommandCollection + DBKeySeparator + common.Status
+	// deferredCommandDeleteBatchSize is the number of the deferred commands deleted in one transaction when purging by age
+	deferredCommandDeleteBatchSize = 1000
+)
+
+// deferredCommandStoredKey returns the deferred command's stored key which combines the collection name and object id
+func deferredCommandStoredKey(id string) string {
+	return CreateKey(DeferredCommandCollection, id)
+}
+
+// deferredCommandCollectionKey returns the key of the sorted set indexing the deferred commands of the given status, the
+// empty status is not filtered
+func deferredCommandCollectionKey(status string) string {
+	if status == "" {
+		return DeferredCommandCollection
+	}
+	return CreateKey(DeferredCommandCollectionStatus, status)
+}
+
+// addDeferredCommand adds a new deferred command into DB
+func addDeferredCommand(conn redis.Conn, c commandModels.DeferredCommand) (commandModels.DeferredCommand, errors.EdgeX) {
+	if c.Id == "" {
+		c.Id = uuid.New().String()
+	}
+	if c.Created == 0 {
+		c.Created = pkgCommon.MakeTimestamp()
+	}
+	c.Modified = c.Created
+
+	m, err := json.Marshal(c)
+	if err != nil {
+		return c, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal deferred command for Redis persistence", err)
+	}
+	storedKey := deferredCommandStoredKey(c.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeferredCommandCollection, c.Created, storedKey)
+	_ = conn.Send(ZADD, deferredCommandCollectionKey(c.Status), c.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return c, errors.NewCommonEdgeX(errors.KindDatabaseError, "deferred command creation failed", err)
+	}
+	return c, nil
+}
+
+// updateDeferredCommand updates the deferred command and moves it to the sorted set of its new status
+func updateDeferredCommand(conn redis.Conn, c commandModels.DeferredCommand) errors.EdgeX {
+	old, edgeXerr := deferredCommandById(conn, c.Id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	c.Modified = pkgCommon.MakeTimestamp()
+
+	m, err := json.Marshal(c)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal deferred command for Redis persistence", err)
+	}
+	storedKey := deferredCommandStoredKey(c.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZREM, deferredCommandCollectionKey(old.Status), storedKey)
+	_ = conn.Send(ZADD, deferredCommandCollectionKey(c.Status), old.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "deferred command update failed", err)
+	}
+	return nil
+}
+
+// deferredCommandById queries the deferred command by id
+func deferredCommandById(conn redis.Conn, id string) (c commandModels.DeferredCommand, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, deferredCommandStoredKey(id), &c)
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return c, nil
+}
+
+// deferredCommandsByStatus queries the deferred commands by the optional status with the given offset and limit, sorted
+// in descending order of created timestamp
+func deferredCommandsByStatus(conn redis.Conn, status string, offset, limit int) ([]commandModels.DeferredCommand, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, deferredCommandCollectionKey(status), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToDeferredCommands(objects)
+}
+
+// deleteDeferredCommandsByAge deletes the deferred commands which are no longer pending and older than the given age in
+// milliseconds batch by batch
+func deleteDeferredCommandsByAge(conn redis.Conn, age int64) errors.EdgeX {
+	expireTimestamp := time.Now().UnixMilli() - age
+	for _, status := range []string{commandModels.DeferredCommandStatusDelivered, commandModels.DeferredCommandStatusFailed, commandModels.DeferredCommandStatusExpired} {
+		statusKey := deferredCommandCollectionKey(status)
+		for {
+			// the deleted commands are removed from the sorted set so the offset is always 0
+			ids, err := redis.Values(conn.Do(ZRANGEBYSCORE, statusKey, InfiniteMin, expireTimestamp, LIMIT, 0, deferredCommandDeleteBatchSize))
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.KindDatabaseError, "query the deferred commands to be deleted failed", err)
+			}
+			if len(ids) == 0 {
+				break
+			}
+			_ = conn.Send(MULTI)
+			for _, id := range ids {
+				_ = conn.Send(DEL, id)
+			}
+			_ = conn.Send(ZREM, append([]any{DeferredCommandCollection}, ids...)...)
+			_ = conn.Send(ZREM, append([]any{statusKey}, ids...)...)
+			_, err = conn.Do(EXEC)
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("deletion of the %s deferred commands failed", status), err)
+			}
+			if len(ids) < deferredCommandDeleteBatchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func convertObjectsToDeferredCommands(objects [][]byte) ([]commandModels.DeferredCommand, errors.EdgeX) {
+	commands := make([]commandModels.DeferredCommand, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &commands[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "deferred command format parsing failed from the database", err)
+		}
+	}
+	return commands, nil
+}
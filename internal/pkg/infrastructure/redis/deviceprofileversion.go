@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	DeviceProfileVersionCollection = "md|dpv"
+	// DeviceProfileVersionCollectionName is the prefix of the sorted sets of the version stored keys of each device
+	// profile scored by the created timestamp
+	DeviceProfileVersionCollectionName = DeviceProfileVersionCollection + DBKeySeparator + common.Name
+	// DeviceProfileVersionCollectionProfileName is the hash of the version stored keys by the profile name of the version
+	DeviceProfileVersionCollectionProfileName = DeviceProfileVersionCollection + DBKeySeparator + common.ProfileName
+)
+
+// deviceProfileVersionStoredKey returns the device profile version's stored key which combines the collection name and
+// object id
+func deviceProfileVersionStoredKey(id string) string {
+	return CreateKey(DeviceProfileVersionCollection, id)
+}
+
+// addDeviceProfileVersion adds a new device profile version into DB
+func addDeviceProfileVersion(conn redis.Conn, v metadataModels.DeviceProfileVersion) (metadataModels.DeviceProfileVersion, errors.EdgeX) {
+	exists, edgeXerr := objectNameExists(conn, DeviceProfileVersionCollectionProfileName, v.ProfileName)
+	if edgeXerr != nil {
+		return v, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return v, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device profile version with profile name %s exists", v.ProfileName), nil)
+	}
+
+	if v.Id == "" {
+		v.Id = uuid.New().String()
+	}
+	if v.Created == 0 {
+		v.Created = pkgCommon.MakeTimestamp()
+	}
+
+	m, err := json.Marshal(v)
+	if err != nil {
+		return v, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile version for Redis persistence", err)
+	}
+	storedKey := deviceProfileVersionStoredKey(v.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceProfileVersionCollection, v.Created, storedKey)
+	_ = conn.Send(ZADD, CreateKey(DeviceProfileVersionCollectionName, v.Name), v.Created, storedKey)
+	_ = conn.Send(HSET, DeviceProfileVersionCollectionProfileName, v.ProfileName, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return v, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile version creation failed", err)
+	}
+	return v, nil
+}
+
+// deviceProfileVersionByProfileName queries the device profile version by the name of the profile storing its content
+func deviceProfileVersionByProfileName(conn redis.Conn, profileName string) (v metadataModels.DeviceProfileVersion, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeviceProfileVersionCollectionProfileName, profileName, &v)
+	if edgeXerr != nil {
+		return v, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return v, nil
+}
+
+// deviceProfileVersionsByName queries the versions of the device profile with the given offset and limit in descending
+// order of the created timestamp
+func deviceProfileVersionsByName(conn redis.Conn, offset int, limit int, name string) ([]metadataModels.DeviceProfileVersion, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(DeviceProfileVersionCollectionName, name), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	versions := make([]metadataModels.DeviceProfileVersion, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &versions[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile version format parsing failed from the database", err)
+		}
+	}
+	return versions, nil
+}
+
+// deleteDeviceProfileVersionByProfileName deletes the device profile version by the name of the profile storing its
+// content, it's a no-op if the version does not exist
+func deleteDeviceProfileVersionByProfileName(conn redis.Conn, profileName string) errors.EdgeX {
+	v, edgeXerr := deviceProfileVersionByProfileName(conn, profileName)
+	if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+		return nil
+	} else if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	storedKey := deviceProfileVersionStoredKey(v.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceProfileVersionCollection, storedKey)
+	_ = conn.Send(ZREM, CreateKey(DeviceProfileVersionCollectionName, v.Name), storedKey)
+	_ = conn.Send(HDEL, DeviceProfileVersionCollectionProfileName, v.ProfileName)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile version deletion failed", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	// DeviceSnapshotCollection is the sorted set of all the device snapshot stored keys scored by the created timestamp
+	DeviceSnapshotCollection           = "cc|ds"
+	DeviceSnapshotCollectionDeviceName = DeviceSnapshotCollection + DBKeySeparator + common.Device + DBKeySeparator + common.Name
+)
+
+// deviceSnapshotStoredKey returns the device snapshot's stored key which combines the collection name and object id
+func deviceSnapshotStoredKey(id string) string {
+	return CreateKey(DeviceSnapshotCollection, id)
+}
+
+// deviceSnapshotCollectionKey returns the key of the sorted set indexing the device snapshots of the given device name,
+// the empty device name is not filtered
+func deviceSnapshotCollectionKey(deviceName string) string {
+	if deviceName == "" {
+		return DeviceSnapshotCollection
+	}
+	return CreateKey(DeviceSnapshotCollectionDeviceName, deviceName)
+}
+
+// addDeviceSnapshot adds a new device snapshot into DB
+func addDeviceSnapshot(conn redis.Conn, s commandModels.DeviceSnapshot) (commandModels.DeviceSnapshot, errors.EdgeX) {
+	if s.Id == "" {
+		s.Id = uuid.New().String()
+	}
+	if s.Created == 0 {
+		s.Created = pkgCommon.MakeTimestamp()
+	}
+
+	m, err := json.Marshal(s)
+	if err != nil {
+		return s, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device snapshot for Redis persistence", err)
+	}
+	storedKey := deviceSnapshotStoredKey(s.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceSnapshotCollection, s.Created, storedKey)
+	_ = conn.Send(ZADD, deviceSnapshotCollectionKey(s.DeviceName), s.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return s, errors.NewCommonEdgeX(errors.KindDatabaseError, "device snapshot creation failed", err)
+	}
+	return s, nil
+}
+
+// deviceSnapshotById queries the device snapshot by id
+func deviceSnapshotById(conn redis.Conn, id string) (s commandModels.DeviceSnapshot, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, deviceSnapshotStoredKey(id), &s)
+	if edgeXerr != nil {
+		return s, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return s, nil
+}
+
+// deviceSnapshotsByDeviceName queries the device snapshots by the optional device name with the given offset and limit,
+// sorted in descending order of created timestamp
+func deviceSnapshotsByDeviceName(conn redis.Conn, deviceName string, offset, limit int) ([]commandModels.DeviceSnapshot, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, deviceSnapshotCollectionKey(deviceName), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	snapshots := make([]commandModels.DeviceSnapshot, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &snapshots[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device snapshot format parsing failed from the database", err)
+		}
+	}
+	return snapshots, nil
+}
+
+// deleteDeviceSnapshotById deletes the device snapshot by id
+func deleteDeviceSnapshotById(conn redis.Conn, id string) errors.EdgeX {
+	s, edgeXerr := deviceSnapshotById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	storedKey := deviceSnapshotStoredKey(s.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceSnapshotCollection, storedKey)
+	_ = conn.Send(ZREM, deviceSnapshotCollectionKey(s.DeviceName), storedKey)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device snapshot deletion failed", err)
+	}
+	return nil
+}
@@ -1,16 +1,18 @@
 //
-// Copyright (C) 2020-2021 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package redis
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -118,6 +120,76 @@ func (c *Client) DeleteEventsByAge(age int64) (edgeXerr errors.EdgeX) {
 	return nil
 }
 
+// DeleteEventsByAgeAndDeviceName deletes the specific device's events and their corresponding readings that are older than age.
+// This function is implemented to starts up two goroutines to delete readings and events in the background to achieve better performance.
+func (c *Client) DeleteEventsByAgeAndDeviceName(age int64, deviceName string) (edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	expireTimestamp := time.Now().UnixNano() - age
+
+	eventIds, readingIds, err := getEventReadingIdsByKeyScoreRange(conn, CreateKey(EventsCollectionDeviceName, deviceName), "0", strconv.FormatInt(expireTimestamp, 10))
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	c.loggingClient.Debugf("Prepare to delete %v readings", len(readingIds))
+	go c.asyncDeleteReadingsByIds(readingIds)
+	c.loggingClient.Debugf("Prepare to delete %v events", len(eventIds))
+	go c.asyncDeleteEventsByIds(eventIds)
+
+	return nil
+}
+
+// DeleteEventsByAgeAndExcludedDeviceNames deletes events and their corresponding readings that are older than age, except the
+// events of the specified devices.  This function is implemented to starts up two goroutines to delete readings and events in
+// the background to achieve better performance.
+func (c *Client) DeleteEventsByAgeAndExcludedDeviceNames(age int64, deviceNames []string) (edgeXerr errors.EdgeX) {
+	if len(deviceNames) == 0 {
+		return c.DeleteEventsByAge(age)
+	}
+
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	expireTimestamp := strconv.FormatInt(time.Now().UnixNano()-age, 10)
+
+	// collect the stored keys of the excluded devices' events to skip them
+	excludedEventKeys := make(map[string]struct{})
+	for _, deviceName := range deviceNames {
+		keys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, CreateKey(EventsCollectionDeviceName, deviceName), "0", expireTimestamp))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve event ids of device %s failed", deviceName), err)
+		}
+		for _, key := range keys {
+			excludedEventKeys[key] = struct{}{}
+		}
+	}
+
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, EventsCollectionOrigin, "0", expireTimestamp))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve event ids by key %s failed", EventsCollectionOrigin), err)
+	}
+	var eventIds, readingIds []string
+	for _, storedKey := range storedKeys {
+		if _, excluded := excludedEventKeys[storedKey]; excluded {
+			continue
+		}
+		eId := idFromStoredKey(storedKey)
+		rIds, err := redis.Strings(conn.Do(ZRANGE, CreateKey(EventsCollectionReadings, eId), 0, -1))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve all reading Ids of event %s failed", eId), err)
+		}
+		eventIds = append(eventIds, storedKey)
+		readingIds = append(readingIds, rIds...)
+	}
+	c.loggingClient.Debugf("Prepare to delete %v readings", len(readingIds))
+	go c.asyncDeleteReadingsByIds(readingIds)
+	c.loggingClient.Debugf("Prepare to delete %v events", len(eventIds))
+	go c.asyncDeleteEventsByIds(eventIds)
+
+	return nil
+}
+
 // ************************** DB HELPER FUNCTIONS ***************************
 // eventStoredKey return the event's stored key which combines the collection name and object id
 func eventStoredKey(id string) string {
@@ -130,8 +202,57 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 	if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
 		return addedEvent, errors.NewCommonEdgeX(errors.KindDuplicateName, "Event Id exists", nil)
 	}
-	edgeXerr = nil
 
+	_ = conn.Send(MULTI)
+	addedEvent, edgeXerr = sendAddEventCmds(conn, e)
+	if edgeXerr != nil {
+		_, _ = conn.Do(DISCARD)
+		return models.Event{}, edgeXerr
+	}
+
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "event creation failed", err)
+	}
+
+	return addedEvent, edgeXerr
+}
+
+// addEvents adds the events and their readings in a single MULTI/EXEC transaction, either all the events are added or none of them
+func addEvents(conn redis.Conn, events []models.Event) (addedEvents []models.Event, edgeXerr errors.EdgeX) {
+	// query Events by Id first to avoid the Id conflict with the stored events and within the events
+	ids := make(map[string]struct{}, len(events))
+	for _, e := range events {
+		if _, exists := ids[e.Id]; exists {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("Event Id %s is duplicated", e.Id), nil)
+		}
+		ids[e.Id] = struct{}{}
+		_, edgeXerr = eventById(conn, e.Id)
+		if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("Event Id %s exists", e.Id), nil)
+		}
+	}
+
+	addedEvents = make([]models.Event, len(events))
+	_ = conn.Send(MULTI)
+	for i, e := range events {
+		addedEvents[i], edgeXerr = sendAddEventCmds(conn, e)
+		if edgeXerr != nil {
+			_, _ = conn.Do(DISCARD)
+			return nil, edgeXerr
+		}
+	}
+
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "events creation failed", err)
+	}
+
+	return addedEvents, nil
+}
+
+// sendAddEventCmds queues the commands to save the event and its readings, it should be invoked within a MULTI/EXEC transaction
+func sendAddEventCmds(conn redis.Conn, e models.Event) (models.Event, errors.EdgeX) {
 	event := models.Event{
 		Id:          e.Id,
 		DeviceName:  e.DeviceName,
@@ -143,11 +264,10 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 
 	m, err := json.Marshal(event)
 	if err != nil {
-		return addedEvent, errors.NewCommonEdgeX(errors.KindContractInvalid, "event parsing failed", err)
+		return models.Event{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "event parsing failed", err)
 	}
 
 	storedKey := eventStoredKey(e.Id)
-	_ = conn.Send(MULTI)
 	// use the SET command to save event as blob
 	_ = conn.Send(SET, storedKey, m)
 	_ = conn.Send(ZADD, EventsCollection, e.Origin, storedKey)
@@ -175,12 +295,7 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 		_ = conn.Send(ZADD, rids...)
 	}
 
-	_, err = conn.Do(EXEC)
-	if err != nil {
-		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "event creation failed", err)
-	}
-
-	return e, edgeXerr
+	return e, nil
 }
 
 func deleteEventById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
@@ -274,6 +389,47 @@ func eventsByTimeRange(conn redis.Conn, startTime int64, endTime int64, offset i
 	return convertObjectsToEvents(conn, objects)
 }
 
+// eventsByCursor query events by the query conditions and the limit, starting after the cursor
+func eventsByCursor(conn redis.Conn, conds dataModels.EventQueryConditions, cursor dataModels.Cursor, limit int) ([]models.Event, errors.EdgeX) {
+	var cursorMember string
+	if !cursor.IsEmpty() {
+		cursorMember = eventStoredKey(cursor.Id)
+	}
+	objects, edgeXerr := getObjectsByScoreRangeAndCursor(conn, eventsKeyByQueryConditions(conds), conds.Start, conds.End, cursor.Origin, cursorMember, limit)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+	return convertObjectsToEvents(conn, objects)
+}
+
+// streamEvents iterates the events by the query conditions in ascending order of origin batch by batch, and passes the
+// events to the handler one by one, the iteration stops when the context is done or the handler returns error
+func streamEvents(ctx context.Context, conn redis.Conn, conds dataModels.EventQueryConditions, batchSize int, handler func(models.Event) error) errors.EdgeX {
+	return forEachObjectsBatchByScoreRange(conn, eventsKeyByQueryConditions(conds), conds.Start, conds.End, batchSize, func(objects [][]byte) errors.EdgeX {
+		if err := ctx.Err(); err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, "event stream is canceled", err)
+		}
+		events, edgeXerr := convertObjectsToEvents(conn, objects)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, e := range events {
+			if err := handler(e); err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+		return nil
+	})
+}
+
+// eventsKeyByQueryConditions returns the key of the sorted set containing the events matched with the device name of the query conditions
+func eventsKeyByQueryConditions(conds dataModels.EventQueryConditions) string {
+	if conds.DeviceName != "" {
+		return CreateKey(EventsCollectionDeviceName, conds.DeviceName)
+	}
+	return EventsCollectionOrigin
+}
+
 func convertObjectsToEvents(conn redis.Conn, objects [][]byte) (events []models.Event, edgeXerr errors.EdgeX) {
 	events = make([]models.Event, len(objects))
 	for i, in := range objects {
@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// SubscriptionExternalAddressCollection is the sorted set of all the subscription external addresses stored keys scored by the created timestamp
+const SubscriptionExternalAddressCollection = "sn|subextaddr"
+
+// subscriptionExternalAddressStoredKey returns the subscription external addresses' stored key which combines the collection name and
+// the subscription name
+func subscriptionExternalAddressStoredKey(subscriptionName string) string {
+	return CreateKey(SubscriptionExternalAddressCollection, subscriptionName)
+}
+
+// setSubscriptionExternalAddresses adds the external addresses of the subscription, or replaces the existing ones while keeping their created timestamp
+func setSubscriptionExternalAddresses(conn redis.Conn, addresses notificationModels.SubscriptionExternalAddresses) (notificationModels.SubscriptionExternalAddresses, errors.EdgeX) {
+	storedKey := subscriptionExternalAddressStoredKey(addresses.SubscriptionName)
+	addresses.Modified = pkgCommon.MakeTimestamp()
+	addresses.Created = addresses.Modified
+	existing, edgeXerr := subscriptionExternalAddressesByName(conn, addresses.SubscriptionName)
+	if edgeXerr == nil {
+		addresses.Created = existing.Created
+	} else if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+		return addresses, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	m, err := json.Marshal(addresses)
+	if err != nil {
+		return addresses, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal subscription external addresses for Redis persistence", err)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, SubscriptionExternalAddressCollection, addresses.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return addresses, errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription external addresses setting failed", err)
+	}
+	return addresses, nil
+}
+
+// subscriptionExternalAddressesByName queries the external addresses of the subscription by the subscription name
+func subscriptionExternalAddressesByName(conn redis.Conn, subscriptionName string) (addresses notificationModels.SubscriptionExternalAddresses, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, subscriptionExternalAddressStoredKey(subscriptionName), &addresses)
+	if edgeXerr != nil {
+		return addresses, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return addresses, nil
+}
+
+// allSubscriptionExternalAddresses queries the external addresses of all the subscriptions
+func allSubscriptionExternalAddresses(conn redis.Conn) ([]notificationModels.SubscriptionExternalAddresses, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, SubscriptionExternalAddressCollection, 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	allAddresses := make([]notificationModels.SubscriptionExternalAddresses, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &allAddresses[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription external addresses format parsing failed from the database", err)
+		}
+	}
+	return allAddresses, nil
+}
+
+// deleteSubscriptionExternalAddressesByName deletes the external addresses of the subscription by the subscription name
+func deleteSubscriptionExternalAddressesByName(conn redis.Conn, subscriptionName string) errors.EdgeX {
+	storedKey := subscriptionExternalAddressStoredKey(subscriptionName)
+	exists, edgeXerr := objectIdExists(conn, storedKey)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no external addresses of subscription %s found", subscriptionName), nil)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, SubscriptionExternalAddressCollection, storedKey)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription external addresses deletion failed", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/gomodule/redigo/redis"
+)
+
+// LatestReadingsCollection is the set of the device names which have the latest readings stored, and the latest readings
+// of each device are stored in the hash of CreateKey(LatestReadingsCollection, deviceName) keyed by the resource name
+const LatestReadingsCollection = "cd|lr"
+
+// upsertLatestReadings stores the latest readings of the device resources in one transaction, the stored reading of a
+// device resource is replaced by the new one
+func upsertLatestReadings(conn redis.Conn, readings []models.Reading) errors.EdgeX {
+	_ = conn.Send(MULTI)
+	for _, r := range readings {
+		baseReading := r.GetBaseReading()
+		m, err := json.Marshal(r)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal the latest reading for Redis persistence", err)
+		}
+		_ = conn.Send(SADD, LatestReadingsCollection, baseReading.DeviceName)
+		_ = conn.Send(HSET, CreateKey(LatestReadingsCollection, baseReading.DeviceName), baseReading.ResourceName, m)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "latest readings upsert failed", err)
+	}
+	return nil
+}
+
+// allLatestReadings queries the latest readings of all the device resources
+func allLatestReadings(conn redis.Conn) ([]models.Reading, errors.EdgeX) {
+	deviceNames, err := redis.Strings(conn.Do(SMEMBERS, LatestReadingsCollection))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query the device names of the latest readings failed", err)
+	}
+
+	var objects [][]byte
+	for _, deviceName := range deviceNames {
+		values, err := redis.ByteSlices(conn.Do(HVALS, CreateKey(LatestReadingsCollection, deviceName)))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query the latest readings of device %s failed", deviceName), err)
+		}
+		objects = append(objects, values...)
+	}
+	return convertObjectsToReadings(objects)
+}
+
+// deleteLatestReadingsByDeviceName deletes the latest readings of all the resources of the specified device
+func deleteLatestReadingsByDeviceName(conn redis.Conn, deviceName string) errors.EdgeX {
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, CreateKey(LatestReadingsCollection, deviceName))
+	_ = conn.Send(SREM, LatestReadingsCollection, deviceName)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("delete the latest readings of device %s failed", deviceName), err)
+	}
+	return nil
+}
@@ -285,6 +285,7 @@ func deleteNotificationByIds(conn redis.Conn, ids []string) errors.EdgeX {
 	}
 	for _, transmission := range transmissions {
 		sendDeleteTransmissionCmd(conn, transmissionStoredKey(transmission.Id), transmission)
+		_ = conn.Send(HDEL, TransmissionCollectionSuppressed, transmission.Id)
 	}
 	_, err := conn.Do(EXEC)
 	if err != nil {
@@ -479,6 +480,7 @@ func (c *Client) asyncDeleteTransmissionByStoreKeys(storeKeys []string) {
 			continue
 		}
 		sendDeleteTransmissionCmd(conn, transmissionStoredKey(trans.Id), trans)
+		_ = conn.Send(HDEL, TransmissionCollectionSuppressed, trans.Id)
 		cmdSize++
 
 		if cmdSize >= c.BatchSize {
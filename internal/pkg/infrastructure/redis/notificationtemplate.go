@@ -0,0 +1,158 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	NotificationTemplateCollection     = "sn|tpl"
+	NotificationTemplateCollectionName = NotificationTemplateCollection + DBKeySeparator + common.Name
+)
+
+// notificationTemplateStoredKey returns the notification template's stored key which combines the collection name and object id
+func notificationTemplateStoredKey(id string) string {
+	return CreateKey(NotificationTemplateCollection, id)
+}
+
+// sendAddNotificationTemplateCmd sends redis command for adding notification template
+func sendAddNotificationTemplateCmd(conn redis.Conn, storedKey string, template notificationModels.NotificationTemplate) errors.EdgeX {
+	m, err := json.Marshal(template)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal notification template for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, NotificationTemplateCollection, template.Created, storedKey)
+	_ = conn.Send(HSET, NotificationTemplateCollectionName, template.Name, storedKey)
+	return nil
+}
+
+// sendDeleteNotificationTemplateCmd sends redis command to delete a notification template
+func sendDeleteNotificationTemplateCmd(conn redis.Conn, storedKey string, template notificationModels.NotificationTemplate) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, NotificationTemplateCollection, storedKey)
+	_ = conn.Send(HDEL, NotificationTemplateCollectionName, template.Name)
+}
+
+// addNotificationTemplate adds a new notification template into DB
+func addNotificationTemplate(conn redis.Conn, template notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, notificationTemplateStoredKey(template.Id))
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return template, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("notification template id %s already exists", template.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, NotificationTemplateCollectionName, template.Name)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return template, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("notification template name %s already exists", template.Name), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if template.Created == 0 {
+		template.Created = ts
+	}
+	template.Modified = ts
+
+	storedKey := notificationTemplateStoredKey(template.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddNotificationTemplateCmd(conn, storedKey, template)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "notification template creation failed", err)
+	}
+
+	return template, edgeXerr
+}
+
+// allNotificationTemplates queries notification templates by offset and limit
+func allNotificationTemplates(conn redis.Conn, offset, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, NotificationTemplateCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	templates := make([]notificationModels.NotificationTemplate, len(objects))
+	for i, o := range objects {
+		p := notificationModels.NotificationTemplate{}
+		err := json.Unmarshal(o, &p)
+		if err != nil {
+			return []notificationModels.NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "notification template format parsing failed from the database", err)
+		}
+		templates[i] = p
+	}
+	return templates, nil
+}
+
+// notificationTemplateById queries notification template by id
+func notificationTemplateById(conn redis.Conn, id string) (template notificationModels.NotificationTemplate, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, notificationTemplateStoredKey(id), &template)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// notificationTemplateByName queries notification template by name
+func notificationTemplateByName(conn redis.Conn, name string) (template notificationModels.NotificationTemplate, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, NotificationTemplateCollectionName, name, &template)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// updateNotificationTemplate updates a notification template
+func updateNotificationTemplate(conn redis.Conn, template notificationModels.NotificationTemplate) errors.EdgeX {
+	oldPolicy, edgeXerr := notificationTemplateByName(conn, template.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	template.Modified = pkgCommon.MakeTimestamp()
+	storedKey := notificationTemplateStoredKey(template.Id)
+
+	_ = conn.Send(MULTI)
+	sendDeleteNotificationTemplateCmd(conn, storedKey, oldPolicy)
+	edgeXerr = sendAddNotificationTemplateCmd(conn, storedKey, template)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "notification template update failed", err)
+	}
+	return nil
+}
+
+// deleteNotificationTemplateByName deletes the notification template by name
+func deleteNotificationTemplateByName(conn redis.Conn, name string) errors.EdgeX {
+	template, edgeXerr := notificationTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteNotificationTemplateCmd(conn, notificationTemplateStoredKey(template.Id), template)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "notification template deletion failed", err)
+	}
+	return nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -110,6 +110,123 @@ func getObjectsByScoreRange(conn redis.Conn, key string, start int64, end int64,
 	return getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(objIds))
 }
 
+// getObjectsByScoreRangeAndCursor returns at most limit objects from the sorted set in descending order of (score, member), whose
+// score is within the start and end range and which come after the (cursorScore, cursorMember) position if cursorMember is not empty
+func getObjectsByScoreRangeAndCursor(conn redis.Conn, key string, start int64, end int64, cursorScore int64, cursorMember string, limit int) ([][]byte, errors.EdgeX) {
+	if limit == 0 {
+		return nil, nil
+	}
+
+	var objIds []string
+	max := strconv.FormatInt(end, 10)
+	if cursorMember != "" {
+		if cursorScore < start {
+			return nil, nil
+		}
+		if cursorScore <= end {
+			// members with the same score are returned in reverse lexicographical order, so only the members less than
+			// the cursor member come after the cursor
+			sameScoreIds, err := redis.Strings(conn.Do(ZREVRANGEBYSCORE, key, cursorScore, cursorScore))
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to query members from %s with score %v", key, cursorScore), err)
+			}
+			for _, id := range sameScoreIds {
+				if limit > 0 && len(objIds) >= limit {
+					break
+				}
+				if id < cursorMember {
+					objIds = append(objIds, id)
+				}
+			}
+			max = "(" + strconv.FormatInt(cursorScore, 10)
+		}
+	}
+
+	if limit < 0 || len(objIds) < limit {
+		args := redis.Args{}.Add(key, max, start)
+		if limit > 0 {
+			args = args.Add(LIMIT, 0, limit-len(objIds))
+		}
+		ids, err := redis.Strings(conn.Do(ZREVRANGEBYSCORE, args...))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to query members from %s between score range %v to %v", key, start, max), err)
+		}
+		objIds = append(objIds, ids...)
+	}
+
+	return getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(objIds))
+}
+
+// forEachObjectsBatchByScoreRange iterates the objects of the sorted set whose score is within the start and end range in ascending order
+// of (score, member), and passes the objects to the handler batch by batch, the iteration stops when the handler returns error. The batches
+// are paged by the (score, member) of the last member rather than by offset, so that the members added or removed while iterating don't
+// make the iteration skip or repeat the other members.
+func forEachObjectsBatchByScoreRange(conn redis.Conn, key string, start int64, end int64, batchSize int, handler func(objects [][]byte) errors.EdgeX) errors.EdgeX {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	min := strconv.FormatInt(start, 10)
+	var lastScore, lastMember string
+	for {
+		var ids []string
+		if lastMember != "" {
+			// members with the same score are returned in lexicographical order, so only the members greater than the last
+			// member of the last score remain to be iterated
+			sameScoreIds, err := redis.Strings(conn.Do(ZRANGEBYSCORE, key, lastScore, lastScore))
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to query members from %s with score %v", key, lastScore), err)
+			}
+			for _, id := range sameScoreIds {
+				if len(ids) < batchSize && id > lastMember {
+					ids = append(ids, id)
+				}
+			}
+			if len(ids) > 0 {
+				lastMember = ids[len(ids)-1]
+			}
+			min = "(" + lastScore
+		}
+		exhausted := false
+		if count := batchSize - len(ids); count > 0 {
+			// ZRANGEBYSCORE key min max WITHSCORES LIMIT 0 count
+			membersWithScores, err := redis.Strings(conn.Do(ZRANGEBYSCORE, key, min, end, WITHSCORES, LIMIT, 0, count))
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to query members from %s between score range %v to %v", key, min, end), err)
+			}
+			for i := 0; i+1 < len(membersWithScores); i += 2 {
+				ids = append(ids, membersWithScores[i])
+				lastMember, lastScore = membersWithScores[i], membersWithScores[i+1]
+			}
+			exhausted = len(membersWithScores)/2 < count
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(ids))
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if edgeXerr = handler(objects); edgeXerr != nil {
+			return edgeXerr
+		}
+		if exhausted {
+			return nil
+		}
+	}
+}
+
+// unionKeysToCacheSet creates a temporary sorted set resulting from the ZUNIONSTORE of all the given sets and returns its key,
+// the caller is responsible for deleting the temporary sorted set
+func unionKeysToCacheSet(conn redis.Conn, redisKeys ...string) (string, errors.EdgeX) {
+	cacheSet := uuid.New().String()
+	args := redis.Args{}.Add(cacheSet, len(redisKeys)).AddFlat(redisKeys)
+	if _, err := conn.Do(ZUNIONSTORE, args...); err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to execute %s command with args %v", ZUNIONSTORE, args), err)
+	}
+	return cacheSet, nil
+}
+
 // getObjectsByLabelsAndSomeRange retrieves the entries for keys enumerated in a sorted set using the specified Redis range
 // command (i.e. RANGE, REVRANGE). The entries are retrieved in the order specified by the supplied Redis command.
 func getObjectsByLabelsAndSomeRange(conn redis.Conn, command string, key string, labels []string, offset int, limit int) ([][]byte, errors.EdgeX) {
@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sortedSetMember struct {
+	member string
+	score  float64
+}
+
+// sortedSetConn is the redis.Conn serving ZRANGEBYSCORE from one sorted set and MGET with the members as the objects
+type sortedSetConn struct {
+	redis.Conn
+	members []sortedSetMember
+}
+
+func (c *sortedSetConn) remove(member string) {
+	c.members = slices.DeleteFunc(c.members, func(m sortedSetMember) bool { return m.member == member })
+}
+
+// scoreBound parses the min or max argument of ZRANGEBYSCORE, which is exclusive when prefixed with '('
+func scoreBound(arg any) (float64, bool) {
+	bound := fmt.Sprint(arg)
+	score, _ := strconv.ParseFloat(strings.TrimPrefix(bound, "("), 64)
+	return score, strings.HasPrefix(bound, "(")
+}
+
+func (c *sortedSetConn) Do(command string, args ...any) (any, error) {
+	if command == MGET {
+		var objects []any
+		for _, id := range args {
+			objects = append(objects, []byte(id.(string)))
+		}
+		return objects, nil
+	}
+
+	min, minExclusive := scoreBound(args[1])
+	max, maxExclusive := scoreBound(args[2])
+	withScores := slices.Contains(args, any(WITHSCORES))
+	offset, count := 0, -1
+	if i := slices.Index(args, any(LIMIT)); i > 0 {
+		offset, count = args[i+1].(int), args[i+2].(int)
+	}
+	slices.SortFunc(c.members, func(a, b sortedSetMember) int {
+		return cmp.Or(cmp.Compare(a.score, b.score), strings.Compare(a.member, b.member))
+	})
+	var reply []any
+	replied := 0
+	for _, m := range c.members {
+		if m.score < min || (minExclusive && m.score == min) || m.score > max || (maxExclusive && m.score == max) {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if count >= 0 && replied >= count {
+			break
+		}
+		replied++
+		reply = append(reply, []byte(m.member))
+		if withScores {
+			reply = append(reply, []byte(strconv.FormatFloat(m.score, 'g', 17, 64)))
+		}
+	}
+	return reply, nil
+}
+
+func TestForEachObjectsBatchByScoreRange(t *testing.T) {
+	conn := &sortedSetConn{members: []sortedSetMember{
+		{"a", 1}, {"b", 2}, {"c", 2}, {"d", 2}, {"e", 3}, {"f", 4}, {"g", 5},
+	}}
+
+	var iterated []string
+	err := forEachObjectsBatchByScoreRange(conn, "key", 2, 4, 2, func(objects [][]byte) errors.EdgeX {
+		for _, object := range objects {
+			iterated = append(iterated, string(object))
+			// the iterated members are removed while iterating, which must not make the iteration skip the other members
+			conn.remove(string(object))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c", "d", "e", "f"}, iterated)
+}
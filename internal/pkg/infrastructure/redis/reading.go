@@ -1,18 +1,21 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package redis
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
 	"github.com/gomodule/redigo/redis"
@@ -343,3 +346,259 @@ func latestReadingByOffset(conn redis.Conn, offset int) (reading models.Reading,
 	}
 	return readings[0], nil
 }
+
+// readingAggregatesByDeviceNameAndResourceNameAndTimeRange computes the reading aggregates in Go since the readings are
+// stored as blobs in Redis. The readings within the time range are iterated in ascending order of origin in batches, so
+// that the whole time range never needs to be loaded into memory at once.
+func readingAggregatesByDeviceNameAndResourceNameAndTimeRange(conn redis.Conn, deviceName string, resourceName string, start int64, end int64, interval int64, batchSize int) ([]dataModels.ReadingAggregate, errors.EdgeX) {
+	if end < start {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "end must be greater than start", nil)
+	}
+	if interval <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "interval must be greater than 0", nil)
+	}
+	key := CreateKey(ReadingsCollectionDeviceNameResourceName, deviceName, resourceName)
+	aggregator := newReadingAggregator(start, interval)
+	edgeXerr := forEachObjectsBatchByScoreRange(conn, key, start, end, batchSize, func(objects [][]byte) errors.EdgeX {
+		readings, edgeXerr := convertObjectsToReadings(objects)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, r := range readings {
+			aggregator.add(r)
+		}
+		return nil
+	})
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return aggregator.aggregates, nil
+}
+
+// readingsByCursor query readings by the query conditions and the limit, starting after the cursor
+func readingsByCursor(conn redis.Conn, conds dataModels.ReadingQueryConditions, cursor dataModels.Cursor, limit int, batchSize int) ([]models.Reading, errors.EdgeX) {
+	key, isCacheSet, edgeXerr := readingsKeyByQueryConditions(conn, conds)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+	if isCacheSet {
+		defer func() { _, _ = conn.Do(DEL, key) }()
+	}
+
+	if !conds.ReadingFilter.IsEmpty() {
+		return filterReadingsByCursor(conn, key, conds, cursor, 0, limit, batchSize)
+	}
+
+	var cursorMember string
+	if !cursor.IsEmpty() {
+		cursorMember = readingStoredKey(cursor.Id)
+	}
+	objects, edgeXerr := getObjectsByScoreRangeAndCursor(conn, key, conds.Start, conds.End, cursor.Origin, cursorMember, limit)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+	return convertObjectsToReadings(objects)
+}
+
+// readingsByQueryConditions query readings by the query conditions with offset and limit
+func readingsByQueryConditions(conn redis.Conn, conds dataModels.ReadingQueryConditions, offset int, limit int, batchSize int) ([]models.Reading, errors.EdgeX) {
+	key, isCacheSet, edgeXerr := readingsKeyByQueryConditions(conn, conds)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+	if isCacheSet {
+		defer func() { _, _ = conn.Do(DEL, key) }()
+	}
+
+	if !conds.ReadingFilter.IsEmpty() {
+		return filterReadingsByCursor(conn, key, conds, dataModels.Cursor{}, offset, limit, batchSize)
+	}
+
+	objects, edgeXerr := getObjectsByScoreRange(conn, key, conds.Start, conds.End, offset, limit)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+	return convertObjectsToReadings(objects)
+}
+
+// filterReadingsByCursor scans the readings of the sorted set in descending order of (origin, id) batch by batch, starting
+// after the cursor, and filters the readings by the reading filter of the query conditions since the reading values and
+// tags are stored as blobs in Redis. The first offset matched readings are skipped, and at most limit matched readings are returned.
+func filterReadingsByCursor(conn redis.Conn, key string, conds dataModels.ReadingQueryConditions, cursor dataModels.Cursor, offset int, limit int, batchSize int) ([]models.Reading, errors.EdgeX) {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	readings := []models.Reading{}
+	if limit == 0 {
+		return readings, nil
+	}
+
+	var cursorMember string
+	if !cursor.IsEmpty() {
+		cursorMember = readingStoredKey(cursor.Id)
+	}
+	for {
+		objects, edgeXerr := getObjectsByScoreRangeAndCursor(conn, key, conds.Start, conds.End, cursor.Origin, cursorMember, batchSize)
+		if edgeXerr != nil {
+			return nil, edgeXerr
+		}
+		batch, edgeXerr := convertObjectsToReadings(objects)
+		if edgeXerr != nil {
+			return nil, edgeXerr
+		}
+		for _, r := range batch {
+			if !conds.ReadingFilter.Matches(r) {
+				continue
+			}
+			if offset > 0 {
+				offset--
+				continue
+			}
+			readings = append(readings, r)
+			if limit > 0 && len(readings) >= limit {
+				return readings, nil
+			}
+		}
+		if len(batch) < batchSize {
+			return readings, nil
+		}
+		last := batch[len(batch)-1].GetBaseReading()
+		cursor = dataModels.Cursor{Origin: last.Origin, Id: last.Id}
+		cursorMember = readingStoredKey(last.Id)
+	}
+}
+
+// readingCountByQueryConditions returns the count of readings by the query conditions
+func readingCountByQueryConditions(conn redis.Conn, conds dataModels.ReadingQueryConditions, batchSize int) (uint32, errors.EdgeX) {
+	key, isCacheSet, edgeXerr := readingsKeyByQueryConditions(conn, conds)
+	if edgeXerr != nil {
+		return 0, edgeXerr
+	}
+	if isCacheSet {
+		defer func() { _, _ = conn.Do(DEL, key) }()
+	}
+
+	if conds.ReadingFilter.IsEmpty() {
+		return getMemberCountByScoreRange(conn, key, conds.Start, conds.End)
+	}
+	var count uint32
+	edgeXerr = forEachObjectsBatchByScoreRange(conn, key, conds.Start, conds.End, batchSize, func(objects [][]byte) errors.EdgeX {
+		readings, edgeXerr := convertObjectsToReadings(objects)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, r := range readings {
+			if conds.ReadingFilter.Matches(r) {
+				count++
+			}
+		}
+		return nil
+	})
+	return count, edgeXerr
+}
+
+// streamReadings iterates the readings by the query conditions in ascending order of origin batch by batch, and passes the
+// readings to the handler one by one, the iteration stops when the context is done or the handler returns error
+func streamReadings(ctx context.Context, conn redis.Conn, conds dataModels.ReadingQueryConditions, batchSize int, handler func(models.Reading) error) errors.EdgeX {
+	key, isCacheSet, edgeXerr := readingsKeyByQueryConditions(conn, conds)
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+	if isCacheSet {
+		defer func() { _, _ = conn.Do(DEL, key) }()
+	}
+
+	return forEachObjectsBatchByScoreRange(conn, key, conds.Start, conds.End, batchSize, func(objects [][]byte) errors.EdgeX {
+		if err := ctx.Err(); err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, "reading stream is canceled", err)
+		}
+		readings, edgeXerr := convertObjectsToReadings(objects)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, r := range readings {
+			if !conds.ReadingFilter.Matches(r) {
+				continue
+			}
+			if err := handler(r); err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+		return nil
+	})
+}
+
+// readingsKeyByQueryConditions returns the key of the sorted set containing the readings matched with the device name and resource name(s)
+// of the query conditions, isCacheSet is true if the key refers to a temporary sorted set which should be deleted after use
+func readingsKeyByQueryConditions(conn redis.Conn, conds dataModels.ReadingQueryConditions) (key string, isCacheSet bool, edgeXerr errors.EdgeX) {
+	if len(conds.ResourceNames) > 0 {
+		redisKeys := make([]string, len(conds.ResourceNames))
+		for i, resourceName := range conds.ResourceNames {
+			if conds.DeviceName != "" {
+				redisKeys[i] = CreateKey(ReadingsCollectionDeviceNameResourceName, conds.DeviceName, resourceName)
+			} else {
+				redisKeys[i] = CreateKey(ReadingsCollectionResourceName, resourceName)
+			}
+		}
+		key, edgeXerr = unionKeysToCacheSet(conn, redisKeys...)
+		return key, edgeXerr == nil, edgeXerr
+	}
+
+	switch {
+	case conds.DeviceName != "" && conds.ResourceName != "":
+		return CreateKey(ReadingsCollectionDeviceNameResourceName, conds.DeviceName, conds.ResourceName), false, nil
+	case conds.DeviceName != "":
+		return CreateKey(ReadingsCollectionDeviceName, conds.DeviceName), false, nil
+	case conds.ResourceName != "":
+		return CreateKey(ReadingsCollectionResourceName, conds.ResourceName), false, nil
+	default:
+		return ReadingsCollectionOrigin, false, nil
+	}
+}
+
+// readingAggregator accumulates the numeric readings into the fixed time buckets, the readings must be added in
+// ascending order of origin
+type readingAggregator struct {
+	start      int64
+	interval   int64
+	aggregates []dataModels.ReadingAggregate
+}
+
+func newReadingAggregator(start int64, interval int64) *readingAggregator {
+	return &readingAggregator{
+		start:      start,
+		interval:   interval,
+		aggregates: []dataModels.ReadingAggregate{},
+	}
+}
+
+// add accumulates the reading into its time bucket, the non-numeric readings are ignored
+func (a *readingAggregator) add(r models.Reading) {
+	simpleReading, ok := r.(models.SimpleReading)
+	if !ok || !dataModels.IsNumericValueType(simpleReading.ValueType) {
+		return
+	}
+	value, err := strconv.ParseFloat(simpleReading.Value, 64)
+	if err != nil {
+		return
+	}
+
+	bucketStart := a.start + ((simpleReading.Origin-a.start)/a.interval)*a.interval
+	if len(a.aggregates) == 0 || a.aggregates[len(a.aggregates)-1].BucketStart != bucketStart {
+		a.aggregates = append(a.aggregates, dataModels.ReadingAggregate{
+			BucketStart: bucketStart,
+			Min:         value,
+			Max:         value,
+			First:       value,
+		})
+	}
+
+	aggregate := &a.aggregates[len(a.aggregates)-1]
+	aggregate.Count++
+	aggregate.Sum += value
+	aggregate.Avg = aggregate.Sum / float64(aggregate.Count)
+	aggregate.Min = min(aggregate.Min, value)
+	aggregate.Max = max(aggregate.Max, value)
+	aggregate.Last = value
+}
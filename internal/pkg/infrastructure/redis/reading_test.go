@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,6 +9,8 @@ import (
 	"encoding/json"
 	"testing"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 	"github.com/stretchr/testify/assert"
@@ -106,3 +108,57 @@ func TestConvertObjectsToReadings(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedReadings, events)
 }
+
+func TestReadingAggregator(t *testing.T) {
+	numericReading := func(origin int64, value string) models.SimpleReading {
+		r := simpleReadingData()
+		r.Origin = origin
+		r.ValueType = common.ValueTypeFloat64
+		r.Value = value
+		return r
+	}
+
+	aggregator := newReadingAggregator(100, 10)
+	aggregator.add(numericReading(100, "3"))
+	aggregator.add(numericReading(105, "1"))
+	aggregator.add(numericReading(109, "2"))
+	aggregator.add(simpleReadingData())
+	aggregator.add(binaryReadingData())
+	aggregator.add(nullReadingData())
+	aggregator.add(numericReading(107, "abc"))
+	aggregator.add(numericReading(125, "-4.5"))
+
+	expected := []dataModels.ReadingAggregate{
+		{BucketStart: 100, Count: 3, Min: 1, Max: 3, Avg: 2, Sum: 6, First: 3, Last: 2},
+		{BucketStart: 120, Count: 1, Min: -4.5, Max: -4.5, Avg: -4.5, Sum: -4.5, First: -4.5, Last: -4.5},
+	}
+	assert.Equal(t, expected, aggregator.aggregates)
+}
+
+func TestReadingRollupAccumulator(t *testing.T) {
+	numericReading := func(resourceName string, origin int64, value string) models.SimpleReading {
+		r := simpleReadingData()
+		r.ResourceName = resourceName
+		r.Origin = origin
+		r.ValueType = common.ValueTypeInt64
+		r.Value = value
+		return r
+	}
+
+	accumulator := newReadingRollupAccumulator(10)
+	assert.True(t, accumulator.add(numericReading(testResourceName, 105, "1")))
+	assert.True(t, accumulator.add(numericReading(testResourceName, 100, "3")))
+	assert.True(t, accumulator.add(numericReading(testResourceName, 109, "2")))
+	assert.True(t, accumulator.add(numericReading("otherResource", 101, "7")))
+	assert.False(t, accumulator.add(simpleReadingData()))
+	assert.False(t, accumulator.add(binaryReadingData()))
+	assert.False(t, accumulator.add(numericReading(testResourceName, 107, "abc")))
+
+	rollups := accumulator.rollups()
+	require.Len(t, rollups, 2)
+	assert.Equal(t, "otherResource", rollups[0].ResourceName)
+	assert.Equal(t, dataModels.ReadingAggregate{BucketStart: 100, Count: 1, Min: 7, Max: 7, Avg: 7, Sum: 7, First: 7, Last: 7}, rollups[0].ReadingAggregate)
+	assert.Equal(t, testResourceName, rollups[1].ResourceName)
+	assert.Equal(t, int64(10), rollups[1].Resolution)
+	assert.Equal(t, dataModels.ReadingAggregate{BucketStart: 100, Count: 3, Min: 1, Max: 3, Avg: 2, Sum: 6, First: 3, Last: 2}, rollups[1].ReadingAggregate)
+}
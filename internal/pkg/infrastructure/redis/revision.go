@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	// RevisionCollection is the sorted set of all the revision stored keys scored by the created timestamp
+	RevisionCollection = "md|rev"
+	// RevisionCollectionEntity is the prefix of the sorted sets of the revision stored keys of each entity scored by
+	// the version
+	RevisionCollectionEntity = RevisionCollection + DBKeySeparator + "entity"
+	// RevisionCollectionVersion is the prefix of the counters allocating the versions of the revisions of each entity
+	RevisionCollectionVersion = RevisionCollection + DBKeySeparator + "version"
+)
+
+// revisionStoredKey returns the revision's stored key which combines the collection name and object id
+func revisionStoredKey(id string) string {
+	return CreateKey(RevisionCollection, id)
+}
+
+// revisionEntityKey returns the key of the sorted set indexing the revisions of the entity
+func revisionEntityKey(entityType string, entityName string) string {
+	return CreateKey(RevisionCollectionEntity, entityType, entityName)
+}
+
+// revisionVersionKey returns the key of the counter allocating the versions of the revisions of the entity
+func revisionVersionKey(entityType string, entityName string) string {
+	return CreateKey(RevisionCollectionVersion, entityType, entityName)
+}
+
+// nextRevisionVersion allocates the next version of the entity by incrementing its counter. The missing counter, e.g. of
+// the revisions recorded before the counter was introduced, is initialized with the latest version of the entity.
+func nextRevisionVersion(conn redis.Conn, entityType string, entityName string) (int64, errors.EdgeX) {
+	versionKey := revisionVersionKey(entityType, entityName)
+	exists, err := redis.Bool(conn.Do(EXISTS, versionKey))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "revision version existence check failed", err)
+	}
+	if !exists {
+		latest, err := redis.Int64Map(conn.Do(ZREVRANGE, revisionEntityKey(entityType, entityName), 0, 0, WITHSCORES))
+		if err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "query latest revision version from database failed", err)
+		}
+		var latestVersion int64
+		for _, version := range latest {
+			latestVersion = version
+		}
+		// the counter initialized by a concurrent allocation is kept
+		if _, err = conn.Do(SETNX, versionKey, latestVersion); err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "revision version initialization failed", err)
+		}
+	}
+	version, err := redis.Int64(conn.Do(INCR, versionKey))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "revision version allocation failed", err)
+	}
+	return version, nil
+}
+
+// addRevision adds a new revision into DB with the next version of the entity, and returns the revision with the
+// allocated version
+func addRevision(conn redis.Conn, r metadataModels.Revision) (metadataModels.Revision, errors.EdgeX) {
+	if r.Id == "" {
+		r.Id = uuid.New().String()
+	}
+	if r.Created == 0 {
+		r.Created = pkgCommon.MakeTimestamp()
+	}
+
+	version, edgeXerr := nextRevisionVersion(conn, r.EntityType, r.EntityName)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	r.Version = version
+	entityKey := revisionEntityKey(r.EntityType, r.EntityName)
+
+	m, err := json.Marshal(r)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal revision for Redis persistence", err)
+	}
+	storedKey := revisionStoredKey(r.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, RevisionCollection, r.Created, storedKey)
+	_ = conn.Send(ZADD, entityKey, r.Version, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindDatabaseError, "revision creation failed", err)
+	}
+	return r, nil
+}
+
+// revisionByVersion queries the revision of the entity by version
+func revisionByVersion(conn redis.Conn, entityType string, entityName string, version int64) (r metadataModels.Revision, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByScoreRange(conn, revisionEntityKey(entityType, entityName), version, version, 0, 1)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(objects) == 0 {
+		return r, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("revision %d of %s '%s' does not exist", version, entityType, entityName), nil)
+	}
+	err := json.Unmarshal(objects[0], &r)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindDatabaseError, "revision format parsing failed from the database", err)
+	}
+	return r, nil
+}
+
+// revisionsByKey queries the revisions indexed by the sorted set with the given offset and limit in descending order of
+// the score
+func revisionsByKey(conn redis.Conn, key string, offset int, limit int) ([]metadataModels.Revision, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, key, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	revisions := make([]metadataModels.Revision, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &revisions[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "revision format parsing failed from the database", err)
+		}
+	}
+	return revisions, nil
+}
+
+// deleteRevisionsByEntityBeforeVersion deletes the revisions of the entity whose version is less than or equal to the
+// given version
+func deleteRevisionsByEntityBeforeVersion(conn redis.Conn, entityType string, entityName string, version int64) errors.EdgeX {
+	entityKey := revisionEntityKey(entityType, entityName)
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, entityKey, InfiniteMin, version))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query revision stored keys from database failed", err)
+	}
+	if len(storedKeys) == 0 {
+		return nil
+	}
+
+	_ = conn.Send(MULTI)
+	for _, storedKey := range storedKeys {
+		_ = conn.Send(DEL, storedKey)
+		_ = conn.Send(ZREM, RevisionCollection, storedKey)
+		_ = conn.Send(ZREM, entityKey, storedKey)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "revision deletion failed", err)
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/gomodule/redigo/redis"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	ReadingRollupsCollection                       = "cd|rr"
+	ReadingRollupsCollectionDeviceNameResourceName = ReadingRollupsCollection + DBKeySeparator + common.DeviceName + DBKeySeparator + common.ResourceName
+)
+
+// readingRollupStoredKey returns the rollup's stored key which combines the collection name, the device resource, the
+// resolution and the bucket start, so that the rollup of the same time bucket can be merged in place
+func readingRollupStoredKey(r dataModels.ReadingRollup) string {
+	return CreateKey(ReadingRollupsCollection, r.DeviceName, r.ResourceName, strconv.FormatInt(r.Resolution, 10), strconv.FormatInt(r.BucketStart, 10))
+}
+
+// rollupReadings replaces the numeric readings whose origin is before the specified timestamp with the reading rollups of
+// the resolution, and deletes the events which have no readings left. The readings are indexed under their events only,
+// so the events are scanned batch by batch in ascending order of origin until the events of all the aged readings are
+// found, and each batch is committed in one transaction.
+func rollupReadings(conn redis.Conn, before int64, resolution int64, batchSize int) (uint32, errors.EdgeX) {
+	if resolution <= 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "resolution must be greater than 0", nil)
+	}
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	readingKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, ReadingsCollectionOrigin, 0, fmt.Sprintf("(%d", before)))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve reading ids before %d failed", before), err)
+	}
+	agedReadingKeys := make(map[string]struct{}, len(readingKeys))
+	for _, key := range readingKeys {
+		agedReadingKeys[key] = struct{}{}
+	}
+
+	var count uint32
+	offset := 0
+	for len(agedReadingKeys) > 0 {
+		eventKeys, err := redis.Strings(conn.Do(ZRANGE, EventsCollectionOrigin, offset, offset+batchSize-1))
+		if err != nil {
+			return count, errors.NewCommonEdgeX(errors.KindDatabaseError, "retrieve event ids failed", err)
+		}
+		if len(eventKeys) == 0 {
+			break
+		}
+		rolledUp, deletedEvents, edgeXerr := rollupEventsReadings(conn, eventKeys, agedReadingKeys, before, resolution)
+		if edgeXerr != nil {
+			return count, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		count += rolledUp
+		// the deleted events are removed from the origin index, which shifts the following events
+		offset += len(eventKeys) - deletedEvents
+	}
+	return count, nil
+}
+
+// rollupEventsReadings rolls up the aged numeric readings of the events and merges the rollups into the stored ones, then
+// deletes the rolled up readings and the events which have no readings left, the events after the specified timestamp are
+// deleted only if their readings are rolled up. The readings found in the events are removed from agedReadingKeys, and
+// the count of the rolled up readings and deleted events are returned.
+func rollupEventsReadings(conn redis.Conn, eventKeys []string, agedReadingKeys map[string]struct{}, before int64, resolution int64) (uint32, int, errors.EdgeX) {
+	accumulator := newReadingRollupAccumulator(resolution)
+	rolledUpReadings := make(map[string][]models.BaseReading)
+	var emptyEvents []models.Event
+	var count uint32
+	for _, eventKey := range eventKeys {
+		var event models.Event
+		edgeXerr := getObjectById(conn, eventKey, &event)
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			// the event might be deleted in the background after the event ids are retrieved
+			continue
+		} else if edgeXerr != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		readings, edgeXerr := readingsByEventId(conn, event.Id)
+		if edgeXerr != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+
+		remaining := len(readings)
+		for _, r := range readings {
+			storedKey := readingStoredKey(r.GetBaseReading().Id)
+			if _, aged := agedReadingKeys[storedKey]; !aged {
+				continue
+			}
+			delete(agedReadingKeys, storedKey)
+			if accumulator.add(r) {
+				rolledUpReadings[event.Id] = append(rolledUpReadings[event.Id], r.GetBaseReading())
+				remaining--
+				count++
+			}
+		}
+		if remaining == 0 && (event.Origin < before || len(rolledUpReadings[event.Id]) > 0) {
+			emptyEvents = append(emptyEvents, event)
+		}
+	}
+	if count == 0 && len(emptyEvents) == 0 {
+		return 0, 0, nil
+	}
+
+	rollups, edgeXerr := mergeStoredReadingRollups(conn, accumulator.rollups())
+	if edgeXerr != nil {
+		return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, rollup := range rollups {
+		m, err := json.Marshal(rollup)
+		if err != nil {
+			_, _ = conn.Do(DISCARD)
+			return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "reading rollup parsing failed", err)
+		}
+		storedKey := readingRollupStoredKey(rollup)
+		_ = conn.Send(SET, storedKey, m)
+		_ = conn.Send(ZADD, ReadingRollupsCollection, rollup.BucketStart, storedKey)
+		_ = conn.Send(ZADD, CreateKey(ReadingRollupsCollectionDeviceNameResourceName, rollup.DeviceName, rollup.ResourceName), rollup.BucketStart, storedKey)
+	}
+	for eventId, readings := range rolledUpReadings {
+		for _, r := range readings {
+			storedKey := readingStoredKey(r.Id)
+			_ = conn.Send(UNLINK, storedKey)
+			_ = conn.Send(ZREM, ReadingsCollection, storedKey)
+			_ = conn.Send(ZREM, ReadingsCollectionOrigin, storedKey)
+			_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceName, r.DeviceName), storedKey)
+			_ = conn.Send(ZREM, CreateKey(ReadingsCollectionResourceName, r.ResourceName), storedKey)
+			_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceNameResourceName, r.DeviceName, r.ResourceName), storedKey)
+			_ = conn.Send(ZREM, CreateKey(EventsCollectionReadings, eventId), storedKey)
+		}
+	}
+	for _, e := range emptyEvents {
+		storedKey := eventStoredKey(e.Id)
+		_ = conn.Send(UNLINK, storedKey)
+		_ = conn.Send(UNLINK, CreateKey(EventsCollectionReadings, e.Id))
+		_ = conn.Send(ZREM, EventsCollection, storedKey)
+		_ = conn.Send(ZREM, EventsCollectionOrigin, storedKey)
+		_ = conn.Send(ZREM, CreateKey(EventsCollectionDeviceName, e.DeviceName), storedKey)
+	}
+	if _, err := conn.Do(EXEC); err != nil {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "reading rollup failed", err)
+	}
+	return count, len(emptyEvents), nil
+}
+
+// mergeStoredReadingRollups merges the stored rollups of the same time buckets into the new rollups, the stored rollups
+// are always earlier than the new ones since the readings are rolled up once they are aged
+func mergeStoredReadingRollups(conn redis.Conn, rollups []dataModels.ReadingRollup) ([]dataModels.ReadingRollup, errors.EdgeX) {
+	if len(rollups) == 0 {
+		return rollups, nil
+	}
+	storedKeys := make([]interface{}, len(rollups))
+	for i, rollup := range rollups {
+		storedKeys[i] = readingRollupStoredKey(rollup)
+	}
+	objects, err := redis.ByteSlices(conn.Do(MGET, storedKeys...))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query reading rollups from database failed", err)
+	}
+
+	for i, object := range objects {
+		if object == nil {
+			continue
+		}
+		var stored dataModels.ReadingRollup
+		if err = json.Unmarshal(object, &stored); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "reading rollup format parsing failed from the database", err)
+		}
+		rollups[i].ReadingAggregate = stored.ReadingAggregate.Merge(rollups[i].ReadingAggregate)
+	}
+	return rollups, nil
+}
+
+// readingRollupsByDeviceNameAndResourceNameAndTimeRange queries the reading rollups by the device resource and bucket
+// start within the time range, sorted in ascending order of bucket start
+func readingRollupsByDeviceNameAndResourceNameAndTimeRange(conn redis.Conn, deviceName string, resourceName string, start int64, end int64, batchSize int) ([]dataModels.ReadingRollup, errors.EdgeX) {
+	if end < start {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "end must be greater than start", nil)
+	}
+	rollups := []dataModels.ReadingRollup{}
+	key := CreateKey(ReadingRollupsCollectionDeviceNameResourceName, deviceName, resourceName)
+	edgeXerr := forEachObjectsBatchByScoreRange(conn, key, start, end, batchSize, func(objects [][]byte) errors.EdgeX {
+		for _, object := range objects {
+			var rollup dataModels.ReadingRollup
+			if err := json.Unmarshal(object, &rollup); err != nil {
+				return errors.NewCommonEdgeX(errors.KindDatabaseError, "reading rollup format parsing failed from the database", err)
+			}
+			rollups = append(rollups, rollup)
+		}
+		return nil
+	})
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return rollups, nil
+}
+
+// deleteReadingRollupsBefore deletes the reading rollups whose bucket start is before the specified timestamp
+func deleteReadingRollupsBefore(conn redis.Conn, before int64, batchSize int) errors.EdgeX {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, ReadingRollupsCollection, InfiniteMin, fmt.Sprintf("(%d", before)))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve reading rollup ids before %d failed", before), err)
+	}
+
+	for batch := range slices.Chunk(storedKeys, batchSize) {
+		objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(batch))
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		_ = conn.Send(MULTI)
+		for _, object := range objects {
+			var rollup dataModels.ReadingRollup
+			if err = json.Unmarshal(object, &rollup); err != nil {
+				_, _ = conn.Do(DISCARD)
+				return errors.NewCommonEdgeX(errors.KindDatabaseError, "reading rollup format parsing failed from the database", err)
+			}
+			_ = conn.Send(ZREM, CreateKey(ReadingRollupsCollectionDeviceNameResourceName, rollup.DeviceName, rollup.ResourceName), readingRollupStoredKey(rollup))
+		}
+		for _, storedKey := range batch {
+			_ = conn.Send(UNLINK, storedKey)
+			_ = conn.Send(ZREM, ReadingRollupsCollection, storedKey)
+		}
+		if _, err = conn.Do(EXEC); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "reading rollup deletion failed", err)
+		}
+	}
+	return nil
+}
+
+// readingRollupAccumulator accumulates the numeric readings into the rollups of the time buckets aligned to the resolution,
+// the readings can be added in any order
+type readingRollupAccumulator struct {
+	resolution int64
+	entries    map[string]*readingRollupEntry
+}
+
+type readingRollupEntry struct {
+	rollup      dataModels.ReadingRollup
+	firstOrigin int64
+	lastOrigin  int64
+}
+
+func newReadingRollupAccumulator(resolution int64) *readingRollupAccumulator {
+	return &readingRollupAccumulator{
+		resolution: resolution,
+		entries:    make(map[string]*readingRollupEntry),
+	}
+}
+
+// add accumulates the reading into the rollup of its device resource and time bucket, and returns false if the reading
+// is ignored because it is not numeric
+func (a *readingRollupAccumulator) add(r models.Reading) bool {
+	simpleReading, ok := r.(models.SimpleReading)
+	if !ok || !dataModels.IsNumericValueType(simpleReading.ValueType) {
+		return false
+	}
+	value, err := strconv.ParseFloat(simpleReading.Value, 64)
+	if err != nil {
+		return false
+	}
+
+	rollup := dataModels.ReadingRollup{
+		DeviceName:   simpleReading.DeviceName,
+		ProfileName:  simpleReading.ProfileName,
+		ResourceName: simpleReading.ResourceName,
+		ValueType:    simpleReading.ValueType,
+		Units:        simpleReading.Units,
+		Resolution:   a.resolution,
+		ReadingAggregate: dataModels.ReadingAggregate{
+			BucketStart: (simpleReading.Origin / a.resolution) * a.resolution,
+			Min:         value,
+			Max:         value,
+			First:       value,
+			Last:        value,
+		},
+	}
+	key := readingRollupStoredKey(rollup)
+	entry, ok := a.entries[key]
+	if !ok {
+		entry = &readingRollupEntry{rollup: rollup, firstOrigin: simpleReading.Origin, lastOrigin: simpleReading.Origin}
+		a.entries[key] = entry
+	}
+
+	aggregate := &entry.rollup.ReadingAggregate
+	aggregate.Count++
+	aggregate.Sum += value
+	aggregate.Avg = aggregate.Sum / float64(aggregate.Count)
+	aggregate.Min = min(aggregate.Min, value)
+	aggregate.Max = max(aggregate.Max, value)
+	if simpleReading.Origin < entry.firstOrigin {
+		entry.firstOrigin = simpleReading.Origin
+		aggregate.First = value
+	}
+	if simpleReading.Origin >= entry.lastOrigin {
+		entry.lastOrigin = simpleReading.Origin
+		aggregate.Last = value
+	}
+	return true
+}
+
+// rollups returns the accumulated rollups sorted by the stored key
+func (a *readingRollupAccumulator) rollups() []dataModels.ReadingRollup {
+	keys := slices.Sorted(maps.Keys(a.entries))
+	rollups := make([]dataModels.ReadingRollup, len(keys))
+	for i, key := range keys {
+		rollups[i] = a.entries[key].rollup
+	}
+	return rollups
+}
@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// SubscriptionThrottleCollection is the sorted set of all the subscription throttle stored keys scored by the created timestamp
+const SubscriptionThrottleCollection = "sn|subthrottle"
+
+// subscriptionThrottleStoredKey returns the subscription throttle's stored key which combines the collection name and
+// the subscription name
+func subscriptionThrottleStoredKey(subscriptionName string) string {
+	return CreateKey(SubscriptionThrottleCollection, subscriptionName)
+}
+
+// setSubscriptionThrottle adds the throttle of the subscription, or replaces the existing one while keeping its created timestamp
+func setSubscriptionThrottle(conn redis.Conn, throttle notificationModels.SubscriptionThrottle) (notificationModels.SubscriptionThrottle, errors.EdgeX) {
+	storedKey := subscriptionThrottleStoredKey(throttle.SubscriptionName)
+	throttle.Modified = pkgCommon.MakeTimestamp()
+	throttle.Created = throttle.Modified
+	existing, edgeXerr := subscriptionThrottleByName(conn, throttle.SubscriptionName)
+	if edgeXerr == nil {
+		throttle.Created = existing.Created
+	} else if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+		return throttle, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	m, err := json.Marshal(throttle)
+	if err != nil {
+		return throttle, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal subscription throttle for Redis persistence", err)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, SubscriptionThrottleCollection, throttle.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return throttle, errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription throttle setting failed", err)
+	}
+	return throttle, nil
+}
+
+// subscriptionThrottleByName queries the throttle of the subscription by the subscription name
+func subscriptionThrottleByName(conn redis.Conn, subscriptionName string) (throttle notificationModels.SubscriptionThrottle, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, subscriptionThrottleStoredKey(subscriptionName), &throttle)
+	if edgeXerr != nil {
+		return throttle, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return throttle, nil
+}
+
+// allSubscriptionThrottles queries the throttles of all the subscriptions
+func allSubscriptionThrottles(conn redis.Conn) ([]notificationModels.SubscriptionThrottle, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, SubscriptionThrottleCollection, 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	throttles := make([]notificationModels.SubscriptionThrottle, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &throttles[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription throttle format parsing failed from the database", err)
+		}
+	}
+	return throttles, nil
+}
+
+// deleteSubscriptionThrottleByName deletes the throttle of the subscription by the subscription name
+func deleteSubscriptionThrottleByName(conn redis.Conn, subscriptionName string) errors.EdgeX {
+	storedKey := subscriptionThrottleStoredKey(subscriptionName)
+	exists, edgeXerr := objectIdExists(conn, storedKey)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no throttle of subscription %s found", subscriptionName), nil)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, SubscriptionThrottleCollection, storedKey)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription throttle deletion failed", err)
+	}
+	return nil
+}
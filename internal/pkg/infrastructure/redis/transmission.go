@@ -24,6 +24,9 @@ const (
 	TransmissionCollectionSubscriptionName = TransmissionCollection + DBKeySeparator + common.Subscription + DBKeySeparator + common.Name
 	TransmissionCollectionNotificationId   = TransmissionCollection + DBKeySeparator + common.Notification + DBKeySeparator + common.Id
 	TransmissionCollectionCreated          = TransmissionCollection + DBKeySeparator + common.Created
+	// TransmissionCollectionSuppressed is the hash of the numbers of the suppressed notifications reported by the
+	// transmissions, keyed by the transmission id
+	TransmissionCollectionSuppressed = TransmissionCollection + DBKeySeparator + "suppressed"
 )
 
 // notificationStoredKey return the transmission's stored key which combines the collection name and object id
@@ -83,6 +86,31 @@ func addTransmission(conn redis.Conn, trans models.Transmission) (models.Transmi
 	return trans, edgeXerr
 }
 
+// transmissionSuppressedCounts queries the numbers of the suppressed notifications reported by the transmissions of the
+// given ids, the transmissions reporting none are not included
+func transmissionSuppressedCounts(conn redis.Conn, ids []string) (map[string]int, errors.EdgeX) {
+	counts := make(map[string]int)
+	if len(ids) == 0 {
+		return counts, nil
+	}
+	args := redis.Args{}.Add(TransmissionCollectionSuppressed).AddFlat(ids)
+	values, err := redis.Values(conn.Do(HMGET, args...))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query the suppressed counts of the transmissions failed", err)
+	}
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		suppressed, err := redis.Int(value, nil)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "suppressed count parsing failed from the database", err)
+		}
+		counts[ids[i]] = suppressed
+	}
+	return counts, nil
+}
+
 // sendDeleteTransmissionCmd sends redis command to delete a transmission
 func sendDeleteTransmissionCmd(conn redis.Conn, storedKey string, trans models.Transmission) {
 	_ = conn.Send(DEL, storedKey)
@@ -123,6 +151,7 @@ func deleteTransmissionById(conn redis.Conn, id string) errors.EdgeX {
 	storedKey := transmissionStoredKey(transmission.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteTransmissionCmd(conn, storedKey, transmission)
+	_ = conn.Send(HDEL, TransmissionCollectionSuppressed, transmission.Id)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission deletion failed", err)
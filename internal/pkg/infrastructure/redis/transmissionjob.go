@@ -0,0 +1,186 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	// TransmissionJobCollection is the sorted set of all the transmission job stored keys scored by the created timestamp
+	TransmissionJobCollection = "sn|tj"
+	// TransmissionJobCollectionNextAttemptAt is the sorted set of all the transmission job stored keys scored by the next attempt timestamp
+	TransmissionJobCollectionNextAttemptAt = TransmissionJobCollection + DBKeySeparator + "nextattemptat"
+)
+
+// transmissionJobStoredKey returns the transmission job's stored key which combines the collection name and object id
+func transmissionJobStoredKey(id string) string {
+	return CreateKey(TransmissionJobCollection, id)
+}
+
+// addTransmissionJob adds a new transmission job into DB
+func addTransmissionJob(conn redis.Conn, job notificationModels.TransmissionJob) (notificationModels.TransmissionJob, errors.EdgeX) {
+	if job.Id == "" {
+		job.Id = uuid.New().String()
+	}
+	if job.Created == 0 {
+		job.Created = pkgCommon.MakeTimestamp()
+	}
+	job.Modified = job.Created
+
+	m, err := json.Marshal(job)
+	if err != nil {
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal transmission job for Redis persistence", err)
+	}
+	storedKey := transmissionJobStoredKey(job.Id)
+	exists, edgeXerr := objectIdExists(conn, storedKey)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return job, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("transmission job id %s already exists", job.Id), nil)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, TransmissionJobCollection, job.Created, storedKey)
+	_ = conn.Send(ZADD, TransmissionJobCollectionNextAttemptAt, job.NextAttemptAt, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return job, errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission job creation failed", err)
+	}
+	return job, nil
+}
+
+// updateTransmissionJob updates the transmission job and reschedules it by the next attempt
+func updateTransmissionJob(conn redis.Conn, job notificationModels.TransmissionJob) errors.EdgeX {
+	storedKey := transmissionJobStoredKey(job.Id)
+	exists, edgeXerr := objectIdExists(conn, storedKey)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "transmission job does not exist", nil)
+	}
+	job.Modified = pkgCommon.MakeTimestamp()
+
+	m, err := json.Marshal(job)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal transmission job for Redis persistence", err)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, TransmissionJobCollectionNextAttemptAt, job.NextAttemptAt, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission job update failed", err)
+	}
+	return nil
+}
+
+// addTransmissionOfJob adds the transmission of the job with the suppressed count of the job, and updates the job with
+// it in one transaction, so that the job always refers to the persisted transmission
+func addTransmissionOfJob(conn redis.Conn, job notificationModels.TransmissionJob) (notificationModels.TransmissionJob, errors.EdgeX) {
+	storedKey := transmissionJobStoredKey(job.Id)
+	exists, edgeXerr := objectIdExists(conn, storedKey)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return job, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "transmission job does not exist", nil)
+	}
+	transStoredKey := transmissionStoredKey(job.Transmission.Id)
+	exists, edgeXerr = objectIdExists(conn, transStoredKey)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return job, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("transmission id %s already exists", job.Transmission.Id), nil)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if job.Transmission.Created == 0 {
+		job.Transmission.Created = ts
+	}
+	job.Modified = ts
+	m, err := json.Marshal(job)
+	if err != nil {
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal transmission job for Redis persistence", err)
+	}
+
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddTransmissionCmd(conn, transStoredKey, job.Transmission)
+	if edgeXerr != nil {
+		_, _ = conn.Do(DISCARD)
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if job.Suppressed > 0 {
+		_ = conn.Send(HSET, TransmissionCollectionSuppressed, job.Transmission.Id, job.Suppressed)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, TransmissionJobCollectionNextAttemptAt, job.NextAttemptAt, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return job, errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission creation of transmission job failed", err)
+	}
+	return job, nil
+}
+
+// deleteTransmissionJobById deletes the transmission job by id
+func deleteTransmissionJobById(conn redis.Conn, id string) errors.EdgeX {
+	storedKey := transmissionJobStoredKey(id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, TransmissionJobCollection, storedKey)
+	_ = conn.Send(ZREM, TransmissionJobCollectionNextAttemptAt, storedKey)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission job deletion failed", err)
+	}
+	return nil
+}
+
+// allTransmissionJobs queries the transmission jobs with the given offset and limit, sorted in descending order of
+// created timestamp
+func allTransmissionJobs(conn redis.Conn, offset, limit int) ([]notificationModels.TransmissionJob, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, TransmissionJobCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToTransmissionJobs(objects)
+}
+
+// dueTransmissionJobs queries at most limit transmission jobs whose next attempt is due by the end timestamp, sorted in
+// ascending order of the next attempt
+func dueTransmissionJobs(conn redis.Conn, end int64, limit int) ([]notificationModels.TransmissionJob, errors.EdgeX) {
+	if limit == 0 {
+		return nil, nil
+	}
+	// ZRANGEBYSCORE key min max LIMIT offset count, the negative count returns all the jobs due
+	ids, err := redis.Values(conn.Do(ZRANGEBYSCORE, TransmissionJobCollectionNextAttemptAt, InfiniteMin, end, LIMIT, 0, limit))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query the due transmission jobs failed", err)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, ids)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToTransmissionJobs(objects)
+}
+
+func convertObjectsToTransmissionJobs(objects [][]byte) ([]notificationModels.TransmissionJob, errors.EdgeX) {
+	jobs := make([]notificationModels.TransmissionJob, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &jobs[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission job format parsing failed from the database", err)
+		}
+	}
+	return jobs, nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -157,17 +157,26 @@ func ParseQueryStringToString(r *http.Request, queryStringKey string, defaultVal
 	return value[0]
 }
 
-func ParseTimeRangeOffsetLimit(c echo.Context, minOffset int, maxOffset int, minLimit int, maxLimit int) (start int64, end int64, offset int, limit int, edgexErr errors.EdgeX) {
+// ParseTimeRange parses the start and end path parameters and checks that end is not before start
+func ParseTimeRange(c echo.Context) (start int64, end int64, edgexErr errors.EdgeX) {
 	start, edgexErr = ParsePathParamToInt64(c, common.Start, 0, math.MaxInt64)
 	if edgexErr != nil {
-		return start, end, offset, limit, edgexErr
+		return start, end, edgexErr
 	}
 	end, edgexErr = ParsePathParamToInt64(c, common.End, 0, math.MaxInt64)
 	if edgexErr != nil {
-		return start, end, offset, limit, edgexErr
+		return start, end, edgexErr
 	}
 	if end < start {
-		return start, end, offset, limit, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be greater than start's value %v", end, start), nil)
+		return start, end, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be greater than start's value %v", end, start), nil)
+	}
+	return start, end, nil
+}
+
+func ParseTimeRangeOffsetLimit(c echo.Context, minOffset int, maxOffset int, minLimit int, maxLimit int) (start int64, end int64, offset int, limit int, edgexErr errors.EdgeX) {
+	start, end, edgexErr = ParseTimeRange(c)
+	if edgexErr != nil {
+		return start, end, offset, limit, edgexErr
 	}
 	offset, limit, _, edgexErr = ParseGetAllObjectsRequestQueryString(c, minOffset, maxOffset, minLimit, maxLimit)
 	if edgexErr != nil {
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/secret"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+)
+
+// envDisableJWTValidation is the environment variable which disables the JWT validation of the authentication handler
+// returned by handlers.AutoConfigAuthenticationFunc
+const envDisableJWTValidation = "EDGEX_DISABLE_JWT_VALIDATION"
+
+type callerContextKey struct{}
+
+// JWTValidationEnabled returns whether the authentication handler returned by handlers.AutoConfigAuthenticationFunc
+// verifies the JWT of the requests, i.e. security is enabled and the JWT validation isn't disabled by
+// EDGEX_DISABLE_JWT_VALIDATION. Otherwise the handler accepts any request and the JWT claims can't be trusted.
+func JWTValidationEnabled() bool {
+	// Golang standard library treats an error as false, as the authentication handler does
+	disableJWTValidation, _ := strconv.ParseBool(os.Getenv(envDisableJWTValidation))
+	return secret.IsSecurityEnabled() && !disableJWTValidation
+}
+
+// CallerFromRequest returns the identity of the caller from the JWT carried by the Authorization header of the request,
+// which is the OIDC standard "name" claim used by EdgeX or the "sub" claim otherwise. The JWT signature isn't checked
+// here, so the caller is only identified when JWTValidationEnabled, i.e. when the authentication handler of the route
+// rejects the requests whose JWT is invalid. The empty string is returned if the caller can't be identified.
+func CallerFromRequest(r *http.Request) string {
+	claims, ok := trustedClaimsFromRequest(r)
+	if !ok {
+		return ""
+	}
+	if name, ok := claims["name"].(string); ok && name != "" {
+		return name
+	}
+	sub, _ := claims.GetSubject()
+	return sub
+}
+
+// CallerClaimsFromRequest returns the "iss" and "sub" claims of the JWT carried by the Authorization header of the
+// request, which are empty if the request carries no JWT. As with CallerFromRequest, the claims are only returned when
+// JWTValidationEnabled.
+func CallerClaimsFromRequest(r *http.Request) (issuer string, subject string) {
+	claims, ok := trustedClaimsFromRequest(r)
+	if !ok {
+		return "", ""
+	}
+	issuer, _ = claims.GetIssuer()
+	subject, _ = claims.GetSubject()
+	return issuer, subject
+}
+
+// CallerToContext is the echo middleware which stores the caller identified by CallerFromRequest in the request context,
+// so that the caller is known to the application layer which only receives the context
+func CallerToContext(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		r := c.Request()
+		if caller := CallerFromRequest(r); caller != "" {
+			c.SetRequest(r.WithContext(context.WithValue(r.Context(), callerContextKey{}, caller)))
+		}
+		return next(c)
+	}
+}
+
+// CallerFromContext returns the caller stored in the context by CallerToContext, which is empty if the caller is unknown
+func CallerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(callerContextKey{}).(string)
+	return caller
+}
+
+// trustedClaimsFromRequest parses the JWT of the request without verifying it, which must be done by the authentication
+// handler before the claims are used, so no claims are returned when the handler doesn't verify the JWT
+func trustedClaimsFromRequest(r *http.Request) (jwt.MapClaims, bool) {
+	if !JWTValidationEnabled() {
+		return nil, false
+	}
+	authParts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(authParts) < 2 || !strings.EqualFold(authParts[0], "Bearer") {
+		return nil, false
+	}
+	claims := jwt.MapClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(authParts[1], claims)
+	if err != nil {
+		return nil, false
+	}
+	return claims, true
+}
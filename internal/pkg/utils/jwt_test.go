@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/secret"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallerFromRequest(t *testing.T) {
+	signedToken := func(claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		require.NoError(t, err)
+		return token
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		expected      string
+	}{
+		{"name claim", "Bearer " + signedToken(jwt.MapClaims{"name": "app-rules-engine", "sub": "1234"}), "app-rules-engine"},
+		{"sub claim", "Bearer " + signedToken(jwt.MapClaims{"sub": "1234"}), "1234"},
+		{"no claim", "Bearer " + signedToken(jwt.MapClaims{}), ""},
+		{"malformed token", "Bearer abc", ""},
+		{"not bearer", "Basic YWRtaW46YWRtaW4=", ""},
+		{"no authorization", "", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			if testCase.authorization != "" {
+				req.Header.Set("Authorization", testCase.authorization)
+			}
+			assert.Equal(t, testCase.expected, CallerFromRequest(req))
+		})
+	}
+}
+
+func TestCallerFromRequestWithoutJWTValidation(t *testing.T) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"name": "app-rules-engine", "iss": "/v1/identity/oidc", "sub": "1234"}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		envName  string
+		envValue string
+	}{
+		{"security disabled", secret.EnvSecretStore, "false"},
+		{"JWT validation disabled", envDisableJWTValidation, "true"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv(testCase.envName, testCase.envValue)
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			assert.False(t, JWTValidationEnabled())
+			assert.Empty(t, CallerFromRequest(req), "the caller of the unverified JWT should not be identified")
+			issuer, subject := CallerClaimsFromRequest(req)
+			assert.Empty(t, issuer)
+			assert.Empty(t, subject)
+		})
+	}
+}
+
+func TestCallerClaimsFromRequest(t *testing.T) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": "/v1/identity/oidc", "sub": "1234"}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		authorization   string
+		expectedIssuer  string
+		expectedSubject string
+	}{
+		{"issuer and subject", "Bearer " + token, "/v1/identity/oidc", "1234"},
+		{"malformed token", "Bearer abc", "", ""},
+		{"no authorization", "", "", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			if testCase.authorization != "" {
+				req.Header.Set("Authorization", testCase.authorization)
+			}
+			issuer, subject := CallerClaimsFromRequest(req)
+			assert.Equal(t, testCase.expectedIssuer, issuer)
+			assert.Equal(t, testCase.expectedSubject, subject)
+		})
+	}
+}
+
+func TestCallerToContext(t *testing.T) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"name": "device-virtual"}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		authorization string
+		expected      string
+	}{
+		{"caller", "Bearer " + token, "device-virtual"},
+		{"no authorization", "", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			if testCase.authorization != "" {
+				req.Header.Set("Authorization", testCase.authorization)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			var caller string
+			err := CallerToContext(func(c echo.Context) error {
+				caller = CallerFromContext(c.Request().Context())
+				return nil
+			})(c)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, caller)
+		})
+	}
+}
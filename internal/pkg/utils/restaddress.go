@@ -29,11 +29,9 @@ var methods = map[string]struct{}{
 func SendRequestWithRESTAddress(lc logger.LoggingClient, content string, contentType string,
 	address models.RESTAddress, jwtSecretProvider interfaces.AuthenticationInjector) (res string, err errors.EdgeX) {
 
-	executingUrl := getUrlStr(address)
-
-	req, err := getHttpRequest(address.HTTPMethod, executingUrl, content, contentType)
+	req, err := NewRequestWithRESTAddress(content, contentType, address)
 	if err != nil {
-		return "", errors.NewCommonEdgeX(errors.KindServerError, "fail to create http request", err)
+		return "", errors.NewCommonEdgeXWrapper(err)
 	}
 
 	if jwtSecretProvider != nil {
@@ -51,6 +49,15 @@ func SendRequestWithRESTAddress(lc logger.LoggingClient, content string, content
 	return res, nil
 }
 
+// NewRequestWithRESTAddress creates the request sending the content to the REST address
+func NewRequestWithRESTAddress(content string, contentType string, address models.RESTAddress) (*http.Request, errors.EdgeX) {
+	req, err := getHttpRequest(address.HTTPMethod, getUrlStr(address), content, contentType)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to create http request", err)
+	}
+	return req, nil
+}
+
 func SendRequestAndGetResponse(client *http.Client, req *http.Request) (res string, edgeXerr errors.EdgeX) {
 	resp, err := client.Do(req)
 
@@ -0,0 +1,189 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// slackMessage is the message of the Slack incoming webhook, which carries the notification as a legacy attachment so
+// that the severity is shown as the color bar
+type slackMessage struct {
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments"`
+}
+
+type slackAttachment struct {
+	Color  string       `json:"color"`
+	Title  string       `json:"title"`
+	Text   string       `json:"text"`
+	Fields []slackField `json:"fields"`
+	Footer string       `json:"footer,omitempty"`
+	Ts     int64        `json:"ts,omitempty"`
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// teamsMessage is the message of the Teams incoming webhook, which carries the notification as an Adaptive Card
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string    `json:"contentType"`
+	Content     teamsCard `json:"content"`
+}
+
+type teamsCard struct {
+	Schema  string           `json:"$schema"`
+	Type    string           `json:"type"`
+	Version string           `json:"version"`
+	Body    []teamsCardBlock `json:"body"`
+}
+
+type teamsCardBlock struct {
+	Type   string      `json:"type"`
+	Text   string      `json:"text,omitempty"`
+	Weight string      `json:"weight,omitempty"`
+	Size   string      `json:"size,omitempty"`
+	Color  string      `json:"color,omitempty"`
+	Wrap   bool        `json:"wrap,omitempty"`
+	Facts  []teamsFact `json:"facts,omitempty"`
+}
+
+type teamsFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// ChatWebhookSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications to
+// the Slack and Teams incoming webhooks. The notifications are formatted as the chat messages and sent by the REST
+// sender, so the signing and TLS secrets of the external address secure the requests as well.
+type ChatWebhookSender struct {
+	dic        *di.Container
+	restSender Sender
+}
+
+// NewChatWebhookSender creates the ChatWebhookSender instance
+func NewChatWebhookSender(dic *di.Container, restSender Sender) Sender {
+	return &ChatWebhookSender{dic: dic, restSender: restSender}
+}
+
+// Send sends the chat message titled by the severity and category of the notification to the webhook
+func (sender *ChatWebhookSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+	return sender.SendWithSubject(notification, "", address)
+}
+
+// SendWithSubject sends the chat message titled by the subject to the webhook
+func (sender *ChatWebhookSender) SendWithSubject(notification models.Notification, subject string, address models.Address) (res string, err errors.EdgeX) {
+	external, err := externalAddressOf(address, ExternalChannelSlack, ExternalChannelTeams)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if subject == "" {
+		subject = chatTitle(notification)
+	}
+
+	var message any
+	if external.Kind == ExternalChannelSlack {
+		message = slackMessageOf(notification, subject)
+	} else {
+		message = teamsMessageOf(notification, subject)
+	}
+	payload, encodeErr := json.Marshal(message)
+	if encodeErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("fail to encode the %s message", external.Kind), encodeErr)
+	}
+	notification.Content = string(payload)
+	notification.ContentType = common.ContentTypeJSON
+	return sender.restSender.Send(notification, external)
+}
+
+func chatTitle(notification models.Notification) string {
+	if notification.Category == "" {
+		return fmt.Sprintf("[%s] notification from %s", notification.Severity, notification.Sender)
+	}
+	return fmt.Sprintf("[%s] %s", notification.Severity, notification.Category)
+}
+
+// chatFacts are the notification properties shown along with the content by the name
+func chatFacts(notification models.Notification) [][2]string {
+	facts := [][2]string{
+		{"Severity", string(notification.Severity)},
+		{"Category", notification.Category},
+		{"Sender", notification.Sender},
+		{"Labels", strings.Join(notification.Labels, ", ")},
+		{"Description", notification.Description},
+	}
+	nonEmpty := facts[:0]
+	for _, fact := range facts {
+		if fact[1] != "" {
+			nonEmpty = append(nonEmpty, fact)
+		}
+	}
+	return nonEmpty
+}
+
+func slackMessageOf(notification models.Notification, title string) slackMessage {
+	attachment := slackAttachment{
+		Title:  title,
+		Text:   notification.Content,
+		Footer: common.SupportNotificationsServiceKey,
+		Ts:     notification.Created / 1000,
+	}
+	switch notification.Severity {
+	case models.Critical:
+		attachment.Color = "danger"
+	case models.Normal:
+		attachment.Color = "#439FE0"
+	default:
+		attachment.Color = "#9E9E9E"
+	}
+	for _, fact := range chatFacts(notification) {
+		attachment.Fields = append(attachment.Fields, slackField{Title: fact[0], Value: fact[1], Short: len(fact[1]) <= 40})
+	}
+	return slackMessage{Text: title, Attachments: []slackAttachment{attachment}}
+}
+
+func teamsMessageOf(notification models.Notification, title string) teamsMessage {
+	titleBlock := teamsCardBlock{Type: "TextBlock", Text: title, Weight: "Bolder", Size: "Medium", Wrap: true}
+	switch notification.Severity {
+	case models.Critical:
+		titleBlock.Color = "Attention"
+	case models.Normal:
+		titleBlock.Color = "Accent"
+	default:
+		titleBlock.Color = "Default"
+	}
+	factSet := teamsCardBlock{Type: "FactSet"}
+	for _, fact := range chatFacts(notification) {
+		factSet.Facts = append(factSet.Facts, teamsFact{Title: fact[0], Value: fact[1]})
+	}
+	return teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: teamsCard{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body:    []teamsCardBlock{titleBlock, {Type: "TextBlock", Text: notification.Content, Wrap: true}, factSet},
+			},
+		}},
+	}
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatWebhookSenderSend(t *testing.T) {
+	var receivedContentType string
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedContentType = r.Header.Get(common.ContentType)
+		receivedBody, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	address := restAddressOf(t, server.Listener.Addr(), http.MethodPost)
+
+	t.Run("Slack", func(t *testing.T) {
+		dic := externalChannelDic()
+		sender := NewChatWebhookSender(dic, NewRESTSender(dic, nil))
+
+		res, err := sender.Send(externalNotification, externalAddressOfKind(address, ExternalChannelSlack))
+		require.NoError(t, err)
+		assert.Equal(t, "ok", res)
+		assert.Equal(t, common.ContentTypeJSON, receivedContentType)
+
+		var message slackMessage
+		require.NoError(t, json.Unmarshal(receivedBody, &message))
+		assert.Equal(t, "[CRITICAL] health-check", message.Text)
+		require.Len(t, message.Attachments, 1)
+		assert.Equal(t, "danger", message.Attachments[0].Color)
+		assert.Equal(t, externalNotification.Content, message.Attachments[0].Text)
+		assert.Contains(t, message.Attachments[0].Fields, slackField{Title: "Labels", Value: "device, offline", Short: true})
+	})
+	t.Run("Teams with subject", func(t *testing.T) {
+		dic := externalChannelDic()
+		sender := NewChatWebhookSender(dic, NewRESTSender(dic, nil))
+
+		_, err := sender.(SubjectSender).SendWithSubject(externalNotification, "device-1 offline", externalAddressOfKind(address, ExternalChannelTeams))
+		require.NoError(t, err)
+
+		var message teamsMessage
+		require.NoError(t, json.Unmarshal(receivedBody, &message))
+		assert.Equal(t, "message", message.Type)
+		require.Len(t, message.Attachments, 1)
+		card := message.Attachments[0].Content
+		assert.Equal(t, "AdaptiveCard", card.Type)
+		require.Len(t, card.Body, 3)
+		assert.Equal(t, "device-1 offline", card.Body[0].Text)
+		assert.Equal(t, "Attention", card.Body[0].Color)
+		assert.Equal(t, externalNotification.Content, card.Body[1].Text)
+		assert.Contains(t, card.Body[2].Facts, teamsFact{Title: "Sender", Value: externalNotification.Sender})
+	})
+	t.Run("other kind", func(t *testing.T) {
+		dic := externalChannelDic()
+		sender := NewChatWebhookSender(dic, NewRESTSender(dic, nil))
+
+		_, err := sender.Send(externalNotification, externalAddressOfKind(address, ExternalChannelSMS))
+		assert.Error(t, err)
+	})
+	t.Run("not external address", func(t *testing.T) {
+		dic := externalChannelDic()
+		sender := NewChatWebhookSender(dic, NewRESTSender(dic, nil))
+
+		_, err := sender.Send(externalNotification, address)
+		assert.Error(t, err)
+	})
+}
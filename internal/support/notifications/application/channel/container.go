@@ -21,6 +21,15 @@ var MQTTSenderName = di.TypeInstanceToName(MQTTSender{})
 // ZeroMQTSenderName contains the name of the channel.ZeroMQSender implementation in the DIC.
 var ZeroMQTSenderName = di.TypeInstanceToName(ZeroMQSender{})
 
+// ChatWebhookSenderName contains the name of the channel.ChatWebhookSender implementation in the DIC.
+var ChatWebhookSenderName = di.TypeInstanceToName(ChatWebhookSender{})
+
+// SMSSenderName contains the name of the channel.SMSSender implementation in the DIC.
+var SMSSenderName = di.TypeInstanceToName(SMSSender{})
+
+// SyslogSenderName contains the name of the channel.SyslogSender implementation in the DIC.
+var SyslogSenderName = di.TypeInstanceToName(SyslogSender{})
+
 // RESTSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
 func RESTSenderFrom(get di.Get) Sender {
 	return get(RESTSenderName).(Sender)
@@ -40,3 +49,18 @@ func MQTTSenderFrom(get di.Get) Sender {
 func ZeroMQSenderFrom(get di.Get) Sender {
 	return get(ZeroMQTSenderName).(Sender)
 }
+
+// ChatWebhookSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
+func ChatWebhookSenderFrom(get di.Get) Sender {
+	return get(ChatWebhookSenderName).(Sender)
+}
+
+// SMSSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
+func SMSSenderFrom(get di.Get) Sender {
+	return get(SMSSenderName).(Sender)
+}
+
+// SyslogSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
+func SyslogSenderFrom(get di.Get) Sender {
+	return get(SyslogSenderName).(Sender)
+}
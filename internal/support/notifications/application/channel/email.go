@@ -28,11 +28,15 @@ const (
 	secretKeyPassword = "password"
 )
 
+// headerLineBreakReplacer replaces the line breaks of a header value, which would otherwise end the header and inject
+// the following text as new headers or the body
+var headerLineBreakReplacer = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
 func buildSmtpMessage(sender string, subject string, toAddresses []string, contentType string, message string) []byte {
 	smtpNewline := "\r\n"
 
 	// required CRLF at ends of lines and CRLF between header and body for SMTP RFC 822 style email
-	buf := bytes.NewBufferString("Subject: " + subject + smtpNewline)
+	buf := bytes.NewBufferString("Subject: " + headerLineBreakReplacer.Replace(subject) + smtpNewline)
 
 	buf.WriteString("From: " + sender + smtpNewline)
 
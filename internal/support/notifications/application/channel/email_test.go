@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSmtpMessage(t *testing.T) {
+	tests := []struct {
+		name            string
+		subject         string
+		expectedSubject string
+	}{
+		{"plain subject", "Disk full", "Subject: Disk full"},
+		{"subject with CRLF", "Disk full\r\nBcc: attacker@example.com", "Subject: Disk full Bcc: attacker@example.com"},
+		{"subject with LF", "Disk full\nBcc: attacker@example.com", "Subject: Disk full Bcc: attacker@example.com"},
+		{"subject with CR", "Disk full\rBcc: attacker@example.com", "Subject: Disk full Bcc: attacker@example.com"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			msg := string(buildSmtpMessage("sender@example.com", testCase.subject, []string{"to@example.com"}, "", "content"))
+
+			header, body, found := strings.Cut(msg, "\r\n\r\n")
+			require.True(t, found)
+			lines := strings.Split(header, "\r\n")
+			require.Len(t, lines, 3)
+			assert.Equal(t, testCase.expectedSubject, lines[0])
+			assert.Equal(t, "From: sender@example.com", lines[1])
+			assert.Equal(t, "To: to@example.com", lines[2])
+			assert.Equal(t, "content\r\n", body)
+		})
+	}
+}
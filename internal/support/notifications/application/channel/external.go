@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// The kinds of the external channels of the notificationModels.ExternalAddress
+const (
+	ExternalChannelSlack  = "Slack"
+	ExternalChannelTeams  = "Teams"
+	ExternalChannelSMS    = "SMS"
+	ExternalChannelSyslog = "Syslog"
+	// ExternalChannelREST delivers the notifications as they are to the REST receiver, which is secured by the signing
+	// and TLS secrets of the external address
+	ExternalChannelREST = "REST"
+)
+
+// externalAddressOf casts the address to the ExternalAddress of the kinds, which is required by the senders of the
+// external channels
+func externalAddressOf(address models.Address, kinds ...string) (notificationModels.ExternalAddress, errors.EdgeX) {
+	external, ok := address.(notificationModels.ExternalAddress)
+	if !ok {
+		return external, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the address %v is not an external address", address.GetBaseAddress()), nil)
+	}
+	for _, kind := range kinds {
+		if external.Kind == kind {
+			return external, nil
+		}
+	}
+	return external, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the external channel kind %s of the address %v is not one of %v", external.Kind, address.GetBaseAddress(), kinds), nil)
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+var externalNotification = models.Notification{
+	Sender:      "core-metadata",
+	Category:    "health-check",
+	Labels:      []string{"device", "offline"},
+	Severity:    models.Critical,
+	Content:     "device-1 is offline",
+	ContentType: common.ContentTypeText,
+	Description: "device health",
+}
+
+// externalChannelDic creates the container of the external channel senders and the REST sender they send with
+func externalChannelDic() *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+}
+
+// externalAddressOfKind returns the ExternalAddress of the kind at the REST address
+func externalAddressOfKind(address models.RESTAddress, kind string) notificationModels.ExternalAddress {
+	return notificationModels.ExternalAddress{RESTAddress: address, Kind: kind}
+}
+
+// restAddressOf returns the REST address of the listening address
+func restAddressOf(t *testing.T, addr net.Addr, method string) models.RESTAddress {
+	host, portStr, err := net.SplitHostPort(addr.String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+	return models.RESTAddress{
+		BaseAddress: models.BaseAddress{Type: common.REST, Host: host, Port: port},
+		HTTPMethod:  method,
+	}
+}
+
+func TestExternalAddressOf(t *testing.T) {
+	restAddress := models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: "hooks.example.com", Port: 443}}
+	tests := []struct {
+		name          string
+		address       models.Address
+		kinds         []string
+		errorExpected bool
+	}{
+		{"external address", externalAddressOfKind(restAddress, ExternalChannelSlack), []string{ExternalChannelSlack, ExternalChannelTeams}, false},
+		{"other kind", externalAddressOfKind(restAddress, ExternalChannelSMS), []string{ExternalChannelSlack, ExternalChannelTeams}, true},
+		{"REST address", restAddress, []string{ExternalChannelSlack}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			external, err := externalAddressOf(testCase.address, testCase.kinds...)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.address, external)
+		})
+	}
+}
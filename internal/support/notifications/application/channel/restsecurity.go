@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of the REST request as "sha256=" followed by the hex encoded signature
+	SignatureHeader = "X-EdgeX-Signature"
+	// TimestampHeader carries the Unix time in seconds when the REST request is signed
+	TimestampHeader = "X-EdgeX-Timestamp"
+	// signaturePrefix is the prefix of the SignatureHeader value naming the hash function
+	signaturePrefix = "sha256="
+	// tlsClientIdleTimeout is how long the cached client of a TLS secret is kept without being used, so that the clients
+	// of the TLS secrets no longer used by any external address are evicted
+	tlsClientIdleTimeout = time.Hour
+)
+
+const (
+	// secretKeySigningKey is the key to read the signing key from the secret data
+	secretKeySigningKey = "signingkey"
+	// secretKeyClientCert, secretKeyClientKey and secretKeyCACert are the keys to read the PEM encoded client
+	// certificate, private key and CA certificate from the secret data, same as the MessageBus secrets
+	secretKeyClientCert = "clientcert"
+	secretKeyClientKey  = "clientkey"
+	secretKeyCACert     = "cacert"
+)
+
+// SignRESTRequest signs the request body with the key and sets the SignatureHeader and TimestampHeader. The signature is
+// HMAC-SHA256 of the timestamp, a dot and the body, so that the receiver can verify the request came from this service,
+// and reject the replayed requests whose timestamp is too old.
+func SignRESTRequest(req *http.Request, key []byte, now time.Time) errors.EdgeX {
+	var body []byte
+	if req.GetBody != nil {
+		reader, err := req.GetBody()
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, "fail to read the request body to sign", err)
+		}
+		defer func() { _ = reader.Close() }()
+		if body, err = io.ReadAll(reader); err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, "fail to read the request body to sign", err)
+		}
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, signaturePrefix+hex.EncodeToString(restSignature(key, timestamp, body)))
+	return nil
+}
+
+func restSignature(key []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// restTLSConfig creates the TLS configuration from the secret data, which presents the client certificate if any, and
+// trusts the CA certificate in addition to the system CAs if any
+func restTLSConfig(secrets map[string]string) (*tls.Config, errors.EdgeX) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	clientCert, clientKey := secrets[secretKeyClientCert], secrets[secretKeyClientKey]
+	if clientCert != "" || clientKey != "" {
+		pair, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to load the client certificate and key", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{pair}
+	}
+	if caCert := secrets[secretKeyCACert]; caCert != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the CA certificate", nil)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// tlsClient is the HTTP client presenting the client certificate of the TLS secret, the hash of the secret data which
+// the client is created from, and the time the client is last used
+type tlsClient struct {
+	client     *http.Client
+	secretHash string
+	lastUsed   time.Time
+}
+
+// secureRequest signs the request and returns the client presenting the client certificate, as configured by the signing
+// and TLS secrets of the external address
+func (sender *RESTSender) secureRequest(req *http.Request, info notificationModels.ExternalAddress) (*http.Client, errors.EdgeX) {
+	if sender.secretProvider == nil && (info.SigningSecretName != "" || info.TLSSecretName != "") {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing to secure the REST request", nil)
+	}
+	if info.SigningSecretName != "" {
+		secrets, err := sender.secretProvider.GetSecret(info.SigningSecretName, secretKeySigningKey)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to retrieve the signing key from the secret %s", info.SigningSecretName), err)
+		}
+		key := secrets[secretKeySigningKey]
+		if key == "" {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the signing key of the secret %s is empty", info.SigningSecretName), nil)
+		}
+		if edgexErr := SignRESTRequest(req, []byte(key), time.Now()); edgexErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+	if info.TLSSecretName == "" {
+		return &http.Client{}, nil
+	}
+
+	secrets, err := sender.secretProvider.GetSecret(info.TLSSecretName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to retrieve the TLS secret %s", info.TLSSecretName), err)
+	}
+	hash := sha256.Sum256([]byte(secrets[secretKeyClientCert] + "\x00" + secrets[secretKeyClientKey] + "\x00" + secrets[secretKeyCACert]))
+	secretHash := hex.EncodeToString(hash[:])
+	now := time.Now()
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+	sender.evictClients(now)
+	// the client is recreated once the secret data changes, so that the rotated secret takes effect
+	if cached, ok := sender.clientCache[info.TLSSecretName]; ok {
+		if cached.secretHash == secretHash {
+			cached.lastUsed = now
+			sender.clientCache[info.TLSSecretName] = cached
+			return cached.client, nil
+		}
+		cached.client.CloseIdleConnections()
+	}
+	tlsConfig, edgexErr := restTLSConfig(secrets)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), fmt.Sprintf("invalid TLS secret %s", info.TLSSecretName), edgexErr)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	client := &http.Client{Transport: transport}
+	sender.clientCache[info.TLSSecretName] = tlsClient{client: client, secretHash: secretHash, lastUsed: now}
+	return client, nil
+}
+
+// evictClients closes and removes the cached clients which aren't used within the tlsClientIdleTimeout, so that the
+// cache only holds the clients of the TLS secrets in use. The caller must hold the mutex.
+func (sender *RESTSender) evictClients(now time.Time) {
+	for secretName, cached := range sender.clientCache {
+		if now.Sub(cached.lastUsed) > tlsClientIdleTimeout {
+			cached.client.CloseIdleConnections()
+			delete(sender.clientCache, secretName)
+		}
+	}
+}
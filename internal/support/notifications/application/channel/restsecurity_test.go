@@ -0,0 +1,191 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestSignRESTRequest(t *testing.T) {
+	key := []byte("secret")
+	now := time.Unix(1700000000, 0)
+	req, err := http.NewRequest(http.MethodPost, "http://localhost/path", strings.NewReader(`{"a":1}`))
+	require.NoError(t, err)
+
+	require.NoError(t, SignRESTRequest(req, key, now))
+
+	assert.Equal(t, "1700000000", req.Header.Get(TimestampHeader))
+	signature, err := hex.DecodeString(strings.TrimPrefix(req.Header.Get(SignatureHeader), signaturePrefix))
+	require.NoError(t, err)
+	assert.True(t, hmac.Equal(restSignature(key, "1700000000", []byte(`{"a":1}`)), signature))
+	assert.False(t, hmac.Equal(restSignature(key, "1700000001", []byte(`{"a":1}`)), signature), "the timestamp should be signed")
+
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(body), "the body should be intact after signing")
+}
+
+// selfSignedCert generates a self-signed certificate of the loopback address and the private key in PEM
+func selfSignedCert(t *testing.T) (certPEM []byte, keyPEM []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "support-notifications"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestRESTSenderSendSecured(t *testing.T) {
+	signingKey := "secret"
+	clientCert, clientKey := selfSignedCert(t)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(clientCert))
+
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	secretProvider := &mocks.SecretProviderExt{}
+	secretProvider.On("GetSecret", "signing", secretKeySigningKey).Return(map[string]string{secretKeySigningKey: signingKey}, nil)
+	secretProvider.On("GetSecret", "tls").Return(map[string]string{
+		secretKeyClientCert: string(clientCert), secretKeyClientKey: string(clientKey), secretKeyCACert: string(caCert),
+	}, nil)
+	secretProvider.On("GetSecret", "ca-only").Return(map[string]string{secretKeyCACert: string(caCert)}, nil)
+	secretProvider.On("GetSecret", "empty-signing", secretKeySigningKey).Return(map[string]string{}, nil)
+
+	tests := []struct {
+		name              string
+		signingSecretName string
+		tlsSecretName     string
+		errorExpected     bool
+	}{
+		{"signed with client certificate", "signing", "tls", false},
+		{"without client certificate", "", "ca-only", true},
+		{"empty signing key", "empty-signing", "tls", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			received = nil
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+			})
+			sender := NewRESTSender(dic, secretProvider)
+			address := notificationModels.ExternalAddress{
+				RESTAddress: models.RESTAddress{
+					BaseAddress: models.BaseAddress{Type: common.REST, Scheme: "https", Host: host, Port: port},
+					HTTPMethod:  http.MethodPost,
+					Path:        "/tickets",
+				},
+				Kind:              ExternalChannelREST,
+				SigningSecretName: testCase.signingSecretName,
+				TLSSecretName:     testCase.tlsSecretName,
+			}
+
+			res, err := sender.Send(models.Notification{Content: `{"severity":"CRITICAL"}`, ContentType: common.ContentTypeJSON}, address)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "ok", res)
+			require.NotNil(t, received)
+			timestamp := received.Header.Get(TimestampHeader)
+			signature, decodeErr := hex.DecodeString(strings.TrimPrefix(received.Header.Get(SignatureHeader), signaturePrefix))
+			require.NoError(t, decodeErr)
+			assert.True(t, hmac.Equal(restSignature([]byte(signingKey), timestamp, receivedBody), signature))
+		})
+	}
+}
+
+func TestRESTSenderClientCache(t *testing.T) {
+	caCert, _ := selfSignedCert(t)
+	rotatedCACert, _ := selfSignedCert(t)
+	secretProvider := &mocks.SecretProviderExt{}
+	secretProvider.On("GetSecret", "tls").Return(map[string]string{secretKeyCACert: string(caCert)}, nil).Once()
+	secretProvider.On("GetSecret", "tls").Return(map[string]string{secretKeyCACert: string(rotatedCACert)}, nil)
+	secretProvider.On("GetSecret", "other").Return(map[string]string{secretKeyCACert: string(caCert)}, nil)
+	sender := NewRESTSender(nil, secretProvider).(*RESTSender)
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "https://localhost/path", http.NoBody)
+		require.NoError(t, err)
+		return req
+	}
+	info := notificationModels.ExternalAddress{Kind: ExternalChannelREST, TLSSecretName: "tls"}
+	other := notificationModels.ExternalAddress{Kind: ExternalChannelREST, TLSSecretName: "other"}
+
+	client, err := sender.secureRequest(newRequest(), info)
+	require.NoError(t, err)
+	rotated, err := sender.secureRequest(newRequest(), info)
+	require.NoError(t, err)
+	assert.NotSame(t, client, rotated, "the client should be recreated once the secret is rotated")
+	cached, err := sender.secureRequest(newRequest(), info)
+	require.NoError(t, err)
+	assert.Same(t, rotated, cached, "the client should be reused while the secret stays the same")
+	assert.Len(t, sender.clientCache, 1, "the rotated client should replace the previous one")
+
+	_, err = sender.secureRequest(newRequest(), other)
+	require.NoError(t, err)
+	require.Len(t, sender.clientCache, 2)
+	idle := sender.clientCache[info.TLSSecretName]
+	idle.lastUsed = time.Now().Add(-tlsClientIdleTimeout - time.Minute)
+	sender.clientCache[info.TLSSecretName] = idle
+	_, err = sender.secureRequest(newRequest(), other)
+	require.NoError(t, err)
+	assert.Len(t, sender.clientCache, 1, "the client of the TLS secret no longer in use should be evicted")
+	assert.Contains(t, sender.clientCache, other.TLSSecretName)
+}
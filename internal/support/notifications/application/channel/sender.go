@@ -17,6 +17,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces"
@@ -34,32 +35,70 @@ type Sender interface {
 	Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX)
 }
 
+// SubjectSender is implemented by the Sender of the channels carrying a subject, e.g. email, so that the subject rendered
+// from the notification template replaces the configured one
+type SubjectSender interface {
+	SendWithSubject(notification models.Notification, subject string, address models.Address) (res string, err errors.EdgeX)
+}
+
 // RESTSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications via REST
 type RESTSender struct {
 	dic            *di.Container
 	secretProvider bootstrapInterfaces.SecretProviderExt
+	mutex          sync.Mutex
+	// clientCache stores the HTTP clients presenting the client certificates for reusing, keyed by the TLS secret name
+	clientCache map[string]tlsClient
 }
 
 // NewRESTSender creates the RESTSender instance
 func NewRESTSender(dic *di.Container, secretProvider bootstrapInterfaces.SecretProviderExt) Sender {
-	return &RESTSender{dic: dic, secretProvider: secretProvider}
+	return &RESTSender{dic: dic, secretProvider: secretProvider, clientCache: make(map[string]tlsClient)}
 }
 
 // Send sends the REST request to the specified address
 func (sender *RESTSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
 	lc := container.LoggingClientFrom(sender.dic.Get)
 
-	restAddress, ok := address.(models.RESTAddress)
-	if !ok {
+	// the external address carries the signing and TLS secrets securing the requests to its REST address
+	var external notificationModels.ExternalAddress
+	switch a := address.(type) {
+	case models.RESTAddress:
+		external.RESTAddress = a
+	case notificationModels.ExternalAddress:
+		external = a
+	default:
 		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to RESTAddress", nil)
 	}
+	restAddress := external.RESTAddress
 
 	var injector interfaces.AuthenticationInjector
 	if restAddress.InjectEdgeXAuth {
 		injector = secret.NewJWTSecretProvider(sender.secretProvider)
 	}
 
-	return utils.SendRequestWithRESTAddress(lc, notification.Content, notification.ContentType, restAddress, injector)
+	if external.SigningSecretName == "" && external.TLSSecretName == "" {
+		return utils.SendRequestWithRESTAddress(lc, notification.Content, notification.ContentType, restAddress, injector)
+	}
+
+	req, err := utils.NewRequestWithRESTAddress(notification.Content, notification.ContentType, restAddress)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if injector != nil {
+		if injectErr := injector.AddAuthenticationData(req); injectErr != nil {
+			return "", errors.NewCommonEdgeXWrapper(injectErr)
+		}
+	}
+	client, err := sender.secureRequest(req, external)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	res, err = utils.SendRequestAndGetResponse(client, req)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("success to send the secured rest request with address %v", restAddress.BaseAddress)
+	return res, nil
 }
 
 // EmailSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications via email
@@ -72,16 +111,22 @@ func NewEmailSender(dic *di.Container) Sender {
 	return &EmailSender{dic: dic}
 }
 
-// Send sends the email to the specified address
+// Send sends the email to the specified address with the configured subject
 func (sender *EmailSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
 	smtpInfo := notificationContainer.ConfigurationFrom(sender.dic.Get).Smtp
+	return sender.SendWithSubject(notification, smtpInfo.Subject, address)
+}
+
+// SendWithSubject sends the email with the subject to the specified address
+func (sender *EmailSender) SendWithSubject(notification models.Notification, subject string, address models.Address) (res string, err errors.EdgeX) {
+	smtpInfo := notificationContainer.ConfigurationFrom(sender.dic.Get).Smtp
 
 	emailAddress, ok := address.(models.EmailAddress)
 	if !ok {
 		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to EmailAddress", nil)
 	}
 
-	msg := buildSmtpMessage(notification.Sender, smtpInfo.Subject, emailAddress.Recipients, notification.ContentType, notification.Content)
+	msg := buildSmtpMessage(notification.Sender, subject, emailAddress.Recipients, notification.ContentType, notification.Content)
 	auth, err := deduceAuth(sender.dic, smtpInfo)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
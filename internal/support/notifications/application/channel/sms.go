@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// secretKeyToken is the key to read the bearer token from the secret data
+const secretKeyToken = "token"
+
+// smsMessage is the JSON request body sent to the SMS gateway
+type smsMessage struct {
+	From string   `json:"from,omitempty"`
+	To   []string `json:"to"`
+	Text string   `json:"text"`
+}
+
+// SMSSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications via the
+// generic HTTP SMS gateway. The gateway receives the smsMessage at the REST address, authenticated by the credentials
+// from the secret store.
+type SMSSender struct {
+	dic            *di.Container
+	secretProvider bootstrapInterfaces.SecretProviderExt
+	client         *http.Client
+}
+
+// NewSMSSender creates the SMSSender instance
+func NewSMSSender(dic *di.Container, secretProvider bootstrapInterfaces.SecretProviderExt) Sender {
+	return &SMSSender{dic: dic, secretProvider: secretProvider, client: &http.Client{}}
+}
+
+// Send sends the notification content to the configured recipients via the SMS gateway at the address
+func (sender *SMSSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+	external, err := externalAddressOf(address, ExternalChannelSMS)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	restAddress := external.RESTAddress
+	if len(external.Recipients) == 0 {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no SMS recipient is configured for the address %v", restAddress.BaseAddress), nil)
+	}
+
+	payload, encodeErr := json.Marshal(smsMessage{From: external.From, To: external.Recipients, Text: notification.Content})
+	if encodeErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "fail to encode the SMS message", encodeErr)
+	}
+	req, err := utils.NewRequestWithRESTAddress(string(payload), common.ContentTypeJSON, restAddress)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = sender.authenticate(req, external.SecretName); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	res, err = utils.SendRequestAndGetResponse(sender.client, req)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	container.LoggingClientFrom(sender.dic.Get).Debugf("success to send the SMS to %d recipients via the gateway %v", len(external.Recipients), restAddress.BaseAddress)
+	return res, nil
+}
+
+// authenticate sets the bearer token if the secret stores the 'token', or the basic authentication otherwise
+func (sender *SMSSender) authenticate(req *http.Request, secretName string) errors.EdgeX {
+	if secretName == "" {
+		return nil
+	}
+	if sender.secretProvider == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing to authenticate with the SMS gateway", nil)
+	}
+	secrets, err := sender.secretProvider.GetSecret(secretName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to retrieve the SMS gateway credentials from the secret %s", secretName), err)
+	}
+	if token := secrets[secretKeyToken]; token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+	username := secrets[secretKeyUsername]
+	if username == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("neither token nor username exists in the secret %s", secretName), nil)
+	}
+	req.SetBasicAuth(username, secrets[secretKeyPassword])
+	return nil
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestSMSSenderSend(t *testing.T) {
+	var receivedAuth string
+	var received smsMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedAuth = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"queued"}`))
+	}))
+	defer server.Close()
+	address := restAddressOf(t, server.Listener.Addr(), http.MethodPost)
+	address.Path = "/messages"
+
+	secretProvider := &mocks.SecretProviderExt{}
+	secretProvider.On("GetSecret", "basic").Return(map[string]string{secretKeyUsername: "user", secretKeyPassword: "pass"}, nil)
+	secretProvider.On("GetSecret", "bearer").Return(map[string]string{secretKeyToken: "abc"}, nil)
+	secretProvider.On("GetSecret", "empty").Return(map[string]string{}, nil)
+
+	basicRequest, err := http.NewRequest(http.MethodPost, server.URL, nil)
+	require.NoError(t, err)
+	basicRequest.SetBasicAuth("user", "pass")
+
+	tests := []struct {
+		name          string
+		address       notificationModels.ExternalAddress
+		expectedAuth  string
+		errorExpected bool
+	}{
+		{"basic authentication", notificationModels.ExternalAddress{Recipients: []string{"+15550100", "+15550101"}, From: "EdgeX", SecretName: "basic"}, basicRequest.Header.Get("Authorization"), false},
+		{"bearer authentication", notificationModels.ExternalAddress{Recipients: []string{"+15550100"}, SecretName: "bearer"}, "Bearer abc", false},
+		{"no authentication", notificationModels.ExternalAddress{Recipients: []string{"+15550100"}}, "", false},
+		{"no credentials in the secret", notificationModels.ExternalAddress{Recipients: []string{"+15550100"}, SecretName: "empty"}, "", true},
+		{"no recipient", notificationModels.ExternalAddress{}, "", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			receivedAuth, received = "", smsMessage{}
+			testCase.address.RESTAddress, testCase.address.Kind = address, ExternalChannelSMS
+			sender := NewSMSSender(externalChannelDic(), secretProvider)
+
+			res, err := sender.Send(externalNotification, testCase.address)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, `{"status":"queued"}`, res)
+			assert.Equal(t, testCase.expectedAuth, receivedAuth)
+			assert.Equal(t, smsMessage{From: testCase.address.From, To: testCase.address.Recipients, Text: externalNotification.Content}, received)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// The transports of the syslog messages of the notificationModels.ExternalAddress
+const (
+	SyslogNetworkUDP = "udp"
+	SyslogNetworkTCP = "tcp"
+	SyslogNetworkTLS = "tls"
+)
+
+const (
+	// defaultSyslogFacility is the user-level messages facility
+	defaultSyslogFacility = 1
+	// maxSyslogFacility is the last facility code defined by RFC 5424
+	maxSyslogFacility = 23
+	// syslogTimestampLayout is the RFC 5424 TIMESTAMP with the fraction of a second limited to 6 digits
+	syslogTimestampLayout = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// SyslogSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications to the
+// syslog receiver as the RFC 5424 messages. A message is sent as a datagram over UDP, and with the octet-counting
+// framing of RFC 6587 and RFC 5425 over TCP and TLS, on a new connection per notification.
+type SyslogSender struct {
+	dic            *di.Container
+	secretProvider bootstrapInterfaces.SecretProviderExt
+	hostname       string
+	procID         string
+}
+
+// NewSyslogSender creates the SyslogSender instance
+func NewSyslogSender(dic *di.Container, secretProvider bootstrapInterfaces.SecretProviderExt) Sender {
+	// the HOSTNAME is the NILVALUE if the hostname is unknown
+	hostname, _ := os.Hostname()
+	return &SyslogSender{dic: dic, secretProvider: secretProvider, hostname: hostname, procID: strconv.Itoa(os.Getpid())}
+}
+
+// Send sends the syslog message of the notification to the syslog receiver at the address
+func (sender *SyslogSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+	external, err := externalAddressOf(address, ExternalChannelSyslog)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	facility := external.Facility
+	if facility == 0 {
+		facility = defaultSyslogFacility
+	}
+	if facility < 0 || facility > maxSyslogFacility {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid syslog facility %d, which should be between 0 and %d", facility, maxSyslogFacility), nil)
+	}
+	appName := external.AppName
+	if appName == "" {
+		appName = common.SupportNotificationsServiceKey
+	}
+	message := syslogMessage(notification, facility, sender.hostname, appName, sender.procID, time.Now())
+
+	conn, err := sender.dial(external)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	defer func() { _ = conn.Close() }()
+	if external.Network != "" && external.Network != SyslogNetworkUDP {
+		message = fmt.Sprintf("%d %s", len(message), message)
+	}
+	_ = conn.SetWriteDeadline(time.Now().Add(WaitDuration))
+	if _, writeErr := conn.Write([]byte(message)); writeErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("fail to send the syslog message to %s", conn.RemoteAddr()), writeErr)
+	}
+	container.LoggingClientFrom(sender.dic.Get).Debugf("success to send the syslog message with address %v", external.BaseAddress)
+	return "", nil
+}
+
+// dial connects to the syslog receiver with the transport of the external address
+func (sender *SyslogSender) dial(external notificationModels.ExternalAddress) (net.Conn, errors.EdgeX) {
+	hostPort := net.JoinHostPort(external.Host, strconv.Itoa(external.Port))
+	dialer := &net.Dialer{Timeout: WaitDuration}
+	var conn net.Conn
+	var err error
+	switch external.Network {
+	case "", SyslogNetworkUDP:
+		conn, err = dialer.Dial("udp", hostPort)
+	case SyslogNetworkTCP:
+		conn, err = dialer.Dial("tcp", hostPort)
+	case SyslogNetworkTLS:
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if external.SecretName != "" {
+			if sender.secretProvider == nil {
+				return nil, errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing to secure the syslog transport", nil)
+			}
+			secrets, secretErr := sender.secretProvider.GetSecret(external.SecretName)
+			if secretErr != nil {
+				return nil, errors.NewCommonEdgeX(errors.Kind(secretErr), fmt.Sprintf("fail to retrieve the TLS secret %s", external.SecretName), secretErr)
+			}
+			var edgexErr errors.EdgeX
+			if tlsConfig, edgexErr = restTLSConfig(secrets); edgexErr != nil {
+				return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), fmt.Sprintf("invalid TLS secret %s", external.SecretName), edgexErr)
+			}
+		}
+		conn, err = tls.DialWithDialer(dialer, "tcp", hostPort, tlsConfig)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported syslog network %s", external.Network), nil)
+	}
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("fail to connect to the syslog receiver %s", hostPort), err)
+	}
+	return conn, nil
+}
+
+// syslogMessage formats the notification as the RFC 5424 message without the structured data, whose MSGID is the
+// notification category and MSG is the notification content. The notification creation time is the TIMESTAMP if any.
+func syslogMessage(notification models.Notification, facility int, hostname, appName, procID string, now time.Time) string {
+	timestamp := now
+	if notification.Created > 0 {
+		timestamp = time.UnixMilli(notification.Created)
+	}
+	return fmt.Sprintf("<%d>1 %s %s %s %s %s - %s",
+		facility*8+syslogSeverity(notification.Severity),
+		timestamp.UTC().Format(syslogTimestampLayout),
+		syslogHeaderField(hostname, 255),
+		syslogHeaderField(appName, 48),
+		syslogHeaderField(procID, 128),
+		syslogHeaderField(notification.Category, 32),
+		notification.Content)
+}
+
+// syslogSeverity maps the notification severity to the syslog severity, CRITICAL to Critical, NORMAL to Notice, and
+// MINOR to Informational
+func syslogSeverity(severity models.NotificationSeverity) int {
+	switch severity {
+	case models.Critical:
+		return 2
+	case models.Normal:
+		return 5
+	default:
+		return 6
+	}
+}
+
+// syslogHeaderField replaces the characters other than the printable US-ASCII in the header field, truncates it to the
+// max length, and returns the NILVALUE for the empty field
+func syslogHeaderField(value string, maxLength int) string {
+	field := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, value)
+	if len(field) > maxLength {
+		field = field[:maxLength]
+	}
+	if field == "" {
+		return "-"
+	}
+	return field
+}
@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestSyslogMessage(t *testing.T) {
+	n := externalNotification
+	n.Created = time.Date(2025, 1, 2, 3, 4, 5, 6000000, time.UTC).UnixMilli()
+	n.Category = "health check"
+
+	message := syslogMessage(n, 4, "gateway-1", "support-notifications", "42", time.Now())
+
+	// facility 4 (security) and severity 2 (critical) make the PRI 34
+	assert.Equal(t, "<34>1 2025-01-02T03:04:05.006000Z gateway-1 support-notifications 42 health_check - device-1 is offline", message)
+	assert.True(t, strings.HasPrefix(syslogMessage(models.Notification{Severity: models.Minor}, 1, "", "app", "1", time.Now()), "<14>1 "))
+	assert.Contains(t, syslogMessage(models.Notification{}, 1, "", "app", "1", time.Now()), " - app 1 - - ", "the empty fields should be the NILVALUE")
+}
+
+// readOctetCounted reads a syslog message framed by the octet counting from the connection
+func readOctetCounted(conn net.Conn) (string, error) {
+	reader := bufio.NewReader(conn)
+	length, err := reader.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+	size, err := strconv.Atoi(strings.TrimSpace(length))
+	if err != nil {
+		return "", err
+	}
+	message := make([]byte, size)
+	if _, err = io.ReadFull(reader, message); err != nil {
+		return "", err
+	}
+	return string(message), nil
+}
+
+func TestSyslogSenderSend(t *testing.T) {
+	expectedSuffix := " health-check - " + externalNotification.Content
+
+	t.Run("udp", func(t *testing.T) {
+		listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+		address := restAddressOf(t, listener.LocalAddr(), http.MethodPost)
+		sender := NewSyslogSender(externalChannelDic(), nil)
+
+		_, err = sender.Send(externalNotification, externalAddressOfKind(address, ExternalChannelSyslog))
+		require.NoError(t, err)
+
+		buf := make([]byte, 2048)
+		require.NoError(t, listener.SetReadDeadline(time.Now().Add(WaitDuration)))
+		size, _, err := listener.ReadFrom(buf)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(buf[:size]), "<10>1 "), "the default facility should be user-level")
+		assert.True(t, strings.HasSuffix(string(buf[:size]), expectedSuffix))
+	})
+
+	serverCert, serverKey := selfSignedCert(t)
+	pair, err := tls.X509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(serverCert))
+	secretProvider := &mocks.SecretProviderExt{}
+	secretProvider.On("GetSecret", "syslog-tls").Return(map[string]string{
+		secretKeyClientCert: string(serverCert), secretKeyClientKey: string(serverKey), secretKeyCACert: string(serverCert),
+	}, nil)
+
+	streamTests := []struct {
+		name     string
+		network  string
+		listen   func() (net.Listener, error)
+		facility int
+	}{
+		{"tcp", SyslogNetworkTCP, func() (net.Listener, error) { return net.Listen("tcp", "127.0.0.1:0") }, 16},
+		{"tls", SyslogNetworkTLS, func() (net.Listener, error) {
+			return tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+				Certificates: []tls.Certificate{pair}, ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12,
+			})
+		}, 0},
+	}
+	for _, testCase := range streamTests {
+		t.Run(testCase.name, func(t *testing.T) {
+			listener, err := testCase.listen()
+			require.NoError(t, err)
+			defer listener.Close()
+			received := make(chan string, 1)
+			go func() {
+				conn, acceptErr := listener.Accept()
+				if acceptErr != nil {
+					return
+				}
+				defer conn.Close()
+				message, readErr := readOctetCounted(conn)
+				if readErr != nil {
+					message = readErr.Error()
+				}
+				received <- message
+			}()
+			address := restAddressOf(t, listener.Addr(), http.MethodPost)
+			external := notificationModels.ExternalAddress{RESTAddress: address, Kind: ExternalChannelSyslog, Network: testCase.network, Facility: testCase.facility, SecretName: "syslog-tls"}
+			sender := NewSyslogSender(externalChannelDic(), secretProvider)
+
+			_, err = sender.Send(externalNotification, external)
+			require.NoError(t, err)
+
+			select {
+			case message := <-received:
+				expectedPri := "<10>1 "
+				if testCase.facility > 0 {
+					expectedPri = "<" + strconv.Itoa(testCase.facility*8+2) + ">1 "
+				}
+				assert.True(t, strings.HasPrefix(message, expectedPri))
+				assert.True(t, strings.HasSuffix(message, expectedSuffix))
+			case <-time.After(WaitDuration):
+				t.Fatal("the syslog message is not received")
+			}
+		})
+	}
+
+	t.Run("invalid facility", func(t *testing.T) {
+		address := restAddressOf(t, &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 514}, http.MethodPost)
+		sender := NewSyslogSender(externalChannelDic(), nil)
+		_, err := sender.Send(externalNotification, notificationModels.ExternalAddress{RESTAddress: address, Kind: ExternalChannelSyslog, Facility: 24})
+		assert.Error(t, err)
+	})
+}
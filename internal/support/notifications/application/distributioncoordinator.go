@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -34,9 +34,21 @@ func distribute(dic *di.Container, n models.Notification) errors.EdgeX {
 			lc.Debugf("subscription %s is locked, skip the notification transmission", sub.Name)
 			continue
 		}
+		admitted, dropped, release := throttleNotification(sub.Name, n)
+		if !admitted {
+			lc.Debugf("notification %s is suppressed by the throttle of subscription %s", n.Id, sub.Name)
+			continue
+		}
 		for _, address := range sub.Channels {
-			// Async transmit the notification to improve the performance
-			go transmit(dic, n, sub, address) // nolint:errcheck
+			// Queue the transmission to be sent by the workers, the notification stays NEW if it fails so that it is
+			// distributed again on the next startup
+			err = enqueueTransmission(dic, n, sub, address, dropped)
+			if err != nil {
+				lc.Errorf("fail to queue the notification transmission, err: %v", err)
+				// the notification is distributed again, so the admission must not count towards the throttle
+				release()
+				return errors.NewCommonEdgeXWrapper(err)
+			}
 		}
 	}
 
@@ -48,47 +60,3 @@ func distribute(dic *di.Container, n models.Notification) errors.EdgeX {
 	}
 	return nil
 }
-
-// transmit transmits the notification with specified subscription and address
-func transmit(dic *di.Container, n models.Notification, sub models.Subscription, address models.Address) (models.Transmission, errors.EdgeX) {
-	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
-	dbClient := container.DBClientFrom(dic.Get)
-
-	trans := models.NewTransmission(sub.Name, address, n.Id)
-	trans = firstSend(dic, n, trans)
-	trans, err := dbClient.AddTransmission(trans)
-	if err != nil {
-		lc.Error(err.Message())
-		return trans, errors.NewCommonEdgeXWrapper(err)
-	}
-
-	if n.Status == models.Escalated {
-		// Do not resend if the notification status is Escalated
-		return trans, nil
-	}
-
-	// Resend the critical notification if the transmission is failed.
-	if n.Severity == models.Critical && trans.Status == models.Failed {
-		// Change the transmission status to RESENDING which means this transmission process is resending the notification and should not be removed.
-		trans.Status = models.RESENDING
-		err = dbClient.UpdateTransmission(trans)
-		if err != nil {
-			lc.Error(err.Message())
-			return trans, errors.NewCommonEdgeXWrapper(err)
-		}
-		trans, err = reSend(dic, n, sub, trans)
-		if err != nil {
-			lc.Errorf("fail to handle the critical notification sending for the subscription %s with address %v, err: %v", sub.Name, address.GetBaseAddress(), err)
-			return trans, errors.NewCommonEdgeXWrapper(err)
-		}
-	}
-	// Trigger a escalated notification if the transmission is Escalated
-	if trans.Status == models.Escalated {
-		err = escalatedSend(dic, n, trans)
-		if err != nil {
-			lc.Errorf("fail to handle the escalated notification sending, err: %v", err)
-			return trans, errors.NewCommonEdgeXWrapper(err)
-		}
-	}
-	return trans, nil
-}
@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// externalAddresses keeps the external addresses of the subscriptions in memory, so that the REST channels are resolved
+// without querying the database on every transmission
+var externalAddresses = newExternalAddressCache()
+
+type externalAddressCache struct {
+	mutex     sync.RWMutex
+	addresses map[string][]notificationModels.ExternalAddress
+}
+
+func newExternalAddressCache() *externalAddressCache {
+	return &externalAddressCache{addresses: make(map[string][]notificationModels.ExternalAddress)}
+}
+
+func (c *externalAddressCache) set(subscriptionName string, addresses []notificationModels.ExternalAddress) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.addresses[subscriptionName] = addresses
+}
+
+func (c *externalAddressCache) remove(subscriptionName string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.addresses, subscriptionName)
+}
+
+// externalAddressOf returns the external address of the subscription matching the REST address, which carries the REST
+// address itself along with the settings of the external channel
+func (c *externalAddressCache) externalAddressOf(subscriptionName string, address models.RESTAddress) (notificationModels.ExternalAddress, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	for _, external := range c.addresses[subscriptionName] {
+		if external.Matches(address) {
+			external.RESTAddress = address
+			return external, true
+		}
+	}
+	return notificationModels.ExternalAddress{}, false
+}
+
+// LoadSubscriptionExternalAddresses loads the external addresses of all the subscriptions from the database
+func LoadSubscriptionExternalAddresses(dic *di.Container) errors.EdgeX {
+	all, err := container.DBClientFrom(dic.Get).AllSubscriptionExternalAddresses()
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, s := range all {
+		externalAddresses.set(s.SubscriptionName, s.Addresses)
+	}
+	return nil
+}
+
+// SetSubscriptionExternalAddresses adds or replaces the external addresses of the subscription, each of which must
+// address one of the REST channels of the subscription
+func SetSubscriptionExternalAddresses(ctx context.Context, subscriptionName string, dto dtos.SubscriptionExternalAddresses, dic *di.Container) errors.EdgeX {
+	if subscriptionName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription name is empty", nil)
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	sub, err := dbClient.SubscriptionByName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	dto.SubscriptionName = subscriptionName
+	s := dtos.ToSubscriptionExternalAddressesModel(dto)
+	for i, external := range s.Addresses {
+		for _, other := range s.Addresses[:i] {
+			if other.Matches(external.RESTAddress) {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("duplicate external address %s:%d%s of the subscription %s", external.Host, external.Port, external.Path, subscriptionName), nil)
+			}
+		}
+		if !subscribesRESTAddress(sub, external) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the external address %s:%d%s is not a REST channel of the subscription %s", external.Host, external.Port, external.Path, subscriptionName), nil)
+		}
+	}
+	if _, err = dbClient.SetSubscriptionExternalAddresses(s); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	externalAddresses.set(subscriptionName, s.Addresses)
+
+	lc.Debugf("Subscription external addresses set on DB successfully. Subscription name: %s, Correlation-ID: %s ",
+		subscriptionName, correlation.FromContext(ctx))
+	return nil
+}
+
+func subscribesRESTAddress(sub models.Subscription, external notificationModels.ExternalAddress) bool {
+	for _, address := range sub.Channels {
+		restAddress, ok := address.(models.RESTAddress)
+		if ok && external.Matches(restAddress) {
+			return true
+		}
+	}
+	return false
+}
+
+// SubscriptionExternalAddressesByName queries the external addresses of the subscription by the subscription name
+func SubscriptionExternalAddressesByName(subscriptionName string, dic *di.Container) (dto dtos.SubscriptionExternalAddresses, err errors.EdgeX) {
+	if subscriptionName == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription name is empty", nil)
+	}
+	s, err := container.DBClientFrom(dic.Get).SubscriptionExternalAddressesByName(subscriptionName)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromSubscriptionExternalAddressesModelToDTO(s), nil
+}
+
+// DeleteSubscriptionExternalAddressesByName deletes the external addresses of the subscription, so that all its REST
+// channels receive the notifications as they are
+func DeleteSubscriptionExternalAddressesByName(subscriptionName string, dic *di.Container) errors.EdgeX {
+	if subscriptionName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription name is empty", nil)
+	}
+	err := container.DBClientFrom(dic.Get).DeleteSubscriptionExternalAddressesByName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	externalAddresses.remove(subscriptionName)
+	return nil
+}
@@ -0,0 +1,246 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	contractDTOs "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/cache"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/template"
+)
+
+// notificationTemplateBindingsMutex serializes the template writes, so that the bindings are checked against the
+// bindings stored by the other writes
+var notificationTemplateBindingsMutex sync.Mutex
+
+// LoadNotificationTemplateCache loads all the notification templates from the database into a new NotificationTemplateCache
+func LoadNotificationTemplateCache(dic *di.Container) (cache.NotificationTemplateCache, errors.EdgeX) {
+	templates, err := container.DBClientFrom(dic.Get).AllNotificationTemplates(0, -1)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return cache.NewNotificationTemplateCache(templates), nil
+}
+
+// AddNotificationTemplate adds a new notification template
+func AddNotificationTemplate(ctx context.Context, t notificationModels.NotificationTemplate, dic *di.Container) (string, errors.EdgeX) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	notificationTemplateBindingsMutex.Lock()
+	defer notificationTemplateBindingsMutex.Unlock()
+	if err := checkNotificationTemplateBindings(dbClient, t); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	addedTemplate, err := dbClient.AddNotificationTemplate(t)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if templateCache := container.NotificationTemplateCacheFrom(dic.Get); templateCache != nil {
+		templateCache.SetNotificationTemplate(addedTemplate)
+	}
+
+	lc.Debugf("Notification template created on DB successfully. NotificationTemplate ID: %s, Correlation-ID: %s ",
+		addedTemplate.Id, correlation.FromContext(ctx))
+	return addedTemplate.Id, nil
+}
+
+// NotificationTemplateByName queries the notification template by name
+func NotificationTemplateByName(name string, dic *di.Container) (dto dtos.NotificationTemplate, err errors.EdgeX) {
+	if name == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	t, err := container.DBClientFrom(dic.Get).NotificationTemplateByName(name)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromNotificationTemplateModelToDTO(t), nil
+}
+
+// AllNotificationTemplates queries the notification templates with the specified offset and limit
+func AllNotificationTemplates(offset, limit int, dic *di.Container) (templates []dtos.NotificationTemplate, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	totalCount, err = dbClient.NotificationTemplateTotalCount()
+	if err != nil {
+		return templates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.NotificationTemplate{}, totalCount, err
+	}
+
+	templateModels, err := dbClient.AllNotificationTemplates(offset, limit)
+	if err != nil {
+		return templates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromNotificationTemplateModelsToDTOs(templateModels), totalCount, nil
+}
+
+// PatchNotificationTemplate executes the PATCH operation with the DTO to replace the old data
+func PatchNotificationTemplate(ctx context.Context, dto dtos.UpdateNotificationTemplate, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	t, err := notificationTemplateByDTO(dbClient, dto)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	requests.ReplaceNotificationTemplateModelFieldsWithDTO(&t, dto)
+
+	notificationTemplateBindingsMutex.Lock()
+	defer notificationTemplateBindingsMutex.Unlock()
+	if err = checkNotificationTemplateBindings(dbClient, t); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.UpdateNotificationTemplate(t)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if templateCache := container.NotificationTemplateCacheFrom(dic.Get); templateCache != nil {
+		templateCache.SetNotificationTemplate(t)
+	}
+
+	lc.Debugf("Notification template patched on DB successfully. NotificationTemplate ID: %s, Correlation-ID: %s ",
+		t.Id, correlation.FromContext(ctx))
+	return nil
+}
+
+// checkNotificationTemplateBindings checks that each channel type of a subscription is bound to one template at most,
+// so that the template of a transmission is resolved unambiguously
+func checkNotificationTemplateBindings(dbClient interfaces.DBClient, t notificationModels.NotificationTemplate) errors.EdgeX {
+	bound := make(map[notificationModels.TemplateBinding]string, len(t.Bindings))
+	for _, b := range t.Bindings {
+		if _, exists := bound[b]; exists {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("subscription '%s' with channel type '%s' is bound more than once", b.SubscriptionName, b.ChannelType), nil)
+		}
+		bound[b] = t.Name
+	}
+	if len(bound) == 0 {
+		return nil
+	}
+
+	templates, err := dbClient.AllNotificationTemplates(0, -1)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, other := range templates {
+		if other.Name == t.Name {
+			continue
+		}
+		for _, b := range other.Bindings {
+			if _, exists := bound[b]; exists {
+				return errors.NewCommonEdgeX(errors.KindStatusConflict,
+					fmt.Sprintf("subscription '%s' with channel type '%s' is already bound to notification template '%s'", b.SubscriptionName, b.ChannelType, other.Name), nil)
+			}
+		}
+	}
+	return nil
+}
+
+func notificationTemplateByDTO(dbClient interfaces.DBClient, dto dtos.UpdateNotificationTemplate) (t notificationModels.NotificationTemplate, err errors.EdgeX) {
+	// The ID or Name is required by DTO and the DTO also accepts empty string ID if the Name is provided
+	if dto.Id != nil && *dto.Id != "" {
+		t, err = dbClient.NotificationTemplateById(*dto.Id)
+		if err != nil {
+			return t, errors.NewCommonEdgeXWrapper(err)
+		}
+	} else {
+		t, err = dbClient.NotificationTemplateByName(*dto.Name)
+		if err != nil {
+			return t, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if dto.Name != nil && *dto.Name != t.Name {
+		return t, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("notification template name '%s' not match the existing '%s' ", *dto.Name, t.Name), nil)
+	}
+	return t, nil
+}
+
+// DeleteNotificationTemplateByName deletes the notification template by name
+func DeleteNotificationTemplateByName(name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	err := container.DBClientFrom(dic.Get).DeleteNotificationTemplateByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if templateCache := container.NotificationTemplateCacheFrom(dic.Get); templateCache != nil {
+		templateCache.RemoveNotificationTemplate(name)
+	}
+	return nil
+}
+
+// RenderNotificationTemplate renders the stored or unsaved template of the request with the sample notification, and
+// returns the subject, body and content type as they would be sent
+func RenderNotificationTemplate(req requests.RenderNotificationTemplateRequest, dic *di.Container) (subject string, body string, contentType string, err errors.EdgeX) {
+	var t notificationModels.NotificationTemplate
+	if req.Template != nil {
+		t = dtos.ToNotificationTemplateModel(*req.Template)
+	} else {
+		t, err = container.DBClientFrom(dic.Get).NotificationTemplateByName(req.TemplateName)
+		if err != nil {
+			return "", "", "", errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	n := contractDTOs.ToNotificationModel(req.Notification)
+	subject, body, err = template.Render(t, template.NewData(n, req.SubscriptionName, req.ChannelType))
+	if err != nil {
+		return "", "", "", errors.NewCommonEdgeXWrapper(err)
+	}
+	contentType = n.ContentType
+	if t.ContentType != "" {
+		contentType = t.ContentType
+	}
+	return subject, body, contentType, nil
+}
+
+// renderNotification renders the notification with the template bound to the subscription channel, and returns the
+// notification with the rendered content and the rendered subject. The notification is returned as is if no template
+// is bound or the rendering fails.
+func renderNotification(dic *di.Container, n models.Notification, subscriptionName string, address models.Address) (models.Notification, string) {
+	templateCache := container.NotificationTemplateCacheFrom(dic.Get)
+	if templateCache == nil {
+		return n, ""
+	}
+	channelType := address.GetBaseAddress().Type
+	t, ok := templateCache.TemplateFor(subscriptionName, channelType)
+	if !ok {
+		return n, ""
+	}
+
+	subject, body, err := template.Render(t, template.NewData(n, subscriptionName, channelType))
+	if err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("failed to render the notification %s with template %s, send it without the template, %v", n.Id, t.Name, err)
+		return n, ""
+	}
+	n.Content = body
+	if t.ContentType != "" {
+		n.ContentType = t.ContentType
+	}
+	return n, subject
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	contractDTOs "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/cache"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestSendNotificationViaChannelWithTemplate(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            string
+		expectedContent string
+		expectedType    string
+	}{
+		{"rendered", "{{.Severity}}: {{.Content}} to {{.SubscriptionName}}", "NORMAL: test to TestSubscription", common.ContentTypeText},
+		{"rendering failed, sent as is", "{{.Unknown}}", notification.Content, notification.ContentType},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			rendered := notification
+			rendered.Content = testCase.expectedContent
+			rendered.ContentType = testCase.expectedType
+			restSender := &senderMock.Sender{}
+			restSender.On("Send", rendered, testRestAddress).Return("", nil)
+			templateCache := cache.NewNotificationTemplateCache([]notificationModels.NotificationTemplate{{
+				Name:        "template1",
+				Body:        testCase.body,
+				ContentType: common.ContentTypeText,
+				Bindings:    []notificationModels.TemplateBinding{{SubscriptionName: sub.Name, ChannelType: common.REST}},
+			}})
+			dic := mockDic()
+			dic.Update(di.ServiceConstructorMap{
+				channel.RESTSenderName: func(get di.Get) interface{} {
+					return restSender
+				},
+				container.NotificationTemplateCacheInterfaceName: func(get di.Get) interface{} {
+					return templateCache
+				},
+			})
+
+			record := sendNotificationViaChannel(dic, notification, sub.Name, testRestAddress)
+			assert.EqualValues(t, models.Sent, record.Status)
+			restSender.AssertCalled(t, "Send", rendered, testRestAddress)
+		})
+	}
+}
+
+func TestRenderNotificationTemplate(t *testing.T) {
+	stored := notificationModels.NotificationTemplate{Name: "stored", Subject: "[{{.Severity}}] {{.Category}}", Body: "{{.Content}}"}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationTemplateByName", stored.Name).Return(stored, nil)
+	dbClientMock.On("NotificationTemplateByName", "unknown").Return(notificationModels.NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	n := contractDTOs.FromNotificationModelToDTO(notification)
+
+	subject, body, contentType, err := RenderNotificationTemplate(requests.RenderNotificationTemplateRequest{TemplateName: stored.Name, Notification: n}, dic)
+	require.NoError(t, err)
+	assert.Equal(t, "[NORMAL] health-check", subject)
+	assert.Equal(t, notification.Content, body)
+	assert.Equal(t, notification.ContentType, contentType)
+
+	_, _, _, err = RenderNotificationTemplate(requests.RenderNotificationTemplateRequest{TemplateName: "unknown", Notification: n}, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestCheckNotificationTemplateBindings(t *testing.T) {
+	emailBinding := notificationModels.TemplateBinding{SubscriptionName: sub.Name, ChannelType: common.EMAIL}
+	restBinding := notificationModels.TemplateBinding{SubscriptionName: sub.Name, ChannelType: common.REST}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllNotificationTemplates", 0, -1).Return([]notificationModels.NotificationTemplate{
+		{Name: "email", Bindings: []notificationModels.TemplateBinding{emailBinding}},
+	}, nil)
+
+	tests := []struct {
+		name         string
+		template     notificationModels.NotificationTemplate
+		expectedKind errors.ErrKind
+	}{
+		{"no binding", notificationModels.NotificationTemplate{Name: "new"}, ""},
+		{"unbound channel type", notificationModels.NotificationTemplate{Name: "new", Bindings: []notificationModels.TemplateBinding{restBinding}}, ""},
+		{"channel type bound by itself", notificationModels.NotificationTemplate{Name: "email", Bindings: []notificationModels.TemplateBinding{emailBinding}}, ""},
+		{"channel type bound by another template", notificationModels.NotificationTemplate{Name: "new", Bindings: []notificationModels.TemplateBinding{emailBinding}}, errors.KindStatusConflict},
+		{"channel type bound twice", notificationModels.NotificationTemplate{Name: "new", Bindings: []notificationModels.TemplateBinding{restBinding, restBinding}}, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkNotificationTemplateBindings(dbClientMock, testCase.template)
+			if testCase.expectedKind == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+			}
+		})
+	}
+}
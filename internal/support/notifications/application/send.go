@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -25,55 +25,34 @@ import (
 func firstSend(dic *di.Container, n models.Notification, trans models.Transmission) models.Transmission {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
-	record := sendNotificationViaChannel(dic, n, trans.Channel)
+	record := sendNotificationViaChannel(dic, n, trans.SubscriptionName, trans.Channel)
 	trans.Records = append(trans.Records, record)
 	trans.Status = record.Status
 	lc.Debugf("sent the notification to %s with address %v, transmission status %s", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Status)
 	return trans
 }
 
-// reSend sends the Critical notification and return the transmission
-func reSend(dic *di.Container, n models.Notification, sub models.Subscription, trans models.Transmission) (models.Transmission, errors.EdgeX) {
+// reSend sends the Critical notification again and return the transmission, which is still RESENDING if failed
+func reSend(dic *di.Container, n models.Notification, trans models.Transmission) (models.Transmission, errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
-	config := container.ConfigurationFrom(dic.Get)
 
-	resendLimit, resendInterval, err := resendLimitAndInterval(config, sub)
+	record := sendNotificationViaChannel(dic, n, trans.SubscriptionName, trans.Channel)
+	if record.Status == models.Failed {
+		// fail to transmit the notification, keep resending
+		trans.Status = models.RESENDING
+	} else {
+		trans.Status = record.Status
+	}
+	trans.ResendCount = trans.ResendCount + 1
+	trans.Records = append(trans.Records, record)
+	err := dbClient.UpdateTransmission(trans)
 	if err != nil {
 		return trans, errors.NewCommonEdgeXWrapper(err)
 	}
-	for i := 1; i <= resendLimit; i++ {
-		// Since this sending process is triggered for the critical notification which is failed to send to the subscription at the first time,
-		// so we wait seconds and retry to send the notification again.
-		time.Sleep(resendInterval)
-		lc.Warn("fail to send the critical notification. Retry to send again...")
-
-		record := sendNotificationViaChannel(dic, n, trans.Channel)
-		if record.Status == models.Failed {
-			// fail to transmit the notification, keep resending
-			trans.Status = models.RESENDING
-		} else {
-			trans.Status = record.Status
-		}
-		trans.ResendCount = trans.ResendCount + 1
-		trans.Records = append(trans.Records, record)
-		err = dbClient.UpdateTransmission(trans)
-		if err != nil {
-			return trans, errors.NewCommonEdgeXWrapper(err)
-		}
 
-		if trans.Status == models.RESENDING {
-			continue
-		}
+	if trans.Status != models.RESENDING {
 		lc.Debugf("success to send the critical notification to %s with address %v, transmission Id: %s", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Id)
-		return trans, nil
-	}
-
-	lc.Warn("Resend count exceeds the configurable limit, escalate the transmission.")
-	trans.Status = models.Escalated
-	err = dbClient.UpdateTransmission(trans)
-	if err != nil {
-		return trans, errors.NewCommonEdgeXWrapper(err)
 	}
 	return trans, nil
 }
@@ -112,7 +91,10 @@ func escalatedSend(dic *di.Container, n models.Notification, trans models.Transm
 	}
 
 	for _, address := range sub.Channels {
-		go transmit(dic, escalated, sub, address) // nolint:errcheck
+		err = enqueueTransmission(dic, escalated, sub, address, 0)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
 	}
 	return nil
 }
@@ -126,17 +108,22 @@ func escalatedNotification(n models.Notification, trans models.Transmission) mod
 	return n
 }
 
-// sendNotificationViaChannel sends notification via address and return the transmission record. The record status should be SENT or FAILED.
-func sendNotificationViaChannel(dic *di.Container, n models.Notification, address models.Address) (transRecord models.TransmissionRecord) {
+// sendNotificationViaChannel sends notification via address of the subscription and return the transmission record, the
+// notification is rendered with the template bound to the channel if any. The record status should be SENT or FAILED.
+func sendNotificationViaChannel(dic *di.Container, n models.Notification, subscriptionName string, address models.Address) (transRecord models.TransmissionRecord) {
 	var err errors.EdgeX
+	n, subject := renderNotification(dic, n, subscriptionName, address)
 	transRecord.Status = models.Sent
 	switch address.GetBaseAddress().Type {
 	case common.REST:
-		restSender := channel.RESTSenderFrom(dic.Get)
-		transRecord.Response, err = restSender.Send(n, address)
+		var restSender channel.Sender
+		var restAddress models.Address
+		restSender, restAddress, err = restChannelSender(dic, subscriptionName, address)
+		if err == nil {
+			transRecord.Response, err = sendWithSubject(restSender, n, subject, restAddress)
+		}
 	case common.EMAIL:
-		emailSender := channel.EmailSenderFrom(dic.Get)
-		transRecord.Response, err = emailSender.Send(n, address)
+		transRecord.Response, err = sendWithSubject(channel.EmailSenderFrom(dic.Get), n, subject, address)
 	case common.MQTT:
 		mqttSender := channel.MQTTSenderFrom(dic.Get)
 		transRecord.Response, err = mqttSender.Send(n, address)
@@ -155,3 +142,37 @@ func sendNotificationViaChannel(dic *di.Container, n models.Notification, addres
 	transRecord.Sent = pkgCommon.MakeTimestamp()
 	return transRecord
 }
+
+// restChannelSender returns the sender of the external channel and the external address if the REST address of the
+// subscription is an external address, or the REST sender and the REST address otherwise. The REST sender is returned
+// along with the external address of the REST kind, which carries the secrets securing the requests.
+func restChannelSender(dic *di.Container, subscriptionName string, address models.Address) (channel.Sender, models.Address, errors.EdgeX) {
+	restAddress, ok := address.(models.RESTAddress)
+	if !ok {
+		return channel.RESTSenderFrom(dic.Get), address, nil
+	}
+	external, ok := externalAddresses.externalAddressOf(subscriptionName, restAddress)
+	if !ok {
+		return channel.RESTSenderFrom(dic.Get), address, nil
+	}
+	switch external.Kind {
+	case channel.ExternalChannelSlack, channel.ExternalChannelTeams:
+		return channel.ChatWebhookSenderFrom(dic.Get), external, nil
+	case channel.ExternalChannelSMS:
+		return channel.SMSSenderFrom(dic.Get), external, nil
+	case channel.ExternalChannelSyslog:
+		return channel.SyslogSenderFrom(dic.Get), external, nil
+	case channel.ExternalChannelREST:
+		return channel.RESTSenderFrom(dic.Get), external, nil
+	default:
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported external channel kind: %s", external.Kind), nil)
+	}
+}
+
+// sendWithSubject sends the notification with the subject rendered from the template if the sender carries the subject
+func sendWithSubject(sender channel.Sender, n models.Notification, subject string, address models.Address) (string, errors.EdgeX) {
+	if subjectSender, ok := sender.(channel.SubjectSender); ok && subject != "" {
+		return subjectSender.SendWithSubject(n, subject, address)
+	}
+	return sender.Send(n, address)
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -12,8 +12,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
-	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
@@ -113,7 +113,6 @@ func TestFirstSend(t *testing.T) {
 
 func TestReSend(t *testing.T) {
 	dic := mockDic()
-	config := notificationContainer.ConfigurationFrom(dic.Get)
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
 	dic.Update(di.ServiceConstructorMap{
@@ -138,31 +137,90 @@ func TestReSend(t *testing.T) {
 	})
 
 	tests := []struct {
-		name          string
-		address       models.Address
-		expectedError bool
+		name           string
+		address        models.Address
+		expectedStatus models.TransmissionStatus
 	}{
-		{"sent rest address successful", testRestAddress, false},
-		{"sent email address successful", testEmailAddress, false},
-		{"sent rest failed", testRestAddress2, true},
-		{"sent email failed", testEmailAddress2, true},
+		{"sent rest address successful", testRestAddress, models.Sent},
+		{"sent email address successful", testEmailAddress, models.Sent},
+		{"sent rest failed", testRestAddress2, models.RESENDING},
+		{"sent email failed", testEmailAddress2, models.RESENDING},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
 			sub.Channels = []models.Address{testCase.address}
 			trans := models.NewTransmission(sub.Name, testCase.address, notification.Id)
 
-			trans, err := reSend(dic, notification, sub, trans)
+			trans, err := reSend(dic, notification, trans)
 			require.NoError(t, err)
 
-			if testCase.expectedError {
-				assert.EqualValues(t, models.Escalated, trans.Status)
-				assert.Equal(t, config.Writable.ResendLimit, trans.ResendCount)
-				assert.Equal(t, config.Writable.ResendLimit, len(trans.Records))
-			} else {
-				assert.EqualValues(t, models.Sent, trans.Status)
-				assert.Equal(t, 1, trans.ResendCount)
-				assert.Equal(t, 1, len(trans.Records))
+			assert.EqualValues(t, testCase.expectedStatus, trans.Status)
+			assert.Equal(t, 1, trans.ResendCount)
+			assert.Equal(t, 1, len(trans.Records))
+		})
+	}
+}
+
+func TestSendNotificationViaExternalChannel(t *testing.T) {
+	chatAddress := models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: "hooks.example.com", Port: 443}, Path: "/chat", HTTPMethod: http.MethodPost}
+	syslogAddress := models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: "syslog.example.com", Port: 514}, HTTPMethod: http.MethodPost}
+	unknownAddress := models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: "unknown.example.com", Port: 443}, HTTPMethod: http.MethodPost}
+	securedAddress := models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: "tickets.example.com", Port: 443}, Path: "/tickets", HTTPMethod: http.MethodPost}
+	otherPathAddress := chatAddress
+	otherPathAddress.Path = "/other"
+	chatExternal := notificationModels.ExternalAddress{RESTAddress: chatAddress, Kind: channel.ExternalChannelTeams}
+	syslogExternal := notificationModels.ExternalAddress{RESTAddress: syslogAddress, Kind: channel.ExternalChannelSyslog}
+	securedExternal := notificationModels.ExternalAddress{RESTAddress: securedAddress, Kind: channel.ExternalChannelREST, SigningSecretName: "signing", TLSSecretName: "tls"}
+	externalAddresses.set(sub.Name, []notificationModels.ExternalAddress{
+		// the external addresses are matched by the host, port and path only
+		{RESTAddress: models.RESTAddress{BaseAddress: models.BaseAddress{Host: chatAddress.Host, Port: chatAddress.Port}, Path: chatAddress.Path}, Kind: chatExternal.Kind},
+		syslogExternal,
+		securedExternal,
+		{RESTAddress: unknownAddress, Kind: "Pager"},
+	})
+	defer externalAddresses.remove(sub.Name)
+	dic := mockDic()
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", notification, testRestAddress).Return("", nil)
+	restSender.On("Send", notification, otherPathAddress).Return("", nil)
+	restSender.On("Send", notification, securedExternal).Return("", nil)
+	chatSender := &senderMock.Sender{}
+	chatSender.On("Send", notification, chatExternal).Return("", nil)
+	syslogSender := &senderMock.Sender{}
+	syslogSender.On("Send", notification, syslogExternal).Return("", nil)
+	dic.Update(di.ServiceConstructorMap{
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+		channel.ChatWebhookSenderName: func(get di.Get) interface{} {
+			return chatSender
+		},
+		channel.SyslogSenderName: func(get di.Get) interface{} {
+			return syslogSender
+		},
+	})
+
+	tests := []struct {
+		name            string
+		address         models.Address
+		expectedSender  *senderMock.Sender
+		expectedAddress models.Address
+		expectedStatus  models.TransmissionStatus
+	}{
+		{"REST receiver", testRestAddress, restSender, testRestAddress, models.Sent},
+		{"chat webhook", chatAddress, chatSender, chatExternal, models.Sent},
+		{"syslog", syslogAddress, syslogSender, syslogExternal, models.Sent},
+		{"other path", otherPathAddress, restSender, otherPathAddress, models.Sent},
+		{"secured REST receiver", securedAddress, restSender, securedExternal, models.Sent},
+		{"unsupported kind", unknownAddress, nil, nil, models.Failed},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			record := sendNotificationViaChannel(dic, notification, sub.Name, testCase.address)
+
+			assert.Equal(t, testCase.expectedStatus, record.Status)
+			if testCase.expectedSender != nil {
+				testCase.expectedSender.AssertCalled(t, "Send", notification, testCase.expectedAddress)
 			}
 		})
 	}
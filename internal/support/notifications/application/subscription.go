@@ -180,6 +180,17 @@ func DeleteSubscriptionByName(name string, ctx context.Context, dic *di.Containe
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	// the throttle belongs to the subscription, and a subscription created later with the same name starts afresh
+	err = dbClient.DeleteSubscriptionThrottleByName(name)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("fail to delete the throttle of the deleted subscription %s, err: %v", name, err)
+	}
+	throttler.remove(name)
+	err = dbClient.DeleteSubscriptionExternalAddressesByName(name)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("fail to delete the external addresses of the deleted subscription %s, err: %v", name, err)
+	}
+	externalAddresses.remove(name)
 	return nil
 }
 
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// LoadSubscriptionThrottles loads the throttles of all the subscriptions from the database into the throttler
+func LoadSubscriptionThrottles(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	throttles, err := container.DBClientFrom(dic.Get).AllSubscriptionThrottles()
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, throttle := range throttles {
+		s, err := throttleSettingsFrom(throttle)
+		if err != nil {
+			lc.Errorf("invalid throttle of the subscription %s, transmit the notifications without throttling, %v", throttle.SubscriptionName, err)
+			continue
+		}
+		throttler.set(throttle.SubscriptionName, s)
+	}
+	return nil
+}
+
+// SetSubscriptionThrottle adds or replaces the throttle of the subscription
+func SetSubscriptionThrottle(ctx context.Context, subscriptionName string, dto dtos.SubscriptionThrottle, dic *di.Container) errors.EdgeX {
+	if subscriptionName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription name is empty", nil)
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	if _, err := dbClient.SubscriptionByName(subscriptionName); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	dto.SubscriptionName = subscriptionName
+	throttle := dtos.ToSubscriptionThrottleModel(dto)
+	s, err := throttleSettingsFrom(throttle)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if _, err = dbClient.SetSubscriptionThrottle(throttle); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	throttler.set(subscriptionName, s)
+
+	lc.Debugf("Subscription throttle set on DB successfully. Subscription name: %s, Correlation-ID: %s ",
+		subscriptionName, correlation.FromContext(ctx))
+	return nil
+}
+
+// SubscriptionThrottleByName queries the throttle of the subscription by the subscription name
+func SubscriptionThrottleByName(subscriptionName string, dic *di.Container) (dto dtos.SubscriptionThrottle, err errors.EdgeX) {
+	if subscriptionName == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription name is empty", nil)
+	}
+	throttle, err := container.DBClientFrom(dic.Get).SubscriptionThrottleByName(subscriptionName)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromSubscriptionThrottleModelToDTO(throttle), nil
+}
+
+// DeleteSubscriptionThrottleByName deletes the throttle of the subscription, so that its notifications are no longer
+// throttled
+func DeleteSubscriptionThrottleByName(subscriptionName string, dic *di.Container) errors.EdgeX {
+	if subscriptionName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription name is empty", nil)
+	}
+	err := container.DBClientFrom(dic.Get).DeleteSubscriptionThrottleByName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	throttler.remove(subscriptionName)
+	return nil
+}
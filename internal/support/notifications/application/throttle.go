@@ -0,0 +1,330 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// DigestContentNotice is the prefix of the digest notification content
+const DigestContentNotice = "This notification summarizes the suppressed notifications"
+
+// digestFlushInterval is how often the digests are checked for the due ones
+const digestFlushInterval = time.Second
+
+var (
+	asyncFlushNotificationDigestsOnce sync.Once
+	// throttler keeps the throttles of the subscriptions and their state in memory, so the pending digests are lost on
+	// restart while the suppressed notifications themselves stay in the database
+	throttler = newNotificationThrottler()
+)
+
+// throttleSettings are the parsed settings of the SubscriptionThrottle
+type throttleSettings struct {
+	rateLimit      int
+	rateInterval   time.Duration
+	dedupWindow    time.Duration
+	digest         bool
+	digestInterval time.Duration
+}
+
+func throttleSettingsFrom(throttle notificationModels.SubscriptionThrottle) (s throttleSettings, err errors.EdgeX) {
+	s.rateLimit, s.digest = throttle.RateLimit, throttle.Digest
+	for _, field := range []struct {
+		name  string
+		value string
+		out   *time.Duration
+	}{
+		{"RateInterval", throttle.RateInterval, &s.rateInterval},
+		{"DedupWindow", throttle.DedupWindow, &s.dedupWindow},
+		{"DigestInterval", throttle.DigestInterval, &s.digestInterval},
+	} {
+		if field.value == "" {
+			continue
+		}
+		duration, parseErr := time.ParseDuration(field.value)
+		if parseErr != nil {
+			return s, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse %s '%s' of the subscription %s throttle", field.name, field.value, throttle.SubscriptionName), parseErr)
+		}
+		*field.out = duration
+	}
+	if s.rateLimit > 0 && s.rateInterval <= 0 {
+		return s, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("RateInterval of the subscription %s throttle is required by the RateLimit", throttle.SubscriptionName), nil)
+	}
+	if s.digest && s.digestInterval <= 0 {
+		return s, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DigestInterval of the subscription %s throttle is required by the Digest", throttle.SubscriptionName), nil)
+	}
+	return s, nil
+}
+
+// digestEntry counts the identical notifications suppressed into a digest
+type digestEntry struct {
+	notification models.Notification
+	count        int
+}
+
+// notificationDigest is the batch of the notifications suppressed for a subscription within a digest interval
+type notificationDigest struct {
+	entries []*digestEntry
+	// index locates the entry of the identical notifications by the dedup key
+	index map[string]*digestEntry
+	total int
+	dueAt int64
+}
+
+// subscriptionThrottleState is the throttle state of a subscription
+type subscriptionThrottleState struct {
+	// transmitted are the timestamps of the notifications transmitted within the rate interval
+	transmitted []int64
+	// lastTransmitted is the timestamp of the last transmitted notification by the dedup key
+	lastTransmitted map[string]int64
+	// dropped is the number of the notifications dropped since the last transmitted notification
+	dropped int
+	digest  *notificationDigest
+}
+
+type notificationThrottler struct {
+	mutex    sync.Mutex
+	settings map[string]throttleSettings
+	states   map[string]*subscriptionThrottleState
+}
+
+func newNotificationThrottler() *notificationThrottler {
+	return &notificationThrottler{
+		settings: make(map[string]throttleSettings),
+		states:   make(map[string]*subscriptionThrottleState),
+	}
+}
+
+// set throttles the subscription with the settings, the state of the subscription is kept
+func (t *notificationThrottler) set(subscriptionName string, s throttleSettings) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.settings[subscriptionName] = s
+}
+
+// remove stops throttling the subscription and discards its state, including the pending digest
+func (t *notificationThrottler) remove(subscriptionName string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.settings, subscriptionName)
+	delete(t.states, subscriptionName)
+}
+
+// admit decides whether the notification is transmitted to the subscription at the timestamp now. The suppressed
+// notification is either dropped or added to the digest, and the admitted notification reports the number of the
+// notifications dropped before it. The admission counts towards the throttle once admitted, and must be released if
+// the transmissions of the notification cannot be queued, so that only the queued notifications are counted.
+func (t *notificationThrottler) admit(subscriptionName string, n models.Notification, now int64) (admitted bool, dropped int, release func()) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	noop := func() {}
+	s, ok := t.settings[subscriptionName]
+	if !ok {
+		return true, 0, noop
+	}
+	state, ok := t.states[subscriptionName]
+	if !ok {
+		state = &subscriptionThrottleState{lastTransmitted: make(map[string]int64)}
+		t.states[subscriptionName] = state
+	}
+	state.transmitted = slices.DeleteFunc(state.transmitted, func(sent int64) bool {
+		return now-sent >= s.rateInterval.Milliseconds()
+	})
+	for key, sent := range state.lastTransmitted {
+		if now-sent >= s.dedupWindow.Milliseconds() {
+			delete(state.lastTransmitted, key)
+		}
+	}
+
+	key := dedupKey(n)
+	_, duplicated := state.lastTransmitted[key]
+	limited := s.rateLimit > 0 && len(state.transmitted) >= s.rateLimit
+	if duplicated || limited {
+		if !s.digest {
+			state.dropped++
+			return false, 0, noop
+		}
+		if state.digest == nil {
+			state.digest = &notificationDigest{index: make(map[string]*digestEntry), dueAt: now + s.digestInterval.Milliseconds()}
+		}
+		entry, ok := state.digest.index[key]
+		if !ok {
+			entry = &digestEntry{notification: n}
+			state.digest.index[key] = entry
+			state.digest.entries = append(state.digest.entries, entry)
+		}
+		// the identical notifications may differ in severity, and the digest reports the highest one
+		if severityRank(n.Severity) > severityRank(entry.notification.Severity) {
+			entry.notification.Severity = n.Severity
+		}
+		entry.count++
+		state.digest.total++
+		return false, 0, noop
+	}
+
+	if s.rateLimit > 0 {
+		state.transmitted = append(state.transmitted, now)
+	}
+	if s.dedupWindow > 0 {
+		state.lastTransmitted[key] = now
+	}
+	dropped, state.dropped = state.dropped, 0
+	return true, dropped, func() {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		// the state is discarded if the subscription is no longer throttled
+		if t.states[subscriptionName] != state {
+			return
+		}
+		if i := slices.Index(state.transmitted, now); i >= 0 {
+			state.transmitted = slices.Delete(state.transmitted, i, i+1)
+		}
+		if sent, ok := state.lastTransmitted[key]; ok && sent == now {
+			delete(state.lastTransmitted, key)
+		}
+		state.dropped += dropped
+	}
+}
+
+// dueDigests removes and returns the digests due at the timestamp now by the subscription name
+func (t *notificationThrottler) dueDigests(now int64) map[string]*notificationDigest {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	due := make(map[string]*notificationDigest)
+	for name, state := range t.states {
+		if state.digest != nil && now >= state.digest.dueAt {
+			due[name] = state.digest
+			state.digest = nil
+		}
+	}
+	return due
+}
+
+// dedupKey identifies the identical notifications by the category, labels and content
+func dedupKey(n models.Notification) string {
+	labels := slices.Clone(n.Labels)
+	slices.Sort(labels)
+	hash := sha256.Sum256([]byte(n.Category + "\x00" + strings.Join(labels, "\x00") + "\x00" + n.Content))
+	return hex.EncodeToString(hash[:])
+}
+
+// throttleNotification decides whether the notification is transmitted to the subscription now, see admit
+func throttleNotification(subscriptionName string, n models.Notification) (admitted bool, dropped int, release func()) {
+	return throttler.admit(subscriptionName, n, pkgCommon.MakeTimestamp())
+}
+
+// AsyncFlushNotificationDigests transmits the digests of the suppressed notifications when they are due, until the
+// context is done
+func AsyncFlushNotificationDigests(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	asyncFlushNotificationDigestsOnce.Do(func() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ticker := time.NewTicker(digestFlushInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					bootstrapContainer.LoggingClientFrom(dic.Get).Info("Exiting notification digest flushing")
+					return
+				case <-ticker.C:
+					flushNotificationDigests(dic, pkgCommon.MakeTimestamp())
+				}
+			}
+		}()
+	})
+}
+
+func flushNotificationDigests(dic *di.Container, now int64) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	for subscriptionName, digest := range throttler.dueDigests(now) {
+		if err := sendNotificationDigest(dic, subscriptionName, digest); err != nil {
+			lc.Errorf("fail to send the digest of %d suppressed notifications to the subscription %s, err: %v", digest.total, subscriptionName, err)
+		}
+	}
+}
+
+// sendNotificationDigest stores the digest notification and queues its transmissions to the subscription channels
+func sendNotificationDigest(dic *di.Container, subscriptionName string, digest *notificationDigest) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	sub, err := dbClient.SubscriptionByName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if sub.AdminState == models.Locked {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("subscription %s is locked, skip the notification digest transmission", sub.Name)
+		return nil
+	}
+
+	n, err := dbClient.AddNotification(digestNotification(digest))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "fail to create the digest notification", err)
+	}
+	for _, address := range sub.Channels {
+		err = enqueueTransmission(dic, n, sub, address, digest.total)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// digestNotification summarizes the suppressed notifications, it has the category and labels of the first suppressed
+// notification and the highest severity among them. The digest is PROCESSED since it is sent to the subscription only.
+func digestNotification(digest *notificationDigest) models.Notification {
+	first := digest.entries[0].notification
+	n := models.Notification{
+		Category:    first.Category,
+		Labels:      first.Labels,
+		Sender:      common.SupportNotificationsServiceKey,
+		Severity:    models.Minor,
+		ContentType: common.ContentTypeText,
+		Description: fmt.Sprintf("digest of %d suppressed notifications", digest.total),
+		Status:      models.Processed,
+	}
+	var content strings.Builder
+	content.WriteString(DigestContentNotice)
+	for _, entry := range digest.entries {
+		if severityRank(entry.notification.Severity) > severityRank(n.Severity) {
+			n.Severity = entry.notification.Severity
+		}
+		fmt.Fprintf(&content, "\n%d x [%s] %s: %s", entry.count, entry.notification.Severity, entry.notification.Category, entry.notification.Content)
+	}
+	n.Content = content.String()
+	return n
+}
+
+func severityRank(severity models.NotificationSeverity) int {
+	switch severity {
+	case models.Critical:
+		return 2
+	case models.Normal:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,206 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestNotificationThrottlerAdmit(t *testing.T) {
+	other := notification
+	other.Content = "other"
+	relabeled := notification
+	relabeled.Labels = []string{"label2", "label1"}
+	reordered := notification
+	reordered.Labels = []string{"label1", "label2"}
+
+	type attempt struct {
+		n                models.Notification
+		at               int64
+		expectedAdmitted bool
+		expectedDropped  int
+	}
+	tests := []struct {
+		name     string
+		settings throttleSettings
+		attempts []attempt
+	}{
+		{"dedup window", throttleSettings{dedupWindow: time.Second}, []attempt{
+			{notification, 0, true, 0},
+			{notification, 500, false, 0},
+			{other, 600, true, 1},
+			{notification, 999, false, 0},
+			{notification, 1000, true, 1},
+		}},
+		{"dedup ignores the label order", throttleSettings{dedupWindow: time.Second}, []attempt{
+			{relabeled, 0, true, 0},
+			{reordered, 100, false, 0},
+		}},
+		{"rate limit", throttleSettings{rateLimit: 2, rateInterval: time.Second}, []attempt{
+			{notification, 0, true, 0},
+			{notification, 100, true, 0},
+			{other, 200, false, 0},
+			{other, 1000, true, 1},
+			{other, 1050, false, 0},
+			{other, 1100, true, 1},
+		}},
+		{"digest", throttleSettings{dedupWindow: time.Second, digest: true, digestInterval: time.Minute}, []attempt{
+			{notification, 0, true, 0},
+			{notification, 100, false, 0},
+			{notification, 1000, true, 0},
+		}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			throttler := newNotificationThrottler()
+			throttler.set(sub.Name, testCase.settings)
+			for i, a := range testCase.attempts {
+				admitted, dropped, _ := throttler.admit(sub.Name, a.n, a.at)
+				assert.Equal(t, a.expectedAdmitted, admitted, "attempt %d", i)
+				assert.Equal(t, a.expectedDropped, dropped, "attempt %d", i)
+			}
+		})
+	}
+}
+
+func TestNotificationThrottlerDueDigests(t *testing.T) {
+	critical := notification
+	critical.Severity = models.Critical
+	critical.Content = "critical"
+	settings := throttleSettings{rateLimit: 1, rateInterval: time.Minute, digest: true, digestInterval: time.Second}
+	throttler := newNotificationThrottler()
+	throttler.set(sub.Name, settings)
+
+	admitted, _, _ := throttler.admit(sub.Name, notification, 0)
+	require.True(t, admitted)
+	for _, n := range []models.Notification{notification, notification, critical} {
+		admitted, _, _ = throttler.admit(sub.Name, n, 100)
+		require.False(t, admitted)
+	}
+
+	assert.Empty(t, throttler.dueDigests(1099))
+	due := throttler.dueDigests(1100)
+	require.Contains(t, due, sub.Name)
+	assert.Equal(t, 3, due[sub.Name].total)
+	assert.Empty(t, throttler.dueDigests(2000), "the flushed digest should be removed")
+
+	digest := digestNotification(due[sub.Name])
+	assert.Equal(t, models.NotificationSeverity(models.Critical), digest.Severity)
+	assert.EqualValues(t, models.Processed, digest.Status)
+	assert.Equal(t, notification.Category, digest.Category)
+	assert.True(t, strings.HasPrefix(digest.Content, DigestContentNotice))
+	assert.Contains(t, digest.Content, "2 x [NORMAL] health-check: test")
+	assert.Contains(t, digest.Content, "1 x [CRITICAL] health-check: critical")
+}
+
+func TestNotificationThrottlerRelease(t *testing.T) {
+	other := notification
+	other.Content = "other"
+	throttler := newNotificationThrottler()
+	throttler.set(sub.Name, throttleSettings{rateLimit: 1, rateInterval: time.Second, dedupWindow: time.Second})
+
+	admitted, _, _ := throttler.admit(sub.Name, notification, 0)
+	require.True(t, admitted)
+	admitted, _, _ = throttler.admit(sub.Name, other, 100)
+	require.False(t, admitted)
+	admitted, dropped, release := throttler.admit(sub.Name, other, 1000)
+	require.True(t, admitted)
+	require.Equal(t, 1, dropped)
+
+	release()
+	admitted, dropped, _ = throttler.admit(sub.Name, other, 1100)
+	assert.True(t, admitted, "the released admission should not count towards the throttle")
+	assert.Equal(t, 1, dropped, "the dropped notifications should be reported again after the release")
+}
+
+func TestNotificationThrottlerRemove(t *testing.T) {
+	throttler := newNotificationThrottler()
+	throttler.set(sub.Name, throttleSettings{dedupWindow: time.Minute, digest: true, digestInterval: time.Second})
+	throttler.admit(sub.Name, notification, 0)
+	throttler.admit(sub.Name, notification, 100)
+
+	throttler.remove(sub.Name)
+	assert.NotContains(t, throttler.settings, sub.Name)
+	assert.NotContains(t, throttler.states, sub.Name)
+	assert.Empty(t, throttler.dueDigests(2000), "the pending digest of the removed subscription should be discarded")
+	admitted, _, _ := throttler.admit(sub.Name, notification, 200)
+	assert.True(t, admitted, "the removed subscription should not be throttled")
+}
+
+func TestThrottleSettingsFrom(t *testing.T) {
+	tests := []struct {
+		name          string
+		throttle      notificationModels.SubscriptionThrottle
+		errorExpected bool
+	}{
+		{"valid", notificationModels.SubscriptionThrottle{RateLimit: 10, RateInterval: "1m", DedupWindow: "5m", Digest: true, DigestInterval: "15m"}, false},
+		{"valid - dedup only", notificationModels.SubscriptionThrottle{DedupWindow: "5m"}, false},
+		{"invalid - rate limit without interval", notificationModels.SubscriptionThrottle{RateLimit: 10}, true},
+		{"invalid - digest without interval", notificationModels.SubscriptionThrottle{DedupWindow: "5m", Digest: true}, true},
+		{"invalid - malformed duration", notificationModels.SubscriptionThrottle{DedupWindow: "5"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.throttle.SubscriptionName = sub.Name
+			_, err := throttleSettingsFrom(testCase.throttle)
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFlushNotificationDigests(t *testing.T) {
+	original := throttler
+	defer func() { throttler = original }()
+	throttler = newNotificationThrottler()
+
+	digestSub := sub
+	digestSub.Channels = []models.Address{testRestAddress, testEmailAddress}
+	digestId := "7f6c1a4e-3b2d-4c5e-8f9a-0b1c2d3e4f5a"
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", sub.Name).Return(digestSub, nil)
+	dbClientMock.On("AddNotification", mock.Anything).Return(func(n models.Notification) models.Notification {
+		n.Id = digestId
+		return n
+	}, nil)
+	dbClientMock.On("AddTransmissionJob", mock.Anything).Return(notificationModels.TransmissionJob{}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	throttler.set(sub.Name, throttleSettings{dedupWindow: time.Minute, digest: true, digestInterval: time.Second})
+	throttler.admit(sub.Name, notification, 0)
+	throttler.admit(sub.Name, notification, 100)
+	throttler.admit(sub.Name, notification, 200)
+
+	flushNotificationDigests(dic, 1100)
+
+	dbClientMock.AssertCalled(t, "AddNotification", mock.MatchedBy(func(n models.Notification) bool {
+		return n.Status == models.Processed && strings.HasPrefix(n.Content, DigestContentNotice)
+	}))
+	dbClientMock.AssertNumberOfCalls(t, "AddTransmissionJob", len(digestSub.Channels))
+	dbClientMock.AssertCalled(t, "AddTransmissionJob", mock.MatchedBy(func(job notificationModels.TransmissionJob) bool {
+		return job.Transmission.NotificationId == digestId && job.Suppressed == 2
+	}))
+}
@@ -8,10 +8,12 @@ package application
 import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
-	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/google/uuid"
 )
@@ -30,7 +32,11 @@ func TransmissionById(id string, dic *di.Container) (trans dtos.Transmission, ed
 	if edgeXerr != nil {
 		return trans, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	trans = dtos.FromTransmissionModelToDTO(transModel)
+	suppressed, edgeXerr := dbClient.TransmissionSuppressedCounts([]string{transModel.Id})
+	if edgeXerr != nil {
+		return trans, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	trans = dtos.FromTransmissionModelToDTO(transModel, suppressed[transModel.Id])
 	return trans, nil
 }
 
@@ -47,15 +53,12 @@ func TransmissionsByTimeRange(start int64, end int64, offset int, limit int, dic
 		return []dtos.Transmission{}, totalCount, err
 	}
 
-	models, err := dbClient.TransmissionsByTimeRange(start, end, offset, limit)
+	transModels, err := dbClient.TransmissionsByTimeRange(start, end, offset, limit)
 	if err != nil {
 		return transmissions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	transmissions = make([]dtos.Transmission, len(models))
-	for i, trans := range models {
-		transmissions[i] = dtos.FromTransmissionModelToDTO(trans)
-	}
-	return transmissions, totalCount, nil
+	transmissions, err = transmissionDTOsFrom(dbClient, transModels)
+	return transmissions, totalCount, err
 }
 
 // AllTransmissions queries transmissions by offset and limit
@@ -71,15 +74,12 @@ func AllTransmissions(offset, limit int, dic *di.Container) (transmissions []dto
 		return []dtos.Transmission{}, totalCount, err
 	}
 
-	models, err := dbClient.AllTransmissions(offset, limit)
+	transModels, err := dbClient.AllTransmissions(offset, limit)
 	if err != nil {
 		return transmissions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	transmissions = make([]dtos.Transmission, len(models))
-	for i, trans := range models {
-		transmissions[i] = dtos.FromTransmissionModelToDTO(trans)
-	}
-	return transmissions, totalCount, nil
+	transmissions, err = transmissionDTOsFrom(dbClient, transModels)
+	return transmissions, totalCount, err
 }
 
 // TransmissionsByStatus queries transmissions with offset, limit, and status
@@ -102,7 +102,8 @@ func TransmissionsByStatus(offset, limit int, status string, dic *di.Container)
 	if err != nil {
 		return transmissions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	return dtos.FromTransmissionModelsToDTOs(transModels), totalCount, nil
+	transmissions, err = transmissionDTOsFrom(dbClient, transModels)
+	return transmissions, totalCount, err
 }
 
 // DeleteProcessedTransmissionsByAge invokes the infrastructure layer function to remove the processed transmissions that are older than age.
@@ -137,7 +138,8 @@ func TransmissionsBySubscriptionName(offset, limit int, subscriptionName string,
 	if err != nil {
 		return transmissions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	return dtos.FromTransmissionModelsToDTOs(transModels), totalCount, nil
+	transmissions, err = transmissionDTOsFrom(dbClient, transModels)
+	return transmissions, totalCount, err
 }
 
 // TransmissionsByNotificationId queries transmissions with offset, limit, and notification id
@@ -159,7 +161,23 @@ func TransmissionsByNotificationId(offset, limit int, notificationId string, dic
 	transModels, err := dbClient.TransmissionsByNotificationId(offset, limit, notificationId)
 	if err != nil {
 		return transmissions, totalCount, errors.NewCommonEdgeXWrapper(err)
-	} else {
-		return dtos.FromTransmissionModelsToDTOs(transModels), totalCount, nil
 	}
+	transmissions, err = transmissionDTOsFrom(dbClient, transModels)
+	return transmissions, totalCount, err
+}
+
+// transmissionDTOsFrom transforms the transmission models to the DTOs reporting the suppressed notifications
+func transmissionDTOsFrom(dbClient interfaces.DBClient, transmissions []models.Transmission) ([]dtos.Transmission, errors.EdgeX) {
+	if len(transmissions) == 0 {
+		return []dtos.Transmission{}, nil
+	}
+	ids := make([]string, len(transmissions))
+	for i, trans := range transmissions {
+		ids[i] = trans.Id
+	}
+	suppressed, err := dbClient.TransmissionSuppressedCounts(ids)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromTransmissionModelsToDTOs(transmissions, suppressed), nil
 }
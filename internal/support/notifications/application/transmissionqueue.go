@@ -0,0 +1,341 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/google/uuid"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+var (
+	asyncProcessTransmissionJobsOnce sync.Once
+	// transmissionJobWakeUp signals the dispatcher to dispatch the due transmission jobs without waiting for the poll interval
+	transmissionJobWakeUp = make(chan struct{}, 1)
+	// transmissionJobNamespace is the namespace of the name-based transmission job ids
+	transmissionJobNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("edgex-support-notifications/transmission-jobs"))
+)
+
+// transmissionQueueSettings are the parsed settings of the TransmissionQueue configuration
+type transmissionQueueSettings struct {
+	workers           int
+	queueSize         int
+	pollInterval      time.Duration
+	maxResendInterval time.Duration
+}
+
+func transmissionQueueSettingsFrom(dic *di.Container) (s transmissionQueueSettings, err errors.EdgeX) {
+	config := container.ConfigurationFrom(dic.Get).TransmissionQueue
+	if config.Workers <= 0 || config.QueueSize <= 0 {
+		return s, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("TransmissionQueue.Workers %d and TransmissionQueue.QueueSize %d must be greater than zero", config.Workers, config.QueueSize), nil)
+	}
+	s.workers, s.queueSize = config.Workers, config.QueueSize
+	for _, field := range []struct {
+		name  string
+		value string
+		out   *time.Duration
+	}{
+		{"PollInterval", config.PollInterval, &s.pollInterval},
+		{"MaxResendInterval", config.MaxResendInterval, &s.maxResendInterval},
+	} {
+		duration, parseErr := time.ParseDuration(field.value)
+		if parseErr != nil {
+			return s, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse TransmissionQueue.%s '%s'", field.name, field.value), parseErr)
+		}
+		if duration <= 0 {
+			return s, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("TransmissionQueue.%s '%s' must be greater than zero", field.name, field.value), nil)
+		}
+		*field.out = duration
+	}
+	return s, nil
+}
+
+// enqueueTransmission persists the job sending the notification to the address of the subscription, the job is picked
+// up by the workers immediately. The suppressed notifications reported by the transmission are counted by suppressed.
+// The notification is queued only once for the same address of the subscription until the job is finished.
+func enqueueTransmission(dic *di.Container, n models.Notification, sub models.Subscription, address models.Address, suppressed int) errors.EdgeX {
+	job := notificationModels.TransmissionJob{
+		Id:            transmissionJobId(n.Id, sub.Name, address),
+		Transmission:  models.NewTransmission(sub.Name, address, n.Id),
+		NextAttemptAt: pkgCommon.MakeTimestamp(),
+		Suppressed:    suppressed,
+	}
+	if _, err := container.DBClientFrom(dic.Get).AddTransmissionJob(job); err != nil {
+		if errors.Kind(err) == errors.KindDuplicateName {
+			bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("The transmission of notification %s for the subscription %s is already queued", n.Id, sub.Name)
+			return nil
+		}
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to queue the transmission of notification %s for the subscription %s", n.Id, sub.Name), err)
+	}
+	wakeTransmissionQueue()
+	return nil
+}
+
+// transmissionJobId returns the id of the job sending the notification to the address of the subscription, which is
+// derived from them so that queueing the same transmission again is rejected as a duplicate
+func transmissionJobId(notificationId, subscriptionName string, address models.Address) string {
+	// the address is a struct of strings and maps, whose JSON encoding is deterministic and cannot fail
+	channel, _ := json.Marshal(address)
+	return uuid.NewSHA1(transmissionJobNamespace, []byte(notificationId+"|"+subscriptionName+"|"+string(channel))).String()
+}
+
+// wakeTransmissionQueue signals the dispatcher to dispatch the due transmission jobs
+func wakeTransmissionQueue() {
+	select {
+	case transmissionJobWakeUp <- struct{}{}:
+	default:
+		// a wake-up is already signaled
+	}
+}
+
+// AsyncProcessTransmissionJobs recovers the transmissions interrupted by the last shutdown, then starts the workers
+// sending the queued transmissions and the dispatcher feeding them the due jobs, until the context is done
+func AsyncProcessTransmissionJobs(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) errors.EdgeX {
+	s, err := transmissionQueueSettingsFrom(dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	asyncProcessTransmissionJobsOnce.Do(func() {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		recoverTransmissions(dic)
+
+		jobs := make(chan notificationModels.TransmissionJob, s.queueSize)
+		// inFlight holds the ids of the dispatched jobs not processed yet, so that they are not dispatched again
+		inFlight := &sync.Map{}
+		for i := 0; i < s.workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case job := <-jobs:
+						processTransmissionJob(job, s, dic)
+						inFlight.Delete(job.Id)
+					}
+				}
+			}()
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			timer := time.NewTimer(s.pollInterval)
+			for {
+				timer.Reset(s.pollInterval)
+				select {
+				case <-ctx.Done():
+					lc.Info("Exiting transmission queue")
+					return
+				case <-transmissionJobWakeUp:
+				case <-timer.C:
+				}
+				dispatchTransmissionJobs(ctx, jobs, inFlight, s, dic)
+			}
+		}()
+	})
+	return nil
+}
+
+// recoverTransmissions queues the RESENDING transmissions without a job, which were resent in memory before the queue
+// was persisted, and distributes the notifications which were still NEW when the service stopped
+func recoverTransmissions(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	jobs, err := dbClient.AllTransmissionJobs(0, -1)
+	if err != nil {
+		lc.Errorf("Failed to query the transmission jobs to recover, %v", err)
+		return
+	}
+	queued := make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		queued[job.Transmission.Id] = true
+	}
+	resending, err := dbClient.TransmissionsByStatus(0, -1, models.RESENDING)
+	if err != nil {
+		lc.Errorf("Failed to query the resending transmissions to recover, %v", err)
+	}
+	recovered := 0
+	for _, trans := range resending {
+		if queued[trans.Id] {
+			continue
+		}
+		job := notificationModels.TransmissionJob{
+			Id:            transmissionJobId(trans.NotificationId, trans.SubscriptionName, trans.Channel),
+			Transmission:  trans,
+			NextAttemptAt: pkgCommon.MakeTimestamp(),
+		}
+		if _, err = dbClient.AddTransmissionJob(job); err != nil {
+			if errors.Kind(err) != errors.KindDuplicateName {
+				lc.Errorf("Failed to queue the resending transmission %s, %v", trans.Id, err)
+			}
+			continue
+		}
+		recovered++
+	}
+
+	notifications, err := dbClient.NotificationsByStatus(0, -1, "", models.New)
+	if err != nil {
+		lc.Errorf("Failed to query the new notifications to recover, %v", err)
+		return
+	}
+	for _, n := range notifications {
+		if err = distribute(dic, n); err != nil {
+			lc.Errorf("Failed to distribute the new notification %s, %v", n.Id, err)
+		}
+	}
+	lc.Infof("Recovered %d resending transmissions and %d new notifications, %d transmissions are queued", recovered, len(notifications), len(jobs))
+}
+
+// dispatchTransmissionJobs feeds the due transmission jobs to the workers, it blocks when all the workers are busy and
+// the buffer is full, and the remaining jobs stay in the database until the next dispatch
+func dispatchTransmissionJobs(ctx context.Context, jobs chan<- notificationModels.TransmissionJob, inFlight *sync.Map, s transmissionQueueSettings, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	// the in-flight jobs may still be due, so the query covers them as well as a full buffer
+	due, err := container.DBClientFrom(dic.Get).DueTransmissionJobs(pkgCommon.MakeTimestamp(), s.queueSize+s.workers)
+	if err != nil {
+		lc.Errorf("Failed to query the due transmission jobs, %v", err)
+		return
+	}
+	for _, job := range due {
+		if _, loaded := inFlight.LoadOrStore(job.Id, true); loaded {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case jobs <- job:
+		}
+	}
+}
+
+// processTransmissionJob sends or resends the transmission of the job. The job is finished once the transmission is
+// sent or escalated, otherwise it is rescheduled with the backoff.
+func processTransmissionJob(job notificationModels.TransmissionJob, s transmissionQueueSettings, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	trans := job.Transmission
+
+	n, err := dbClient.NotificationById(trans.NotificationId)
+	if err != nil {
+		lc.Errorf("fail to query the notification %s of transmission job %s, err: %v", trans.NotificationId, job.Id, err)
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			finishTransmissionJob(job.Id, dic)
+		}
+		return
+	}
+	sub, err := dbClient.SubscriptionByName(trans.SubscriptionName)
+	if err != nil {
+		lc.Errorf("fail to query the subscription %s of transmission job %s, err: %v", trans.SubscriptionName, job.Id, err)
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			finishTransmissionJob(job.Id, dic)
+		}
+		return
+	}
+
+	if trans.Id == "" {
+		trans = firstSend(dic, n, trans)
+		// Do not resend if the notification status is Escalated, or the transmission of the non-critical notification
+		// is failed
+		resend := n.Status != models.Escalated && n.Severity == models.Critical && trans.Status == models.Failed
+		if resend {
+			// Change the transmission status to RESENDING which means this transmission process is resending the notification and should not be removed.
+			trans.Status = models.RESENDING
+		}
+		// the transmission is added together with its id persisted in the job, so that it is resent rather than sent
+		// again as a new transmission if the service stops before the job is rescheduled
+		job.Transmission = trans
+		job, err = dbClient.AddTransmissionOfJob(job)
+		if err != nil {
+			lc.Error(err.Message())
+			return
+		}
+		trans = job.Transmission
+		if !resend {
+			finishTransmissionJob(job.Id, dic)
+			return
+		}
+	} else {
+		trans, err = reSend(dic, n, trans)
+		if err != nil {
+			lc.Errorf("fail to resend the critical notification to %s with address %v, err: %v", sub.Name, trans.Channel.GetBaseAddress(), err)
+			return
+		}
+		if trans.Status != models.RESENDING {
+			finishTransmissionJob(job.Id, dic)
+			return
+		}
+	}
+
+	config := container.ConfigurationFrom(dic.Get)
+	resendLimit, resendInterval, err := resendLimitAndInterval(config, sub)
+	if err != nil {
+		lc.Errorf("fail to handle the critical notification sending for the subscription %s, err: %v", sub.Name, err)
+		return
+	}
+	if trans.ResendCount >= resendLimit {
+		lc.Warn("Resend count exceeds the configurable limit, escalate the transmission.")
+		// Trigger a escalated notification before finishing the job, so that the escalation is retried with the
+		// transmission if it fails
+		escalatedErr := escalatedSend(dic, n, trans)
+		if escalatedErr == nil {
+			trans.Status = models.Escalated
+			if err = dbClient.UpdateTransmission(trans); err != nil {
+				lc.Error(err.Message())
+				return
+			}
+			finishTransmissionJob(job.Id, dic)
+			return
+		}
+		lc.Errorf("fail to handle the escalated notification sending, err: %v", escalatedErr)
+	} else {
+		lc.Warn("fail to send the critical notification. Retry to send again...")
+	}
+	job.Transmission = trans
+	job.NextAttemptAt = pkgCommon.MakeTimestamp() + resendBackoff(trans.ResendCount+1, resendInterval, s.maxResendInterval).Milliseconds()
+	if err = dbClient.UpdateTransmissionJob(job); err != nil {
+		lc.Errorf("fail to reschedule the transmission job %s, err: %v", job.Id, err)
+	}
+}
+
+// finishTransmissionJob removes the processed transmission job from the queue
+func finishTransmissionJob(id string, dic *di.Container) {
+	if err := container.DBClientFrom(dic.Get).DeleteTransmissionJobById(id); err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("fail to delete the transmission job %s, err: %v", id, err)
+	}
+}
+
+// resendBackoff returns the interval before the given resend attempt, which is the resend interval doubled after each
+// attempt and capped at the max resend interval. Half of the interval is randomized so that the transmissions failed
+// together are not resent at the same time.
+func resendBackoff(attempt int, resendInterval, maxResendInterval time.Duration) time.Duration {
+	backoff := resendInterval
+	for i := 1; i < attempt && backoff < maxResendInterval; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, maxResendInterval)
+	half := backoff / 2
+	if half <= 0 {
+		return backoff
+	}
+	return half + rand.N(backoff-half+1)
+}
@@ -0,0 +1,178 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestProcessTransmissionJob(t *testing.T) {
+	testTransmissionId := "6d4e1eb8-3fa5-4ed7-8ba5-5e3e3b3bd7fa"
+	testJobId := "0b7a6f12-4b6d-4f9e-9c3a-0c1d5e1a2b3c"
+	critical := notification
+	critical.Id = "3b3c4bd1-1bd5-4c4f-9d6b-0d6a8a1b2c3d"
+	critical.Severity = models.Critical
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	resending := models.NewTransmission(sub.Name, testRestAddress2, critical.Id)
+	resending.Id = testTransmissionId
+	resending.Status = models.RESENDING
+	resending.ResendCount = 1
+
+	tests := []struct {
+		name               string
+		notification       models.Notification
+		transmission       models.Transmission
+		notificationExists bool
+		expectedStatus     models.TransmissionStatus
+		expectedFinished   bool
+	}{
+		{"first send succeeded", critical, models.NewTransmission(sub.Name, testRestAddress, critical.Id), true, models.Sent, true},
+		{"first send of non-critical notification failed", notification, models.NewTransmission(sub.Name, testRestAddress2, notification.Id), true, models.Failed, true},
+		{"first send of critical notification failed", critical, models.NewTransmission(sub.Name, testRestAddress2, critical.Id), true, models.RESENDING, false},
+		{"resend failed and escalated", critical, resending, true, models.Escalated, true},
+		{"notification not found", critical, resending, false, "", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic()
+			restSender := &senderMock.Sender{}
+			restSender.On("Send", testCase.notification, testRestAddress).Return("", nil)
+			restSender.On("Send", testCase.notification, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
+			dbClientMock := &dbMock.DBClient{}
+			if testCase.notificationExists {
+				dbClientMock.On("NotificationById", testCase.transmission.NotificationId).Return(testCase.notification, nil)
+			} else {
+				dbClientMock.On("NotificationById", testCase.transmission.NotificationId).Return(models.Notification{}, notFound)
+			}
+			dbClientMock.On("SubscriptionByName", sub.Name).Return(sub, nil)
+			dbClientMock.On("SubscriptionByName", models.EscalationSubscriptionName).Return(models.Subscription{}, notFound)
+			dbClientMock.On("AddTransmissionOfJob", mock.Anything).Return(func(job notificationModels.TransmissionJob) notificationModels.TransmissionJob {
+				job.Transmission.Id = testTransmissionId
+				return job
+			}, nil)
+			dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+			dbClientMock.On("UpdateTransmissionJob", mock.Anything).Return(nil)
+			dbClientMock.On("DeleteTransmissionJobById", testJobId).Return(nil)
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				channel.RESTSenderName: func(get di.Get) interface{} {
+					return restSender
+				},
+			})
+
+			start := pkgCommon.MakeTimestamp()
+			job := notificationModels.TransmissionJob{Id: testJobId, Transmission: testCase.transmission, NextAttemptAt: start}
+			processTransmissionJob(job, transmissionQueueSettings{maxResendInterval: time.Minute}, dic)
+
+			if testCase.expectedFinished {
+				dbClientMock.AssertCalled(t, "DeleteTransmissionJobById", testJobId)
+				dbClientMock.AssertNotCalled(t, "UpdateTransmissionJob", mock.Anything)
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeleteTransmissionJobById", testJobId)
+				dbClientMock.AssertCalled(t, "UpdateTransmissionJob", mock.MatchedBy(func(j notificationModels.TransmissionJob) bool {
+					// the first resend is scheduled after 0.5 to 1 resend interval
+					return j.Transmission.Id == testTransmissionId && j.Transmission.Status == testCase.expectedStatus &&
+						j.NextAttemptAt >= start+500 && j.NextAttemptAt <= pkgCommon.MakeTimestamp()+1000
+				}))
+			}
+			if testCase.expectedStatus == "" {
+				dbClientMock.AssertNotCalled(t, "AddTransmissionOfJob", mock.Anything)
+				dbClientMock.AssertNotCalled(t, "UpdateTransmission", mock.Anything)
+			} else if testCase.transmission.Id == "" {
+				dbClientMock.AssertCalled(t, "AddTransmissionOfJob", mock.MatchedBy(func(j notificationModels.TransmissionJob) bool {
+					return j.Id == testJobId && len(j.Transmission.Records) == 1 && j.Transmission.Status == testCase.expectedStatus
+				}))
+				dbClientMock.AssertNotCalled(t, "UpdateTransmission", mock.Anything)
+			}
+			if testCase.expectedStatus == models.Escalated {
+				dbClientMock.AssertCalled(t, "UpdateTransmission", mock.MatchedBy(func(trans models.Transmission) bool {
+					return trans.Status == testCase.expectedStatus
+				}))
+			}
+		})
+	}
+}
+
+func TestEnqueueTransmission(t *testing.T) {
+	duplicated := errors.NewCommonEdgeX(errors.KindDuplicateName, "transmission job already exists", nil)
+	tests := []struct {
+		name          string
+		addErr        errors.EdgeX
+		errorExpected bool
+	}{
+		{"queued", nil, false},
+		{"already queued", duplicated, false},
+		{"database error", errors.NewCommonEdgeX(errors.KindDatabaseError, "failed", nil), true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic()
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddTransmissionJob", mock.Anything).Return(notificationModels.TransmissionJob{}, testCase.addErr)
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+
+			err := enqueueTransmission(dic, notification, sub, testRestAddress, 0)
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			dbClientMock.AssertCalled(t, "AddTransmissionJob", mock.MatchedBy(func(job notificationModels.TransmissionJob) bool {
+				return job.Id == transmissionJobId(notification.Id, sub.Name, testRestAddress)
+			}))
+		})
+	}
+}
+
+func TestTransmissionJobId(t *testing.T) {
+	id := transmissionJobId(notification.Id, sub.Name, testRestAddress)
+	assert.Equal(t, id, transmissionJobId(notification.Id, sub.Name, testRestAddress))
+	assert.NotEqual(t, id, transmissionJobId(notification.Id, sub.Name, testRestAddress2))
+	assert.NotEqual(t, id, transmissionJobId(notification.Id, "another-subscription", testRestAddress))
+	assert.NotEqual(t, id, transmissionJobId("another-notification", sub.Name, testRestAddress))
+}
+
+func TestResendBackoff(t *testing.T) {
+	tests := []struct {
+		name        string
+		attempt     int
+		expectedMin time.Duration
+		expectedMax time.Duration
+	}{
+		{"first attempt", 1, 500 * time.Millisecond, time.Second},
+		{"third attempt", 3, 2 * time.Second, 4 * time.Second},
+		{"capped attempt", 20, 30 * time.Second, time.Minute},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				backoff := resendBackoff(testCase.attempt, time.Second, time.Minute)
+				assert.GreaterOrEqual(t, backoff, testCase.expectedMin)
+				assert.LessOrEqual(t, backoff, testCase.expectedMax)
+			}
+		})
+	}
+}
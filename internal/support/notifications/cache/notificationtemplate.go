@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// NotificationTemplateCache keeps all the notification templates in memory, so that the template of each transmission
+// is resolved without the database round-trips
+type NotificationTemplateCache interface {
+	// TemplateFor returns the template bound to the channel of the given type of the subscription, and whether it is found
+	TemplateFor(subscriptionName, channelType string) (models.NotificationTemplate, bool)
+	SetNotificationTemplate(template models.NotificationTemplate)
+	RemoveNotificationTemplate(name string)
+}
+
+type notificationTemplateCache struct {
+	mutex     sync.RWMutex
+	templates map[string]models.NotificationTemplate
+}
+
+// NewNotificationTemplateCache creates a NotificationTemplateCache with the given templates
+func NewNotificationTemplateCache(templates []models.NotificationTemplate) NotificationTemplateCache {
+	c := &notificationTemplateCache{templates: make(map[string]models.NotificationTemplate, len(templates))}
+	for _, t := range templates {
+		c.templates[t.Name] = t
+	}
+	return c
+}
+
+func (c *notificationTemplateCache) TemplateFor(subscriptionName, channelType string) (models.NotificationTemplate, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var found models.NotificationTemplate
+	var ok bool
+	for _, t := range c.templates {
+		for _, b := range t.Bindings {
+			if b.SubscriptionName != subscriptionName {
+				continue
+			}
+			if b.ChannelType == channelType {
+				return t, true
+			}
+			if b.ChannelType == "" {
+				found, ok = t, true
+			}
+		}
+	}
+	return found, ok
+}
+
+func (c *notificationTemplateCache) SetNotificationTemplate(template models.NotificationTemplate) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.templates[template.Name] = template
+}
+
+func (c *notificationTemplateCache) RemoveNotificationTemplate(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.templates, name)
+}
@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestNotificationTemplateCache(t *testing.T) {
+	subscriptionTemplate := models.NotificationTemplate{Name: "subscription", Bindings: []models.TemplateBinding{{SubscriptionName: "sub1"}}}
+	emailTemplate := models.NotificationTemplate{Name: "email", Bindings: []models.TemplateBinding{{SubscriptionName: "sub1", ChannelType: common.EMAIL}}}
+	c := NewNotificationTemplateCache([]models.NotificationTemplate{subscriptionTemplate, emailTemplate})
+
+	template, ok := c.TemplateFor("sub1", common.EMAIL)
+	assert.True(t, ok)
+	assert.Equal(t, emailTemplate, template)
+	template, ok = c.TemplateFor("sub1", common.REST)
+	assert.True(t, ok)
+	assert.Equal(t, subscriptionTemplate, template)
+	_, ok = c.TemplateFor("sub2", common.REST)
+	assert.False(t, ok)
+
+	c.RemoveNotificationTemplate(emailTemplate.Name)
+	template, ok = c.TemplateFor("sub1", common.EMAIL)
+	assert.True(t, ok)
+	assert.Equal(t, subscriptionTemplate, template)
+}
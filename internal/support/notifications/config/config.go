@@ -22,14 +22,15 @@ import (
 )
 
 type ConfigurationStruct struct {
-	Writable   WritableInfo
-	Clients    bootstrapConfig.ClientsCollection
-	Database   bootstrapConfig.Database
-	Registry   bootstrapConfig.RegistryInfo
-	Service    bootstrapConfig.ServiceInfo
-	MessageBus bootstrapConfig.MessageBusInfo
-	Smtp       SmtpInfo
-	Retention  NotificationRetention
+	Writable          WritableInfo
+	Clients           bootstrapConfig.ClientsCollection
+	Database          bootstrapConfig.Database
+	Registry          bootstrapConfig.RegistryInfo
+	Service           bootstrapConfig.ServiceInfo
+	MessageBus        bootstrapConfig.MessageBusInfo
+	Smtp              SmtpInfo
+	Retention         NotificationRetention
+	TransmissionQueue TransmissionQueueInfo
 }
 
 type WritableInfo struct {
@@ -62,6 +63,20 @@ type NotificationRetention struct {
 	MinCap   uint32
 }
 
+// TransmissionQueueInfo defines how the persisted transmission jobs are processed. The notifications are sent by a fixed
+// number of workers, and a failed critical transmission is resent after the resend interval doubled after each attempt
+// with jitter, up to MaxResendInterval.
+type TransmissionQueueInfo struct {
+	// Workers is the number of the workers sending the notifications concurrently
+	Workers int
+	// QueueSize is the number of the due transmission jobs buffered for the workers
+	QueueSize int
+	// PollInterval is how often the transmission jobs are checked for the due ones
+	PollInterval string
+	// MaxResendInterval caps the interval between two resends of a failed critical transmission, such as "1h"
+	MaxResendInterval string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -0,0 +1,30 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// new constants relates to EdgeX Support Notifications service and will be added to go-mod-core-contracts in the future
+
+// Constants related to defined routes in the v3 service APIs
+const (
+	ApiNotificationTemplateRoute       = common.ApiBase + "/" + NotificationTemplate
+	ApiAllNotificationTemplateRoute    = ApiNotificationTemplateRoute + "/" + common.All
+	ApiNotificationTemplateByNameRoute = ApiNotificationTemplateRoute + "/" + common.Name + "/:" + common.Name
+	ApiRenderNotificationTemplateRoute = ApiNotificationTemplateRoute + "/" + Render
+	ApiSubscriptionThrottleRoute       = common.ApiSubscriptionByNameRoute + "/" + Throttle
+	ApiExternalAddressesRoute          = common.ApiSubscriptionByNameRoute + "/" + ExternalAddresses
+)
+
+// Constants related to defined url path names and parameters in the v3 service APIs
+const (
+	NotificationTemplate = "notificationtemplate"
+	Render               = "render"
+	Throttle             = "throttle"
+	ExternalAddresses    = "externaladdresses"
+)
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/cache"
+)
+
+// NotificationTemplateCacheInterfaceName contains the name of the cache.NotificationTemplateCache implementation in the DIC.
+var NotificationTemplateCacheInterfaceName = di.TypeInstanceToName((*cache.NotificationTemplateCache)(nil))
+
+// NotificationTemplateCacheFrom helper function queries the DIC and returns the cache.NotificationTemplateCache
+// implementation, or nil when the templates are not loaded.
+func NotificationTemplateCacheFrom(get di.Get) cache.NotificationTemplateCache {
+	templateCache, ok := get(NotificationTemplateCacheInterfaceName).(cache.NotificationTemplateCache)
+	if !ok {
+		return nil
+	}
+	return templateCache
+}
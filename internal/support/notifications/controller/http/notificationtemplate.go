@@ -0,0 +1,208 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	requestDTO "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
+)
+
+type NotificationTemplateController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewNotificationTemplateController creates and initializes a NotificationTemplateController
+func NewNotificationTemplateController(dic *di.Container) *NotificationTemplateController {
+	return &NotificationTemplateController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// AddNotificationTemplate handles the POST request of adding new NotificationTemplate
+func (tc *NotificationTemplateController) AddNotificationTemplate(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requestDTO.AddNotificationTemplateRequest
+	err := tc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var addResponses []any
+	for _, req := range reqDTOs {
+		var response any
+		reqId := req.RequestId
+		newId, err := application.AddNotificationTemplate(ctx, dtos.ToNotificationTemplateModel(req.NotificationTemplate), tc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+		} else {
+			response = commonDTO.NewBaseWithIdResponse(reqId, "", http.StatusCreated, newId)
+		}
+		addResponses = append(addResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(addResponses, w, lc)
+}
+
+// NotificationTemplateByName handles the GET request of querying NotificationTemplate by name
+func (tc *NotificationTemplateController) NotificationTemplateByName(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	template, err := application.NotificationTemplateByName(name, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewNotificationTemplateResponse("", "", http.StatusOK, template)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// AllNotificationTemplates handles the GET request of querying all NotificationTemplates
+func (tc *NotificationTemplateController) AllNotificationTemplates(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	config := notificationContainer.ConfigurationFrom(tc.dic.Get)
+
+	// parse URL query string for offset and limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	templates, totalCount, err := application.AllNotificationTemplates(offset, limit, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiNotificationTemplatesResponse("", "", http.StatusOK, totalCount, templates)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// PatchNotificationTemplate handles the PATCH request of updating NotificationTemplate
+func (tc *NotificationTemplateController) PatchNotificationTemplate(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requestDTO.UpdateNotificationTemplateRequest
+	err := tc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var responses []any
+	for _, dto := range reqDTOs {
+		var response any
+		reqId := dto.RequestId
+		err := application.PatchNotificationTemplate(ctx, dto.NotificationTemplate, tc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+		} else {
+			response = commonDTO.NewBaseResponse(reqId, "", http.StatusOK)
+		}
+		responses = append(responses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(responses, w, lc)
+}
+
+// DeleteNotificationTemplateByName handles the DELETE request of deleting NotificationTemplate by name
+func (tc *NotificationTemplateController) DeleteNotificationTemplateByName(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteNotificationTemplateByName(name, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// RenderNotificationTemplate handles the POST request of rendering a stored or unsaved NotificationTemplate with a
+// sample notification, so that the template can be tested without sending any notification
+func (tc *NotificationTemplateController) RenderNotificationTemplate(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+
+	var req requestDTO.RenderNotificationTemplateRequest
+	err := tc.reader.Read(r.Body, &req)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	subject, body, contentType, err := application.RenderNotificationTemplate(req, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, req.RequestId)
+	}
+
+	response := responseDTO.NewRenderNotificationTemplateResponse(req.RequestId, "", http.StatusOK, subject, body, contentType)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
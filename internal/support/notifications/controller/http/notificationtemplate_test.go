@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationRequests "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	notificationResponses "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func addNotificationTemplateRequestData() notificationRequests.AddNotificationTemplateRequest {
+	return notificationRequests.AddNotificationTemplateRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		NotificationTemplate: notificationDTOs.NotificationTemplate{
+			Name:     "template1",
+			Subject:  "[{{.Severity}}] {{.Category}}",
+			Body:     "{{.Content}}",
+			Bindings: []notificationDTOs.TemplateBinding{{SubscriptionName: testSubscriptionName, ChannelType: common.EMAIL}},
+		},
+	}
+}
+
+func TestAddNotificationTemplate(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddNotificationTemplate", mock.Anything).Return(func(t notificationModels.NotificationTemplate) notificationModels.NotificationTemplate {
+		t.Id = ExampleUUID
+		return t
+	}, nil)
+	dbClientMock.On("AllNotificationTemplates", 0, -1).Return([]notificationModels.NotificationTemplate{{
+		Name:     "bound",
+		Bindings: []notificationModels.TemplateBinding{{SubscriptionName: "boundSubscription", ChannelType: common.EMAIL}},
+	}}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewNotificationTemplateController(dic)
+
+	noName := addNotificationTemplateRequestData()
+	noName.NotificationTemplate.Name = ""
+	noBody := addNotificationTemplateRequestData()
+	noBody.NotificationTemplate.Body = ""
+	malformedBody := addNotificationTemplateRequestData()
+	malformedBody.NotificationTemplate.Body = "{{.Content"
+	invalidChannelType := addNotificationTemplateRequestData()
+	invalidChannelType.NotificationTemplate.Bindings[0].ChannelType = "unknown"
+	boundChannel := addNotificationTemplateRequestData()
+	boundChannel.NotificationTemplate.Bindings[0].SubscriptionName = "boundSubscription"
+
+	tests := []struct {
+		name               string
+		request            notificationRequests.AddNotificationTemplateRequest
+		expectedStatusCode int
+	}{
+		{"Valid", addNotificationTemplateRequestData(), http.StatusCreated},
+		{"Invalid - no name", noName, http.StatusBadRequest},
+		{"Invalid - no body", noBody, http.StatusBadRequest},
+		{"Invalid - malformed body", malformedBody, http.StatusBadRequest},
+		{"Invalid - unknown channel type", invalidChannelType, http.StatusBadRequest},
+		{"Conflict - channel bound to another template", boundChannel, http.StatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal([]notificationRequests.AddNotificationTemplateRequest{testCase.request})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, constants.ApiNotificationTemplateRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			err = controller.AddNotificationTemplate(e.NewContext(req, recorder))
+			require.NoError(t, err)
+
+			if testCase.expectedStatusCode == http.StatusBadRequest {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.NotEmpty(t, res.Message, "Message is empty")
+			} else {
+				var res []commonDTO.BaseWithIdResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+				if testCase.expectedStatusCode == http.StatusCreated {
+					assert.Equal(t, ExampleUUID, res[0].Id, "Id not as expected")
+				}
+			}
+		})
+	}
+}
+
+func TestRenderNotificationTemplate(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationTemplateByName", "stored").Return(notificationModels.NotificationTemplate{Name: "stored", Body: "{{upper .Content}}"}, nil)
+	dbClientMock.On("NotificationTemplateByName", "unknown").Return(notificationModels.NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewNotificationTemplateController(dic)
+
+	notification := dtos.Notification{Category: "health-check", Content: "device down", Sender: "sender", Severity: models.Critical}
+	unsaved := addNotificationTemplateRequestData().NotificationTemplate
+
+	tests := []struct {
+		name               string
+		request            notificationRequests.RenderNotificationTemplateRequest
+		expectedStatusCode int
+		expectedSubject    string
+		expectedBody       string
+	}{
+		{"Valid - stored template", notificationRequests.RenderNotificationTemplateRequest{BaseRequest: commonDTO.NewBaseRequest(), TemplateName: "stored", Notification: notification}, http.StatusOK, "", "DEVICE DOWN"},
+		{"Valid - unsaved template", notificationRequests.RenderNotificationTemplateRequest{BaseRequest: commonDTO.NewBaseRequest(), Template: &unsaved, Notification: notification}, http.StatusOK, "[CRITICAL] health-check", "device down"},
+		{"Invalid - no template", notificationRequests.RenderNotificationTemplateRequest{BaseRequest: commonDTO.NewBaseRequest(), Notification: notification}, http.StatusBadRequest, "", ""},
+		{"Invalid - both stored and unsaved template", notificationRequests.RenderNotificationTemplateRequest{BaseRequest: commonDTO.NewBaseRequest(), TemplateName: "stored", Template: &unsaved, Notification: notification}, http.StatusBadRequest, "", ""},
+		{"Invalid - template not found", notificationRequests.RenderNotificationTemplateRequest{BaseRequest: commonDTO.NewBaseRequest(), TemplateName: "unknown", Notification: notification}, http.StatusNotFound, "", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, constants.ApiRenderNotificationTemplateRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			err = controller.RenderNotificationTemplate(e.NewContext(req, recorder))
+			require.NoError(t, err)
+
+			var res notificationResponses.RenderNotificationTemplateResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedSubject, res.Subject)
+			assert.Equal(t, testCase.expectedBody, res.Body)
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationRequests "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	notificationResponses "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -246,3 +248,143 @@ func (sc *SubscriptionController) PatchSubscription(c echo.Context) error {
 	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
 	return pkg.EncodeAndWriteResponse(updateResponses, w, lc)
 }
+
+// SetSubscriptionThrottle handles the PUT request of adding or replacing the throttle of the subscription
+func (sc *SubscriptionController) SetSubscriptionThrottle(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	var req notificationRequests.SetSubscriptionThrottleRequest
+	err := sc.reader.Read(r.Body, &req)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	err = application.SetSubscriptionThrottle(ctx, name, req.Throttle, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, req.RequestId)
+	}
+
+	response := commonDTO.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// SubscriptionThrottleByName handles the GET request of querying the throttle of the subscription
+func (sc *SubscriptionController) SubscriptionThrottleByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	throttle, err := application.SubscriptionThrottleByName(name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := notificationResponses.NewSubscriptionThrottleResponse("", "", http.StatusOK, throttle)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteSubscriptionThrottleByName handles the DELETE request of removing the throttle of the subscription
+func (sc *SubscriptionController) DeleteSubscriptionThrottleByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteSubscriptionThrottleByName(name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// SetSubscriptionExternalAddresses handles the PUT request of adding or replacing the external addresses of the subscription
+func (sc *SubscriptionController) SetSubscriptionExternalAddresses(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	var req notificationRequests.SetSubscriptionExternalAddressesRequest
+	err := sc.reader.Read(r.Body, &req)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	err = application.SetSubscriptionExternalAddresses(ctx, name, req.ExternalAddresses, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, req.RequestId)
+	}
+
+	response := commonDTO.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// SubscriptionExternalAddressesByName handles the GET request of querying the external addresses of the subscription
+func (sc *SubscriptionController) SubscriptionExternalAddressesByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	externalAddresses, err := application.SubscriptionExternalAddressesByName(name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := notificationResponses.NewSubscriptionExternalAddressesResponse("", "", http.StatusOK, externalAddresses)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteSubscriptionExternalAddressesByName handles the DELETE request of removing the external addresses of the subscription
+func (sc *SubscriptionController) DeleteSubscriptionExternalAddressesByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteSubscriptionExternalAddressesByName(name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -15,8 +15,13 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationRequests "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	notificationResponses "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
@@ -583,6 +588,8 @@ func TestDeleteSubscriptionByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeleteSubscriptionByName", subscription.Name).Return(nil)
+	dbClientMock.On("DeleteSubscriptionThrottleByName", subscription.Name).Return(nil)
+	dbClientMock.On("DeleteSubscriptionExternalAddressesByName", subscription.Name).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription external addresses don't exist in the database", nil))
 	dbClientMock.On("DeleteSubscriptionByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
 	dbClientMock.On("SubscriptionByName", notFoundName).Return(subscription, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
 	dbClientMock.On("SubscriptionByName", subscription.Name).Return(subscription, nil)
@@ -779,3 +786,384 @@ func TestPatchSubscription(t *testing.T) {
 		})
 	}
 }
+
+func TestSetSubscriptionThrottle(t *testing.T) {
+	notFoundName := "notFoundName"
+	throttle := notificationDTOs.SubscriptionThrottle{RateLimit: 10, RateInterval: "1m", DedupWindow: "5m"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(models.Subscription{Name: testSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionByName", notFoundName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dbClientMock.On("SetSubscriptionThrottle", notificationDTOs.ToSubscriptionThrottleModel(notificationDTOs.SubscriptionThrottle{
+		SubscriptionName: testSubscriptionName, RateLimit: 10, RateInterval: "1m", DedupWindow: "5m",
+	})).Return(notificationModels.SubscriptionThrottle{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionController(dic)
+	require.NotNil(t, controller)
+
+	invalid := throttle
+	invalid.RateInterval = ""
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		throttle           notificationDTOs.SubscriptionThrottle
+		expectedStatusCode int
+	}{
+		{"Valid - set subscription throttle", testSubscriptionName, throttle, http.StatusOK},
+		{"Invalid - rate limit without rate interval", testSubscriptionName, invalid, http.StatusBadRequest},
+		{"Invalid - subscription not found by name", notFoundName, throttle, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqDTO := notificationRequests.SetSubscriptionThrottleRequest{
+				BaseRequest: commonDTO.NewBaseRequest(),
+				Throttle:    testCase.throttle,
+			}
+			jsonData, err := json.Marshal(reqDTO)
+			require.NoError(t, err)
+			reqPath := fmt.Sprintf("%s/%s/%s", common.ApiSubscriptionByNameRoute, testCase.subscriptionName, constants.Throttle)
+			req, err := http.NewRequest(http.MethodPut, reqPath, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.SetSubscriptionThrottle(c)
+			require.NoError(t, err)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestSubscriptionThrottleByName(t *testing.T) {
+	notFoundName := "notFoundName"
+	throttle := notificationModels.SubscriptionThrottle{SubscriptionName: testSubscriptionName, DedupWindow: "5m"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionThrottleByName", testSubscriptionName).Return(throttle, nil)
+	dbClientMock.On("SubscriptionThrottleByName", notFoundName).Return(notificationModels.SubscriptionThrottle{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription throttle doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid - find subscription throttle by name", testSubscriptionName, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - subscription throttle not found by name", notFoundName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s", common.ApiSubscriptionByNameRoute, testCase.subscriptionName, constants.Throttle)
+			req, err := http.NewRequest(http.MethodGet, reqPath, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.SubscriptionThrottleByName(c)
+			require.NoError(t, err)
+			var res notificationResponses.SubscriptionThrottleResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Equal(t, notificationDTOs.FromSubscriptionThrottleModelToDTO(throttle), res.Throttle, "Throttle not as expected")
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestDeleteSubscriptionThrottleByName(t *testing.T) {
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteSubscriptionThrottleByName", testSubscriptionName).Return(nil)
+	dbClientMock.On("DeleteSubscriptionThrottleByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription throttle doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid - delete subscription throttle by name", testSubscriptionName, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - subscription throttle not found by name", notFoundName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s", common.ApiSubscriptionByNameRoute, testCase.subscriptionName, constants.Throttle)
+			req, err := http.NewRequest(http.MethodDelete, reqPath, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.DeleteSubscriptionThrottleByName(c)
+			require.NoError(t, err)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestSetSubscriptionExternalAddresses(t *testing.T) {
+	notFoundName := "notFoundName"
+	chat := notificationDTOs.ExternalAddress{Host: "hooks.example.com", Port: 443, Path: "/chat", Kind: "Teams"}
+	subscription := models.Subscription{Name: testSubscriptionName, Channels: []models.Address{
+		models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: chat.Host, Port: chat.Port}, Path: chat.Path, HTTPMethod: http.MethodPost},
+	}}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(subscription, nil)
+	dbClientMock.On("SubscriptionByName", notFoundName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dbClientMock.On("SetSubscriptionExternalAddresses", notificationDTOs.ToSubscriptionExternalAddressesModel(notificationDTOs.SubscriptionExternalAddresses{
+		SubscriptionName: testSubscriptionName, Addresses: []notificationDTOs.ExternalAddress{chat},
+	})).Return(notificationModels.SubscriptionExternalAddresses{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionController(dic)
+	require.NotNil(t, controller)
+
+	otherPath := chat
+	otherPath.Path = "/other"
+	invalidKind := chat
+	invalidKind.Kind = "Pager"
+	noRecipients := chat
+	noRecipients.Kind = "SMS"
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		addresses          []notificationDTOs.ExternalAddress
+		expectedStatusCode int
+	}{
+		{"Valid - set subscription external addresses", testSubscriptionName, []notificationDTOs.ExternalAddress{chat}, http.StatusOK},
+		{"Invalid - no external address", testSubscriptionName, nil, http.StatusBadRequest},
+		{"Invalid - unsupported kind", testSubscriptionName, []notificationDTOs.ExternalAddress{invalidKind}, http.StatusBadRequest},
+		{"Invalid - SMS without recipients", testSubscriptionName, []notificationDTOs.ExternalAddress{noRecipients}, http.StatusBadRequest},
+		{"Invalid - not a channel of the subscription", testSubscriptionName, []notificationDTOs.ExternalAddress{otherPath}, http.StatusBadRequest},
+		{"Invalid - duplicate external addresses", testSubscriptionName, []notificationDTOs.ExternalAddress{chat, chat}, http.StatusBadRequest},
+		{"Invalid - subscription not found by name", notFoundName, []notificationDTOs.ExternalAddress{chat}, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqDTO := notificationRequests.SetSubscriptionExternalAddressesRequest{
+				BaseRequest:       commonDTO.NewBaseRequest(),
+				ExternalAddresses: notificationDTOs.SubscriptionExternalAddresses{Addresses: testCase.addresses},
+			}
+			jsonData, err := json.Marshal(reqDTO)
+			require.NoError(t, err)
+			reqPath := fmt.Sprintf("%s/%s/%s", common.ApiSubscriptionByNameRoute, testCase.subscriptionName, constants.ExternalAddresses)
+			req, err := http.NewRequest(http.MethodPut, reqPath, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.SetSubscriptionExternalAddresses(c)
+			require.NoError(t, err)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestSubscriptionExternalAddressesByName(t *testing.T) {
+	notFoundName := "notFoundName"
+	externalAddresses := notificationModels.SubscriptionExternalAddresses{SubscriptionName: testSubscriptionName, Addresses: []notificationModels.ExternalAddress{
+		notificationDTOs.ToExternalAddressModel(notificationDTOs.ExternalAddress{Host: "syslog.example.com", Port: 514, Kind: "Syslog", Network: "tcp"}),
+	}}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionExternalAddressesByName", testSubscriptionName).Return(externalAddresses, nil)
+	dbClientMock.On("SubscriptionExternalAddressesByName", notFoundName).Return(notificationModels.SubscriptionExternalAddresses{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription external addresses don't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid - find subscription external addresses by name", testSubscriptionName, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - subscription external addresses not found by name", notFoundName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s", common.ApiSubscriptionByNameRoute, testCase.subscriptionName, constants.ExternalAddresses)
+			req, err := http.NewRequest(http.MethodGet, reqPath, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.SubscriptionExternalAddressesByName(c)
+			require.NoError(t, err)
+			var res notificationResponses.SubscriptionExternalAddressesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Equal(t, notificationDTOs.FromSubscriptionExternalAddressesModelToDTO(externalAddresses), res.ExternalAddresses, "External addresses not as expected")
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestDeleteSubscriptionExternalAddressesByName(t *testing.T) {
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteSubscriptionExternalAddressesByName", testSubscriptionName).Return(nil)
+	dbClientMock.On("DeleteSubscriptionExternalAddressesByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription external addresses don't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid - delete subscription external addresses by name", testSubscriptionName, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - subscription external addresses not found by name", notFoundName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s", common.ApiSubscriptionByNameRoute, testCase.subscriptionName, constants.ExternalAddresses)
+			req, err := http.NewRequest(http.MethodDelete, reqPath, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.DeleteSubscriptionExternalAddressesByName(c)
+			require.NoError(t, err)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
@@ -13,18 +13,19 @@ import (
 	"testing"
 
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
-	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -48,6 +49,7 @@ func TestTransmissionById(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("TransmissionById", trans.Id).Return(trans, nil)
+	dbClientMock.On("TransmissionSuppressedCounts", []string{trans.Id}).Return(map[string]int{trans.Id: 3}, nil)
 	dbClientMock.On("TransmissionById", notFoundId).Return(models.Transmission{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "transmission doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
@@ -101,6 +103,7 @@ func TestTransmissionById(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
 				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
 				assert.Equal(t, testCase.transmissionId, res.Transmission.Id, "ID is not as expected")
+				assert.Equal(t, 3, res.Transmission.Suppressed, "Suppressed is not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
 			}
 		})
@@ -193,6 +196,7 @@ func TestAllTransmissions(t *testing.T) {
 	dbClientMock.On("TransmissionTotalCount").Return(expectedTransmissionCount, nil)
 	dbClientMock.On("AllTransmissions", 0, 20).Return(transmissions, nil)
 	dbClientMock.On("AllTransmissions", 1, 2).Return([]models.Transmission{transmissions[1], transmissions[2]}, nil)
+	dbClientMock.On("TransmissionSuppressedCounts", mock.Anything).Return(map[string]int{}, nil)
 	dbClientMock.On("AllTransmissions", 4, 1).Return([]models.Transmission{}, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, "query objects bounds out of range.", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	contractsModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// ExternalAddress is the REST channel address of the subscription identified by the Host, Port and Path, which delivers
+// the notifications via the external channel, see models.ExternalAddress
+type ExternalAddress struct {
+	Host              string   `json:"host" validate:"required"`
+	Port              int      `json:"port" validate:"required"`
+	Path              string   `json:"path,omitempty"`
+	Kind              string   `json:"kind" validate:"oneof='Slack' 'Teams' 'SMS' 'Syslog' 'REST'"`
+	Recipients        []string `json:"recipients,omitempty" validate:"required_if=Kind SMS"`
+	From              string   `json:"from,omitempty"`
+	SecretName        string   `json:"secretName,omitempty"`
+	Network           string   `json:"network,omitempty" validate:"omitempty,oneof='udp' 'tcp' 'tls'"`
+	Facility          int      `json:"facility,omitempty" validate:"gte=0,lte=23"`
+	AppName           string   `json:"appName,omitempty"`
+	SigningSecretName string   `json:"signingSecretName,omitempty"`
+	TLSSecretName     string   `json:"tlsSecretName,omitempty"`
+}
+
+// SubscriptionExternalAddresses are the external addresses among the REST channels of the subscription, see
+// models.SubscriptionExternalAddresses
+type SubscriptionExternalAddresses struct {
+	Created          int64             `json:"created,omitempty"`
+	Modified         int64             `json:"modified,omitempty"`
+	SubscriptionName string            `json:"subscriptionName,omitempty"`
+	Addresses        []ExternalAddress `json:"addresses" validate:"required,gt=0,dive"`
+}
+
+// Validate satisfies the Validator interface
+func (s *SubscriptionExternalAddresses) Validate() error {
+	err := common.Validate(s)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid SubscriptionExternalAddresses.", err)
+	}
+	return nil
+}
+
+// ToExternalAddressModel transforms the ExternalAddress DTO to the ExternalAddress Model
+func ToExternalAddressModel(a ExternalAddress) models.ExternalAddress {
+	return models.ExternalAddress{
+		RESTAddress: contractsModels.RESTAddress{
+			BaseAddress: contractsModels.BaseAddress{Type: common.REST, Host: a.Host, Port: a.Port},
+			Path:        a.Path,
+		},
+		Kind:              a.Kind,
+		Recipients:        a.Recipients,
+		From:              a.From,
+		SecretName:        a.SecretName,
+		Network:           a.Network,
+		Facility:          a.Facility,
+		AppName:           a.AppName,
+		SigningSecretName: a.SigningSecretName,
+		TLSSecretName:     a.TLSSecretName,
+	}
+}
+
+// FromExternalAddressModelToDTO transforms the ExternalAddress Model to the ExternalAddress DTO
+func FromExternalAddressModelToDTO(a models.ExternalAddress) ExternalAddress {
+	return ExternalAddress{
+		Host:              a.Host,
+		Port:              a.Port,
+		Path:              a.Path,
+		Kind:              a.Kind,
+		Recipients:        a.Recipients,
+		From:              a.From,
+		SecretName:        a.SecretName,
+		Network:           a.Network,
+		Facility:          a.Facility,
+		AppName:           a.AppName,
+		SigningSecretName: a.SigningSecretName,
+		TLSSecretName:     a.TLSSecretName,
+	}
+}
+
+// ToSubscriptionExternalAddressesModel transforms the SubscriptionExternalAddresses DTO to the
+// SubscriptionExternalAddresses Model
+func ToSubscriptionExternalAddressesModel(s SubscriptionExternalAddresses) models.SubscriptionExternalAddresses {
+	addresses := make([]models.ExternalAddress, len(s.Addresses))
+	for i, a := range s.Addresses {
+		addresses[i] = ToExternalAddressModel(a)
+	}
+	return models.SubscriptionExternalAddresses{
+		SubscriptionName: s.SubscriptionName,
+		Addresses:        addresses,
+	}
+}
+
+// FromSubscriptionExternalAddressesModelToDTO transforms the SubscriptionExternalAddresses Model to the
+// SubscriptionExternalAddresses DTO
+func FromSubscriptionExternalAddressesModelToDTO(s models.SubscriptionExternalAddresses) SubscriptionExternalAddresses {
+	addresses := make([]ExternalAddress, len(s.Addresses))
+	for i, a := range s.Addresses {
+		addresses[i] = FromExternalAddressModelToDTO(a)
+	}
+	return SubscriptionExternalAddresses{
+		Created:          s.Created,
+		Modified:         s.Modified,
+		SubscriptionName: s.SubscriptionName,
+		Addresses:        addresses,
+	}
+}
@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/template"
+)
+
+// NotificationTemplate renders the subject and body of the notifications sent to the bound channels, see models.NotificationTemplate
+type NotificationTemplate struct {
+	Id          string            `json:"id,omitempty" validate:"omitempty,uuid"`
+	Created     int64             `json:"created,omitempty"`
+	Modified    int64             `json:"modified,omitempty"`
+	Name        string            `json:"name" validate:"edgex-dto-none-empty-string"`
+	Description string            `json:"description,omitempty"`
+	Subject     string            `json:"subject,omitempty"`
+	Body        string            `json:"body" validate:"required"`
+	ContentType string            `json:"contentType,omitempty"`
+	Bindings    []TemplateBinding `json:"bindings,omitempty" validate:"dive"`
+}
+
+// TemplateBinding binds the template to all the channels of the subscription, or the channels of the given type
+type TemplateBinding struct {
+	SubscriptionName string `json:"subscriptionName" validate:"edgex-dto-none-empty-string"`
+	ChannelType      string `json:"channelType,omitempty" validate:"omitempty,oneof='REST' 'EMAIL' 'MQTT' 'ZeroMQ'"`
+}
+
+// UpdateNotificationTemplate defines the fields of the NotificationTemplate to be patched, which is located by id or name
+type UpdateNotificationTemplate struct {
+	Id          *string           `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	Name        *string           `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
+	Description *string           `json:"description"`
+	Subject     *string           `json:"subject"`
+	Body        *string           `json:"body" validate:"omitempty,min=1"`
+	ContentType *string           `json:"contentType"`
+	Bindings    []TemplateBinding `json:"bindings" validate:"dive"`
+}
+
+// Validate satisfies the Validator interface
+func (t *NotificationTemplate) Validate() error {
+	err := common.Validate(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid NotificationTemplate.", err)
+	}
+	return template.Validate(t.Subject, t.Body)
+}
+
+// Validate satisfies the Validator interface
+func (t *UpdateNotificationTemplate) Validate() error {
+	err := common.Validate(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid UpdateNotificationTemplate.", err)
+	}
+	var subject, body string
+	if t.Subject != nil {
+		subject = *t.Subject
+	}
+	if t.Body != nil {
+		body = *t.Body
+	}
+	return template.Validate(subject, body)
+}
+
+// ToNotificationTemplateModel transforms the NotificationTemplate DTO to the NotificationTemplate Model
+func ToNotificationTemplateModel(t NotificationTemplate) models.NotificationTemplate {
+	return models.NotificationTemplate{
+		Id:          t.Id,
+		Name:        t.Name,
+		Description: t.Description,
+		Subject:     t.Subject,
+		Body:        t.Body,
+		ContentType: t.ContentType,
+		Bindings:    ToTemplateBindingModels(t.Bindings),
+	}
+}
+
+// FromNotificationTemplateModelToDTO transforms the NotificationTemplate Model to the NotificationTemplate DTO
+func FromNotificationTemplateModelToDTO(t models.NotificationTemplate) NotificationTemplate {
+	return NotificationTemplate{
+		Id:          t.Id,
+		Created:     t.Created,
+		Modified:    t.Modified,
+		Name:        t.Name,
+		Description: t.Description,
+		Subject:     t.Subject,
+		Body:        t.Body,
+		ContentType: t.ContentType,
+		Bindings:    FromTemplateBindingModelsToDTOs(t.Bindings),
+	}
+}
+
+// FromNotificationTemplateModelsToDTOs transforms the NotificationTemplate Models to the NotificationTemplate DTOs
+func FromNotificationTemplateModelsToDTOs(templates []models.NotificationTemplate) []NotificationTemplate {
+	dtos := make([]NotificationTemplate, len(templates))
+	for i, t := range templates {
+		dtos[i] = FromNotificationTemplateModelToDTO(t)
+	}
+	return dtos
+}
+
+// ToTemplateBindingModels transforms the TemplateBinding DTOs to the TemplateBinding Models
+func ToTemplateBindingModels(bindings []TemplateBinding) []models.TemplateBinding {
+	if bindings == nil {
+		return nil
+	}
+	bindingModels := make([]models.TemplateBinding, len(bindings))
+	for i, b := range bindings {
+		bindingModels[i] = models.TemplateBinding{SubscriptionName: b.SubscriptionName, ChannelType: b.ChannelType}
+	}
+	return bindingModels
+}
+
+// FromTemplateBindingModelsToDTOs transforms the TemplateBinding Models to the TemplateBinding DTOs
+func FromTemplateBindingModelsToDTOs(bindings []models.TemplateBinding) []TemplateBinding {
+	if bindings == nil {
+		return nil
+	}
+	dtos := make([]TemplateBinding, len(bindings))
+	for i, b := range bindings {
+		dtos[i] = TemplateBinding{SubscriptionName: b.SubscriptionName, ChannelType: b.ChannelType}
+	}
+	return dtos
+}
@@ -0,0 +1,50 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// SetSubscriptionExternalAddressesRequest defines the Request Content for PUT SubscriptionExternalAddresses DTO of a subscription.
+type SetSubscriptionExternalAddressesRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	ExternalAddresses     dtos.SubscriptionExternalAddresses `json:"externalAddresses"`
+}
+
+// Validate satisfies the Validator interface
+func (s *SetSubscriptionExternalAddressesRequest) Validate() error {
+	err := common.Validate(s)
+	if err != nil {
+		return err
+	}
+	return s.ExternalAddresses.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the SetSubscriptionExternalAddressesRequest type
+func (s *SetSubscriptionExternalAddressesRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		ExternalAddresses dtos.SubscriptionExternalAddresses
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*s = SetSubscriptionExternalAddressesRequest(alias)
+
+	// validate SetSubscriptionExternalAddressesRequest DTO
+	if err := s.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
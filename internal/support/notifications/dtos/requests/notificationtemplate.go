@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	contractDTOs "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddNotificationTemplateRequest defines the Request Content for POST NotificationTemplate DTO.
+type AddNotificationTemplateRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	NotificationTemplate  dtos.NotificationTemplate `json:"notificationTemplate"`
+}
+
+// Validate satisfies the Validator interface
+func (a *AddNotificationTemplateRequest) Validate() error {
+	err := common.Validate(a)
+	if err != nil {
+		return err
+	}
+	return a.NotificationTemplate.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddNotificationTemplateRequest type
+func (a *AddNotificationTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		NotificationTemplate dtos.NotificationTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*a = AddNotificationTemplateRequest(alias)
+
+	// validate AddNotificationTemplateRequest DTO
+	if err := a.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// UpdateNotificationTemplateRequest defines the Request Content for PATCH NotificationTemplate DTO.
+type UpdateNotificationTemplateRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	NotificationTemplate  dtos.UpdateNotificationTemplate `json:"notificationTemplate"`
+}
+
+// Validate satisfies the Validator interface
+func (u *UpdateNotificationTemplateRequest) Validate() error {
+	err := common.Validate(u)
+	if err != nil {
+		return err
+	}
+	return u.NotificationTemplate.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateNotificationTemplateRequest type
+func (u *UpdateNotificationTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		NotificationTemplate dtos.UpdateNotificationTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*u = UpdateNotificationTemplateRequest(alias)
+
+	// validate UpdateNotificationTemplateRequest DTO
+	if err := u.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// ReplaceNotificationTemplateModelFieldsWithDTO replace existing NotificationTemplate's fields with DTO patch
+func ReplaceNotificationTemplateModelFieldsWithDTO(template *models.NotificationTemplate, patch dtos.UpdateNotificationTemplate) {
+	if patch.Description != nil {
+		template.Description = *patch.Description
+	}
+	if patch.Subject != nil {
+		template.Subject = *patch.Subject
+	}
+	if patch.Body != nil {
+		template.Body = *patch.Body
+	}
+	if patch.ContentType != nil {
+		template.ContentType = *patch.ContentType
+	}
+	if patch.Bindings != nil {
+		template.Bindings = dtos.ToTemplateBindingModels(patch.Bindings)
+	}
+}
+
+// RenderNotificationTemplateRequest defines the Request Content for rendering the stored template of TemplateName, or the
+// unsaved Template, with the sample Notification as if it were sent to the channel of the given type of the subscription.
+type RenderNotificationTemplateRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	TemplateName          string                     `json:"templateName,omitempty" validate:"required_without=Template"`
+	Template              *dtos.NotificationTemplate `json:"template,omitempty" validate:"required_without=TemplateName,excluded_with=TemplateName"`
+	Notification          contractDTOs.Notification  `json:"notification"`
+	SubscriptionName      string                     `json:"subscriptionName,omitempty"`
+	ChannelType           string                     `json:"channelType,omitempty" validate:"omitempty,oneof='REST' 'EMAIL' 'MQTT' 'ZeroMQ'"`
+}
+
+// Validate satisfies the Validator interface
+func (r *RenderNotificationTemplateRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid RenderNotificationTemplateRequest.", err)
+	}
+	if r.Template != nil {
+		return r.Template.Validate()
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the RenderNotificationTemplateRequest type
+func (r *RenderNotificationTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		TemplateName     string
+		Template         *dtos.NotificationTemplate
+		Notification     contractDTOs.Notification
+		SubscriptionName string
+		ChannelType      string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = RenderNotificationTemplateRequest(alias)
+
+	// validate RenderNotificationTemplateRequest DTO
+	if err := r.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
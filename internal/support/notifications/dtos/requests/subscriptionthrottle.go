@@ -0,0 +1,50 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// SetSubscriptionThrottleRequest defines the Request Content for PUT SubscriptionThrottle DTO of a subscription.
+type SetSubscriptionThrottleRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Throttle              dtos.SubscriptionThrottle `json:"throttle"`
+}
+
+// Validate satisfies the Validator interface
+func (s *SetSubscriptionThrottleRequest) Validate() error {
+	err := common.Validate(s)
+	if err != nil {
+		return err
+	}
+	return s.Throttle.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the SetSubscriptionThrottleRequest type
+func (s *SetSubscriptionThrottleRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Throttle dtos.SubscriptionThrottle
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*s = SetSubscriptionThrottleRequest(alias)
+
+	// validate SetSubscriptionThrottleRequest DTO
+	if err := s.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
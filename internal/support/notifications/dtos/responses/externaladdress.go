@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// SubscriptionExternalAddressesResponse defines the Response Content for GET SubscriptionExternalAddresses DTO.
+type SubscriptionExternalAddressesResponse struct {
+	common.BaseResponse `json:",inline"`
+	ExternalAddresses   dtos.SubscriptionExternalAddresses `json:"externalAddresses"`
+}
+
+func NewSubscriptionExternalAddressesResponse(requestId string, message string, statusCode int, externalAddresses dtos.SubscriptionExternalAddresses) SubscriptionExternalAddressesResponse {
+	return SubscriptionExternalAddressesResponse{
+		BaseResponse:      common.NewBaseResponse(requestId, message, statusCode),
+		ExternalAddresses: externalAddresses,
+	}
+}
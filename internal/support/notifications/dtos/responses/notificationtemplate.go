@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// NotificationTemplateResponse defines the Response Content for GET NotificationTemplate DTO.
+type NotificationTemplateResponse struct {
+	common.BaseResponse  `json:",inline"`
+	NotificationTemplate dtos.NotificationTemplate `json:"notificationTemplate"`
+}
+
+func NewNotificationTemplateResponse(requestId string, message string, statusCode int, template dtos.NotificationTemplate) NotificationTemplateResponse {
+	return NotificationTemplateResponse{
+		BaseResponse:         common.NewBaseResponse(requestId, message, statusCode),
+		NotificationTemplate: template,
+	}
+}
+
+// MultiNotificationTemplatesResponse defines the Response Content for GET multiple NotificationTemplate DTOs.
+type MultiNotificationTemplatesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	NotificationTemplates             []dtos.NotificationTemplate `json:"notificationTemplates"`
+}
+
+func NewMultiNotificationTemplatesResponse(requestId string, message string, statusCode int, totalCount uint32, templates []dtos.NotificationTemplate) MultiNotificationTemplatesResponse {
+	return MultiNotificationTemplatesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		NotificationTemplates:      templates,
+	}
+}
+
+// RenderNotificationTemplateResponse defines the Response Content of the rendered NotificationTemplate.
+type RenderNotificationTemplateResponse struct {
+	common.BaseResponse `json:",inline"`
+	Subject             string `json:"subject,omitempty"`
+	Body                string `json:"body"`
+	ContentType         string `json:"contentType,omitempty"`
+}
+
+func NewRenderNotificationTemplateResponse(requestId string, message string, statusCode int, subject, body, contentType string) RenderNotificationTemplateResponse {
+	return RenderNotificationTemplateResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Subject:      subject,
+		Body:         body,
+		ContentType:  contentType,
+	}
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// SubscriptionThrottleResponse defines the Response Content for GET SubscriptionThrottle DTO.
+type SubscriptionThrottleResponse struct {
+	common.BaseResponse `json:",inline"`
+	Throttle            dtos.SubscriptionThrottle `json:"throttle"`
+}
+
+func NewSubscriptionThrottleResponse(requestId string, message string, statusCode int, throttle dtos.SubscriptionThrottle) SubscriptionThrottleResponse {
+	return SubscriptionThrottleResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Throttle:     throttle,
+	}
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// SubscriptionThrottle limits the notifications transmitted to the subscription, see models.SubscriptionThrottle
+type SubscriptionThrottle struct {
+	Created          int64  `json:"created,omitempty"`
+	Modified         int64  `json:"modified,omitempty"`
+	SubscriptionName string `json:"subscriptionName,omitempty"`
+	RateLimit        int    `json:"rateLimit,omitempty" validate:"gte=0"`
+	RateInterval     string `json:"rateInterval,omitempty" validate:"required_with=RateLimit,omitempty,edgex-dto-duration"`
+	DedupWindow      string `json:"dedupWindow,omitempty" validate:"omitempty,edgex-dto-duration"`
+	Digest           bool   `json:"digest,omitempty"`
+	DigestInterval   string `json:"digestInterval,omitempty" validate:"required_if=Digest true,omitempty,edgex-dto-duration"`
+}
+
+// Validate satisfies the Validator interface
+func (t *SubscriptionThrottle) Validate() error {
+	err := common.Validate(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid SubscriptionThrottle.", err)
+	}
+	return nil
+}
+
+// ToSubscriptionThrottleModel transforms the SubscriptionThrottle DTO to the SubscriptionThrottle Model
+func ToSubscriptionThrottleModel(t SubscriptionThrottle) models.SubscriptionThrottle {
+	return models.SubscriptionThrottle{
+		SubscriptionName: t.SubscriptionName,
+		RateLimit:        t.RateLimit,
+		RateInterval:     t.RateInterval,
+		DedupWindow:      t.DedupWindow,
+		Digest:           t.Digest,
+		DigestInterval:   t.DigestInterval,
+	}
+}
+
+// FromSubscriptionThrottleModelToDTO transforms the SubscriptionThrottle Model to the SubscriptionThrottle DTO
+func FromSubscriptionThrottleModelToDTO(t models.SubscriptionThrottle) SubscriptionThrottle {
+	return SubscriptionThrottle{
+		Created:          t.Created,
+		Modified:         t.Modified,
+		SubscriptionName: t.SubscriptionName,
+		RateLimit:        t.RateLimit,
+		RateInterval:     t.RateInterval,
+		DedupWindow:      t.DedupWindow,
+		Digest:           t.Digest,
+		DigestInterval:   t.DigestInterval,
+	}
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	contractDTOs "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// Transmission is the Transmission DTO with the number of the notifications suppressed by the subscription throttle and
+// reported by the transmission, which are either dropped before the notification or summarized by the digest notification
+type Transmission struct {
+	contractDTOs.Transmission `json:",inline"`
+	Suppressed                int `json:"suppressed,omitempty"`
+}
+
+// FromTransmissionModelToDTO transforms the Transmission Model to the Transmission DTO reporting the suppressed notifications
+func FromTransmissionModelToDTO(trans models.Transmission, suppressed int) Transmission {
+	return Transmission{
+		Transmission: contractDTOs.FromTransmissionModelToDTO(trans),
+		Suppressed:   suppressed,
+	}
+}
+
+// FromTransmissionModelsToDTOs transforms the Transmission Models to the Transmission DTOs reporting the suppressed
+// notifications, which are counted by the transmission id
+func FromTransmissionModelsToDTOs(transmissions []models.Transmission, suppressed map[string]int) []Transmission {
+	dtos := make([]Transmission, len(transmissions))
+	for i, trans := range transmissions {
+		dtos[i] = FromTransmissionModelToDTO(trans, suppressed[trans.Id])
+	}
+	return dtos
+}
@@ -25,3 +25,48 @@ CREATE TABLE IF NOT EXISTS support_notifications.transmission (
         REFERENCES support_notifications.notification(id)
         ON DELETE CASCADE
 );
+
+-- support_notifications.transmission_job is used to store the queued transmissions waiting to be sent or resent
+CREATE TABLE IF NOT EXISTS support_notifications.transmission_job (
+    id UUID PRIMARY KEY,
+    notification_id UUID NOT NULL,
+    next_attempt_at timestamp NOT NULL,
+    content JSONB NOT NULL,
+    created timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    CONSTRAINT fk_notification
+        FOREIGN KEY(notification_id)
+        REFERENCES support_notifications.notification(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_transmission_job_next_attempt_at
+    ON support_notifications.transmission_job(next_attempt_at);
+
+-- support_notifications.notification_template is used to store the templates rendering the notifications sent to the subscription channels
+CREATE TABLE IF NOT EXISTS support_notifications.notification_template (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL
+);
+
+-- support_notifications.transmission_suppression is used to store the number of the notifications suppressed by the
+-- subscription throttle and reported by the transmission
+CREATE TABLE IF NOT EXISTS support_notifications.transmission_suppression (
+    transmission_id UUID PRIMARY KEY,
+    suppressed INTEGER NOT NULL,
+    CONSTRAINT fk_transmission
+        FOREIGN KEY(transmission_id)
+        REFERENCES support_notifications.transmission(id)
+        ON DELETE CASCADE
+);
+
+-- support_notifications.subscription_throttle is used to store the throttle limiting the notifications transmitted to the subscription
+CREATE TABLE IF NOT EXISTS support_notifications.subscription_throttle (
+    subscription_name TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
+
+-- support_notifications.subscription_external_address is used to store the external addresses among the REST channels of the subscription
+CREATE TABLE IF NOT EXISTS support_notifications.subscription_external_address (
+    subscription_name TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
@@ -9,6 +9,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 )
 
 type DBClient interface {
@@ -66,4 +68,30 @@ type DBClient interface {
 	TransmissionCountByTimeRange(start int64, end int64) (uint32, errors.EdgeX)
 	TransmissionsByNotificationId(offset, limit int, id string) ([]models.Transmission, errors.EdgeX)
 	TransmissionCountByNotificationId(id string) (uint32, errors.EdgeX)
+	TransmissionSuppressedCounts(ids []string) (map[string]int, errors.EdgeX)
+
+	AddTransmissionJob(job notificationModels.TransmissionJob) (notificationModels.TransmissionJob, errors.EdgeX)
+	UpdateTransmissionJob(job notificationModels.TransmissionJob) errors.EdgeX
+	AddTransmissionOfJob(job notificationModels.TransmissionJob) (notificationModels.TransmissionJob, errors.EdgeX)
+	DeleteTransmissionJobById(id string) errors.EdgeX
+	AllTransmissionJobs(offset, limit int) ([]notificationModels.TransmissionJob, errors.EdgeX)
+	DueTransmissionJobs(end int64, limit int) ([]notificationModels.TransmissionJob, errors.EdgeX)
+
+	SetSubscriptionThrottle(throttle notificationModels.SubscriptionThrottle) (notificationModels.SubscriptionThrottle, errors.EdgeX)
+	SubscriptionThrottleByName(subscriptionName string) (notificationModels.SubscriptionThrottle, errors.EdgeX)
+	AllSubscriptionThrottles() ([]notificationModels.SubscriptionThrottle, errors.EdgeX)
+	DeleteSubscriptionThrottleByName(subscriptionName string) errors.EdgeX
+
+	SetSubscriptionExternalAddresses(addresses notificationModels.SubscriptionExternalAddresses) (notificationModels.SubscriptionExternalAddresses, errors.EdgeX)
+	SubscriptionExternalAddressesByName(subscriptionName string) (notificationModels.SubscriptionExternalAddresses, errors.EdgeX)
+	AllSubscriptionExternalAddresses() ([]notificationModels.SubscriptionExternalAddresses, errors.EdgeX)
+	DeleteSubscriptionExternalAddressesByName(subscriptionName string) errors.EdgeX
+
+	AddNotificationTemplate(template notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX)
+	AllNotificationTemplates(offset, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX)
+	NotificationTemplateTotalCount() (uint32, errors.EdgeX)
+	NotificationTemplateById(id string) (notificationModels.NotificationTemplate, errors.EdgeX)
+	NotificationTemplateByName(name string) (notificationModels.NotificationTemplate, errors.EdgeX)
+	UpdateNotificationTemplate(template notificationModels.NotificationTemplate) errors.EdgeX
+	DeleteNotificationTemplateByName(name string) errors.EdgeX
 }
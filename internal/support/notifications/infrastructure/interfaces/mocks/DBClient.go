@@ -1,4 +1,4 @@
-// Code generated by mockery v2.45.0. DO NOT EDIT.
+// Code generated by mockery v2.49.1. DO NOT EDIT.
 
 package mocks
 
@@ -9,6 +9,8 @@ import (
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	notificationsmodels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
 	requests "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 )
 
@@ -47,6 +49,36 @@ func (_m *DBClient) AddNotification(n models.Notification) (models.Notification,
 	return r0, r1
 }
 
+// AddNotificationTemplate provides a mock function with given fields: template
+func (_m *DBClient) AddNotificationTemplate(template notificationsmodels.NotificationTemplate) (notificationsmodels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNotificationTemplate")
+	}
+
+	var r0 notificationsmodels.NotificationTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.NotificationTemplate) (notificationsmodels.NotificationTemplate, errors.EdgeX)); ok {
+		return rf(template)
+	}
+	if rf, ok := ret.Get(0).(func(notificationsmodels.NotificationTemplate) notificationsmodels.NotificationTemplate); ok {
+		r0 = rf(template)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.NotificationTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationsmodels.NotificationTemplate) errors.EdgeX); ok {
+		r1 = rf(template)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddSubscription provides a mock function with given fields: e
 func (_m *DBClient) AddSubscription(e models.Subscription) (models.Subscription, errors.EdgeX) {
 	ret := _m.Called(e)
@@ -107,6 +139,162 @@ func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmiss
 	return r0, r1
 }
 
+// AddTransmissionJob provides a mock function with given fields: job
+func (_m *DBClient) AddTransmissionJob(job notificationsmodels.TransmissionJob) (notificationsmodels.TransmissionJob, errors.EdgeX) {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTransmissionJob")
+	}
+
+	var r0 notificationsmodels.TransmissionJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.TransmissionJob) (notificationsmodels.TransmissionJob, errors.EdgeX)); ok {
+		return rf(job)
+	}
+	if rf, ok := ret.Get(0).(func(notificationsmodels.TransmissionJob) notificationsmodels.TransmissionJob); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.TransmissionJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationsmodels.TransmissionJob) errors.EdgeX); ok {
+		r1 = rf(job)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddTransmissionOfJob provides a mock function with given fields: job
+func (_m *DBClient) AddTransmissionOfJob(job notificationsmodels.TransmissionJob) (notificationsmodels.TransmissionJob, errors.EdgeX) {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTransmissionOfJob")
+	}
+
+	var r0 notificationsmodels.TransmissionJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.TransmissionJob) (notificationsmodels.TransmissionJob, errors.EdgeX)); ok {
+		return rf(job)
+	}
+	if rf, ok := ret.Get(0).(func(notificationsmodels.TransmissionJob) notificationsmodels.TransmissionJob); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.TransmissionJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationsmodels.TransmissionJob) errors.EdgeX); ok {
+		r1 = rf(job)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllNotificationTemplates provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllNotificationTemplates(offset int, limit int) ([]notificationsmodels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllNotificationTemplates")
+	}
+
+	var r0 []notificationsmodels.NotificationTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]notificationsmodels.NotificationTemplate, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []notificationsmodels.NotificationTemplate); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.NotificationTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllSubscriptionExternalAddresses provides a mock function with given fields:
+func (_m *DBClient) AllSubscriptionExternalAddresses() ([]notificationsmodels.SubscriptionExternalAddresses, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllSubscriptionExternalAddresses")
+	}
+
+	var r0 []notificationsmodels.SubscriptionExternalAddresses
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() ([]notificationsmodels.SubscriptionExternalAddresses, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []notificationsmodels.SubscriptionExternalAddresses); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.SubscriptionExternalAddresses)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllSubscriptionThrottles provides a mock function with given fields:
+func (_m *DBClient) AllSubscriptionThrottles() ([]notificationsmodels.SubscriptionThrottle, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllSubscriptionThrottles")
+	}
+
+	var r0 []notificationsmodels.SubscriptionThrottle
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() ([]notificationsmodels.SubscriptionThrottle, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []notificationsmodels.SubscriptionThrottle); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.SubscriptionThrottle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllSubscriptions provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscription, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -139,6 +327,38 @@ func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscripti
 	return r0, r1
 }
 
+// AllTransmissionJobs provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllTransmissionJobs(offset int, limit int) ([]notificationsmodels.TransmissionJob, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllTransmissionJobs")
+	}
+
+	var r0 []notificationsmodels.TransmissionJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]notificationsmodels.TransmissionJob, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []notificationsmodels.TransmissionJob); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.TransmissionJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllTransmissions provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllTransmissions(offset int, limit int) ([]models.Transmission, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -236,6 +456,26 @@ func (_m *DBClient) DeleteNotificationByIds(ids []string) errors.EdgeX {
 	return r0
 }
 
+// DeleteNotificationTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteNotificationTemplateByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNotificationTemplateByName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteProcessedNotificationsByAge provides a mock function with given fields: age
 func (_m *DBClient) DeleteProcessedNotificationsByAge(age int64) errors.EdgeX {
 	ret := _m.Called(age)
@@ -296,6 +536,98 @@ func (_m *DBClient) DeleteSubscriptionByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteSubscriptionExternalAddressesByName provides a mock function with given fields: subscriptionName
+func (_m *DBClient) DeleteSubscriptionExternalAddressesByName(subscriptionName string) errors.EdgeX {
+	ret := _m.Called(subscriptionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubscriptionExternalAddressesByName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(subscriptionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteSubscriptionThrottleByName provides a mock function with given fields: subscriptionName
+func (_m *DBClient) DeleteSubscriptionThrottleByName(subscriptionName string) errors.EdgeX {
+	ret := _m.Called(subscriptionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubscriptionThrottleByName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(subscriptionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteTransmissionJobById provides a mock function with given fields: id
+func (_m *DBClient) DeleteTransmissionJobById(id string) errors.EdgeX {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTransmissionJobById")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DueTransmissionJobs provides a mock function with given fields: end, limit
+func (_m *DBClient) DueTransmissionJobs(end int64, limit int) ([]notificationsmodels.TransmissionJob, errors.EdgeX) {
+	ret := _m.Called(end, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DueTransmissionJobs")
+	}
+
+	var r0 []notificationsmodels.TransmissionJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, int) ([]notificationsmodels.TransmissionJob, errors.EdgeX)); ok {
+		return rf(end, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []notificationsmodels.TransmissionJob); ok {
+		r0 = rf(end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.TransmissionJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) errors.EdgeX); ok {
+		r1 = rf(end, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // LatestNotificationByOffset provides a mock function with given fields: offset
 func (_m *DBClient) LatestNotificationByOffset(offset uint32) (models.Notification, errors.EdgeX) {
 	ret := _m.Called(offset)
@@ -536,6 +868,96 @@ func (_m *DBClient) NotificationCountByTimeRange(start int64, end int64, ack str
 	return r0, r1
 }
 
+// NotificationTemplateById provides a mock function with given fields: id
+func (_m *DBClient) NotificationTemplateById(id string) (notificationsmodels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationTemplateById")
+	}
+
+	var r0 notificationsmodels.NotificationTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.NotificationTemplate, errors.EdgeX)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.NotificationTemplate); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.NotificationTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// NotificationTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) NotificationTemplateByName(name string) (notificationsmodels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationTemplateByName")
+	}
+
+	var r0 notificationsmodels.NotificationTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.NotificationTemplate, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.NotificationTemplate); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.NotificationTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// NotificationTemplateTotalCount provides a mock function with given fields:
+func (_m *DBClient) NotificationTemplateTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationTemplateTotalCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() (uint32, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // NotificationTotalCount provides a mock function with given fields:
 func (_m *DBClient) NotificationTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -758,6 +1180,66 @@ func (_m *DBClient) NotificationsByTimeRange(start int64, end int64, offset int,
 	return r0, r1
 }
 
+// SetSubscriptionExternalAddresses provides a mock function with given fields: addresses
+func (_m *DBClient) SetSubscriptionExternalAddresses(addresses notificationsmodels.SubscriptionExternalAddresses) (notificationsmodels.SubscriptionExternalAddresses, errors.EdgeX) {
+	ret := _m.Called(addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSubscriptionExternalAddresses")
+	}
+
+	var r0 notificationsmodels.SubscriptionExternalAddresses
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.SubscriptionExternalAddresses) (notificationsmodels.SubscriptionExternalAddresses, errors.EdgeX)); ok {
+		return rf(addresses)
+	}
+	if rf, ok := ret.Get(0).(func(notificationsmodels.SubscriptionExternalAddresses) notificationsmodels.SubscriptionExternalAddresses); ok {
+		r0 = rf(addresses)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.SubscriptionExternalAddresses)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationsmodels.SubscriptionExternalAddresses) errors.EdgeX); ok {
+		r1 = rf(addresses)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SetSubscriptionThrottle provides a mock function with given fields: throttle
+func (_m *DBClient) SetSubscriptionThrottle(throttle notificationsmodels.SubscriptionThrottle) (notificationsmodels.SubscriptionThrottle, errors.EdgeX) {
+	ret := _m.Called(throttle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSubscriptionThrottle")
+	}
+
+	var r0 notificationsmodels.SubscriptionThrottle
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.SubscriptionThrottle) (notificationsmodels.SubscriptionThrottle, errors.EdgeX)); ok {
+		return rf(throttle)
+	}
+	if rf, ok := ret.Get(0).(func(notificationsmodels.SubscriptionThrottle) notificationsmodels.SubscriptionThrottle); ok {
+		r0 = rf(throttle)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.SubscriptionThrottle)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationsmodels.SubscriptionThrottle) errors.EdgeX); ok {
+		r1 = rf(throttle)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // SubscriptionById provides a mock function with given fields: id
 func (_m *DBClient) SubscriptionById(id string) (models.Subscription, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -908,6 +1390,66 @@ func (_m *DBClient) SubscriptionCountByReceiver(receiver string) (uint32, errors
 	return r0, r1
 }
 
+// SubscriptionExternalAddressesByName provides a mock function with given fields: subscriptionName
+func (_m *DBClient) SubscriptionExternalAddressesByName(subscriptionName string) (notificationsmodels.SubscriptionExternalAddresses, errors.EdgeX) {
+	ret := _m.Called(subscriptionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscriptionExternalAddressesByName")
+	}
+
+	var r0 notificationsmodels.SubscriptionExternalAddresses
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.SubscriptionExternalAddresses, errors.EdgeX)); ok {
+		return rf(subscriptionName)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.SubscriptionExternalAddresses); ok {
+		r0 = rf(subscriptionName)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.SubscriptionExternalAddresses)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SubscriptionThrottleByName provides a mock function with given fields: subscriptionName
+func (_m *DBClient) SubscriptionThrottleByName(subscriptionName string) (notificationsmodels.SubscriptionThrottle, errors.EdgeX) {
+	ret := _m.Called(subscriptionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscriptionThrottleByName")
+	}
+
+	var r0 notificationsmodels.SubscriptionThrottle
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.SubscriptionThrottle, errors.EdgeX)); ok {
+		return rf(subscriptionName)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.SubscriptionThrottle); ok {
+		r0 = rf(subscriptionName)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.SubscriptionThrottle)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // SubscriptionTotalCount provides a mock function with given fields:
 func (_m *DBClient) SubscriptionTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -1216,6 +1758,38 @@ func (_m *DBClient) TransmissionCountByTimeRange(start int64, end int64) (uint32
 	return r0, r1
 }
 
+// TransmissionSuppressedCounts provides a mock function with given fields: ids
+func (_m *DBClient) TransmissionSuppressedCounts(ids []string) (map[string]int, errors.EdgeX) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransmissionSuppressedCounts")
+	}
+
+	var r0 map[string]int
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]string) (map[string]int, errors.EdgeX)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[string]int); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) errors.EdgeX); ok {
+		r1 = rf(ids)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // TransmissionTotalCount provides a mock function with given fields:
 func (_m *DBClient) TransmissionTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -1414,6 +1988,26 @@ func (_m *DBClient) UpdateNotificationAckStatusByIds(ack bool, ids []string) err
 	return r0
 }
 
+// UpdateNotificationTemplate provides a mock function with given fields: template
+func (_m *DBClient) UpdateNotificationTemplate(template notificationsmodels.NotificationTemplate) errors.EdgeX {
+	ret := _m.Called(template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationTemplate")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.NotificationTemplate) errors.EdgeX); ok {
+		r0 = rf(template)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateSubscription provides a mock function with given fields: s
 func (_m *DBClient) UpdateSubscription(s models.Subscription) errors.EdgeX {
 	ret := _m.Called(s)
@@ -1454,6 +2048,26 @@ func (_m *DBClient) UpdateTransmission(trans models.Transmission) errors.EdgeX {
 	return r0
 }
 
+// UpdateTransmissionJob provides a mock function with given fields: job
+func (_m *DBClient) UpdateTransmissionJob(job notificationsmodels.TransmissionJob) errors.EdgeX {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTransmissionJob")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.TransmissionJob) errors.EdgeX); ok {
+		r0 = rf(job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDBClient(t interface {
@@ -54,6 +54,9 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	emailSender := channel.NewEmailSender(dic)
 	mqttSender := channel.NewMQTTSender(ctx, wg, dic)
 	zeroMQSender := channel.NewZeroMQSender(ctx, wg, dic)
+	chatWebhookSender := channel.NewChatWebhookSender(dic, restSender)
+	smsSender := channel.NewSMSSender(dic, bootstrapContainer.SecretProviderExtFrom(dic.Get))
+	syslogSender := channel.NewSyslogSender(dic, bootstrapContainer.SecretProviderExtFrom(dic.Get))
 	dic.Update(di.ServiceConstructorMap{
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
@@ -67,6 +70,15 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		channel.ZeroMQTSenderName: func(get di.Get) interface{} {
 			return zeroMQSender
 		},
+		channel.ChatWebhookSenderName: func(get di.Get) interface{} {
+			return chatWebhookSender
+		},
+		channel.SMSSenderName: func(get di.Get) interface{} {
+			return smsSender
+		},
+		channel.SyslogSenderName: func(get di.Get) interface{} {
+			return syslogSender
+		},
 	})
 
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -79,5 +91,28 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		}
 		application.AsyncPurgeNotification(retentionInterval, ctx, dic)
 	}
+	templateCache, err := application.LoadNotificationTemplateCache(dic)
+	if err != nil {
+		lc.Errorf("Failed to load the notification templates, %v", err)
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.NotificationTemplateCacheInterfaceName: func(get di.Get) interface{} {
+			return templateCache
+		},
+	})
+	if err = application.LoadSubscriptionThrottles(dic); err != nil {
+		lc.Errorf("Failed to load the subscription throttles, %v", err)
+		return false
+	}
+	if err = application.LoadSubscriptionExternalAddresses(dic); err != nil {
+		lc.Errorf("Failed to load the subscription external addresses, %v", err)
+		return false
+	}
+	if err := application.AsyncProcessTransmissionJobs(ctx, wg, dic); err != nil {
+		lc.Errorf("Failed to start the transmission queue, %v", err)
+		return false
+	}
+	application.AsyncFlushNotificationDigests(ctx, wg, dic)
 	return true
 }
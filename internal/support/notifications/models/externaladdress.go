@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// ExternalAddress is the REST address of a subscription channel which delivers the notifications via the external channel
+// of the Kind, i.e. the 'Slack' or 'Teams' chat webhook, the 'SMS' gateway or the 'Syslog' receiver, or as they are to
+// the 'REST' receiver. The subscriptions can only have the channel types defined by the contracts, so the external
+// channels are subscribed as the REST channels addressing the receivers, and the ExternalAddress carries the settings of
+// the receiver along with the REST address.
+type ExternalAddress struct {
+	models.RESTAddress
+	Kind string
+	// Recipients are the phone numbers the SMS gateway sends the notifications to
+	Recipients []string
+	// From is the sender phone number or name of the SMS messages, which is optional
+	From string
+	// SecretName is the name of the secret storing the SMS gateway credentials as 'username' and 'password' for the
+	// basic authentication or as 'token' for the bearer authentication, or the PEM encoded 'clientcert', 'clientkey' and
+	// 'cacert' of the syslog TLS transport, which is optional
+	SecretName string
+	// Network is the transport of the syslog messages, one of 'udp', 'tcp' and 'tls', and defaults to 'udp'
+	Network string
+	// Facility is the syslog facility code, and defaults to 1 (user-level messages)
+	Facility int
+	// AppName is the APP-NAME of the syslog messages, and defaults to the service key
+	AppName string
+	// SigningSecretName is the name of the secret storing the 'signingkey' which signs the REST requests to the receiver
+	// with HMAC-SHA256, which is optional
+	SigningSecretName string
+	// TLSSecretName is the name of the secret storing the PEM encoded client certificate and private key presented to the
+	// REST receiver as 'clientcert' and 'clientkey', and the CA certificate trusted in addition to the system CAs as
+	// 'cacert', which is optional and so is each of the secret data
+	TLSSecretName string
+}
+
+// Matches tells whether the ExternalAddress is the REST address, which is identified by the host, port and path
+func (a ExternalAddress) Matches(address models.RESTAddress) bool {
+	return a.Host == address.Host && a.Port == address.Port && a.Path == address.Path
+}
+
+// SubscriptionExternalAddresses are the external addresses among the REST channels of the subscription, and the other
+// REST channels receive the notifications as they are
+type SubscriptionExternalAddresses struct {
+	Created          int64
+	Modified         int64
+	SubscriptionName string
+	Addresses        []ExternalAddress
+}
@@ -0,0 +1,31 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// NotificationTemplate renders the subject and body of the notifications sent to the bound subscription channels from
+// the notification fields, e.g. category, severity, labels and content. The Subject and Body are Go text/template
+// templates, and the rendered Body replaces the notification content.
+type NotificationTemplate struct {
+	Id          string
+	Created     int64
+	Modified    int64
+	Name        string
+	Description string
+	Subject     string
+	Body        string
+	// ContentType replaces the content type of the notification if not empty
+	ContentType string
+	Bindings    []TemplateBinding
+}
+
+// TemplateBinding binds the template to the channels of a subscription, which are all the channels if ChannelType is
+// empty, or the channels of the given type, e.g. EMAIL. The binding with the channel type takes precedence. Bindings are
+// keyed by the channel type rather than the address, so all the addresses of the same type in a subscription share the
+// template, and each subscription and channel type pair is bound to one template at most.
+type TemplateBinding struct {
+	SubscriptionName string
+	ChannelType      string
+}
@@ -0,0 +1,22 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// SubscriptionThrottle limits the notifications transmitted to the subscription. A notification is suppressed if an
+// identical one, i.e. with the same category, labels and content, was transmitted within DedupWindow, or RateLimit
+// notifications were transmitted within RateInterval. The suppressed notifications are dropped, or summarized by one
+// digest notification per DigestInterval if Digest is enabled. The durations are in the format of the subscription
+// ResendInterval, and empty disables the check.
+type SubscriptionThrottle struct {
+	Created          int64
+	Modified         int64
+	SubscriptionName string
+	RateLimit        int
+	RateInterval     string
+	DedupWindow      string
+	Digest           bool
+	DigestInterval   string
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// TransmissionJob is a persisted unit of work of the transmission queue, which sends a notification to one channel of a
+// subscription. The job survives restarts until the transmission is sent, or escalated after exceeding the resend limit.
+type TransmissionJob struct {
+	Id       string
+	Created  int64
+	Modified int64
+	// Transmission is the transmission in progress, whose Id is empty until the notification is sent for the first time
+	Transmission models.Transmission
+	// NextAttemptAt is the timestamp in milliseconds of the next send attempt
+	NextAttemptAt int64
+	// Suppressed is the number of the notifications suppressed by the subscription throttle and reported by this
+	// transmission, which are either dropped before the notification or summarized by the digest notification
+	Suppressed int
+}
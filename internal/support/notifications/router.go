@@ -12,6 +12,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	notificationsController "github.com/edgexfoundry/edgex-go/internal/support/notifications/controller/http"
 
 	"github.com/labstack/echo/v4"
@@ -33,6 +34,12 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiSubscriptionByReceiverRoute, sc.SubscriptionsByReceiver, authenticationHook)
 	r.DELETE(common.ApiSubscriptionByNameRoute, sc.DeleteSubscriptionByName, authenticationHook)
 	r.PATCH(common.ApiSubscriptionRoute, sc.PatchSubscription, authenticationHook)
+	r.PUT(constants.ApiSubscriptionThrottleRoute, sc.SetSubscriptionThrottle, authenticationHook)
+	r.GET(constants.ApiSubscriptionThrottleRoute, sc.SubscriptionThrottleByName, authenticationHook)
+	r.DELETE(constants.ApiSubscriptionThrottleRoute, sc.DeleteSubscriptionThrottleByName, authenticationHook)
+	r.PUT(constants.ApiExternalAddressesRoute, sc.SetSubscriptionExternalAddresses, authenticationHook)
+	r.GET(constants.ApiExternalAddressesRoute, sc.SubscriptionExternalAddressesByName, authenticationHook)
+	r.DELETE(constants.ApiExternalAddressesRoute, sc.DeleteSubscriptionExternalAddressesByName, authenticationHook)
 
 	// Notification
 	nc := notificationsController.NewNotificationController(dic)
@@ -61,4 +68,13 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.DELETE(common.ApiTransmissionByAgeRoute, trans.DeleteProcessedTransmissionsByAge, authenticationHook)
 	r.GET(common.ApiTransmissionBySubscriptionNameRoute, trans.TransmissionsBySubscriptionName, authenticationHook)
 	r.GET(common.ApiTransmissionByNotificationIdRoute, trans.TransmissionsByNotificationId, authenticationHook)
+
+	// Notification Template
+	tc := notificationsController.NewNotificationTemplateController(dic)
+	r.POST(constants.ApiNotificationTemplateRoute, tc.AddNotificationTemplate, authenticationHook)
+	r.PATCH(constants.ApiNotificationTemplateRoute, tc.PatchNotificationTemplate, authenticationHook)
+	r.GET(constants.ApiAllNotificationTemplateRoute, tc.AllNotificationTemplates, authenticationHook)
+	r.GET(constants.ApiNotificationTemplateByNameRoute, tc.NotificationTemplateByName, authenticationHook)
+	r.DELETE(constants.ApiNotificationTemplateByNameRoute, tc.DeleteNotificationTemplateByName, authenticationHook)
+	r.POST(constants.ApiRenderNotificationTemplateRoute, tc.RenderNotificationTemplate, authenticationHook)
 }
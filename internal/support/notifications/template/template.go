@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"fmt"
+	"strings"
+	textTemplate "text/template"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// funcs are the functions available to the templates in addition to the text/template builtins
+var funcs = textTemplate.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	// timestamp formats the timestamp in milliseconds as RFC3339 in UTC
+	"timestamp": func(millis int64) string {
+		return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+	},
+}
+
+// Data is what the templates are rendered with, i.e. the notification fields and where the notification is sent to
+type Data struct {
+	Id               string
+	Created          int64
+	Category         string
+	Labels           []string
+	Content          string
+	ContentType      string
+	Description      string
+	Sender           string
+	Severity         string
+	Status           string
+	SubscriptionName string
+	ChannelType      string
+}
+
+// NewData returns the Data of the notification sent to the channel of the given type of the subscription
+func NewData(n models.Notification, subscriptionName, channelType string) Data {
+	return Data{
+		Id:               n.Id,
+		Created:          n.Created,
+		Category:         n.Category,
+		Labels:           n.Labels,
+		Content:          n.Content,
+		ContentType:      n.ContentType,
+		Description:      n.Description,
+		Sender:           n.Sender,
+		Severity:         string(n.Severity),
+		Status:           string(n.Status),
+		SubscriptionName: subscriptionName,
+		ChannelType:      channelType,
+	}
+}
+
+// Validate checks whether the subject and body of the template are well-formed
+func Validate(subject, body string) errors.EdgeX {
+	for _, field := range []struct {
+		name string
+		text string
+	}{{"subject", subject}, {"body", body}} {
+		if _, err := textTemplate.New(field.name).Funcs(funcs).Parse(field.text); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid template %s", field.name), err)
+		}
+	}
+	return nil
+}
+
+// Render renders the subject and body of the template with the data, the subject is empty if the template has none
+func Render(t notificationModels.NotificationTemplate, data Data) (subject string, body string, err errors.EdgeX) {
+	subject, err = render(t.Name+".subject", t.Subject, data)
+	if err != nil {
+		return "", "", errors.NewCommonEdgeXWrapper(err)
+	}
+	body, err = render(t.Name+".body", t.Body, data)
+	if err != nil {
+		return "", "", errors.NewCommonEdgeXWrapper(err)
+	}
+	return subject, body, nil
+}
+
+func render(name, text string, data Data) (string, errors.EdgeX) {
+	if text == "" {
+		return "", nil
+	}
+	tmpl, err := textTemplate.New(name).Funcs(funcs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse template %s", name), err)
+	}
+	var sb strings.Builder
+	if err = tmpl.Execute(&sb, data); err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to render template %s", name), err)
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestRender(t *testing.T) {
+	n := models.Notification{Category: "health-check", Labels: []string{"site1", "floor2"}, Content: "device down",
+		Severity: models.Critical}
+	n.Created = 1700000000000
+	data := NewData(n, "sub1", common.EMAIL)
+
+	tests := []struct {
+		name            string
+		subject         string
+		body            string
+		expectedSubject string
+		expectedBody    string
+		errorExpected   bool
+	}{
+		{"valid", "[{{.Severity}}] {{upper .Category}}", "{{.Content}} at {{timestamp .Created}} ({{join .Labels \", \"}}) via {{.ChannelType}}",
+			"[CRITICAL] HEALTH-CHECK", "device down at 2023-11-14T22:13:20Z (site1, floor2) via EMAIL", false},
+		{"valid - without subject", "", "{{lower .Severity}}", "", "critical", false},
+		{"invalid - unknown field", "", "{{.Unknown}}", "", "", true},
+		{"invalid - malformed", "{{.Category", "", "", "", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			subject, body, err := Render(notificationModels.NotificationTemplate{Name: "test", Subject: testCase.subject, Body: testCase.body}, data)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedSubject, subject)
+			assert.Equal(t, testCase.expectedBody, body)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("{{.Category}}", "{{join .Labels \",\"}}"))
+	assert.Error(t, Validate("{{.Category", ""))
+	assert.Error(t, Validate("", "{{unknownFunc .Content}}"))
+}